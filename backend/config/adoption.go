@@ -0,0 +1,61 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// AdoptionQuestion - question of the questionnaire of adoption applications.
+type AdoptionQuestion struct {
+	Key       string `json:"key"`
+	Text      string `json:"text"`
+	Required  bool   `json:"required"`
+	MaxLength int    `json:"max_length"`
+}
+
+// defaultAdoptionQuestionMaxLength - maximal length of the answer when the question doesn't set it.
+const defaultAdoptionQuestionMaxLength = 1000
+
+// defaultAdoptionQuestions - questionnaire used when no file is given.
+var defaultAdoptionQuestions = []AdoptionQuestion{
+	{Key: "housing", Text: "Где вы живёте: квартира или дом, своё или съёмное жильё?", Required: true},
+	{Key: "household", Text: "Кто живёт с вами? Есть ли дети, другие животные, аллергии?", Required: true},
+	{Key: "experience", Text: "Были ли у вас животные раньше? Что с ними стало?", Required: true},
+	{Key: "alone_time", Text: "Сколько времени в день животное будет оставаться одно?", Required: false},
+	{Key: "vet_care", Text: "Готовы ли вы к расходам на ветеринара, вакцинацию и стерилизацию?", Required: true},
+	{Key: "contact", Text: "Как с вами удобнее связаться?", Required: true},
+}
+
+// loadAdoptionQuestions reads questionnaire from JSON file with array of questions, default questionnaire is used for empty path.
+func loadAdoptionQuestions(path string) ([]AdoptionQuestion, error) {
+	questions := defaultAdoptionQuestions
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read adoption questionnaire: %w", err)
+		}
+
+		questions = nil
+		if err := json.Unmarshal(data, &questions); err != nil {
+			return nil, fmt.Errorf("parse adoption questionnaire: %w", err)
+		}
+	}
+
+	keys := make(map[string]bool, len(questions))
+	result := make([]AdoptionQuestion, len(questions))
+	for i, question := range questions {
+		if question.Key == "" || question.Text == "" || keys[question.Key] {
+			return nil, fmt.Errorf("invalid adoption question %q: key must be unique and text must be set", question.Key)
+		}
+		keys[question.Key] = true
+
+		if question.MaxLength <= 0 {
+			question.MaxLength = defaultAdoptionQuestionMaxLength
+		}
+		result[i] = question
+	}
+
+	return result, nil
+}
@@ -2,6 +2,11 @@ package config
 
 import (
 	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/kotopesp/sos-kotopes/pkg/logger"
 )
 
@@ -13,6 +18,14 @@ type (
 		TLS
 		Auth
 		CORS
+		Report
+		ContentFilter
+		Media
+		Adoption
+		Post
+		Comment
+		Feed
+		Worker
 	}
 
 	HTTP struct {
@@ -44,6 +57,71 @@ type (
 	CORS struct {
 		AllowedOrigins string
 	}
+
+	Report struct {
+		DefaultThreshold   float64
+		Thresholds         map[string]map[string]float64
+		NewAccountAge      time.Duration
+		NewAccountWeight   float64
+		MinReportWeight    float64
+		MaxReportWeight    float64
+		TrustedMinUpheld   int
+		TrustedMinAccuracy float64
+	}
+
+	ContentFilter struct {
+		StopWords        []string
+		MaxLinks         int
+		MaxPhones        int
+		RateWindow       time.Duration
+		PostRateLimit    int
+		CommentRateLimit int
+	}
+
+	Media struct {
+		Storage        string // filesystem or s3
+		Dir            string
+		URL            string
+		S3Endpoint     string
+		S3Region       string
+		S3Bucket       string
+		S3AccessKey    string
+		S3SecretKey    string
+		S3PublicURL    string
+		MaxPostPhotos  int
+		MaxPhotoSize   int64
+		MaxPhotoPixels int
+		MediumSize     int
+		ThumbnailSize  int
+		Quality        int
+	}
+
+	Adoption struct {
+		Questions []AdoptionQuestion
+	}
+
+	Post struct {
+		Lifetime       time.Duration
+		ExpiryReminder time.Duration
+		BumpInterval   time.Duration
+	}
+
+	Comment struct {
+		EditWindow time.Duration
+	}
+
+	Feed struct {
+		Radius               float64
+		NearbyWeight         float64
+		PreferencesWeight    float64
+		FavouriteUsersWeight float64
+		InteractionsWeight   float64
+		HalfLife             time.Duration
+	}
+
+	Worker struct {
+		Interval time.Duration
+	}
 )
 
 // NewConfig returns app config.
@@ -60,9 +138,94 @@ func NewConfig() (*Config, error) {
 	accessTokenLifetime := flag.Int("access_token_lifetime", 10, "access token lifetime in minutes")
 	refreshTokenLifetime := flag.Int("refresh_token_lifetime", 43800, "refresh token lifetime in minutes")
 	frontendOrigins := flag.String("frontend_allowed_origins", "http://localhost:4200", "comma separated list of origins for the frontend")
+	reportDefaultThreshold := flag.Float64("report_default_threshold", 15, "weighted amount of reports that moves content to moderation")
+	reportThresholds := flag.String("report_thresholds", "post:violent_content=5,post:violent_speech=8,comment:violent_content=5,comment:violent_speech=8", "comma separated list of thresholds in format type:reason=threshold")
+	reportNewAccountAge := flag.Duration("report_new_account_age", 7*24*time.Hour, "accounts younger than this are treated as new by reports weighting")
+	reportNewAccountWeight := flag.Float64("report_new_account_weight", 0.5, "multiplier for reports made from new accounts")
+	reportMinWeight := flag.Float64("report_min_weight", 0.25, "minimal weight of a single report")
+	reportMaxWeight := flag.Float64("report_max_weight", 3, "maximal weight of a single report")
+	reportTrustedMinUpheld := flag.Int("report_trusted_min_upheld", 10, "upheld reports needed to send content to moderation immediately")
+	reportTrustedMinAccuracy := flag.Float64("report_trusted_min_accuracy", 0.9, "share of upheld reports needed to send content to moderation immediately")
+	filterStopWordsRU := flag.String("content_filter_stop_words_ru", "казино,букмекер,ставка,виагра,закладка", "comma separated list of russian stop words, word forms are matched too")
+	filterStopWordsEN := flag.String("content_filter_stop_words_en", "casino,viagra,betting,escort", "comma separated list of english stop words, word forms are matched too")
+	filterMaxLinks := flag.Int("content_filter_max_links", 2, "maximal amount of links in published content, 0 disables the check")
+	filterMaxPhones := flag.Int("content_filter_max_phones", 2, "maximal amount of phone numbers in published content, 0 disables the check")
+	filterRateWindow := flag.Duration("content_filter_rate_window", time.Hour, "window in which activity of the author is counted")
+	filterPostRateLimit := flag.Int("content_filter_post_rate_limit", 5, "amount of posts author can create within the window, 0 disables the check")
+	filterCommentRateLimit := flag.Int("content_filter_comment_rate_limit", 30, "amount of comments author can create within the window, 0 disables the check")
+	mediaStorage := flag.String("media_storage", "filesystem", "storage of uploaded photos: filesystem or s3")
+	mediaDir := flag.String("media_dir", "./media", "directory for uploaded photos when filesystem storage is used")
+	mediaURL := flag.String("media_url", "/media", "prefix of URLs of uploaded photos when filesystem storage is used")
+	mediaS3Endpoint := flag.String("media_s3_endpoint", "http://localhost:9000", "address of S3 compatible storage")
+	mediaS3Region := flag.String("media_s3_region", "us-east-1", "region of S3 compatible storage")
+	mediaS3Bucket := flag.String("media_s3_bucket", "sos-kotopes", "bucket for uploaded photos")
+	mediaS3AccessKey := flag.String("media_s3_access_key", "", "access key of S3 compatible storage")
+	mediaS3SecretKey := flag.String("media_s3_secret_key", "", "secret key of S3 compatible storage")
+	mediaS3PublicURL := flag.String("media_s3_public_url", "", "prefix of URLs of uploaded photos, endpoint with bucket is used when empty")
+	mediaMaxPostPhotos := flag.Int("media_max_post_photos", 10, "maximal amount of photos attached to a single post")
+	mediaMaxPhotoSize := flag.Int64("media_max_photo_size", 10*1024*1024, "maximal size of uploaded photo in bytes")
+	mediaMaxPhotoPixels := flag.Int("media_max_photo_pixels", 40_000_000, "maximal width * height of uploaded photo")
+	mediaMediumSize := flag.Int("media_medium_size", 1280, "longest side of medium variant of photos")
+	mediaThumbnailSize := flag.Int("media_thumbnail_size", 320, "longest side of thumbnail variant of photos")
+	mediaQuality := flag.Int("media_quality", 85, "quality of JPEG and WebP encoding of photos, 1-100")
+	adoptionQuestionnaire := flag.String("adoption_questionnaire", "", "path to JSON file with questions of adoption applications, built-in questions are used when empty")
+	postLifetime := flag.Duration("post_lifetime", 30*24*time.Hour, "active post is archived when it isn't bumped or renewed for this time, 0 disables archiving")
+	postExpiryReminder := flag.Duration("post_expiry_reminder", 3*24*time.Hour, "the author is reminded this time before the post is archived")
+	postBumpInterval := flag.Duration("post_bump_interval", 24*time.Hour, "minimal time between bumps of the post")
+	commentEditWindow := flag.Duration("comment_edit_window", 24*time.Hour, "the author may edit the comment within this time after creation, 0 disables the limit")
+	feedRadius := flag.Float64("feed_radius", 10, "default radius in kilometers around the user in which posts are nearby")
+	feedNearbyWeight := flag.Float64("feed_nearby_weight", 3, "score of nearby posts in the feed, 0 leaves them out")
+	feedPreferencesWeight := flag.Float64("feed_preferences_weight", 2, "score of posts matching preferences of the user in the feed, 0 leaves them out")
+	feedFavouriteUsersWeight := flag.Float64("feed_favourite_users_weight", 4, "score of posts of favourite users in the feed, 0 leaves them out")
+	feedInteractionsWeight := flag.Float64("feed_interactions_weight", 1, "score of posts the user liked, commented or favourited in the feed, 0 leaves them out")
+	feedHalfLife := flag.Duration("feed_half_life", 72*time.Hour, "time after which score of the post in the feed halves, 0 disables decay")
+	workerInterval := flag.Duration("worker_interval", 10*time.Minute, "interval between runs of background jobs")
 
 	flag.Parse()
 
+	if *mediaStorage != "filesystem" && *mediaStorage != "s3" {
+		return nil, fmt.Errorf("invalid media storage %q", *mediaStorage)
+	}
+
+	if *mediaQuality < 1 || *mediaQuality > 100 {
+		return nil, fmt.Errorf("invalid media quality %d", *mediaQuality)
+	}
+
+	thresholds, err := parseReportThresholds(*reportThresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	if *workerInterval <= 0 {
+		return nil, fmt.Errorf("invalid worker interval %s", *workerInterval)
+	}
+
+	if *commentEditWindow < 0 {
+		return nil, fmt.Errorf("invalid comment edit window %s", *commentEditWindow)
+	}
+
+	if *feedRadius <= 0 {
+		return nil, fmt.Errorf("invalid feed radius %v", *feedRadius)
+	}
+
+	if *feedNearbyWeight < 0 || *feedPreferencesWeight < 0 || *feedFavouriteUsersWeight < 0 || *feedInteractionsWeight < 0 {
+		return nil, fmt.Errorf("invalid feed weights %v, %v, %v, %v, they must not be negative",
+			*feedNearbyWeight, *feedPreferencesWeight, *feedFavouriteUsersWeight, *feedInteractionsWeight)
+	}
+
+	if *feedHalfLife < 0 {
+		return nil, fmt.Errorf("invalid feed half life %s", *feedHalfLife)
+	}
+
+	if *postExpiryReminder < 0 || *postLifetime > 0 && *postExpiryReminder >= *postLifetime {
+		return nil, fmt.Errorf("invalid post expiry reminder %s, it must be shorter than post lifetime", *postExpiryReminder)
+	}
+
+	adoptionQuestions, err := loadAdoptionQuestions(*adoptionQuestionnaire)
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := &Config{
 		HTTP: HTTP{
 			Port: *port,
@@ -88,7 +251,111 @@ func NewConfig() (*Config, error) {
 		CORS: CORS{
 			AllowedOrigins: *frontendOrigins,
 		},
+		Report: Report{
+			DefaultThreshold:   *reportDefaultThreshold,
+			Thresholds:         thresholds,
+			NewAccountAge:      *reportNewAccountAge,
+			NewAccountWeight:   *reportNewAccountWeight,
+			MinReportWeight:    *reportMinWeight,
+			MaxReportWeight:    *reportMaxWeight,
+			TrustedMinUpheld:   *reportTrustedMinUpheld,
+			TrustedMinAccuracy: *reportTrustedMinAccuracy,
+		},
+		ContentFilter: ContentFilter{
+			StopWords:        append(splitList(*filterStopWordsRU), splitList(*filterStopWordsEN)...),
+			MaxLinks:         *filterMaxLinks,
+			MaxPhones:        *filterMaxPhones,
+			RateWindow:       *filterRateWindow,
+			PostRateLimit:    *filterPostRateLimit,
+			CommentRateLimit: *filterCommentRateLimit,
+		},
+		Media: Media{
+			Storage:        *mediaStorage,
+			Dir:            *mediaDir,
+			URL:            *mediaURL,
+			S3Endpoint:     *mediaS3Endpoint,
+			S3Region:       *mediaS3Region,
+			S3Bucket:       *mediaS3Bucket,
+			S3AccessKey:    *mediaS3AccessKey,
+			S3SecretKey:    *mediaS3SecretKey,
+			S3PublicURL:    *mediaS3PublicURL,
+			MaxPostPhotos:  *mediaMaxPostPhotos,
+			MaxPhotoSize:   *mediaMaxPhotoSize,
+			MaxPhotoPixels: *mediaMaxPhotoPixels,
+			MediumSize:     *mediaMediumSize,
+			ThumbnailSize:  *mediaThumbnailSize,
+			Quality:        *mediaQuality,
+		},
+		Adoption: Adoption{
+			Questions: adoptionQuestions,
+		},
+		Post: Post{
+			Lifetime:       *postLifetime,
+			ExpiryReminder: *postExpiryReminder,
+			BumpInterval:   *postBumpInterval,
+		},
+		Comment: Comment{
+			EditWindow: *commentEditWindow,
+		},
+		Feed: Feed{
+			Radius:               *feedRadius,
+			NearbyWeight:         *feedNearbyWeight,
+			PreferencesWeight:    *feedPreferencesWeight,
+			FavouriteUsersWeight: *feedFavouriteUsersWeight,
+			InteractionsWeight:   *feedInteractionsWeight,
+			HalfLife:             *feedHalfLife,
+		},
+		Worker: Worker{
+			Interval: *workerInterval,
+		},
 	}
 
 	return cfg, nil
 }
+
+// parseReportThresholds parses thresholds in format "post:spam=15,comment:violent_content=5".
+func parseReportThresholds(value string) (map[string]map[string]float64, error) {
+	thresholds := make(map[string]map[string]float64)
+
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		key, rawThreshold, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid report threshold %q", item)
+		}
+
+		reportableType, reason, ok := strings.Cut(key, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid report threshold %q", item)
+		}
+
+		threshold, err := strconv.ParseFloat(rawThreshold, 64)
+		if err != nil || threshold <= 0 {
+			return nil, fmt.Errorf("invalid report threshold %q", item)
+		}
+
+		if thresholds[reportableType] == nil {
+			thresholds[reportableType] = make(map[string]float64)
+		}
+		thresholds[reportableType][reason] = threshold
+	}
+
+	return thresholds, nil
+}
+
+// splitList splits comma separated list and drops empty items.
+func splitList(value string) []string {
+	var items []string
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -13,6 +13,7 @@ import (
 	reportservice "github.com/kotopesp/sos-kotopes/internal/service/report"
 	rolesService "github.com/kotopesp/sos-kotopes/internal/service/role"
 	usersService "github.com/kotopesp/sos-kotopes/internal/service/user"
+	userfavouriteservice "github.com/kotopesp/sos-kotopes/internal/service/userfavourite"
 
 	baseValidator "github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -24,17 +25,38 @@ import (
 	"github.com/kotopesp/sos-kotopes/internal/core"
 	"github.com/kotopesp/sos-kotopes/internal/service/auth"
 	"github.com/kotopesp/sos-kotopes/internal/store/user"
+	"github.com/kotopesp/sos-kotopes/internal/worker"
 	"github.com/kotopesp/sos-kotopes/pkg/logger"
 	"github.com/kotopesp/sos-kotopes/pkg/postgres"
 
+	adoptionservice "github.com/kotopesp/sos-kotopes/internal/service/adoption"
+	animalservice "github.com/kotopesp/sos-kotopes/internal/service/animal"
+	blockservice "github.com/kotopesp/sos-kotopes/internal/service/block"
 	commentservice "github.com/kotopesp/sos-kotopes/internal/service/comment"
+	"github.com/kotopesp/sos-kotopes/internal/service/contentfilter"
+	feedservice "github.com/kotopesp/sos-kotopes/internal/service/feed"
+	mediaservice "github.com/kotopesp/sos-kotopes/internal/service/media"
+	notificationservice "github.com/kotopesp/sos-kotopes/internal/service/notification"
+	profileservice "github.com/kotopesp/sos-kotopes/internal/service/profile"
+	adoptionstore "github.com/kotopesp/sos-kotopes/internal/store/adoption"
 	animalstore "github.com/kotopesp/sos-kotopes/internal/store/animal"
+	blobstore "github.com/kotopesp/sos-kotopes/internal/store/blob"
+	blockstore "github.com/kotopesp/sos-kotopes/internal/store/block"
 	commentstore "github.com/kotopesp/sos-kotopes/internal/store/comment"
+	feedstore "github.com/kotopesp/sos-kotopes/internal/store/feed"
+	likestore "github.com/kotopesp/sos-kotopes/internal/store/like"
+	mediastore "github.com/kotopesp/sos-kotopes/internal/store/media"
+	mentionstore "github.com/kotopesp/sos-kotopes/internal/store/mention"
+	messagestore "github.com/kotopesp/sos-kotopes/internal/store/message"
 	moderatorstore "github.com/kotopesp/sos-kotopes/internal/store/moderator"
+	notificationstore "github.com/kotopesp/sos-kotopes/internal/store/notification"
 	poststore "github.com/kotopesp/sos-kotopes/internal/store/post"
 	postfavouritestore "github.com/kotopesp/sos-kotopes/internal/store/postfavourite"
+	profilestore "github.com/kotopesp/sos-kotopes/internal/store/profile"
 	refreshsessionstore "github.com/kotopesp/sos-kotopes/internal/store/refresh_session"
 	reportstore "github.com/kotopesp/sos-kotopes/internal/store/report"
+	reviewstore "github.com/kotopesp/sos-kotopes/internal/store/review"
+	revisionstore "github.com/kotopesp/sos-kotopes/internal/store/revision"
 	rolesstore "github.com/kotopesp/sos-kotopes/internal/store/role"
 	userFavouriteStore "github.com/kotopesp/sos-kotopes/internal/store/userfavourite"
 )
@@ -69,18 +91,121 @@ func Run(cfg *config.Config) {
 	refreshSessionStore := refreshsessionstore.New(pg)
 	reportStore := reportstore.New(pg)
 	moderatorStore := moderatorstore.New(pg)
+	reviewStore := reviewstore.New(pg)
+	messageStore := messagestore.New(pg)
+	mediaStore := mediastore.New(pg)
+	adoptionStore := adoptionstore.New(pg)
+	notificationStore := notificationstore.New(pg)
+	revisionStore := revisionstore.New(pg)
+	likeStore := likestore.New(pg)
+	mentionStore := mentionstore.New(pg)
+	blockStore := blockstore.New(pg)
+	feedStore := feedstore.New(pg)
+	profileStore := profilestore.New(pg)
+	var blobStore core.BlobStore
+	if cfg.Media.Storage == "s3" {
+		blobStore = blobstore.NewS3(blobstore.S3Config{
+			Endpoint:  cfg.Media.S3Endpoint,
+			Region:    cfg.Media.S3Region,
+			Bucket:    cfg.Media.S3Bucket,
+			AccessKey: cfg.Media.S3AccessKey,
+			SecretKey: cfg.Media.S3SecretKey,
+			PublicURL: cfg.Media.S3PublicURL,
+		})
+	} else {
+		blobStore = blobstore.NewFilesystem(cfg.Media.Dir, cfg.Media.URL)
+	}
 	// Services
+	mediaService := mediaservice.New(
+		mediaStore,
+		blobStore,
+		core.MediaServiceConfig{
+			MaxPostPhotos:  cfg.Media.MaxPostPhotos,
+			MaxPhotoSize:   cfg.Media.MaxPhotoSize,
+			MaxPhotoPixels: cfg.Media.MaxPhotoPixels,
+			MediumSize:     cfg.Media.MediumSize,
+			ThumbnailSize:  cfg.Media.ThumbnailSize,
+			Quality:        cfg.Media.Quality,
+		},
+	)
+	// Photos moved out of the database by migration are uploaded in background, so start is not delayed
+	go func() {
+		if err := mediaService.MoveLegacyBlobs(ctx); err != nil {
+			logger.Log().Error(ctx, "error with moving legacy photos to blob store: %s", err.Error())
+		}
+		if err := mediaService.HashMissingPhotos(ctx); err != nil {
+			logger.Log().Error(ctx, "error with computing hashes of photos: %s", err.Error())
+		}
+	}()
+	contentFilter := contentfilter.New(
+		postStore,
+		commentStore,
+		core.ContentFilterConfig{
+			StopWords:        cfg.ContentFilter.StopWords,
+			MaxLinks:         cfg.ContentFilter.MaxLinks,
+			MaxPhones:        cfg.ContentFilter.MaxPhones,
+			RateWindow:       cfg.ContentFilter.RateWindow,
+			PostRateLimit:    cfg.ContentFilter.PostRateLimit,
+			CommentRateLimit: cfg.ContentFilter.CommentRateLimit,
+		},
+	)
 	commentService := commentservice.New(
 		commentStore,
 		postStore,
+		contentFilter,
+		revisionStore,
+		likeStore,
+		userStore,
+		mentionStore,
+		notificationStore,
+		blockStore,
+		core.CommentServiceConfig{
+			EditWindow: cfg.Comment.EditWindow,
+		},
 	)
 	roleService := rolesService.New(roleStore, userStore)
-	reportService := reportservice.NewReportService(reportStore, postStore, commentStore)
-	userService := usersService.New(userStore, favouriteUserStore)
-	moderatorService := moderatorsService.New(moderatorStore, postStore, reportStore, userStore, commentStore)
+	reportThresholds := make(map[string]map[core.ReportReason]float64, len(cfg.Report.Thresholds))
+	for reportableType, reasons := range cfg.Report.Thresholds {
+		reportThresholds[reportableType] = make(map[core.ReportReason]float64, len(reasons))
+		for reason, threshold := range reasons {
+			reportThresholds[reportableType][core.ReportReason(reason)] = threshold
+		}
+	}
+	reportService := reportservice.NewReportService(
+		reportStore,
+		postStore,
+		commentStore,
+		userStore,
+		moderatorStore,
+		reviewStore,
+		messageStore,
+		core.ReportServiceConfig{
+			DefaultThreshold:   cfg.Report.DefaultThreshold,
+			Thresholds:         reportThresholds,
+			NewAccountAge:      cfg.Report.NewAccountAge,
+			NewAccountWeight:   cfg.Report.NewAccountWeight,
+			MinReportWeight:    cfg.Report.MinReportWeight,
+			MaxReportWeight:    cfg.Report.MaxReportWeight,
+			TrustedMinUpheld:   cfg.Report.TrustedMinUpheld,
+			TrustedMinAccuracy: cfg.Report.TrustedMinAccuracy,
+		},
+	)
+	userService := usersService.New(userStore, favouriteUserStore, mediaService)
+	moderatorService := moderatorsService.New(
+		moderatorStore,
+		postStore,
+		reportStore,
+		userStore,
+		commentStore,
+		reviewStore,
+		messageStore,
+		revisionStore,
+		commentService,
+	)
 	authService := auth.New(
 		userStore,
 		refreshSessionStore,
+		mediaService,
 		core.AuthServiceConfig{
 			JWTSecret:            cfg.JWTSecret,
 			VKClientID:           cfg.VKClientID,
@@ -90,7 +215,61 @@ func Run(cfg *config.Config) {
 			RefreshTokenLifetime: cfg.RefreshTokenLifetime,
 		},
 	)
-	postService := postservice.New(postStore, postFavouriteStore, animalStore, userStore)
+	postService := postservice.New(
+		postStore,
+		postFavouriteStore,
+		animalStore,
+		userStore,
+		contentFilter,
+		mediaService,
+		notificationStore,
+		revisionStore,
+		likeStore,
+		blockStore,
+		core.PostServiceConfig{
+			Lifetime:       cfg.Post.Lifetime,
+			ExpiryReminder: cfg.Post.ExpiryReminder,
+			BumpInterval:   cfg.Post.BumpInterval,
+		},
+	)
+	notificationService := notificationservice.New(notificationStore)
+	blockService := blockservice.New(blockStore, userStore)
+	userFavouriteService := userfavouriteservice.New(favouriteUserStore, userStore, postService, blockStore)
+	feedService := feedservice.New(
+		feedStore,
+		blockStore,
+		postService,
+		core.FeedServiceConfig{
+			Radius: cfg.Feed.Radius,
+			Weights: core.FeedWeights{
+				Nearby:         cfg.Feed.NearbyWeight,
+				Preferences:    cfg.Feed.PreferencesWeight,
+				FavouriteUsers: cfg.Feed.FavouriteUsersWeight,
+				Interactions:   cfg.Feed.InteractionsWeight,
+			},
+			HalfLife: cfg.Feed.HalfLife,
+		},
+	)
+	profileService := profileservice.New(profileStore, userService, roleService, commentService)
+
+	// Background jobs
+	go worker.Run(ctx, cfg.Worker.Interval,
+		worker.Job{Name: "expire posts", Run: postService.ExpirePosts},
+		worker.Job{Name: "publish scheduled posts", Run: postService.PublishScheduledPosts},
+	)
+	animalService := animalservice.New(animalStore, mediaService)
+	adoptionQuestions := make([]core.AdoptionQuestion, len(cfg.Adoption.Questions))
+	for i, question := range cfg.Adoption.Questions {
+		adoptionQuestions[i] = core.AdoptionQuestion(question)
+	}
+	adoptionService := adoptionservice.New(
+		adoptionStore,
+		animalStore,
+		userStore,
+		core.AdoptionServiceConfig{
+			Questions: adoptionQuestions,
+		},
+	)
 
 	// Validator
 	formValidator := validator.New(ctx, baseValidator.New())
@@ -99,9 +278,15 @@ func Run(cfg *config.Config) {
 		CaseSensitive:            true,
 		StrictRouting:            false,
 		EnableSplittingOnParsers: true,
+		// every photo of the post may have maximal size, the rest of the form is small
+		BodyLimit: int(cfg.Media.MaxPhotoSize)*max(cfg.Media.MaxPostPhotos, 1) + 1024*1024,
 	})
 	app.Use(recover.New())
 
+	if cfg.Media.Storage == "filesystem" {
+		app.Static(cfg.Media.URL, cfg.Media.Dir)
+	}
+
 	// This configuration is necessary so that the frontend can send requests with cookies.
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     cfg.CORS.AllowedOrigins,
@@ -117,6 +302,14 @@ func Run(cfg *config.Config) {
 		roleService,
 		reportService,
 		moderatorService,
+		mediaService,
+		animalService,
+		adoptionService,
+		notificationService,
+		blockService,
+		userFavouriteService,
+		feedService,
+		profileService,
 		formValidator,
 	)
 
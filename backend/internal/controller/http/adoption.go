@@ -0,0 +1,280 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	adoptionModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/adoption"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// @Summary		Get adoption questionnaire
+// @Tags			adoption
+// @Description	Get questions the applicant answers when applying for an animal
+// @ID				get-adoption-questionnaire
+// @Produce		json
+// @Success		200	{object}	model.Response{data=[]adoption.QuestionResponse}
+// @Router			/adoption/questionnaire [get]
+func (r *Router) getAdoptionQuestionnaire(ctx *fiber.Ctx) error {
+	questions := r.adoptionService.GetQuestionnaire(ctx.UserContext())
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(adoptionModel.ToQuestionResponses(questions)))
+}
+
+// @Summary		Apply for an animal
+// @Tags			adoption
+// @Description	Apply for adoption of an animal which needs home, the user may have one open application for the animal
+// @ID				apply-for-adoption
+// @Accept			json
+// @Produce		json
+// @Param			id		path		int					true	"Animal ID"	minimum(1)
+// @Param			request	body		adoption.Apply		true	"Answers of the questionnaire"
+// @Success		201		{object}	model.Response{data=adoption.ApplicationResponse}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		404		{object}	model.Response
+// @Failure		409		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/animals/{id}/adoption-applications [post]
+func (r *Router) applyForAdoption(ctx *fiber.Ctx) error {
+	var pathParams adoptionModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var request adoptionModel.Apply
+
+	fiberError, parseOrValidationError = parseBodyAndValidate(ctx, r.formValidator, &request)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	application, err := r.adoptionService.Apply(ctx.UserContext(), request.ToCoreAdoptionApplication(pathParams.ID, userID))
+	if err != nil {
+		switch {
+		case errors.Is(err, core.ErrAnimalNotFound):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrAdoptionApplicationExists):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusConflict).JSON(model.ErrorResponse(err.Error()))
+		case oneOfErrors(err, core.ErrAnimalNotAdoptable, core.ErrOwnAnimalAdoption, core.ErrInvalidAdoptionAnswers):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(model.OKResponse(adoptionModel.ToApplicationResponse(application)))
+}
+
+// @Summary		Get adoption applications for an animal
+// @Tags			adoption
+// @Description	Get applications for the animal, the latest go first, only the author and the keeper of the animal can do it
+// @ID				get-animal-adoption-applications
+// @Produce		json
+// @Param			id		path		int		true	"Animal ID"	minimum(1)
+// @Param			limit	query		int		true	"Limit"		minimum(1)	maximum(100)
+// @Param			offset	query		int		false	"Offset"	minimum(0)
+// @Param			status	query		string	false	"Status"	Enums(submitted, under_review, interview, approved, rejected, withdrawn)
+// @Success		200		{object}	model.Response{data=adoption.Response}
+// @Failure		401		{object}	model.Response
+// @Failure		403		{object}	model.Response
+// @Failure		404		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/animals/{id}/adoption-applications [get]
+func (r *Router) getAnimalAdoptionApplications(ctx *fiber.Ctx) error {
+	var pathParams adoptionModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var params adoptionModel.GetApplicationsParams
+
+	fiberError, parseOrValidationError = parseQueryAndValidate(ctx, r.formValidator, &params)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	coreParams := params.ToCoreGetAdoptionApplicationsParams()
+	coreParams.AnimalID = &pathParams.ID
+
+	applications, total, err := r.adoptionService.GetAnimalApplications(ctx.UserContext(), userID, coreParams)
+	if err != nil {
+		switch {
+		case errors.Is(err, core.ErrAnimalNotFound):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrAdoptionAccessDenied):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(
+		adoptionModel.ToResponse(paginate(total, params.Limit, params.Offset), applications),
+	))
+}
+
+// @Summary		Get own adoption applications
+// @Tags			adoption
+// @Description	Get applications of the current user, the latest go first
+// @ID				get-user-adoption-applications
+// @Produce		json
+// @Param			limit	query		int		true	"Limit"		minimum(1)	maximum(100)
+// @Param			offset	query		int		false	"Offset"	minimum(0)
+// @Param			status	query		string	false	"Status"	Enums(submitted, under_review, interview, approved, rejected, withdrawn)
+// @Success		200		{object}	model.Response{data=adoption.Response}
+// @Failure		401		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/adoption-applications [get]
+func (r *Router) getUserAdoptionApplications(ctx *fiber.Ctx) error {
+	var params adoptionModel.GetApplicationsParams
+
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &params)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	applications, total, err := r.adoptionService.GetUserApplications(ctx.UserContext(), userID, params.ToCoreGetAdoptionApplicationsParams())
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(
+		adoptionModel.ToResponse(paginate(total, params.Limit, params.Offset), applications),
+	))
+}
+
+// @Summary		Get adoption application by ID
+// @Tags			adoption
+// @Description	Get the application, only the applicant and the author and the keeper of the animal can see it
+// @ID				get-adoption-application-by-id
+// @Produce		json
+// @Param			id	path		int	true	"Application ID"	minimum(1)
+// @Success		200	{object}	model.Response{data=adoption.ApplicationResponse}
+// @Failure		401	{object}	model.Response
+// @Failure		404	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/adoption-applications/{id} [get]
+func (r *Router) getAdoptionApplicationByID(ctx *fiber.Ctx) error {
+	var pathParams adoptionModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	application, err := r.adoptionService.GetApplication(ctx.UserContext(), userID, pathParams.ID)
+	if err != nil {
+		if errors.Is(err, core.ErrAdoptionApplicationNotFound) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(adoptionModel.ToApplicationResponse(application)))
+}
+
+// @Summary		Update adoption application
+// @Tags			adoption
+// @Description	Review the application or change the note about it, only the author and the keeper of the animal can do it.
+// @Description	The applicant may only withdraw the open application. Approval marks the animal as adopted and rejects other open applications.
+// @ID				update-adoption-application
+// @Accept			json
+// @Produce		json
+// @Param			id		path		int							true	"Application ID"	minimum(1)
+// @Param			request	body		adoption.UpdateApplication	true	"New status and note"
+// @Success		200		{object}	model.Response{data=adoption.ApplicationResponse}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		403		{object}	model.Response
+// @Failure		404		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/adoption-applications/{id} [patch]
+func (r *Router) updateAdoptionApplication(ctx *fiber.Ctx) error {
+	var pathParams adoptionModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var request adoptionModel.UpdateApplication
+
+	fiberError, parseOrValidationError = parseBodyAndValidate(ctx, r.formValidator, &request)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	application, err := r.adoptionService.UpdateApplication(ctx.UserContext(), request.ToCoreUpdateAdoptionApplication(pathParams.ID, userID))
+	if err != nil {
+		switch {
+		case errors.Is(err, core.ErrAdoptionApplicationNotFound):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrAdoptionAccessDenied):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+		case oneOfErrors(err, core.ErrAdoptionStatusTransition, core.ErrAnimalNotAdoptable, core.ErrOwnAnimalAdoption):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(adoptionModel.ToApplicationResponse(application)))
+}
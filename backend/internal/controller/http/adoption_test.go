@@ -0,0 +1,124 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyForAdoption(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	const route = "/api/v1/animals/%d/adoption-applications"
+
+	tests := []struct {
+		name          string
+		animalID      int
+		body          string
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:     "success",
+			animalID: 1,
+			body:     `{"answers": {"housing": "flat"}}`,
+			mockBehaviour: func() {
+				dependencies.adoptionService.EXPECT().
+					Apply(mock.Anything, core.AdoptionApplication{
+						AnimalID:    1,
+						ApplicantID: authorID,
+						Answers:     core.AdoptionAnswers{"housing": "flat"},
+					}).
+					Return(core.AdoptionApplication{ID: 1, AnimalID: 1, ApplicantID: authorID, Status: core.AdoptionSubmitted}, nil).Once()
+			},
+			wantCode: http.StatusCreated,
+		},
+		{
+			name:     "already applied",
+			animalID: 2,
+			body:     `{"answers": {"housing": "flat"}}`,
+			mockBehaviour: func() {
+				dependencies.adoptionService.EXPECT().
+					Apply(mock.Anything, mock.MatchedBy(func(a core.AdoptionApplication) bool { return a.AnimalID == 2 })).
+					Return(core.AdoptionApplication{}, core.ErrAdoptionApplicationExists).Once()
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:     "invalid answers",
+			animalID: 3,
+			body:     `{"answers": {"salary": "big"}}`,
+			mockBehaviour: func() {
+				dependencies.adoptionService.EXPECT().
+					Apply(mock.Anything, mock.MatchedBy(func(a core.AdoptionApplication) bool { return a.AnimalID == 3 })).
+					Return(core.AdoptionApplication{}, fmt.Errorf("%w: unknown question", core.ErrInvalidAdoptionAnswers)).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "animal not adoptable",
+			animalID: 4,
+			body:     `{"answers": {}}`,
+			mockBehaviour: func() {
+				dependencies.adoptionService.EXPECT().
+					Apply(mock.Anything, mock.MatchedBy(func(a core.AdoptionApplication) bool { return a.AnimalID == 4 })).
+					Return(core.AdoptionApplication{}, core.ErrAnimalNotAdoptable).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "animal not found",
+			animalID: 5,
+			body:     `{"answers": {}}`,
+			mockBehaviour: func() {
+				dependencies.adoptionService.EXPECT().
+					Apply(mock.Anything, mock.MatchedBy(func(a core.AdoptionApplication) bool { return a.AnimalID == 5 })).
+					Return(core.AdoptionApplication{}, core.ErrAnimalNotFound).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "internal error",
+			animalID: 6,
+			body:     `{"answers": {}}`,
+			mockBehaviour: func() {
+				dependencies.adoptionService.EXPECT().
+					Apply(mock.Anything, mock.MatchedBy(func(a core.AdoptionApplication) bool { return a.AnimalID == 6 })).
+					Return(core.AdoptionApplication{}, errors.New("internal error")).Once()
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:          "answers missing",
+			animalID:      1,
+			body:          `{}`,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(route, tt.animalID), strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
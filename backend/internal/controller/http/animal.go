@@ -0,0 +1,333 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	animalModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/animal"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// @Summary		Get animals
+// @Tags			animal
+// @Description	Get registered animals, the latest registered go first
+// @ID				get-animals
+// @Produce		json
+// @Param			limit		query		int		true	"Limit"		minimum(1)	maximum(100)
+// @Param			offset		query		int		false	"Offset"	minimum(0)
+// @Param			author_id	query		int		false	"Animals registered by the user"	minimum(1)
+// @Param			keeper_id	query		int		false	"Animals kept by the user"	minimum(1)
+// @Param			stage		query		string	false	"Type of the latest event"	Enums(found, at_vet, in_foster, adopted, returned_to_owner, deceased)
+// @Param			status		query		string	false	"Status"	Enums(lost, found, need_home, adopted)
+// @Param			animal_type	query		string	false	"Animal type"
+// @Success		200			{object}	model.Response{data=animal.Response}
+// @Failure		422			{object}	model.Response{data=validator.Response}
+// @Failure		500			{object}	model.Response
+// @Router			/animals [get]
+func (r *Router) getAnimals(ctx *fiber.Ctx) error {
+	var params animalModel.GetAnimalsParams
+
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &params)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	animals, total, err := r.animalService.GetAnimals(ctx.UserContext(), params.ToCoreGetAnimalsParams())
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(
+		animalModel.ToResponse(paginate(total, params.Limit, params.Offset), animals),
+	))
+}
+
+// @Summary		Get animal by ID
+// @Tags			animal
+// @Description	Get animal by ID, posts about the animal are fetched by /posts?animal_id={id}
+// @ID				get-animal-by-id
+// @Produce		json
+// @Param			id	path		int	true	"Animal ID"	minimum(1)
+// @Success		200	{object}	model.Response{data=animal.AnimalResponse}
+// @Failure		404	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Router			/animals/{id} [get]
+func (r *Router) getAnimalByID(ctx *fiber.Ctx) error {
+	var pathParams animalModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	animal, err := r.animalService.GetAnimalByID(ctx.UserContext(), pathParams.ID)
+	if err != nil {
+		if errors.Is(err, core.ErrAnimalNotFound) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(animalModel.ToAnimalResponse(animal)))
+}
+
+// @Summary		Register an animal
+// @Tags			animal
+// @Description	Register an animal without a post, e.g. the animal is taken from the street by the keeper
+// @ID				create-animal
+// @Accept			json
+// @Produce		json
+// @Param			request	body		animal.CreateAnimal	true	"Animal"
+// @Success		201		{object}	model.Response{data=animal.AnimalResponse}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/animals [post]
+func (r *Router) createAnimal(ctx *fiber.Ctx) error {
+	var request animalModel.CreateAnimal
+
+	fiberError, parseOrValidationError := parseBodyAndValidate(ctx, r.formValidator, &request)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	animal, err := r.animalService.CreateAnimal(ctx.UserContext(), request.ToCoreAnimal(userID))
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(model.OKResponse(animalModel.ToAnimalResponse(animal)))
+}
+
+// @Summary		Update an animal
+// @Tags			animal
+// @Description	Update an animal, only the author and the keeper of the animal can do it
+// @ID				update-animal
+// @Accept			json
+// @Produce		json
+// @Param			id		path		int						true	"Animal ID"	minimum(1)
+// @Param			request	body		animal.UpdateAnimal		true	"Changed fields of the animal"
+// @Success		200		{object}	model.Response{data=animal.AnimalResponse}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		403		{object}	model.Response
+// @Failure		404		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/animals/{id} [patch]
+func (r *Router) updateAnimal(ctx *fiber.Ctx) error {
+	var pathParams animalModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var request animalModel.UpdateAnimal
+
+	fiberError, parseOrValidationError = parseBodyAndValidate(ctx, r.formValidator, &request)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	animal, err := r.animalService.UpdateAnimal(ctx.UserContext(), request.ToCoreUpdateRequestBodyAnimal(pathParams.ID, userID))
+	if err != nil {
+		switch {
+		case errors.Is(err, core.ErrAnimalNotFound):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrAnimalAccessDenied):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(animalModel.ToAnimalResponse(animal)))
+}
+
+// @Summary		Assign an animal to a keeper
+// @Tags			animal
+// @Description	Assign an animal to a user with keeper role or leave it without keeper.
+// @Description	The author and the current keeper of the animal can do it.
+// @ID				set-animal-keeper
+// @Accept			json
+// @Produce		json
+// @Param			id		path		int					true	"Animal ID"	minimum(1)
+// @Param			request	body		animal.SetKeeper	true	"Keeper"
+// @Success		200		{object}	model.Response{data=animal.AnimalResponse}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		403		{object}	model.Response
+// @Failure		404		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/animals/{id}/keeper [put]
+func (r *Router) setAnimalKeeper(ctx *fiber.Ctx) error {
+	var pathParams animalModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var request animalModel.SetKeeper
+
+	fiberError, parseOrValidationError = parseBodyAndValidate(ctx, r.formValidator, &request)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	animal, err := r.animalService.SetAnimalKeeper(ctx.UserContext(), userID, pathParams.ID, request.KeeperID)
+	if err != nil {
+		switch {
+		case errors.Is(err, core.ErrAnimalNotFound):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrAnimalAccessDenied):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrUserIsNotKeeper):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(animalModel.ToAnimalResponse(animal)))
+}
+
+// @Summary		Get case history of an animal
+// @Tags			animal
+// @Description	Get events of the animal from the earliest one
+// @ID				get-animal-events
+// @Produce		json
+// @Param			id	path		int	true	"Animal ID"	minimum(1)
+// @Success		200	{object}	model.Response{data=[]animal.EventResponse}
+// @Failure		404	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Router			/animals/{id}/events [get]
+func (r *Router) getAnimalEvents(ctx *fiber.Ctx) error {
+	var pathParams animalModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	events, err := r.animalService.GetAnimalEvents(ctx.UserContext(), pathParams.ID)
+	if err != nil {
+		if errors.Is(err, core.ErrAnimalNotFound) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(animalModel.ToEventResponses(events)))
+}
+
+// @Summary		Record an event of an animal
+// @Tags			animal
+// @Description	Record an event of the case history of the animal, only the author and the keeper of the animal can do it.
+// @Description	Type of the latest event becomes the stage of the animal.
+// @ID				create-animal-event
+// @Accept			multipart/form-data
+// @Produce		json
+// @Param			id			path		int		true	"Animal ID"	minimum(1)
+// @Param			type		formData	string	true	"Type"	Enums(found, at_vet, in_foster, adopted, returned_to_owner, deceased)
+// @Param			note		formData	string	false	"Note"	maxlength(2000)
+// @Param			happened_at	formData	string	false	"Time of the event in RFC 3339, now by default"
+// @Param			photos		formData	file	false	"Photos of the event, up to 10"
+// @Success		201			{object}	model.Response{data=animal.EventResponse}
+// @Failure		400			{object}	model.Response
+// @Failure		401			{object}	model.Response
+// @Failure		403			{object}	model.Response
+// @Failure		404			{object}	model.Response
+// @Failure		422			{object}	model.Response{data=validator.Response}
+// @Failure		500			{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/animals/{id}/events [post]
+func (r *Router) createAnimalEvent(ctx *fiber.Ctx) error {
+	var pathParams animalModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var request animalModel.CreateEvent
+
+	fiberError, parseOrValidationError = parseBodyAndValidate(ctx, r.formValidator, &request)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	photos, err := openPhotos(ctx)
+	switch {
+	case errors.Is(err, model.ErrInvalidBody):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	case err != nil:
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	event, err := r.animalService.AddAnimalEvent(ctx.UserContext(), request.ToCoreAnimalEvent(pathParams.ID, userID), photos)
+	if err != nil {
+		switch {
+		case errors.Is(err, core.ErrAnimalNotFound):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrAnimalAccessDenied):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrAnimalEventInFuture), errors.Is(err, core.ErrTooManyPhotos), isPhotoError(err):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(model.OKResponse(animalModel.ToEventResponse(event)))
+}
@@ -0,0 +1,137 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	animalModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/animal"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAnimalKeeper(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	const route = "/api/v1/animals/%d/keeper"
+
+	keeperID := 2
+	animal := core.Animal{ID: 1, AuthorID: &[]int{authorID}[0], KeeperUserID: &keeperID, Colors: []string{"black"}}
+
+	tests := []struct {
+		name          string
+		animalID      int
+		body          string
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:     "success",
+			animalID: 1,
+			body:     `{"keeper_id": 2}`,
+			mockBehaviour: func() {
+				dependencies.animalService.EXPECT().
+					SetAnimalKeeper(mock.Anything, authorID, 1, &keeperID).
+					Return(animal, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "keeper removed",
+			animalID: 1,
+			body:     `{"keeper_id": null}`,
+			mockBehaviour: func() {
+				dependencies.animalService.EXPECT().
+					SetAnimalKeeper(mock.Anything, authorID, 1, (*int)(nil)).
+					Return(core.Animal{ID: 1}, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "user is not keeper",
+			animalID: 2,
+			body:     `{"keeper_id": 3}`,
+			mockBehaviour: func() {
+				dependencies.animalService.EXPECT().
+					SetAnimalKeeper(mock.Anything, authorID, 2, mock.Anything).
+					Return(core.Animal{}, core.ErrUserIsNotKeeper).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "access denied",
+			animalID: 3,
+			body:     `{"keeper_id": 2}`,
+			mockBehaviour: func() {
+				dependencies.animalService.EXPECT().
+					SetAnimalKeeper(mock.Anything, authorID, 3, mock.Anything).
+					Return(core.Animal{}, core.ErrAnimalAccessDenied).Once()
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "animal not found",
+			animalID: 4,
+			body:     `{"keeper_id": 2}`,
+			mockBehaviour: func() {
+				dependencies.animalService.EXPECT().
+					SetAnimalKeeper(mock.Anything, authorID, 4, mock.Anything).
+					Return(core.Animal{}, core.ErrAnimalNotFound).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "internal error",
+			animalID: 5,
+			body:     `{"keeper_id": 2}`,
+			mockBehaviour: func() {
+				dependencies.animalService.EXPECT().
+					SetAnimalKeeper(mock.Anything, authorID, 5, mock.Anything).
+					Return(core.Animal{}, errors.New("internal error")).Once()
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:          "invalid keeper",
+			animalID:      1,
+			body:          `{"keeper_id": 0}`,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodPut, fmt.Sprintf(route, tt.animalID), strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+
+			if tt.name == "success" {
+				var data struct {
+					Data animalModel.AnimalResponse `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(body, &data))
+				assert.Equal(t, animalModel.ToAnimalResponse(animal), data.Data)
+			}
+		})
+	}
+}
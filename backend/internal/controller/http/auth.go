@@ -33,6 +33,21 @@ func (r *Router) protectedMiddleware() fiber.Handler {
 	})
 }
 
+// optionalAuthMiddleware identifies the user of public routes: requests without token are anonymous,
+// requests with invalid token are rejected as by protectedMiddleware
+func (r *Router) optionalAuthMiddleware() fiber.Handler {
+	return jwtware.New(jwtware.Config{
+		Filter: func(ctx *fiber.Ctx) bool {
+			return ctx.Get(fiber.HeaderAuthorization) == ""
+		},
+		SigningKey: jwtware.SigningKey{
+			JWTAlg: jwtware.HS256,
+			Key:    r.authService.GetJWTSecret(),
+		},
+		ErrorHandler: authErrorHandler,
+	})
+}
+
 // loginBasic Login through username and password
 //
 //	@Summary		Login through username and password
@@ -139,11 +154,16 @@ func (r *Router) signup(ctx *fiber.Ctx) error {
 
 	coreUser := user.ToCoreUser()
 
-	err = r.authService.SignupBasic(ctx.UserContext(), coreUser)
+	err = r.authService.SignupBasic(ctx.UserContext(), coreUser, user.ToCoreMediaUpload())
 	if err == nil {
 		return ctx.SendStatus(fiber.StatusCreated)
 	}
 
+	if isPhotoError(err) {
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	}
+
 	if errors.Is(err, core.ErrNotUniqueUsername) {
 		logger.Log().Info(ctx.UserContext(), err.Error())
 		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(
@@ -458,6 +458,7 @@ func TestSignup(t *testing.T) {
 					"SignupBasic",
 					mock.Anything,
 					tt.signupBasicArg2.ToCoreUser(),
+					(*core.MediaUpload)(nil),
 				).Return(tt.signupBasicRet1).Once()
 			}
 
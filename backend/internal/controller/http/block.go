@@ -0,0 +1,148 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	blockModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/block"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// @Summary		Block user
+// @Tags			user
+// @Description	Block the user: their posts and comments are hidden from the current user, they can't comment posts of the current user or mention them
+// @ID				block-user
+// @Param			id	path	int	true	"User ID"	minimum(1)
+// @Success		204
+// @Failure		400	{object}	model.Response
+// @Failure		401	{object}	model.Response
+// @Failure		404	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/{id}/block [post]
+func (r *Router) blockUser(ctx *fiber.Ctx) error {
+	return r.changeBlock(ctx, core.BlockTypeBlock, true)
+}
+
+// @Summary		Unblock user
+// @Tags			user
+// @Description	Unblock the user blocked by the current user
+// @ID				unblock-user
+// @Param			id	path	int	true	"User ID"	minimum(1)
+// @Success		204
+// @Failure		401	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/{id}/block [delete]
+func (r *Router) unblockUser(ctx *fiber.Ctx) error {
+	return r.changeBlock(ctx, core.BlockTypeBlock, false)
+}
+
+// @Summary		Mute user
+// @Tags			user
+// @Description	Mute the user: their posts and comments are hidden from the current user, the user isn't restricted otherwise
+// @ID				mute-user
+// @Param			id	path	int	true	"User ID"	minimum(1)
+// @Success		204
+// @Failure		400	{object}	model.Response
+// @Failure		401	{object}	model.Response
+// @Failure		404	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/{id}/mute [post]
+func (r *Router) muteUser(ctx *fiber.Ctx) error {
+	return r.changeBlock(ctx, core.BlockTypeMute, true)
+}
+
+// @Summary		Unmute user
+// @Tags			user
+// @Description	Unmute the user muted by the current user
+// @ID				unmute-user
+// @Param			id	path	int	true	"User ID"	minimum(1)
+// @Success		204
+// @Failure		401	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/{id}/mute [delete]
+func (r *Router) unmuteUser(ctx *fiber.Ctx) error {
+	return r.changeBlock(ctx, core.BlockTypeMute, false)
+}
+
+// changeBlock puts or removes the block of the type on the user from the path
+func (r *Router) changeBlock(ctx *fiber.Ctx, blockType core.BlockType, block bool) error {
+	var pathParams blockModel.PathParams
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	if block {
+		err = r.blockService.BlockUser(ctx.UserContext(), userID, pathParams.UserID, blockType)
+	} else {
+		err = r.blockService.UnblockUser(ctx.UserContext(), userID, pathParams.UserID, blockType)
+	}
+	switch {
+	case errors.Is(err, core.ErrBlockYourself):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	case errors.Is(err, core.ErrNoSuchUser):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+	case err != nil:
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// @Summary		Get blocked users
+// @Tags			user
+// @Description	Get users blocked or muted by the current user, the latest blocks go first
+// @ID				get-blocked-users
+// @Produce		json
+// @Param			limit	query		int		true	"Limit"		minimum(1)	maximum(100)
+// @Param			offset	query		int		false	"Offset"	minimum(0)
+// @Param			type	query		string	false	"Only blocked or only muted users"	Enums(block, mute)
+// @Success		200		{object}	model.Response{data=block.Response}
+// @Failure		401		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/blocked [get]
+func (r *Router) getBlockedUsers(ctx *fiber.Ctx) error {
+	var params blockModel.GetBlockedUsersParams
+
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &params)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	blocks, total, err := r.blockService.GetBlockedUsers(ctx.UserContext(), params.ToCoreGetBlockedUsersParams(userID))
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(
+		blockModel.ToResponse(paginate(total, params.Limit, params.Offset), blocks),
+	))
+}
@@ -0,0 +1,203 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/block"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockUser(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	tests := []struct {
+		name          string
+		method        string
+		route         string
+		token         string
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:   "block",
+			method: http.MethodPost,
+			route:  "/api/v1/users/2/block",
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.blockService.EXPECT().
+					BlockUser(mock.Anything, authorID, 2, core.BlockTypeBlock).
+					Return(nil).Once()
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:   "unblock",
+			method: http.MethodDelete,
+			route:  "/api/v1/users/2/block",
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.blockService.EXPECT().
+					UnblockUser(mock.Anything, authorID, 2, core.BlockTypeBlock).
+					Return(nil).Once()
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:   "mute",
+			method: http.MethodPost,
+			route:  "/api/v1/users/3/mute",
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.blockService.EXPECT().
+					BlockUser(mock.Anything, authorID, 3, core.BlockTypeMute).
+					Return(nil).Once()
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:   "unmute",
+			method: http.MethodDelete,
+			route:  "/api/v1/users/3/mute",
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.blockService.EXPECT().
+					UnblockUser(mock.Anything, authorID, 3, core.BlockTypeMute).
+					Return(nil).Once()
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:   "block yourself",
+			method: http.MethodPost,
+			route:  "/api/v1/users/1/block",
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.blockService.EXPECT().
+					BlockUser(mock.Anything, authorID, 1, core.BlockTypeBlock).
+					Return(core.ErrBlockYourself).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "no such user",
+			method: http.MethodPost,
+			route:  "/api/v1/users/4/block",
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.blockService.EXPECT().
+					BlockUser(mock.Anything, authorID, 4, core.BlockTypeBlock).
+					Return(core.ErrNoSuchUser).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:          "invalid user id",
+			method:        http.MethodPost,
+			route:         "/api/v1/users/0/block",
+			token:         token,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "unauthorized",
+			method:        http.MethodPost,
+			route:         "/api/v1/users/2/block",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(tt.method, tt.route, http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+			}
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestGetBlockedUsers(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	mute := core.BlockTypeMute
+	dependencies.blockService.EXPECT().
+		GetBlockedUsers(mock.Anything, mock.MatchedBy(func(p core.GetBlockedUsersParams) bool {
+			return p.UserID == authorID && *p.Limit == 10 && *p.Type == mute
+		})).
+		Return([]core.UserBlock{{
+			UserID:        authorID,
+			BlockedUserID: 2,
+			BlockedUser:   core.User{ID: 2, Username: "alice"},
+			Type:          core.BlockTypeMute,
+			CreatedAt:     time.Now().UTC(),
+		}}, 1, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/blocked?limit=10&type=mute", http.NoBody)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Data block.Response `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Data.Users, 1)
+	assert.Equal(t, 2, body.Data.Users[0].ID)
+	assert.Equal(t, "alice", body.Data.Users[0].Username)
+	assert.Equal(t, "mute", body.Data.Users[0].Type)
+}
+
+func TestGetBlockedUsers_InvalidType(t *testing.T) {
+	t.Parallel()
+	app, _ := newTestApp(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/blocked?limit=10&type=ban", http.NoBody)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+func TestGetPosts_Viewer(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	dependencies.postService.EXPECT().
+		GetAllPosts(mock.Anything, mock.MatchedBy(func(p core.GetAllPostsParams) bool { return p.ViewerID == authorID })).
+		Return(nil, 0, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts?limit=10", http.NoBody)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -18,10 +18,13 @@ import (
 // @Accept			json
 // @Produce		json
 // @Param			post_id	path		int	true	"Post ID"	minimum(1)
-// @Param			limit	query		int	true	"Limit"		minimum(1)
-// @Param			offset	query		int	true	"Offset"	minimum(0)
+// @Param			limit	query		int		true	"Limit"		minimum(1)
+// @Param			offset	query		int		false	"Offset, ignored when cursor is set"	minimum(0)
+// @Param			cursor	query		string	false	"Cursor of the next page from meta of the previous response"
+// @Param			sort	query		string	false	"Order of threads, replies always go from the oldest, top threads have the most liked top-level comment"	Enums(oldest, newest, top)
 // @Success		200		{object}	model.Response{data=comment.GetAllCommentsResponse}
 // @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
 // @Failure		404		{object}	model.Response
 // @Failure		422		{object}	model.Response{data=validator.Response}
 // @Failure		500		{object}	model.Response
@@ -39,15 +42,27 @@ func (r *Router) getComments(ctx *fiber.Ctx) error {
 		return fiberError
 	}
 
-	coreComments, total, err := r.commentService.GetAllComments(
-		ctx.UserContext(),
-		getAllCommentsParams.ToCoreGetAllCommentsParams(commentPathParams.PostID),
-	)
+	coreGetAllCommentsParams, err := getAllCommentsParams.ToCoreGetAllCommentsParams(commentPathParams.PostID)
+	if err != nil {
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	// anonymous users see comments without their likes
+	if userID, err := getIDFromToken(ctx); err == nil {
+		coreGetAllCommentsParams.UserID = userID
+	}
+
+	coreComments, total, err := r.commentService.GetAllComments(ctx.UserContext(), coreGetAllCommentsParams)
 	if err != nil {
 		if errors.Is(err, core.ErrPostNotFound) {
 			logger.Log().Debug(ctx.UserContext(), err.Error())
 			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
 		}
+		if isPaginationError(err) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
 
 		logger.Log().Error(ctx.UserContext(), err.Error())
 		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
@@ -55,10 +70,138 @@ func (r *Router) getComments(ctx *fiber.Ctx) error {
 
 	modelComments := comment.ToModelCommentsSlice(coreComments)
 
-	response := comment.ToGetAllCommentsResponse(
-		modelComments,
-		paginate(total, getAllCommentsParams.Limit, getAllCommentsParams.Offset),
-	)
+	meta := paginate(total, getAllCommentsParams.Limit, getAllCommentsParams.Offset)
+	meta.NextCursor = comment.NextCursor(coreGetAllCommentsParams, coreComments)
+
+	response := comment.ToGetAllCommentsResponse(modelComments, meta)
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(response))
+}
+
+// @Summary		Get comment threads
+// @Tags			comments
+// @Description	Get top-level comments of a post, every one with the first replies and amount of all replies.
+// @Description	A page never cuts a thread, the rest of replies are loaded by pages of replies.
+// @ID				get-comment-threads
+// @Produce		json
+// @Param			post_id	path		int		true	"Post ID"	minimum(1)
+// @Param			limit	query		int		true	"Limit of threads"		minimum(1)
+// @Param			offset	query		int		false	"Offset, ignored when cursor is set"	minimum(0)
+// @Param			cursor	query		string	false	"Cursor of the next page from meta of the previous response"
+// @Param			sort	query		string	false	"Order of threads, top threads have the most liked top-level comment"	Enums(oldest, newest, top)
+// @Param			replies	query		int		false	"Amount of the first replies of every thread, 3 by default"	minimum(0)	maximum(20)
+// @Success		200		{object}	model.Response{data=comment.GetCommentThreadsResponse}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		404		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Router			/posts/{post_id}/comments/tree [get]
+func (r *Router) getCommentThreads(ctx *fiber.Ctx) error {
+	var params comment.GetCommentThreadsParams
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &params)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var pathParams comment.PostIDPathParams
+	fiberError, parseOrValidationError = parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	coreParams, err := params.ToCoreGetAllCommentsParams(pathParams.PostID)
+	if err != nil {
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	// anonymous users see comments without their likes
+	if userID, err := getIDFromToken(ctx); err == nil {
+		coreParams.UserID = userID
+	}
+
+	threads, total, err := r.commentService.GetCommentThreads(ctx.UserContext(), coreParams)
+	if err != nil {
+		switch {
+		case errors.Is(err, core.ErrPostNotFound):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case isPaginationError(err):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		default:
+			logger.Log().Error(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+		}
+	}
+
+	meta := paginate(total, params.Limit, params.Offset)
+	meta.NextCursor = comment.NextThreadsCursor(coreParams, threads)
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(comment.ToThreadsResponse(threads, meta)))
+}
+
+// @Summary		Get replies
+// @Tags			comments
+// @Description	Get replies of a top-level comment from the oldest
+// @ID				get-comment-replies
+// @Produce		json
+// @Param			post_id		path		int		true	"Post ID"				minimum(1)
+// @Param			comment_id	path		int		true	"Top-level comment ID"	minimum(1)
+// @Param			limit		query		int		true	"Limit"		minimum(1)
+// @Param			offset		query		int		false	"Offset, ignored when cursor is set"	minimum(0)
+// @Param			cursor		query		string	false	"Cursor of the next page from meta of the previous response"
+// @Success		200			{object}	model.Response{data=comment.GetAllCommentsResponse}
+// @Failure		400			{object}	model.Response
+// @Failure		401			{object}	model.Response
+// @Failure		404			{object}	model.Response
+// @Failure		422			{object}	model.Response{data=validator.Response}
+// @Failure		500			{object}	model.Response
+// @Router			/posts/{post_id}/comments/{comment_id}/replies [get]
+func (r *Router) getCommentReplies(ctx *fiber.Ctx) error {
+	var params comment.GetRepliesParams
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &params)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var pathParams comment.PathParams
+	fiberError, parseOrValidationError = parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	coreParams, err := params.ToCoreGetRepliesParams(pathParams.PostID, pathParams.CommentID)
+	if err != nil {
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	// anonymous users see replies without their likes
+	if userID, err := getIDFromToken(ctx); err == nil {
+		coreParams.UserID = userID
+	}
+
+	replies, total, err := r.commentService.GetReplies(ctx.UserContext(), coreParams)
+	if err != nil {
+		switch {
+		case oneOfErrors(err, core.ErrPostNotFound, core.ErrNoSuchComment, core.ErrCommentPostIDMismatch):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrInvalidCommentParentID) || isPaginationError(err):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		default:
+			logger.Log().Error(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+		}
+	}
+
+	meta := paginate(total, params.Limit, params.Offset)
+	meta.NextCursor = comment.NextRepliesCursor(coreParams, replies)
+
+	response := comment.ToGetAllCommentsResponse(comment.ToModelCommentsSlice(replies), meta)
 
 	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(response))
 }
@@ -74,6 +217,7 @@ func (r *Router) getComments(ctx *fiber.Ctx) error {
 // @Success		201		{object}	model.Response{data=comment.Comment}
 // @Failure		400		{object}	model.Response
 // @Failure		401		{object}	model.Response
+// @Failure		403		{object}	model.Response
 // @Failure		404		{object}	model.Response
 // @Failure		422		{object}	model.Response{data=validator.Response}
 // @Failure		500		{object}	model.Response
@@ -108,6 +252,9 @@ func (r *Router) createComment(ctx *fiber.Ctx) error {
 		case errors.Is(err, core.ErrPostNotFound):
 			logger.Log().Debug(ctx.UserContext(), err.Error())
 			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrBlockedByUser):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
 		case oneOfCreateCommentErrors(err):
 			logger.Log().Debug(ctx.UserContext(), err.Error())
 			errMsg := err.Error()
@@ -191,7 +338,7 @@ func (r *Router) updateComment(ctx *fiber.Ctx) error {
 
 	updatedComment, err := r.commentService.UpdateComment(ctx.UserContext(), newCoreComment)
 	switch {
-	case errors.Is(err, core.ErrCommentAuthorIDMismatch):
+	case oneOfErrors(err, core.ErrCommentAuthorIDMismatch, core.ErrCommentEditWindowExpired):
 		logger.Log().Debug(ctx.UserContext(), err.Error())
 		return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
 	case oneOfUpdateDeleteErrors(err):
@@ -52,6 +52,8 @@ func TestGetComments(t *testing.T) {
 		offset        = 1
 		comments      = generateComments(t)
 		totalComments = 200
+		nextCursor    = pagination.EncodeCursor(core.CommentSortOldest.Cursor(comments[len(comments)-1]))
+		cursor        = core.Cursor{Sort: string(core.CommentSortNewest), ThreadID: &[]int{5}[0], ID: 7}
 	)
 
 	type Data struct {
@@ -68,6 +70,7 @@ func TestGetComments(t *testing.T) {
 		limit         int
 		offset        int
 		postID        int
+		query         string
 		mockBehaviour func()
 		wantData      GetCommentsResponse
 		wantCode      int
@@ -93,6 +96,7 @@ func TestGetComments(t *testing.T) {
 						TotalPages:  (totalComments + limit - 1) / limit,
 						CurrentPage: offset/limit + 1,
 						PerPage:     limit,
+						NextCursor:  &nextCursor,
 					},
 				},
 			},
@@ -146,13 +150,77 @@ func TestGetComments(t *testing.T) {
 			mockBehaviour: func() {},
 			wantCode:      http.StatusUnprocessableEntity,
 		},
+		{
+			name:   "next page by cursor",
+			postID: 1,
+			limit:  limit,
+			offset: offset,
+			query:  "&sort=newest&cursor=" + pagination.EncodeCursor(cursor),
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					GetAllComments(mock.Anything, core.GetAllCommentsParams{
+						PostID: 1,
+						Limit:  &limit,
+						Offset: &offset,
+						Cursor: &cursor,
+						Sort:   core.CommentSortNewest,
+					}).Return(comments[:2], totalComments, nil).Once()
+			},
+			wantData: GetCommentsResponse{
+				Data: Data{
+					Comments: comment.ToModelCommentsSlice(comments[:2]),
+					Meta: pagination.Pagination{
+						Total:       totalComments,
+						TotalPages:  (totalComments + limit - 1) / limit,
+						CurrentPage: offset/limit + 1,
+						PerPage:     limit,
+					},
+				},
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:          "malformed cursor",
+			postID:        1,
+			limit:         limit,
+			offset:        offset,
+			query:         "&cursor=not-a-cursor",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusBadRequest,
+		},
+		{
+			name:   "cursor of other sort",
+			postID: 1,
+			limit:  limit,
+			offset: offset,
+			query:  "&cursor=" + pagination.EncodeCursor(cursor),
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					GetAllComments(mock.Anything, core.GetAllCommentsParams{
+						PostID: 1,
+						Limit:  &limit,
+						Offset: &offset,
+						Cursor: &cursor,
+					}).Return(nil, 0, core.ErrInvalidCursor).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:          "invalid sort",
+			postID:        1,
+			limit:         limit,
+			offset:        offset,
+			query:         "&sort=popular",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockBehaviour()
 
-			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(route, tt.postID, tt.limit, tt.offset), http.NoBody)
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(route, tt.postID, tt.limit, tt.offset)+tt.query, http.NoBody)
 
 			resp, err := app.Test(req, -1)
 			require.NoError(t, err)
@@ -229,6 +297,23 @@ func TestCreateComment(t *testing.T) {
 			},
 			wantCode: http.StatusNotFound,
 		},
+		{
+			name:   "blocked by author of post",
+			postID: 3,
+			token:  token,
+			comment: comment.Create{
+				Content: gofakeit.Sentence(10),
+			},
+			mockBehaviour: func(comment comment.Create) {
+				coreComment := comment.ToCoreComment()
+				coreComment.AuthorID = authorID
+				coreComment.PostID = 3
+				dependencies.commentService.EXPECT().
+					CreateComment(mock.Anything, coreComment).
+					Return(core.Comment{}, core.ErrBlockedByUser).Once()
+			},
+			wantCode: http.StatusForbidden,
+		},
 		{
 			name:   "internal error",
 			postID: 1,
@@ -508,6 +593,26 @@ func TestUpdateComment(t *testing.T) {
 			},
 			wantCode: http.StatusNotFound,
 		},
+		{
+			name:      "edit window expired",
+			postID:    1,
+			commentID: 3,
+			token:     token,
+			comment: comment.Update{
+				Content: gofakeit.Sentence(10),
+			},
+			mockBehaviour: func(comment comment.Update) core.Comment {
+				coreComment := comment.ToCoreComment()
+				coreComment.AuthorID = authorID
+				coreComment.PostID = 1
+				coreComment.ID = 3
+				dependencies.commentService.EXPECT().
+					UpdateComment(mock.Anything, coreComment).
+					Return(core.Comment{}, core.ErrCommentEditWindowExpired).Once()
+				return coreComment
+			},
+			wantCode: http.StatusForbidden,
+		},
 		{
 			name:      "internal error",
 			postID:    1,
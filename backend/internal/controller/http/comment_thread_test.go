@@ -0,0 +1,168 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/comment"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCommentThreads(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	rootID := 1
+	threads := []core.CommentThread{{
+		Comment:      core.Comment{ID: 1, PostID: 1},
+		Replies:      []core.Comment{{ID: 2, PostID: 1, ParentID: &rootID}},
+		RepliesCount: 5,
+	}}
+
+	tests := []struct {
+		name          string
+		query         string
+		mockBehaviour func()
+		wantCode      int
+		wantThreads   int
+	}{
+		{
+			name:  "default replies",
+			query: "limit=1",
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					GetCommentThreads(mock.Anything, mock.MatchedBy(func(p core.GetAllCommentsParams) bool {
+						return p.PostID == 1 && *p.Limit == 1 && p.Replies == core.DefaultThreadReplies
+					})).
+					Return(threads, 3, nil).Once()
+			},
+			wantCode:    http.StatusOK,
+			wantThreads: 1,
+		},
+		{
+			name:  "without replies",
+			query: "limit=2&replies=0&sort=top",
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					GetCommentThreads(mock.Anything, mock.MatchedBy(func(p core.GetAllCommentsParams) bool {
+						return *p.Limit == 2 && p.Replies == 0 && p.Sort == core.CommentSortTop
+					})).
+					Return(nil, 0, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:          "too many replies",
+			query:         "limit=1&replies=21",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "invalid limit",
+			query:         "limit=0",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:  "post not found",
+			query: "limit=3",
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					GetCommentThreads(mock.Anything, mock.MatchedBy(func(p core.GetAllCommentsParams) bool { return *p.Limit == 3 })).
+					Return(nil, 0, core.ErrPostNotFound).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/1/comments/tree?"+tt.query, http.NoBody)
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+
+			if tt.wantThreads > 0 {
+				var body struct {
+					Data comment.GetCommentThreadsResponse `json:"data"`
+				}
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+				assert.Len(t, body.Data.Data, tt.wantThreads)
+				assert.Equal(t, 5, body.Data.Data[0].RepliesCount)
+				assert.Len(t, body.Data.Data[0].Replies, 1)
+			}
+		})
+	}
+}
+
+func TestGetCommentReplies(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	const route = "/api/v1/posts/1/comments/%d/replies?limit=10"
+
+	tests := []struct {
+		name          string
+		commentID     int
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:      "success",
+			commentID: 1,
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					GetReplies(mock.Anything, mock.MatchedBy(func(p core.GetRepliesParams) bool {
+						return p.PostID == 1 && p.ParentID == 1 && *p.Limit == 10
+					})).
+					Return([]core.Comment{{ID: 2}}, 1, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "comment not found",
+			commentID: 2,
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					GetReplies(mock.Anything, mock.MatchedBy(func(p core.GetRepliesParams) bool { return p.ParentID == 2 })).
+					Return(nil, 0, core.ErrNoSuchComment).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:      "reply instead of top-level comment",
+			commentID: 3,
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					GetReplies(mock.Anything, mock.MatchedBy(func(p core.GetRepliesParams) bool { return p.ParentID == 3 })).
+					Return(nil, 0, core.ErrInvalidCommentParentID).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(route, tt.commentID), http.NoBody)
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
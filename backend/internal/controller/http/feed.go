@@ -0,0 +1,135 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	feedModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/feed"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// @Summary		Get feed
+// @Tags			feed
+// @Description	Get the personalised feed of the current user: active posts nearby, matching preferences of the user, of their favourite users and posts they liked, commented or favourited, every post appears once and posts are ranked by weights of their reasons decayed by age
+// @ID				get-feed
+// @Produce		json
+// @Param			limit	query		int		true	"Limit"	minimum(1)	maximum(100)
+// @Param			cursor	query		string	false	"Cursor of the next page from meta of the previous response"
+// @Param			lat		query		number	false	"Latitude of the current location of the user, saved location is used if missing"	minimum(-90)	maximum(90)
+// @Param			lon		query		number	false	"Longitude of the current location of the user, saved location is used if missing"	minimum(-180)	maximum(180)
+// @Success		200		{object}	model.Response{data=feed.Response}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/feed [get]
+func (r *Router) getFeed(ctx *fiber.Ctx) error {
+	var params feedModel.GetFeedParams
+
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &params)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	}
+
+	feedParams, err := params.ToCoreFeedParams(userID)
+	if err != nil {
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	posts, total, err := r.feedService.GetFeed(ctx.UserContext(), feedParams)
+	if err != nil {
+		if isPaginationError(err) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	postsDetails := make([]core.PostDetails, len(posts))
+	for i, post := range posts {
+		postsDetails[i] = post.PostDetails
+	}
+
+	access, err := r.postsContactsAccess(ctx.UserContext(), userID, postsDetails...)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	pagination := paginate(total, params.Limit, 0)
+	pagination.NextCursor = feedModel.NextCursor(feedParams, posts)
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(feedModel.ToResponse(pagination, posts, access)))
+}
+
+// @Summary		Get feed preferences
+// @Tags			feed
+// @Description	Get animals and location the current user wants to see in the feed, empty preferences if they were never saved
+// @ID				get-feed-preferences
+// @Produce		json
+// @Success		200	{object}	model.Response{data=feed.Preferences}
+// @Failure		401	{object}	model.Response
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/feed/preferences [get]
+func (r *Router) getFeedPreferences(ctx *fiber.Ctx) error {
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	}
+
+	preferences, err := r.feedService.GetFeedPreferences(ctx.UserContext(), userID)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(feedModel.ToPreferences(preferences)))
+}
+
+// @Summary		Update feed preferences
+// @Tags			feed
+// @Description	Replace animals and location the current user wants to see in the feed
+// @ID				update-feed-preferences
+// @Accept			json
+// @Produce		json
+// @Param			request	body		feed.Preferences	true	"Preferences"
+// @Success		200		{object}	model.Response{data=feed.Preferences}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/feed/preferences [put]
+func (r *Router) updateFeedPreferences(ctx *fiber.Ctx) error {
+	var preferences feedModel.Preferences
+
+	fiberError, parseOrValidationError := parseBodyAndValidate(ctx, r.formValidator, &preferences)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	}
+
+	updated, err := r.feedService.UpdateFeedPreferences(ctx.UserContext(), preferences.ToCoreFeedPreferences(userID))
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(feedModel.ToPreferences(updated)))
+}
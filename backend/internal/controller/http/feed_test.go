@@ -0,0 +1,218 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/feed"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFeed(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	scoredAt := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	dependencies.feedService.EXPECT().
+		GetFeed(mock.Anything, mock.MatchedBy(func(p core.FeedParams) bool {
+			return p.UserID == authorID && *p.Limit == 1 && *p.Latitude == 55.75 && *p.Longitude == 37.62 && p.Cursor == nil
+		})).
+		Return([]core.FeedPost{{
+			PostDetails: core.PostDetails{Post: core.Post{ID: 5, AuthorID: 2, Title: "Found a cat"}, Username: "alice"},
+			Score:       5,
+			Reasons:     []core.FeedReason{core.FeedReasonNearby, core.FeedReasonInteraction},
+			ScoredAt:    scoredAt,
+		}}, 3, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/feed?limit=1&lat=55.75&lon=37.62", http.NoBody)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Data feed.Response `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, 3, body.Data.Meta.Total)
+	require.Len(t, body.Data.Posts, 1)
+	assert.Equal(t, 5, body.Data.Posts[0].ID)
+	assert.Equal(t, 5.0, body.Data.Posts[0].Score)
+	assert.Equal(t, []string{"nearby", "interaction"}, body.Data.Posts[0].Reasons)
+
+	require.NotNil(t, body.Data.Meta.NextCursor)
+	cursor, err := pagination.DecodeCursor(body.Data.Meta.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, core.FeedSort, cursor.Sort)
+	assert.Equal(t, 5, cursor.ID)
+	assert.Equal(t, 5.0, *cursor.Number)
+	assert.True(t, scoredAt.Equal(*cursor.Time))
+}
+
+func TestGetFeed_Errors(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	tests := []struct {
+		name          string
+		route         string
+		token         string
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:  "cursor of other sort",
+			route: "/api/v1/feed?limit=10&cursor=" + pagination.EncodeCursor(core.Cursor{Sort: "newest", ID: 1}),
+			token: token,
+			mockBehaviour: func() {
+				dependencies.feedService.EXPECT().
+					GetFeed(mock.Anything, mock.MatchedBy(func(p core.FeedParams) bool { return p.Cursor != nil })).
+					Return(nil, 0, core.ErrInvalidCursor).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:          "malformed cursor",
+			route:         "/api/v1/feed?limit=10&cursor=%21%21",
+			token:         token,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusBadRequest,
+		},
+		{
+			name:          "latitude without longitude",
+			route:         "/api/v1/feed?limit=10&lat=55.75",
+			token:         token,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "missing limit",
+			route:         "/api/v1/feed",
+			token:         token,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "unauthorized",
+			route:         "/api/v1/feed?limit=10",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodGet, tt.route, http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+			}
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestGetFeedPreferences(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	dependencies.feedService.EXPECT().
+		GetFeedPreferences(mock.Anything, authorID).
+		Return(core.FeedPreferences{UserID: authorID}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/feed/preferences", http.NoBody)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, []interface{}{}, body.Data["animal_types"])
+	assert.Nil(t, body.Data["latitude"])
+}
+
+func TestUpdateFeedPreferences(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	tests := []struct {
+		name          string
+		body          string
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name: "success",
+			body: `{"animal_types":["cat"],"statuses":["lost","found"],"colors":["ginger"],"latitude":55.75,"longitude":37.62,"radius":5}`,
+			mockBehaviour: func() {
+				dependencies.feedService.EXPECT().
+					UpdateFeedPreferences(mock.Anything, mock.MatchedBy(func(p core.FeedPreferences) bool {
+						return p.UserID == authorID && len(p.AnimalTypes) == 1 && len(p.Statuses) == 2 && *p.Radius == 5
+					})).
+					RunAndReturn(func(_ context.Context, p core.FeedPreferences) (core.FeedPreferences, error) {
+						return p, nil
+					}).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:          "unknown animal type",
+			body:          `{"animal_types":["dragon"]}`,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "latitude without longitude",
+			body:          `{"latitude":55.75}`,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "negative radius",
+			body:          `{"latitude":55.75,"longitude":37.62,"radius":-1}`,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/feed/preferences", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
@@ -1,29 +1,21 @@
 package http
 
 import (
-	"bytes"
-	"context"
 	"errors"
 	"fmt"
 
-	"path/filepath"
-	"strings"
-
 	"github.com/gofiber/fiber/v2"
 	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
 	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/validator"
+	"github.com/kotopesp/sos-kotopes/internal/core"
 	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/valyala/fasthttp"
 
 	"io"
-	"mime/multipart"
 
 	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
 )
 
-const MaxFileSize = 1 * 1024 * 1024
-
-var AllowedExtensions = []string{".jpg", ".jpeg", ".png"}
-
 // token helpers: getting info from token
 func getIDFromToken(ctx *fiber.Ctx) (id int, err error) {
 	idItem := getPayloadItem(ctx, "id")
@@ -115,87 +107,63 @@ func oneOfErrors(e error, errs ...error) bool {
 	return false
 }
 
-func GetPhotoBytes(photo *multipart.FileHeader) (*[]byte, error) {
-	file, err := photo.Open()
-	if err != nil {
-		return nil, err
-	}
-
-	defer file.Close()
-
-	photoBytes, err := io.ReadAll(file)
-	if err != nil {
-		return nil, err
-	}
-
-	return &photoBytes, nil
-}
-
-func IsValidExtension(ctx context.Context, file *multipart.FileHeader, allowedExtensions []string) (err error) {
-	ext := filepath.Ext(file.Filename)
-	for _, allowedExt := range allowedExtensions {
-		if strings.EqualFold(ext, allowedExt) {
-			return nil
-		}
-	}
-	logger.Log().Debug(ctx, model.ErrInvalidExtension.Error())
-	return model.ErrInvalidExtension
+// isPaginationError reports whether cursor or sort of the listing are invalid, such errors are caused by the client.
+func isPaginationError(err error) bool {
+	return oneOfErrors(err, core.ErrInvalidCursor, core.ErrLocationRequired)
 }
 
-func IsValidPhotoSize(ctx context.Context, file *multipart.FileHeader) (err error) {
-	fileSize := file.Size
-	if fileSize > MaxFileSize {
-		logger.Log().Debug(ctx, model.ErrInvalidPhotoSize.Error())
-		return model.ErrInvalidPhotoSize
-	}
-
-	return nil
+// isPhotoError reports whether the uploaded photo was rejected by MediaService, such errors are caused by the client.
+func isPhotoError(err error) bool {
+	return oneOfErrors(err, core.ErrPhotoTooLarge, core.ErrInvalidPhoto, core.ErrPhotoFormat, core.ErrPhotoDimensions)
 }
 
-func validatePhoto(ctx context.Context, file *multipart.FileHeader) (err error) {
-	// Check file size
-	err = IsValidPhotoSize(ctx, file)
-	if err != nil {
-		logger.Log().Debug(ctx, err.Error())
-		return err
-	}
-
-	// Check file extension
-	err = IsValidExtension(ctx, file, AllowedExtensions)
-	if err != nil {
-		logger.Log().Debug(ctx, err.Error())
-		return err
-	}
-
-	// Add additional photo validation checks here
-
-	return nil
-}
-
-// Works only for requests with one file
-func openAndValidatePhoto(ctx *fiber.Ctx) (photoBytes *[]byte, err error) {
+// openPhoto reads photo of the request, works only for requests with one file.
+// Content of the photo is verified by MediaService, extension and type sent by the client are never trusted.
+func openPhoto(ctx *fiber.Ctx) (photoBytes *[]byte, err error) {
 	if form, err := ctx.MultipartForm(); err == nil {
 		if files := form.File["photo"]; len(files) > 0 {
-			file := files[0]
-
-			// Read file content
-			fileContent, err := file.Open()
+			fileContent, err := files[0].Open()
 			if err != nil {
 				return nil, err
 			}
 			defer fileContent.Close()
 
-			buffer := bytes.NewBuffer(nil)
-			if _, err = io.Copy(buffer, fileContent); err != nil {
-				return nil, err
-			}
-			// Validate photo
-			if err := validatePhoto(ctx.UserContext(), file); err != nil {
+			bytesTmp, err := io.ReadAll(fileContent)
+			if err != nil {
 				return nil, err
 			}
-			bytesTmp := buffer.Bytes()
 			photoBytes = &bytesTmp
 		}
 	}
 	return photoBytes, nil
 }
+
+// openPhotos reads all photos of the request in the order they were sent.
+// Returns nil if request isn't multipart or has no photos, model.ErrInvalidBody if the multipart form is malformed.
+// Field photo is kept for clients uploading single photo.
+func openPhotos(ctx *fiber.Ctx) (photos []core.MediaUpload, err error) {
+	form, err := ctx.MultipartForm()
+	if errors.Is(err, fasthttp.ErrNoMultipartForm) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %s", model.ErrInvalidBody, err)
+	}
+
+	files := append(form.File["photos"], form.File["photo"]...)
+	for _, file := range files {
+		fileContent, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := io.ReadAll(fileContent)
+		fileContent.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		photos = append(photos, core.MediaUpload{Data: data})
+	}
+
+	return photos, nil
+}
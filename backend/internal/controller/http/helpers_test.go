@@ -0,0 +1,83 @@
+package http
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenPhotos(t *testing.T) {
+	t.Parallel()
+
+	photosForm := new(bytes.Buffer)
+	mp := multipart.NewWriter(photosForm)
+	for _, file := range []struct{ field, data string }{{"photo", "second"}, {"photos", "first"}} {
+		part, err := mp.CreateFormFile(file.field, file.field+".jpg")
+		require.NoError(t, err)
+		_, err = part.Write([]byte(file.data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, mp.Close())
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantPhotos  []core.MediaUpload
+		wantErr     error
+	}{
+		{
+			name:        "json body",
+			contentType: fiber.MIMEApplicationJSON,
+			body:        `{"title":"title"}`,
+		},
+		{
+			name:        "photos",
+			contentType: mp.FormDataContentType(),
+			body:        photosForm.String(),
+			wantPhotos:  []core.MediaUpload{{Data: []byte("first")}, {Data: []byte("second")}},
+		},
+		{
+			name:        "malformed form",
+			contentType: "multipart/form-data; boundary=boundary",
+			body:        "--boundary\r\nContent-Disposition: form-data; name=\"photos\"; filename=\"a.jpg\"\r\n\r\ntruncated",
+			wantErr:     model.ErrInvalidBody,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				photos []core.MediaUpload
+				err    error
+			)
+			// the form is parsed by the handler, otherwise the server rejects malformed forms itself
+			app := fiber.New(fiber.Config{DisablePreParseMultipartForm: true})
+			app.Post("/", func(ctx *fiber.Ctx) error {
+				photos, err = openPhotos(ctx)
+				return nil
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			req.Header.Set(fiber.HeaderContentType, tt.contentType)
+
+			resp, testErr := app.Test(req, -1)
+			require.NoError(t, testErr)
+			require.NoError(t, resp.Body.Close())
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantPhotos, photos)
+		})
+	}
+}
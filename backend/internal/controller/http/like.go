@@ -0,0 +1,136 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/comment"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/like"
+	postModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// @Summary		Like a comment
+// @Tags			comments
+// @Description	Like the published comment of the published post, liking it again changes nothing
+// @ID				like-comment
+// @Produce		json
+// @Param			post_id		path		int	true	"Post ID"		minimum(1)
+// @Param			comment_id	path		int	true	"Comment ID"	minimum(1)
+// @Success		200			{object}	model.Response{data=like.Response}
+// @Failure		401			{object}	model.Response
+// @Failure		404			{object}	model.Response
+// @Failure		422			{object}	model.Response{data=validator.Response}
+// @Failure		500			{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/posts/{post_id}/comments/{comment_id}/likes [post]
+func (r *Router) likeComment(ctx *fiber.Ctx) error {
+	return r.changeCommentLike(ctx, r.commentService.LikeComment)
+}
+
+// @Summary		Unlike a comment
+// @Tags			comments
+// @Description	Remove the like of the current user from the comment
+// @ID				unlike-comment
+// @Produce		json
+// @Param			post_id		path		int	true	"Post ID"		minimum(1)
+// @Param			comment_id	path		int	true	"Comment ID"	minimum(1)
+// @Success		200			{object}	model.Response{data=like.Response}
+// @Failure		401			{object}	model.Response
+// @Failure		404			{object}	model.Response
+// @Failure		422			{object}	model.Response{data=validator.Response}
+// @Failure		500			{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/posts/{post_id}/comments/{comment_id}/likes [delete]
+func (r *Router) unlikeComment(ctx *fiber.Ctx) error {
+	return r.changeCommentLike(ctx, r.commentService.UnlikeComment)
+}
+
+func (r *Router) changeCommentLike(ctx *fiber.Ctx, change func(ctx context.Context, postID, commentID, userID int) (core.Likes, error)) error {
+	var pathParams comment.PathParams
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	likes, err := change(ctx.UserContext(), pathParams.PostID, pathParams.CommentID, userID)
+	switch {
+	case oneOfUpdateDeleteErrors(err), errors.Is(err, core.ErrPostNotFound):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+	case err != nil:
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(like.ToResponse(likes)))
+}
+
+// @Summary		Like a post
+// @Tags			post
+// @Description	Like the published post, liking it again changes nothing
+// @ID				like-post
+// @Produce		json
+// @Param			id	path		int	true	"Post ID"	minimum(1)
+// @Success		200	{object}	model.Response{data=like.Response}
+// @Failure		401	{object}	model.Response
+// @Failure		404	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/posts/{id}/likes [post]
+func (r *Router) likePost(ctx *fiber.Ctx) error {
+	return r.changePostLike(ctx, r.postService.LikePost)
+}
+
+// @Summary		Unlike a post
+// @Tags			post
+// @Description	Remove the like of the current user from the post
+// @ID				unlike-post
+// @Produce		json
+// @Param			id	path		int	true	"Post ID"	minimum(1)
+// @Success		200	{object}	model.Response{data=like.Response}
+// @Failure		401	{object}	model.Response
+// @Failure		404	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/posts/{id}/likes [delete]
+func (r *Router) unlikePost(ctx *fiber.Ctx) error {
+	return r.changePostLike(ctx, r.postService.UnlikePost)
+}
+
+func (r *Router) changePostLike(ctx *fiber.Ctx, change func(ctx context.Context, postID, userID int) (core.Likes, error)) error {
+	var pathParams postModel.PathParams
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	likes, err := change(ctx.UserContext(), pathParams.PostID, userID)
+	switch {
+	case errors.Is(err, core.ErrPostNotFound):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+	case err != nil:
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(like.ToResponse(likes)))
+}
@@ -0,0 +1,191 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLikeComment(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	const route = "/api/v1/posts/1/comments/%d/likes"
+
+	tests := []struct {
+		name          string
+		method        string
+		commentID     int
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:      "like",
+			method:    http.MethodPost,
+			commentID: 1,
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					LikeComment(mock.Anything, 1, 1, authorID).
+					Return(core.Likes{Count: 1, LikedByMe: true}, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "unlike",
+			method:    http.MethodDelete,
+			commentID: 2,
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					UnlikeComment(mock.Anything, 1, 2, authorID).
+					Return(core.Likes{}, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "deleted comment",
+			method:    http.MethodPost,
+			commentID: 3,
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					LikeComment(mock.Anything, 1, 3, authorID).
+					Return(core.Likes{}, core.ErrCommentIsDeleted).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:      "post not found",
+			method:    http.MethodPost,
+			commentID: 5,
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					LikeComment(mock.Anything, 1, 5, authorID).
+					Return(core.Likes{}, core.ErrPostNotFound).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:      "internal error",
+			method:    http.MethodPost,
+			commentID: 4,
+			mockBehaviour: func() {
+				dependencies.commentService.EXPECT().
+					LikeComment(mock.Anything, 1, 4, authorID).
+					Return(core.Likes{}, errors.New("internal error")).Once()
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:          "invalid comment id",
+			method:        http.MethodPost,
+			commentID:     0,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(tt.method, fmt.Sprintf(route, tt.commentID), http.NoBody)
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestLikePost(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	const route = "/api/v1/posts/%d/likes"
+
+	tests := []struct {
+		name          string
+		method        string
+		postID        int
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:   "like",
+			method: http.MethodPost,
+			postID: 1,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					LikePost(mock.Anything, 1, authorID).
+					Return(core.Likes{Count: 1, LikedByMe: true}, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "unlike",
+			method: http.MethodDelete,
+			postID: 2,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					UnlikePost(mock.Anything, 2, authorID).
+					Return(core.Likes{}, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "post not found",
+			method: http.MethodPost,
+			postID: 3,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					LikePost(mock.Anything, 3, authorID).
+					Return(core.Likes{}, core.ErrPostNotFound).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(tt.method, fmt.Sprintf(route, tt.postID), http.NoBody)
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestGetComments_LikedByCurrentUser(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	dependencies.commentService.EXPECT().
+		GetAllComments(mock.Anything, mock.MatchedBy(func(p core.GetAllCommentsParams) bool {
+			return p.UserID == authorID && p.Sort == core.CommentSortTop
+		})).
+		Return([]core.Comment{{ID: 1, Likes: core.Likes{Count: 1, LikedByMe: true}}}, 1, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/1/comments?limit=10&sort=top", http.NoBody)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,236 @@
+package http
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	mediaModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/media"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+const (
+	// mutableCacheControl - photo may be replaced or hidden together with the post or the user it belongs to,
+	// so clients revalidate it often. Content of the photo with the given ID never changes, so revalidation is cheap.
+	mutableCacheControl = "public, max-age=60"
+	// privateCacheControl - photo is visible to the requesting user only, e.g. photo of the draft
+	privateCacheControl = "private, no-cache"
+)
+
+// @Summary		Get photo
+// @Tags			media
+// @Description	Get the photo in the requested size, downscaled sizes are available in the format of the photo and WebP.
+// @Description	Photos of drafts are available to their authors only, photos of deleted posts and posts on moderation are not available.
+// @ID				get-media
+// @Produce		image/jpeg,image/png,image/webp
+// @Param			id		path		int		true	"Photo ID"	minimum(1)
+// @Param			size	query		string	false	"Size"		Enums(original, medium, thumbnail)
+// @Param			format	query		string	false	"Format"	Enums(original, webp)
+// @Success		200		{file}		binary
+// @Success		206		{file}		binary
+// @Success		304
+// @Failure		404		{object}	model.Response
+// @Failure		416
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Router			/media/{id} [get]
+func (r *Router) getMedia(ctx *fiber.Ctx) error {
+	var pathParams mediaModel.PathParams
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var fileParams mediaModel.FileParams
+	fiberError, parseOrValidationError = parseQueryAndValidate(ctx, r.formValidator, &fileParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	mediaFileParams := fileParams.ToCoreMediaFileParams()
+	mediaFileParams.ViewerID = getViewerID(ctx)
+
+	file, err := r.mediaService.GetMediaFile(ctx.UserContext(), pathParams.ID, mediaFileParams)
+	if err != nil {
+		return mediaErrorResponse(ctx, err)
+	}
+
+	return r.sendMediaFile(ctx, file)
+}
+
+// @Summary		Get avatar of the user
+// @Tags			media
+// @Description	Get avatar of the user in the requested size, avatars of deleted users are not available
+// @ID				get-user-avatar
+// @Produce		image/jpeg,image/png,image/webp
+// @Param			id		path		int		true	"User ID"	minimum(1)
+// @Param			size	query		string	false	"Size"		Enums(original, medium, thumbnail)
+// @Param			format	query		string	false	"Format"	Enums(original, webp)
+// @Success		200		{file}		binary
+// @Success		206		{file}		binary
+// @Success		304
+// @Failure		404		{object}	model.Response
+// @Failure		416
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Router			/users/{id}/avatar [get]
+func (r *Router) getUserAvatar(ctx *fiber.Ctx) error {
+	var pathParams mediaModel.PathParams
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var fileParams mediaModel.FileParams
+	fiberError, parseOrValidationError = parseQueryAndValidate(ctx, r.formValidator, &fileParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	file, err := r.mediaService.GetUserPhotoFile(ctx.UserContext(), pathParams.ID, fileParams.ToCoreMediaFileParams())
+	if err != nil {
+		return mediaErrorResponse(ctx, err)
+	}
+
+	return r.sendMediaFile(ctx, file)
+}
+
+// @Summary		Get photo of the post
+// @Tags			media
+// @Description	Get photo of the post in the requested size, the cover of the post by default.
+// @Description	Photos of drafts are available to their authors only, photos of deleted posts and posts on moderation are not available.
+// @ID				get-post-photo
+// @Produce		image/jpeg,image/png,image/webp
+// @Param			id		path		int		true	"Post ID"	minimum(1)
+// @Param			index	query		int		false	"Position of the photo"	minimum(0)
+// @Param			size	query		string	false	"Size"		Enums(original, medium, thumbnail)
+// @Param			format	query		string	false	"Format"	Enums(original, webp)
+// @Success		200		{file}		binary
+// @Success		206		{file}		binary
+// @Success		304
+// @Failure		404		{object}	model.Response
+// @Failure		416
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Router			/posts/{id}/photo [get]
+func (r *Router) getPostPhoto(ctx *fiber.Ctx) error {
+	var pathParams mediaModel.PathParams
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var fileParams mediaModel.FileParams
+	fiberError, parseOrValidationError = parseQueryAndValidate(ctx, r.formValidator, &fileParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var photoParams mediaModel.PostPhotoParams
+	fiberError, parseOrValidationError = parseQueryAndValidate(ctx, r.formValidator, &photoParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	mediaFileParams := fileParams.ToCoreMediaFileParams()
+	mediaFileParams.ViewerID = getViewerID(ctx)
+
+	file, err := r.mediaService.GetPostPhotoFile(
+		ctx.UserContext(),
+		pathParams.ID,
+		photoParams.Index,
+		mediaFileParams,
+	)
+	if err != nil {
+		return mediaErrorResponse(ctx, err)
+	}
+
+	return r.sendMediaFile(ctx, file)
+}
+
+// sendMediaFile sends content of the file with caching headers. Conditional requests are answered without reading the file,
+// a single byte range is supported, other ranges are ignored and the whole file is sent.
+func (r *Router) sendMediaFile(ctx *fiber.Ctx, file core.MediaFile) error {
+	cacheControl := mutableCacheControl
+	if file.Private {
+		cacheControl = privateCacheControl
+	}
+
+	etag := mediaETag(file)
+
+	ctx.Set(fiber.HeaderETag, etag)
+	ctx.Set(fiber.HeaderLastModified, file.ModifiedAt.UTC().Format(http.TimeFormat))
+	ctx.Set(fiber.HeaderCacheControl, cacheControl)
+	ctx.Set(fiber.HeaderAcceptRanges, "bytes")
+
+	if notModified(ctx, etag, file.ModifiedAt) {
+		return ctx.SendStatus(fiber.StatusNotModified)
+	}
+
+	data, err := r.mediaService.ReadMediaFile(ctx.UserContext(), file)
+	if err != nil {
+		return mediaErrorResponse(ctx, err)
+	}
+
+	ctx.Set(fiber.HeaderContentType, file.ContentType)
+
+	// range is ignored if the client has other version of the file
+	if ctx.Get(fiber.HeaderRange) != "" && (ctx.Get(fiber.HeaderIfRange) == "" || ctx.Get(fiber.HeaderIfRange) == etag) {
+		ranges, err := ctx.Range(len(data))
+		switch {
+		case errors.Is(err, fiber.ErrRangeUnsatisfiable):
+			ctx.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes */%d", len(data)))
+			return ctx.SendStatus(fiber.StatusRequestedRangeNotSatisfiable)
+		case err == nil && ranges.Type == "bytes" && len(ranges.Ranges) == 1:
+			byteRange := ranges.Ranges[0]
+			ctx.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes %d-%d/%d", byteRange.Start, byteRange.End, len(data)))
+			return ctx.Status(fiber.StatusPartialContent).Send(data[byteRange.Start : byteRange.End+1])
+		}
+	}
+
+	return ctx.Status(fiber.StatusOK).Send(data)
+}
+
+// mediaETag - content of the file with the given storage key never changes, so the key identifies the version of the file
+func mediaETag(file core.MediaFile) string {
+	sum := sha256.Sum256([]byte(file.StorageKey))
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// notModified checks conditional headers of the request, If-None-Match takes precedence over If-Modified-Since
+func notModified(ctx *fiber.Ctx, etag string, modifiedAt time.Time) bool {
+	if noneMatch := ctx.Get(fiber.HeaderIfNoneMatch); noneMatch != "" {
+		for _, tag := range strings.Split(noneMatch, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == etag || tag == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	if modifiedSince := ctx.Get(fiber.HeaderIfModifiedSince); modifiedSince != "" {
+		since, err := http.ParseTime(modifiedSince)
+		// Last-Modified has precision of seconds
+		return err == nil && !modifiedAt.Truncate(time.Second).After(since)
+	}
+
+	return false
+}
+
+func mediaErrorResponse(ctx *fiber.Ctx, err error) error {
+	if errors.Is(err, core.ErrMediaNotFound) {
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	logger.Log().Error(ctx.UserContext(), err.Error())
+	return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+}
@@ -0,0 +1,181 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMedia(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	modifiedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	file := core.MediaFile{StorageKey: "posts/1/a_thumbnail.webp", ContentType: "image/webp", ModifiedAt: modifiedAt}
+	data := []byte("0123456789")
+	etag := mediaETag(file)
+
+	tests := []struct {
+		name          string
+		route         string
+		headers       map[string]string
+		mockBehaviour func()
+		wantCode      int
+		wantBody      string
+		wantHeaders   map[string]string
+	}{
+		{
+			name:  "success",
+			route: "/api/v1/media/1?size=thumbnail&format=webp",
+			mockBehaviour: func() {
+				dependencies.mediaService.EXPECT().
+					GetMediaFile(mock.Anything, 1, core.MediaFileParams{Variant: core.MediaVariantThumbnail, WebP: true}).
+					Return(file, nil).Once()
+				dependencies.mediaService.EXPECT().ReadMediaFile(mock.Anything, file).Return(data, nil).Once()
+			},
+			wantCode: http.StatusOK,
+			wantBody: "0123456789",
+			wantHeaders: map[string]string{
+				"Content-Type":  "image/webp",
+				"ETag":          etag,
+				"Last-Modified": "Wed, 01 May 2024 10:00:00 GMT",
+				"Cache-Control": mutableCacheControl,
+			},
+		},
+		{
+			name:    "not modified by etag",
+			route:   "/api/v1/media/1",
+			headers: map[string]string{"If-None-Match": `"other", ` + etag},
+			mockBehaviour: func() {
+				dependencies.mediaService.EXPECT().GetMediaFile(mock.Anything, 1, core.MediaFileParams{}).Return(file, nil).Once()
+			},
+			wantCode: http.StatusNotModified,
+		},
+		{
+			name:    "not modified since",
+			route:   "/api/v1/media/1",
+			headers: map[string]string{"If-Modified-Since": "Wed, 01 May 2024 10:00:00 GMT"},
+			mockBehaviour: func() {
+				dependencies.mediaService.EXPECT().GetMediaFile(mock.Anything, 1, core.MediaFileParams{}).Return(file, nil).Once()
+			},
+			wantCode: http.StatusNotModified,
+		},
+		{
+			name:    "range",
+			route:   "/api/v1/media/1",
+			headers: map[string]string{"Range": "bytes=2-4"},
+			mockBehaviour: func() {
+				dependencies.mediaService.EXPECT().GetMediaFile(mock.Anything, 1, core.MediaFileParams{}).Return(file, nil).Once()
+				dependencies.mediaService.EXPECT().ReadMediaFile(mock.Anything, file).Return(data, nil).Once()
+			},
+			wantCode:    http.StatusPartialContent,
+			wantBody:    "234",
+			wantHeaders: map[string]string{"Content-Range": "bytes 2-4/10"},
+		},
+		{
+			name:    "range of other version is ignored",
+			route:   "/api/v1/media/1",
+			headers: map[string]string{"Range": "bytes=2-4", "If-Range": `"other"`},
+			mockBehaviour: func() {
+				dependencies.mediaService.EXPECT().GetMediaFile(mock.Anything, 1, core.MediaFileParams{}).Return(file, nil).Once()
+				dependencies.mediaService.EXPECT().ReadMediaFile(mock.Anything, file).Return(data, nil).Once()
+			},
+			wantCode: http.StatusOK,
+			wantBody: "0123456789",
+		},
+		{
+			name:    "unsatisfiable range",
+			route:   "/api/v1/media/1",
+			headers: map[string]string{"Range": "bytes=20-30"},
+			mockBehaviour: func() {
+				dependencies.mediaService.EXPECT().GetMediaFile(mock.Anything, 1, core.MediaFileParams{}).Return(file, nil).Once()
+				dependencies.mediaService.EXPECT().ReadMediaFile(mock.Anything, file).Return(data, nil).Once()
+			},
+			wantCode:    http.StatusRequestedRangeNotSatisfiable,
+			wantHeaders: map[string]string{"Content-Range": "bytes */10"},
+		},
+		{
+			name:  "not found",
+			route: "/api/v1/media/2",
+			mockBehaviour: func() {
+				dependencies.mediaService.EXPECT().GetMediaFile(mock.Anything, 2, core.MediaFileParams{}).
+					Return(core.MediaFile{}, core.ErrMediaNotFound).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:          "invalid size",
+			route:         "/api/v1/media/1?size=huge",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:  "avatar",
+			route: "/api/v1/users/3/avatar?size=medium",
+			mockBehaviour: func() {
+				dependencies.mediaService.EXPECT().
+					GetUserPhotoFile(mock.Anything, 3, core.MediaFileParams{Variant: core.MediaVariantMedium}).
+					Return(file, nil).Once()
+				dependencies.mediaService.EXPECT().ReadMediaFile(mock.Anything, file).Return(data, nil).Once()
+			},
+			wantCode:    http.StatusOK,
+			wantBody:    "0123456789",
+			wantHeaders: map[string]string{"Cache-Control": mutableCacheControl},
+		},
+		{
+			name:    "photo of the draft",
+			route:   "/api/v1/posts/4/photo",
+			headers: map[string]string{"Authorization": "Bearer " + token},
+			mockBehaviour: func() {
+				draftFile := file
+				draftFile.Private = true
+				dependencies.mediaService.EXPECT().GetPostPhotoFile(mock.Anything, 4, 0, core.MediaFileParams{ViewerID: authorID}).
+					Return(draftFile, nil).Once()
+				dependencies.mediaService.EXPECT().ReadMediaFile(mock.Anything, draftFile).Return(data, nil).Once()
+			},
+			wantCode:    http.StatusOK,
+			wantHeaders: map[string]string{"Cache-Control": privateCacheControl},
+		},
+		{
+			name:  "photo of the post",
+			route: "/api/v1/posts/4/photo?index=1",
+			mockBehaviour: func() {
+				dependencies.mediaService.EXPECT().GetPostPhotoFile(mock.Anything, 4, 1, core.MediaFileParams{}).
+					Return(core.MediaFile{}, core.ErrMediaNotFound).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodGet, tt.route, http.NoBody)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+			if tt.wantBody != "" {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantBody, string(body))
+			}
+			for key, value := range tt.wantHeaders {
+				assert.Equal(t, value, resp.Header.Get(key))
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/comment"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetComments_MentionEntities(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	mentions := []core.CommentMention{{CommentID: 1, UserID: 2, Username: "alice", Offset: 3, Length: 6}}
+	dependencies.commentService.EXPECT().
+		GetAllComments(mock.Anything, mock.Anything).
+		Return([]core.Comment{
+			{ID: 1, Status: core.Published, Content: "Hi @alice", Mentions: mentions},
+			{ID: 2, Status: core.Deleted, Content: "Hi @alice", Mentions: mentions},
+		}, 2, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/1/comments?limit=10", http.NoBody)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Data comment.GetAllCommentsResponse `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Data.Data, 2)
+	assert.Equal(t, []comment.Entity{{Type: comment.EntityMention, Offset: 3, Length: 6, UserID: 2, Username: "alice"}}, body.Data.Data[0].Entities)
+	assert.Empty(t, body.Data.Data[1].Entities, "deleted comment has no entities")
+}
@@ -0,0 +1,68 @@
+package adoption
+
+import (
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+)
+
+type (
+	// Apply is the structure used for applying for the animal, answers are keyed by questions of the questionnaire
+	Apply struct {
+		Answers map[string]string `json:"answers" validate:"required"`
+	}
+
+	// UpdateApplication is the structure used for reviewing or withdrawing the application
+	UpdateApplication struct {
+		Status     *string `json:"status" validate:"omitempty,oneof=under_review interview approved rejected withdrawn"`
+		KeeperNote *string `json:"keeper_note" validate:"omitempty,max=2000"` // Only the author and the keeper of the animal may set it
+	}
+
+	// QuestionResponse represents the question of the questionnaire
+	QuestionResponse struct {
+		Key       string `json:"key"`
+		Text      string `json:"text"`
+		Required  bool   `json:"required"`
+		MaxLength int    `json:"max_length"`
+	}
+
+	// ApplicantResponse represents profile of the applicant at the moment of applying
+	ApplicantResponse struct {
+		ID           int       `json:"id"`
+		Username     string    `json:"username"`
+		Firstname    *string   `json:"firstname,omitempty"`
+		Lastname     *string   `json:"lastname,omitempty"`
+		Description  *string   `json:"description,omitempty"`
+		RegisteredAt time.Time `json:"registered_at"`
+	}
+
+	// ApplicationResponse represents the adoption application
+	ApplicationResponse struct {
+		ID         int               `json:"id"`
+		AnimalID   int               `json:"animal_id"`
+		Status     string            `json:"status"`
+		Answers    map[string]string `json:"answers"`
+		Applicant  ApplicantResponse `json:"applicant"`
+		KeeperNote string            `json:"keeper_note,omitempty"` // Shown only to the author and the keeper of the animal
+		CreatedAt  time.Time         `json:"created_at"`
+		UpdatedAt  time.Time         `json:"updated_at"`
+		ClosedAt   *time.Time        `json:"closed_at,omitempty"`
+	}
+
+	// Response represents the list of applications with pagination
+	Response struct {
+		Meta         pagination.Pagination `json:"meta"`
+		Applications []ApplicationResponse `json:"applications"`
+	}
+
+	// GetApplicationsParams represents the parameters for fetching a list of applications
+	GetApplicationsParams struct {
+		Limit  int     `query:"limit" validate:"gt=0,lte=100"`
+		Offset int     `query:"offset" validate:"gte=0"`
+		Status *string `query:"status" validate:"omitempty,oneof=submitted under_review interview approved rejected withdrawn"`
+	}
+
+	PathParams struct {
+		ID int `params:"id" validate:"gt=0"`
+	}
+)
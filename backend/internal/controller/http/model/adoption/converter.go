@@ -0,0 +1,103 @@
+package adoption
+
+import (
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+// ToCoreAdoptionApplication converts Apply to the application of the user for the animal
+func (a *Apply) ToCoreAdoptionApplication(animalID, applicantID int) core.AdoptionApplication {
+	return core.AdoptionApplication{
+		AnimalID:    animalID,
+		ApplicantID: applicantID,
+		Answers:     a.Answers,
+	}
+}
+
+// ToCoreUpdateAdoptionApplication converts UpdateApplication to change of the application made by the user
+func (u *UpdateApplication) ToCoreUpdateAdoptionApplication(id, userID int) core.UpdateAdoptionApplication {
+	update := core.UpdateAdoptionApplication{
+		ID:         id,
+		UserID:     userID,
+		KeeperNote: u.KeeperNote,
+	}
+
+	if u.Status != nil {
+		status := core.AdoptionStatus(*u.Status)
+		update.Status = &status
+	}
+
+	return update
+}
+
+// ToCoreGetAdoptionApplicationsParams converts GetApplicationsParams to core.GetAdoptionApplicationsParams
+func (p *GetApplicationsParams) ToCoreGetAdoptionApplicationsParams() core.GetAdoptionApplicationsParams {
+	params := core.GetAdoptionApplicationsParams{
+		Limit:  &p.Limit,
+		Offset: &p.Offset,
+	}
+
+	if p.Status != nil {
+		status := core.AdoptionStatus(*p.Status)
+		params.Status = &status
+	}
+
+	return params
+}
+
+// ToQuestionResponses converts questionnaire to QuestionResponse list
+func ToQuestionResponses(questions []core.AdoptionQuestion) []QuestionResponse {
+	res := make([]QuestionResponse, len(questions))
+
+	for i, question := range questions {
+		res[i] = QuestionResponse{
+			Key:       question.Key,
+			Text:      question.Text,
+			Required:  question.Required,
+			MaxLength: question.MaxLength,
+		}
+	}
+
+	return res
+}
+
+// ToApplicationResponse converts core.AdoptionApplication to ApplicationResponse
+func ToApplicationResponse(application core.AdoptionApplication) ApplicationResponse {
+	answers := application.Answers
+	if answers == nil {
+		answers = core.AdoptionAnswers{}
+	}
+
+	return ApplicationResponse{
+		ID:       application.ID,
+		AnimalID: application.AnimalID,
+		Status:   string(application.Status),
+		Answers:  answers,
+		Applicant: ApplicantResponse{
+			ID:           application.ApplicantID,
+			Username:     application.Applicant.Username,
+			Firstname:    application.Applicant.Firstname,
+			Lastname:     application.Applicant.Lastname,
+			Description:  application.Applicant.Description,
+			RegisteredAt: application.Applicant.RegisteredAt,
+		},
+		KeeperNote: application.KeeperNote,
+		CreatedAt:  application.CreatedAt,
+		UpdatedAt:  application.UpdatedAt,
+		ClosedAt:   application.ClosedAt,
+	}
+}
+
+// ToResponse converts a list of core.AdoptionApplication to Response with pagination meta
+func ToResponse(meta pagination.Pagination, applications []core.AdoptionApplication) Response {
+	res := make([]ApplicationResponse, len(applications))
+
+	for i, application := range applications {
+		res[i] = ToApplicationResponse(application)
+	}
+
+	return Response{
+		Meta:         meta,
+		Applications: res,
+	}
+}
@@ -1,13 +1,94 @@
 package animal
 
+import (
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+)
+
 type (
+	// CreateAnimal is the structure used for registering an animal without a post
+	CreateAnimal struct {
+		AnimalType  string `form:"animal_type" json:"animal_type" validate:"required,oneof=dog cat rabbit bird rodent other"`
+		Age         int    `form:"age" json:"age" validate:"gte=0"`
+		Color       string `form:"color" json:"color" validate:"required"`
+		Gender      string `form:"gender" json:"gender" validate:"required,oneof=male female"`
+		Description string `form:"description" json:"description" validate:"max=2000"`
+		Status      string `form:"status" json:"status" validate:"required,oneof=lost found need_home"`
+		post.AnimalDetails
+	}
+
 	// UpdateAnimal is the structure used for updating an animal
 	UpdateAnimal struct {
-		AnimalType  *string `form:"animal_type" json:"animal_type"`
-		Age         *int    `form:"age" json:"age"`
+		AnimalType  *string `form:"animal_type" json:"animal_type" validate:"omitempty,oneof=dog cat rabbit bird rodent other"`
+		Age         *int    `form:"age" json:"age" validate:"omitempty,gte=0"`
 		Color       *string `form:"color" json:"color"`
-		Gender      *string `form:"gender" json:"gender"`
-		Description *string `form:"description" json:"description"`
-		Status      *string `form:"status" json:"status"`
+		Gender      *string `form:"gender" json:"gender" validate:"omitempty,oneof=male female"`
+		Description *string `form:"description" json:"description" validate:"omitempty,max=2000"`
+		Status      *string `form:"status" json:"status" validate:"omitempty,oneof=lost found need_home adopted"`
+		post.AnimalDetails
+	}
+
+	// SetKeeper is the structure used for assigning an animal to a keeper
+	SetKeeper struct {
+		KeeperID *int `json:"keeper_id" validate:"omitempty,gt=0"` // ID of the user with keeper role, null leaves the animal without keeper
+	}
+
+	// CreateEvent is the structure used for recording an event of the case history, photos are sent in the form
+	CreateEvent struct {
+		Type       string  `form:"type" json:"type" validate:"required,oneof=found at_vet in_foster adopted returned_to_owner deceased"`
+		Note       string  `form:"note" json:"note" validate:"max=2000"`
+		HappenedAt *string `form:"happened_at" json:"happened_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"` // RFC 3339, now by default
+	}
+
+	// AnimalResponse represents the animal with its current stage and keeper
+	AnimalResponse struct {
+		ID          int     `json:"id"`
+		AnimalType  string  `json:"animal_type"`
+		Age         int     `json:"age"`
+		Color       string  `json:"color"`
+		Gender      string  `json:"gender"`
+		Description string  `json:"description"`
+		Status      string  `json:"status"`
+		Stage       *string `json:"stage,omitempty"`     // Type of the latest event, missing if the animal has no events
+		AuthorID    *int    `json:"author_id,omitempty"` // User who registered the animal
+		KeeperID    *int    `json:"keeper_id,omitempty"` // User who keeps the animal
+		post.AnimalDetailsResponse
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	// Response represents the list of animals with pagination
+	Response struct {
+		Meta    pagination.Pagination `json:"meta"`
+		Animals []AnimalResponse      `json:"animals"`
+	}
+
+	// EventResponse represents the event of the case history of the animal
+	EventResponse struct {
+		ID         int                  `json:"id"`
+		AnimalID   int                  `json:"animal_id"`
+		AuthorID   int                  `json:"author_id"`
+		Type       string               `json:"type"`
+		Note       string               `json:"note"`
+		HappenedAt time.Time            `json:"happened_at"`
+		CreatedAt  time.Time            `json:"created_at"`
+		Photos     []post.PhotoResponse `json:"photos"`
+	}
+
+	// GetAnimalsParams represents the parameters for fetching a list of animals with filters
+	GetAnimalsParams struct {
+		Limit      int     `query:"limit" validate:"gt=0,lte=100"`
+		Offset     int     `query:"offset" validate:"gte=0"`
+		AuthorID   *int    `query:"author_id" validate:"omitempty,gt=0"` // Animals registered by the user
+		KeeperID   *int    `query:"keeper_id" validate:"omitempty,gt=0"` // Animals kept by the user
+		Stage      *string `query:"stage" validate:"omitempty,oneof=found at_vet in_foster adopted returned_to_owner deceased"`
+		Status     *string `query:"status" validate:"omitempty,oneof=lost found need_home adopted"`
+		AnimalType *string `query:"animal_type" validate:"omitempty,oneof=dog cat rabbit bird rodent other"`
+	}
+
+	PathParams struct {
+		ID int `params:"id" validate:"gt=0"`
 	}
 )
@@ -0,0 +1,167 @@
+package animal
+
+import (
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+// ToCoreAnimal converts CreateAnimal to core.Animal registered by the user
+func (a *CreateAnimal) ToCoreAnimal(authorID int) core.Animal {
+	if a == nil {
+		return core.Animal{}
+	}
+
+	animal := core.Animal{
+		AuthorID:        &authorID,
+		AnimalType:      a.AnimalType,
+		Size:            a.Size,
+		Age:             a.Age,
+		Color:           a.Color,
+		Colors:          a.Colors,
+		CoatPattern:     a.CoatPattern,
+		Gender:          a.Gender,
+		Description:     a.Description,
+		MicrochipNumber: a.MicrochipNumber,
+		TattooID:        a.TattooID,
+		IsSterilized:    a.IsSterilized,
+		IsVaccinated:    a.IsVaccinated,
+		HasCollar:       a.HasCollar,
+		Status:          a.Status,
+	}
+	if a.Name != nil {
+		animal.Name = *a.Name
+	}
+	if a.Breed != nil {
+		animal.Breed = *a.Breed
+	}
+	if a.DistinguishingMarks != nil {
+		animal.DistinguishingMarks = *a.DistinguishingMarks
+	}
+	if a.CollarDescription != nil {
+		animal.CollarDescription = *a.CollarDescription
+	}
+
+	return animal
+}
+
+// ToCoreUpdateRequestBodyAnimal converts UpdateAnimal to changes of the animal made by the user
+func (a *UpdateAnimal) ToCoreUpdateRequestBodyAnimal(id, userID int) core.UpdateRequestBodyAnimal {
+	if a == nil {
+		return core.UpdateRequestBodyAnimal{ID: id, UserID: userID}
+	}
+
+	return core.UpdateRequestBodyAnimal{
+		ID:                  id,
+		UserID:              userID,
+		Name:                a.Name,
+		AnimalType:          a.AnimalType,
+		Breed:               a.Breed,
+		Size:                a.Size,
+		Age:                 a.Age,
+		Color:               a.Color,
+		Colors:              a.Colors,
+		CoatPattern:         a.CoatPattern,
+		Gender:              a.Gender,
+		Description:         a.Description,
+		DistinguishingMarks: a.DistinguishingMarks,
+		MicrochipNumber:     a.MicrochipNumber,
+		TattooID:            a.TattooID,
+		IsSterilized:        a.IsSterilized,
+		IsVaccinated:        a.IsVaccinated,
+		HasCollar:           a.HasCollar,
+		CollarDescription:   a.CollarDescription,
+		Status:              a.Status,
+	}
+}
+
+// ToCoreAnimalEvent converts CreateEvent to core.AnimalEvent recorded by the user
+func (e *CreateEvent) ToCoreAnimalEvent(animalID, authorID int) core.AnimalEvent {
+	event := core.AnimalEvent{
+		AnimalID: animalID,
+		AuthorID: authorID,
+		Type:     e.Type,
+		Note:     e.Note,
+	}
+
+	if e.HappenedAt != nil {
+		// format is checked by validation
+		happenedAt, _ := time.Parse(time.RFC3339, *e.HappenedAt)
+		event.HappenedAt = happenedAt.UTC()
+	}
+
+	return event
+}
+
+// ToCoreGetAnimalsParams converts GetAnimalsParams to core.GetAnimalsParams
+func (p *GetAnimalsParams) ToCoreGetAnimalsParams() core.GetAnimalsParams {
+	return core.GetAnimalsParams{
+		Limit:        &p.Limit,
+		Offset:       &p.Offset,
+		AuthorID:     p.AuthorID,
+		KeeperUserID: p.KeeperID,
+		Stage:        p.Stage,
+		Status:       p.Status,
+		AnimalType:   p.AnimalType,
+	}
+}
+
+// ToAnimalResponse converts core.Animal to AnimalResponse
+func ToAnimalResponse(animal core.Animal) AnimalResponse {
+	return AnimalResponse{
+		ID:                    animal.ID,
+		AnimalType:            animal.AnimalType,
+		Age:                   animal.Age,
+		Color:                 animal.Color,
+		Gender:                animal.Gender,
+		Description:           animal.Description,
+		Status:                animal.Status,
+		Stage:                 animal.Stage,
+		AuthorID:              animal.AuthorID,
+		KeeperID:              animal.KeeperUserID,
+		AnimalDetailsResponse: post.ToAnimalDetailsResponse(animal),
+		CreatedAt:             animal.CreatedAt,
+		UpdatedAt:             animal.UpdatedAt,
+	}
+}
+
+// ToResponse converts a list of core.Animal to Response with pagination meta
+func ToResponse(meta pagination.Pagination, animals []core.Animal) Response {
+	res := make([]AnimalResponse, len(animals))
+
+	for i, animal := range animals {
+		res[i] = ToAnimalResponse(animal)
+	}
+
+	return Response{
+		Meta:    meta,
+		Animals: res,
+	}
+}
+
+// ToEventResponse converts core.AnimalEvent to EventResponse
+func ToEventResponse(event core.AnimalEvent) EventResponse {
+	return EventResponse{
+		ID:         event.ID,
+		AnimalID:   event.AnimalID,
+		AuthorID:   event.AuthorID,
+		Type:       event.Type,
+		Note:       event.Note,
+		HappenedAt: event.HappenedAt,
+		CreatedAt:  event.CreatedAt,
+		Photos:     post.ToPhotoResponses(event.Photos),
+	}
+}
+
+// ToEventResponses converts case history of the animal to EventResponse list
+func ToEventResponses(events []core.AnimalEvent) []EventResponse {
+	res := make([]EventResponse, len(events))
+
+	for i, event := range events {
+		res[i] = ToEventResponse(event)
+	}
+
+	return res
+}
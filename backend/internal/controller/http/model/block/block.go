@@ -0,0 +1,35 @@
+package block
+
+import (
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+)
+
+type (
+	// PathParams - the user to block or mute
+	PathParams struct {
+		UserID int `params:"id" validate:"gt=0"`
+	}
+
+	// GetBlockedUsersParams represents the parameters for fetching blocked users
+	GetBlockedUsersParams struct {
+		Limit  int    `query:"limit" validate:"gt=0,lte=100"`
+		Offset int    `query:"offset" validate:"gte=0"`
+		Type   string `query:"type" validate:"omitempty,oneof=block mute"` // Both blocked and muted users when empty
+	}
+
+	// BlockedUser represents the user blocked or muted by the current user
+	BlockedUser struct {
+		ID        int       `json:"id" example:"2"`
+		Username  string    `json:"username" example:"Jack123"`
+		Type      string    `json:"type" example:"block" enums:"block,mute"`
+		CreatedAt time.Time `json:"created_at" example:"2021-09-01T12:00:00Z"` // Time of the block
+	}
+
+	// Response represents the list of blocked users with pagination
+	Response struct {
+		Meta  pagination.Pagination `json:"meta"`
+		Users []BlockedUser         `json:"users"`
+	}
+)
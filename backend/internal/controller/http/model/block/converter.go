@@ -0,0 +1,41 @@
+package block
+
+import (
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+// ToCoreGetBlockedUsersParams converts GetBlockedUsersParams to core.GetBlockedUsersParams of the user
+func (p *GetBlockedUsersParams) ToCoreGetBlockedUsersParams(userID int) core.GetBlockedUsersParams {
+	params := core.GetBlockedUsersParams{
+		UserID: userID,
+		Limit:  &p.Limit,
+		Offset: &p.Offset,
+	}
+
+	if p.Type != "" {
+		blockType := core.BlockType(p.Type)
+		params.Type = &blockType
+	}
+
+	return params
+}
+
+// ToResponse converts a list of core.UserBlock to Response with pagination meta
+func ToResponse(meta pagination.Pagination, blocks []core.UserBlock) Response {
+	users := make([]BlockedUser, len(blocks))
+
+	for i, block := range blocks {
+		users[i] = BlockedUser{
+			ID:        block.BlockedUserID,
+			Username:  block.BlockedUser.Username,
+			Type:      string(block.Type),
+			CreatedAt: block.CreatedAt,
+		}
+	}
+
+	return Response{
+		Meta:  meta,
+		Users: users,
+	}
+}
@@ -7,15 +7,33 @@ import (
 )
 
 type Comment struct {
-	ID        int       `json:"id" example:"3" validate:"required"`
-	ParentID  *int      `json:"parent_id" form:"parent_id" example:"1"`
-	ReplyID   *int      `json:"reply_id" form:"reply_id" example:"3"`
-	User      User      `json:"user" validate:"required"`
-	Content   string    `json:"content" form:"content" validate:"required" example:"Hello, world!"`
-	Status    string    `json:"status" example:"deleted" oneof:"deleted,published,on_moderation" validate:"required"`
-	CreatedAt time.Time `json:"created_at" example:"2021-09-01T12:00:00Z" validate:"required"`
+	ID          int        `json:"id" example:"3" validate:"required"`
+	ParentID    *int       `json:"parent_id" form:"parent_id" example:"1"`
+	ReplyID     *int       `json:"reply_id" form:"reply_id" example:"3"`
+	User        User       `json:"user" validate:"required"`
+	Content     string     `json:"content" form:"content" validate:"required" example:"Hello, world!"`
+	Status      string     `json:"status" example:"deleted" oneof:"deleted,published,on_moderation" validate:"required"`
+	CreatedAt   time.Time  `json:"created_at" example:"2021-09-01T12:00:00Z" validate:"required"`
+	Likes       int        `json:"likes" example:"3"`
+	LikedByMe   bool       `json:"liked_by_me" example:"false"` // Always false for anonymous users
+	Entities    []Entity   `json:"entities"`
+	EditedAt    *time.Time `json:"edited_at" example:"2021-09-01T12:30:00Z"`        // Null for comments never edited
+	Placeholder string     `json:"placeholder,omitempty" example:"comment deleted"` // Shown instead of the content of deleted comment or comment on moderation
 }
 
+// Entity - fragment of the content with special meaning, e.g. mention of the user.
+// Offset and length are counted in characters of the content.
+type Entity struct {
+	Type     string `json:"type" example:"mention" oneof:"mention"`
+	Offset   int    `json:"offset" example:"6"`
+	Length   int    `json:"length" example:"8"`
+	UserID   int    `json:"user_id" example:"2"`
+	Username string `json:"username" example:"Jack123"` // Current username of the mentioned user
+}
+
+// EntityMention - "@username" mentioning the user
+const EntityMention = "mention"
+
 type Create struct {
 	Content  string `json:"content" form:"content" validate:"required" example:"Hello, world!" minlength:"1"`
 	ParentID *int   `json:"parent_id" form:"parent_id" example:"1" min:"1"`
@@ -32,15 +50,61 @@ type GetAllCommentsResponse struct {
 	Meta pagination.Pagination `json:"meta"`
 }
 
+// Thread - top-level comment with the first replies, the rest of replies are loaded by pages
+type Thread struct {
+	Comment
+	Replies      []Comment `json:"replies"`
+	RepliesCount int       `json:"replies_count" example:"12"` // Amount of all replies of the thread
+}
+
+type GetCommentThreadsResponse struct {
+	Data []Thread              `json:"threads"`
+	Meta pagination.Pagination `json:"meta"`
+}
+
 type Update struct {
 	Content string `json:"content" form:"content" validate:"required" minlength:"1" example:"Hello, world!"`
 }
 
 type GetAllCommentsParams struct {
-	Limit  int `query:"limit" validate:"gt=0"`
+	Limit  int     `query:"limit" validate:"gt=0"`
+	Offset int     `query:"offset" validate:"gte=0"` // Ignored when cursor is set
+	Cursor *string `query:"cursor"`                  // Cursor of the next page from the previous response
+	Sort   *string `query:"sort" validate:"omitempty,oneof=oldest newest top"`
+}
+
+type GetCommentThreadsParams struct {
+	GetAllCommentsParams
+	Replies *int `query:"replies" validate:"omitempty,gte=0,lte=20"` // Amount of the first replies of every thread, 3 by default
+}
+
+type GetRepliesParams struct {
+	Limit  int     `query:"limit" validate:"gt=0"`
+	Offset int     `query:"offset" validate:"gte=0"` // Ignored when cursor is set
+	Cursor *string `query:"cursor"`                  // Cursor of the next page from the previous response
+}
+
+// GetUserCommentsParams - page of the comment history of the user, the newest comments go first
+type GetUserCommentsParams struct {
+	Limit  int `query:"limit" validate:"gt=0,lte=100"`
 	Offset int `query:"offset" validate:"gte=0"`
 }
 
+// UserComment - comment of the history of the user with the post it was left under
+type UserComment struct {
+	Comment
+	PostID int `json:"post_id" example:"1"`
+}
+
+type GetUserCommentsResponse struct {
+	Data []UserComment         `json:"comments"`
+	Meta pagination.Pagination `json:"meta"`
+}
+
+type UserIDPathParams struct {
+	UserID int `params:"id" validate:"gt=0"`
+}
+
 type PathParams struct {
 	PostID    int `params:"post_id" validate:"gt=0"`
 	CommentID int `params:"comment_id" validate:"gt=0"`
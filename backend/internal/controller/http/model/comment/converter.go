@@ -40,12 +40,36 @@ func ToModelComment(c core.Comment) Comment {
 			ID:       c.Author.ID,
 			Username: c.Author.Username,
 		},
-		Content:   c.Content,
-		Status:    string(c.Status),
-		CreatedAt: c.CreatedAt,
+		Content:     c.Content,
+		Status:      string(c.Status),
+		CreatedAt:   c.CreatedAt,
+		Likes:       c.Likes.Count,
+		LikedByMe:   c.Likes.LikedByMe,
+		Entities:    toEntities(c),
+		EditedAt:    c.EditedAt,
+		Placeholder: c.Placeholder,
 	}
 }
 
+// toEntities renders mentions of the comment, content of the deleted comment has no entities
+func toEntities(c core.Comment) []Entity {
+	entities := make([]Entity, 0, len(c.Mentions))
+	if c.Status == core.Deleted {
+		return entities
+	}
+
+	for _, mention := range c.Mentions {
+		entities = append(entities, Entity{
+			Type:     EntityMention,
+			Offset:   mention.Offset,
+			Length:   mention.Length,
+			UserID:   mention.UserID,
+			Username: mention.Username,
+		})
+	}
+	return entities
+}
+
 func ToModelCommentsSlice(c []core.Comment) []Comment {
 	modelCommentsSlice := make([]Comment, len(c))
 	for i, comment := range c {
@@ -54,12 +78,39 @@ func ToModelCommentsSlice(c []core.Comment) []Comment {
 	return modelCommentsSlice
 }
 
-func (params *GetAllCommentsParams) ToCoreGetAllCommentsParams(postID int) core.GetAllCommentsParams {
-	return core.GetAllCommentsParams{
+func (params *GetAllCommentsParams) ToCoreGetAllCommentsParams(postID int) (core.GetAllCommentsParams, error) {
+	cursor, err := pagination.DecodeCursor(params.Cursor)
+	if err != nil {
+		return core.GetAllCommentsParams{}, err
+	}
+
+	coreParams := core.GetAllCommentsParams{
 		PostID: postID,
 		Limit:  &params.Limit,
 		Offset: &params.Offset,
+		Cursor: cursor,
+	}
+
+	if params.Sort != nil {
+		coreParams.Sort = core.CommentSort(*params.Sort)
+	}
+
+	return coreParams, nil
+}
+
+// NextCursor returns cursor of the page after the given one, there is no next page when the given page is not full.
+func NextCursor(params core.GetAllCommentsParams, comments []core.Comment) *string {
+	if params.Limit == nil || len(comments) == 0 || len(comments) < *params.Limit {
+		return nil
+	}
+
+	sort := params.Sort
+	if sort == "" {
+		sort = core.CommentSortOldest
 	}
+
+	cursor := pagination.EncodeCursor(sort.Cursor(comments[len(comments)-1]))
+	return &cursor
 }
 
 func ToGetAllCommentsResponse(data []Comment, meta pagination.Pagination) GetAllCommentsResponse {
@@ -68,3 +119,92 @@ func ToGetAllCommentsResponse(data []Comment, meta pagination.Pagination) GetAll
 		Meta: meta,
 	}
 }
+
+func (params *GetCommentThreadsParams) ToCoreGetAllCommentsParams(postID int) (core.GetAllCommentsParams, error) {
+	coreParams, err := params.GetAllCommentsParams.ToCoreGetAllCommentsParams(postID)
+	if err != nil {
+		return core.GetAllCommentsParams{}, err
+	}
+
+	coreParams.Replies = core.DefaultThreadReplies
+	if params.Replies != nil {
+		coreParams.Replies = *params.Replies
+	}
+
+	return coreParams, nil
+}
+
+func (params *GetRepliesParams) ToCoreGetRepliesParams(postID, parentID int) (core.GetRepliesParams, error) {
+	cursor, err := pagination.DecodeCursor(params.Cursor)
+	if err != nil {
+		return core.GetRepliesParams{}, err
+	}
+
+	return core.GetRepliesParams{
+		PostID:   postID,
+		ParentID: parentID,
+		Limit:    &params.Limit,
+		Offset:   &params.Offset,
+		Cursor:   cursor,
+	}, nil
+}
+
+func ToThreadsResponse(threads []core.CommentThread, meta pagination.Pagination) GetCommentThreadsResponse {
+	data := make([]Thread, len(threads))
+	for i, thread := range threads {
+		data[i] = Thread{
+			Comment:      ToModelComment(thread.Comment),
+			Replies:      ToModelCommentsSlice(thread.Replies),
+			RepliesCount: thread.RepliesCount,
+		}
+	}
+
+	return GetCommentThreadsResponse{
+		Data: data,
+		Meta: meta,
+	}
+}
+
+// NextThreadsCursor returns cursor of the page of threads after the given one, there is no next page when the given page is not full.
+func NextThreadsCursor(params core.GetAllCommentsParams, threads []core.CommentThread) *string {
+	roots := make([]core.Comment, len(threads))
+	for i, thread := range threads {
+		roots[i] = thread.Comment
+	}
+
+	return NextCursor(params, roots)
+}
+
+// NextRepliesCursor returns cursor of the page of replies after the given one, there is no next page when the given page is not full.
+func NextRepliesCursor(params core.GetRepliesParams, replies []core.Comment) *string {
+	if params.Limit == nil || len(replies) == 0 || len(replies) < *params.Limit {
+		return nil
+	}
+
+	cursor := pagination.EncodeCursor(core.CommentSortOldest.Cursor(replies[len(replies)-1]))
+	return &cursor
+}
+
+func (params *GetUserCommentsParams) ToCoreGetUserCommentsParams(authorID, userID int) core.GetUserCommentsParams {
+	return core.GetUserCommentsParams{
+		AuthorID: authorID,
+		Limit:    &params.Limit,
+		Offset:   &params.Offset,
+		UserID:   userID,
+	}
+}
+
+func ToGetUserCommentsResponse(comments []core.Comment, meta pagination.Pagination) GetUserCommentsResponse {
+	data := make([]UserComment, len(comments))
+	for i, comment := range comments {
+		data[i] = UserComment{
+			Comment: ToModelComment(comment),
+			PostID:  comment.PostID,
+		}
+	}
+
+	return GetUserCommentsResponse{
+		Data: data,
+		Meta: meta,
+	}
+}
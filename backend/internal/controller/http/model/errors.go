@@ -20,6 +20,5 @@ var (
 	ErrOAuthStateMismatch   = errors.New("states do not match")
 	ErrValidationFailed     = errors.New("validation failed")
 	ErrInvalidBody          = errors.New("invalid body")
-	ErrInvalidPhotoSize     = errors.New("photo is too large")
-	ErrInvalidExtension     = errors.New("invalid photo extension")
+	ErrNoPhoto              = errors.New("photo is required")
 )
@@ -0,0 +1,83 @@
+package feed
+
+import (
+	"github.com/lib/pq"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+// ToCoreFeedParams converts GetFeedParams to core.FeedParams of the user
+func (p *GetFeedParams) ToCoreFeedParams(userID int) (core.FeedParams, error) {
+	cursor, err := pagination.DecodeCursor(p.Cursor)
+	if err != nil {
+		return core.FeedParams{}, err
+	}
+
+	return core.FeedParams{
+		UserID:    userID,
+		Limit:     &p.Limit,
+		Cursor:    cursor,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+	}, nil
+}
+
+// ToCoreFeedPreferences converts Preferences to core.FeedPreferences of the user
+func (p *Preferences) ToCoreFeedPreferences(userID int) core.FeedPreferences {
+	return core.FeedPreferences{
+		UserID:      userID,
+		AnimalTypes: pq.StringArray(p.AnimalTypes),
+		Statuses:    pq.StringArray(p.Statuses),
+		Colors:      pq.StringArray(p.Colors),
+		Latitude:    p.Latitude,
+		Longitude:   p.Longitude,
+		Radius:      p.Radius,
+	}
+}
+
+// ToPreferences converts core.FeedPreferences to Preferences, empty lists are kept empty rather than null
+func ToPreferences(preferences core.FeedPreferences) Preferences {
+	return Preferences{
+		AnimalTypes: append([]string{}, preferences.AnimalTypes...),
+		Statuses:    append([]string{}, preferences.Statuses...),
+		Colors:      append([]string{}, preferences.Colors...),
+		Latitude:    preferences.Latitude,
+		Longitude:   preferences.Longitude,
+		Radius:      preferences.Radius,
+	}
+}
+
+// ToResponse converts the page of the feed to Response with pagination meta
+func ToResponse(meta pagination.Pagination, posts []core.FeedPost, access core.ContactsAccess) Response {
+	res := make([]Post, len(posts))
+
+	for i, feedPost := range posts {
+		reasons := make([]string, len(feedPost.Reasons))
+		for j, reason := range feedPost.Reasons {
+			reasons[j] = string(reason)
+		}
+
+		res[i] = Post{
+			PostResponse: post.ToPostResponse(feedPost.PostDetails, access),
+			Score:        feedPost.Score,
+			Reasons:      reasons,
+		}
+	}
+
+	return Response{
+		Meta:  meta,
+		Posts: res,
+	}
+}
+
+// NextCursor returns cursor of the page after the given one, there is no next page when the given page is not full
+func NextCursor(params core.FeedParams, posts []core.FeedPost) *string {
+	if params.Limit == nil || len(posts) == 0 || len(posts) < *params.Limit {
+		return nil
+	}
+
+	cursor := pagination.EncodeCursor(posts[len(posts)-1].Cursor())
+	return &cursor
+}
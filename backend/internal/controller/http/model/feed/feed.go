@@ -0,0 +1,39 @@
+package feed
+
+import (
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+)
+
+type (
+	// GetFeedParams represents the parameters for fetching the page of the feed
+	GetFeedParams struct {
+		Limit     int      `query:"limit" validate:"gt=0,lte=100"`
+		Cursor    *string  `query:"cursor"`                                                    // Cursor of the next page from the previous response
+		Latitude  *float64 `query:"lat" validate:"required_with=Longitude,omitempty,latitude"` // Current location of the user, saved location is used if missing
+		Longitude *float64 `query:"lon" validate:"required_with=Latitude,omitempty,longitude"` // Current location of the user, saved location is used if missing
+	}
+
+	// Preferences represents what the user wants to see in the feed, empty list matches any value
+	Preferences struct {
+		AnimalTypes []string `json:"animal_types" validate:"omitempty,max=6,unique,dive,oneof=dog cat rabbit bird rodent other" example:"cat"`
+		Statuses    []string `json:"statuses" validate:"omitempty,max=4,unique,dive,oneof=lost found need_home adopted" example:"lost"`
+		Colors      []string `json:"colors" validate:"omitempty,max=7,unique,dive,oneof=black white gray ginger cream brown fawn" example:"ginger"`
+		Latitude    *float64 `json:"latitude" validate:"required_with=Longitude,omitempty,latitude" example:"55.75"` // Home of the user, posts around it are nearby
+		Longitude   *float64 `json:"longitude" validate:"required_with=Latitude,omitempty,longitude" example:"37.62"`
+		Radius      *float64 `json:"radius" validate:"omitempty,gt=0,lte=500" example:"10"` // Kilometers around the location, default radius is used if missing
+	}
+
+	// Post represents the post of the feed with its score and reasons it got into the feed
+	Post struct {
+		post.PostResponse
+		Score   float64  `json:"score" example:"4.5"`
+		Reasons []string `json:"reasons" enums:"nearby,preferences,favourite_user,interaction"`
+	}
+
+	// Response represents the page of the feed
+	Response struct {
+		Meta  pagination.Pagination `json:"meta"`
+		Posts []Post                `json:"posts"`
+	}
+)
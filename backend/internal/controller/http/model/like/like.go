@@ -0,0 +1,16 @@
+package like
+
+import "github.com/kotopesp/sos-kotopes/internal/core"
+
+// Response - likes of the comment or the post after the current user liked or unliked it
+type Response struct {
+	Likes     int  `json:"likes" example:"3"`
+	LikedByMe bool `json:"liked_by_me" example:"true"`
+}
+
+func ToResponse(likes core.Likes) Response {
+	return Response{
+		Likes:     likes.Count,
+		LikedByMe: likes.LikedByMe,
+	}
+}
@@ -0,0 +1,17 @@
+package media
+
+import (
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+// ToCoreMediaFileParams converts FileParams to core.MediaFileParams
+func (p *FileParams) ToCoreMediaFileParams() core.MediaFileParams {
+	var params core.MediaFileParams
+
+	if p.Size != "" && p.Size != "original" {
+		params.Variant = core.MediaVariantName(p.Size)
+	}
+	params.WebP = p.Format == "webp"
+
+	return params
+}
@@ -0,0 +1,18 @@
+package media
+
+type (
+	// FileParams represents requested size and format of the photo
+	FileParams struct {
+		Size   string `query:"size" validate:"omitempty,oneof=original medium thumbnail"` // Size of the photo, original by default
+		Format string `query:"format" validate:"omitempty,oneof=original webp"`           // Format of downscaled sizes, format of the photo by default
+	}
+
+	// PostPhotoParams represents position of the photo among photos of the post
+	PostPhotoParams struct {
+		Index int `query:"index" validate:"gte=0"` // Position of the photo, 0 is the cover of the post
+	}
+
+	PathParams struct {
+		ID int `params:"id" validate:"gt=0"`
+	}
+)
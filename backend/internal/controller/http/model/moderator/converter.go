@@ -3,6 +3,7 @@ package moderator
 import (
 	"time"
 
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
 	post "github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
 	"github.com/kotopesp/sos-kotopes/internal/core"
 )
@@ -23,11 +24,15 @@ func ToPostList(posts []core.PostForModeration) []core.Post {
 	return postList
 }
 
-func ToPostsForModerationResponse(postsAndReasons []core.PostForModeration, details []core.PostDetails) (response []PostsForModerationResponse) {
+func ToPostsForModerationResponse(
+	postsAndReasons []core.PostForModeration, details []core.PostDetails, access core.ContactsAccess,
+) (response []PostsForModerationResponse) {
 	for i, postWithReason := range postsAndReasons {
 		response = append(response, PostsForModerationResponse{
-			Post:    post.ToPostResponse(details[i]),
-			Reasons: postWithReason.Reasons,
+			Post:             post.ToPostResponse(details[i], access),
+			Reasons:          postWithReason.Reasons,
+			Reports:          toPostReportsResponse(postWithReason.Reports, postWithReason.Revisions),
+			ModerationReason: postWithReason.Post.ModerationReason,
 		})
 	}
 
@@ -38,12 +43,67 @@ func ToCommentsForModerationResponse(comments []core.CommentForModeration) []Com
 	var response []CommentsForModerationResponse
 	for _, c := range comments {
 		response = append(response, CommentsForModerationResponse{
-			CommentID: c.Comment.ID,
-			Content:   c.Comment.Content,
-			PostID:    c.Comment.PostID,
-			AuthorID:  c.Comment.AuthorID,
-			CreatedAt: c.Comment.CreatedAt.Format(time.RFC3339),
-			Reasons:   c.Reasons,
+			CommentID:        c.Comment.ID,
+			Content:          c.Comment.Content,
+			PostID:           c.Comment.PostID,
+			AuthorID:         c.Comment.AuthorID,
+			CreatedAt:        c.Comment.CreatedAt.Format(time.RFC3339),
+			Reasons:          c.Reasons,
+			Reports:          toCommentReportsResponse(c.Reports, c.Revisions),
+			ModerationReason: c.Comment.ModerationReason,
+		})
+	}
+	return response
+}
+
+func ToReportsResponse(reports []core.Report) []ReportResponse {
+	response := make([]ReportResponse, len(reports))
+	for i, r := range reports {
+		response[i] = ReportResponse{
+			Reason:      string(r.Reason),
+			Description: r.Description,
+			CreatedAt:   r.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return response
+}
+
+// toPostReportsResponse adds revisions of the post live when the reports were filed to the reports.
+func toPostReportsResponse(reports []core.Report, revisions map[int]core.PostRevision) []ReportResponse {
+	response := ToReportsResponse(reports)
+	for i, r := range reports {
+		if revision, ok := revisions[r.ID]; ok {
+			revisionResponse := post.ToRevisionResponse(revision, nil)
+			response[i].PostRevision = &revisionResponse
+		}
+	}
+	return response
+}
+
+// toCommentReportsResponse adds revisions of the comment live when the reports were filed to the reports.
+func toCommentReportsResponse(reports []core.Report, revisions map[int]core.CommentRevision) []ReportResponse {
+	response := ToReportsResponse(reports)
+	for i, r := range reports {
+		if revision, ok := revisions[r.ID]; ok {
+			response[i].CommentRevision = &CommentRevisionResponse{
+				Revision:  revision.Revision,
+				Content:   revision.Content,
+				CreatedAt: revision.CreatedAt.Format(time.RFC3339),
+			}
+		}
+	}
+	return response
+}
+
+func ToReportedTargetsResponse(targets []core.ReportedTarget) []ReportedTargetResponse {
+	var response []ReportedTargetResponse
+	for _, t := range targets {
+		response = append(response, ReportedTargetResponse{
+			TargetID:   t.ReportableID,
+			TargetType: t.ReportableType,
+			AuthorID:   t.AuthorID,
+			Content:    t.Content,
+			Reports:    ToReportsResponse(t.Reports),
 		})
 	}
 	return response
@@ -54,5 +114,84 @@ func ToCoreBannedUserRecords(request BanUserRequest, moderatorID int) core.Banne
 		UserID:      request.UserID,
 		ModeratorID: moderatorID,
 		ReportID:    request.ReportID,
+		Reason:      core.ReportReason(request.Reason),
+		Comment:     request.Comment,
+	}
+}
+
+func (r ModerationDecisionRequest) ToCoreModerationDecision(moderatorID, targetID int) core.ModerationDecision {
+	return core.ModerationDecision{
+		ModeratorID: moderatorID,
+		TargetID:    targetID,
+		Reason:      core.ReportReason(r.Reason),
+		Comment:     r.Comment,
+	}
+}
+
+func ToModerationDecisionResponse(decision core.ModerationDecision) ModerationDecisionResponse {
+	return ModerationDecisionResponse{
+		TargetType: decision.TargetType,
+		TargetID:   decision.TargetID,
+		Action:     string(decision.Action),
+		Reason:     string(decision.Reason),
+		Comment:    decision.Comment,
+		CreatedAt:  decision.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToModerationHistoryResponse(decisions []core.ModerationDecision) []ModerationDecisionResponse {
+	response := make([]ModerationDecisionResponse, len(decisions))
+	for i, d := range decisions {
+		response[i] = ToModerationDecisionResponse(d)
+	}
+	return response
+}
+
+func ToPostModerationResponse(postModeration core.PostModeration) PostModerationResponse {
+	response := PostModerationResponse{
+		Status:       string(postModeration.Status),
+		FilterReason: postModeration.FilterReason,
+	}
+
+	if postModeration.Decision != nil {
+		decision := ToModerationDecisionResponse(*postModeration.Decision)
+		response.Decision = &decision
+	}
+
+	return response
+}
+
+func (r SearchCommentsRequest) ToCoreSearchCommentsParams() core.SearchCommentsParams {
+	params := core.SearchCommentsParams{
+		Query:  r.Query,
+		Limit:  r.Limit,
+		Offset: r.Offset,
+	}
+
+	if r.Status != nil {
+		status := core.ContentStatus(*r.Status)
+		params.Status = &status
+	}
+
+	return params
+}
+
+func ToSearchCommentsResponse(meta pagination.Pagination, comments []core.Comment) SearchCommentsResponse {
+	response := make([]FoundCommentResponse, len(comments))
+	for i, c := range comments {
+		response[i] = FoundCommentResponse{
+			CommentID: c.ID,
+			PostID:    c.PostID,
+			AuthorID:  c.AuthorID,
+			Content:   c.Content,
+			Highlight: c.Highlight,
+			Status:    string(c.Status),
+			CreatedAt: c.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return SearchCommentsResponse{
+		Meta:     meta,
+		Comments: response,
 	}
 }
@@ -1,6 +1,9 @@
 package moderator
 
-import "github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+import (
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+)
 
 type CreateModeratorRequest struct {
 	ID int `json:"id" validate:"required,gte=0"`
@@ -11,8 +14,28 @@ type GetPostsForModerationRequest struct {
 }
 
 type PostsForModerationResponse struct {
-	Post    post.PostResponse
-	Reasons []string
+	Post             post.PostResponse
+	Reasons          []string
+	Reports          []ReportResponse
+	ModerationReason *string // Reason of the automatic content filter
+}
+
+// ReportResponse - details of a single report shown to moderators.
+type ReportResponse struct {
+	Reason      string  `json:"reason"`
+	Description *string `json:"description,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+	// PostRevision, CommentRevision - version of the content which was live when the report was filed,
+	// omitted for reports filed before history of the content was kept
+	PostRevision    *post.RevisionResponse   `json:"post_revision,omitempty"`
+	CommentRevision *CommentRevisionResponse `json:"comment_revision,omitempty"`
+}
+
+// CommentRevisionResponse - saved version of the comment.
+type CommentRevisionResponse struct {
+	Revision  int    `json:"revision"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at"`
 }
 
 type ModeratedPostRequest struct {
@@ -28,15 +51,81 @@ type ModeratedCommentRequest struct {
 }
 
 type CommentsForModerationResponse struct {
-	CommentID int      `json:"comment_id"`
-	Content   string   `json:"content"`
-	PostID    int      `json:"post_id"`
-	AuthorID  int      `json:"author_id"`
-	CreatedAt string   `json:"created_at"`
-	Reasons   []string `json:"reasons"`
+	CommentID        int              `json:"comment_id"`
+	Content          string           `json:"content"`
+	PostID           int              `json:"post_id"`
+	AuthorID         int              `json:"author_id"`
+	CreatedAt        string           `json:"created_at"`
+	Reasons          []string         `json:"reasons"`
+	Reports          []ReportResponse `json:"reports"`
+	ModerationReason *string          `json:"moderation_reason,omitempty"` // Reason of the automatic content filter
 }
 
 type BanUserRequest struct {
-	UserID   int  `json:"user_id" validate:"required,gt=0"`
-	ReportID *int `json:"report_id" validate:"omitempty,gt=0"`
+	UserID   int     `json:"user_id" validate:"required,gt=0"`
+	ReportID *int    `json:"report_id" validate:"omitempty,gt=0"`
+	Reason   string  `json:"reason" validate:"required,oneof=spam violent_content violent_speech other"`
+	Comment  *string `json:"comment" validate:"omitempty,max=1000"`
+}
+
+// ModerationDecisionRequest - reason moderator picks when deleting content, it is shown to the author.
+type ModerationDecisionRequest struct {
+	Reason  string  `query:"reason" validate:"required,oneof=spam violent_content violent_speech other"`
+	Comment *string `query:"comment" validate:"omitempty,max=1000"`
+}
+
+type ModerationDecisionResponse struct {
+	TargetType string  `json:"target_type"`
+	TargetID   int     `json:"target_id"`
+	Action     string  `json:"action"`
+	Reason     string  `json:"reason"`
+	Comment    *string `json:"comment,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+type PostModerationResponse struct {
+	Status       string                      `json:"status"`
+	FilterReason *string                     `json:"filter_reason,omitempty"`
+	Decision     *ModerationDecisionResponse `json:"decision,omitempty"`
+}
+
+type GetReportedTargetsRequest struct {
+	TargetType string `query:"target_type" validate:"required,oneof=user vet_review keeper_review message"`
+	Filter     string `query:"filter" validate:"required,oneof=ASC DESC"`
+}
+
+type ReportedTargetRequest struct {
+	TargetType string `params:"target_type" validate:"required,oneof=user vet_review keeper_review message"`
+	TargetID   int    `params:"target_id" validate:"required,min=1"`
+}
+
+type ReportedTargetResponse struct {
+	TargetID   int              `json:"target_id"`
+	TargetType string           `json:"target_type"`
+	AuthorID   int              `json:"author_id"`
+	Content    *string          `json:"content,omitempty"`
+	Reports    []ReportResponse `json:"reports"`
+}
+
+// SearchCommentsRequest - full-text search over comments of all statuses.
+type SearchCommentsRequest struct {
+	Query  string  `query:"q" validate:"required,max=200"`
+	Status *string `query:"status" validate:"omitempty,oneof=published on_moderation deleted"`
+	Limit  int     `query:"limit" validate:"gt=0,lte=100"`
+	Offset int     `query:"offset" validate:"gte=0"`
+}
+
+type FoundCommentResponse struct {
+	CommentID int     `json:"comment_id"`
+	PostID    int     `json:"post_id"`
+	AuthorID  int     `json:"author_id"`
+	Content   string  `json:"content"`
+	Highlight *string `json:"highlight,omitempty"` // Fragments of the content with matched words in <mark> tags
+	Status    string  `json:"status"`
+	CreatedAt string  `json:"created_at"`
+}
+
+type SearchCommentsResponse struct {
+	Meta     pagination.Pagination  `json:"meta"`
+	Comments []FoundCommentResponse `json:"comments"`
 }
@@ -0,0 +1,43 @@
+package notification
+
+import (
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+// ToCoreGetNotificationsParams converts GetNotificationsParams to core.GetNotificationsParams of the user
+func (p *GetNotificationsParams) ToCoreGetNotificationsParams(userID int) core.GetNotificationsParams {
+	return core.GetNotificationsParams{
+		UserID: userID,
+		Limit:  &p.Limit,
+		Offset: &p.Offset,
+		Unread: p.Unread,
+	}
+}
+
+// ToNotificationResponse converts core.Notification to NotificationResponse
+func ToNotificationResponse(notification core.Notification) NotificationResponse {
+	return NotificationResponse{
+		ID:         notification.ID,
+		Type:       notification.Type,
+		EntityType: notification.EntityType,
+		EntityID:   notification.EntityID,
+		ReadAt:     notification.ReadAt,
+		CreatedAt:  notification.CreatedAt,
+	}
+}
+
+// ToResponse converts a list of core.Notification to Response with pagination meta
+func ToResponse(meta pagination.Pagination, unread int, notifications []core.Notification) Response {
+	res := make([]NotificationResponse, len(notifications))
+
+	for i, notification := range notifications {
+		res[i] = ToNotificationResponse(notification)
+	}
+
+	return Response{
+		Meta:          meta,
+		Unread:        unread,
+		Notifications: res,
+	}
+}
@@ -0,0 +1,38 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+)
+
+type (
+	// NotificationResponse represents the notification, clients render its text by type
+	NotificationResponse struct {
+		ID         int        `json:"id"`
+		Type       string     `json:"type" example:"post_expiring"`
+		EntityType *string    `json:"entity_type,omitempty" example:"post"` // Type of the entity the notification is about
+		EntityID   *int       `json:"entity_id,omitempty"`
+		ReadAt     *time.Time `json:"read_at,omitempty"`
+		CreatedAt  time.Time  `json:"created_at"`
+	}
+
+	// Response represents the list of notifications with pagination
+	Response struct {
+		Meta          pagination.Pagination  `json:"meta"`
+		Unread        int                    `json:"unread"` // Amount of all unread notifications of the user
+		Notifications []NotificationResponse `json:"notifications"`
+	}
+
+	// GetNotificationsParams represents the parameters for fetching notifications
+	GetNotificationsParams struct {
+		Limit  int  `query:"limit" validate:"gt=0,lte=100"`
+		Offset int  `query:"offset" validate:"gte=0"`
+		Unread bool `query:"unread"` // Only notifications which are not read yet
+	}
+
+	// MarkRead is the structure used for marking notifications as read
+	MarkRead struct {
+		IDs []int `json:"ids" validate:"omitempty,max=100,dive,gt=0"` // Notifications to mark, all notifications are marked when empty
+	}
+)
@@ -1,8 +1,41 @@
 package pagination
 
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
 type Pagination struct {
-	Total       int `json:"total" example:"10"`
-	TotalPages  int `json:"total_pages" example:"10"`
-	CurrentPage int `json:"current_page" example:"1"`
-	PerPage     int `json:"per_page" example:"1"`
+	Total       int     `json:"total" example:"10"`
+	TotalPages  int     `json:"total_pages" example:"10"`
+	CurrentPage int     `json:"current_page" example:"1"`
+	PerPage     int     `json:"per_page" example:"1"`
+	NextCursor  *string `json:"next_cursor,omitempty" example:"eyJzIjoibmV3ZXN0IiwiaWQiOjF9"` // Cursor of the next page, missing on the last page
+}
+
+// EncodeCursor converts cursor to the opaque string clients pass to get the next page.
+func EncodeCursor(cursor core.Cursor) string {
+	data, _ := json.Marshal(cursor) // cursor contains only numbers and times, so it is always marshaled
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses the cursor sent by the client, nil is returned for empty cursor.
+func DecodeCursor(cursor *string) (*core.Cursor, error) {
+	if cursor == nil || *cursor == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(*cursor)
+	if err != nil {
+		return nil, core.ErrInvalidCursor
+	}
+
+	var decoded core.Cursor
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, core.ErrInvalidCursor
+	}
+
+	return &decoded, nil
 }
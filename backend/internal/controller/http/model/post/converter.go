@@ -2,6 +2,7 @@ package post
 
 import (
 	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/user"
 	"github.com/kotopesp/sos-kotopes/internal/core"
 )
 
@@ -12,20 +13,48 @@ func (p *CreateRequestBodyPost) ToCorePostDetails(authorID int) core.PostDetails
 	}
 
 	post := core.Post{
-		Title:    p.Title,
-		Content:  p.Content,
-		Photo:    p.Photo,
-		AuthorID: authorID,
+		Title:     p.Title,
+		Content:   p.Content,
+		AuthorID:  authorID,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+	}
+
+	if p.AnimalID != nil {
+		post.AnimalID = *p.AnimalID
 	}
 
 	animal := core.Animal{
-		KeeperID:    authorID,
-		AnimalType:  p.AnimalType,
-		Age:         p.Age,
-		Color:       p.Color,
-		Gender:      p.Gender,
-		Description: p.Description,
-		Status:      p.Status,
+		AuthorID:        &authorID,
+		AnimalType:      p.AnimalType,
+		Size:            p.Size,
+		Age:             p.Age,
+		Color:           p.Color,
+		Colors:          p.Colors,
+		CoatPattern:     p.CoatPattern,
+		Gender:          p.Gender,
+		Description:     p.Description,
+		MicrochipNumber: p.MicrochipNumber,
+		TattooID:        p.TattooID,
+		IsSterilized:    p.IsSterilized,
+		IsVaccinated:    p.IsVaccinated,
+		HasCollar:       p.HasCollar,
+		Status:          p.Status,
+	}
+	if p.Name != nil {
+		animal.Name = *p.Name
+	}
+	if p.Breed != nil {
+		animal.Breed = *p.Breed
+	}
+	if p.DistinguishingMarks != nil {
+		animal.DistinguishingMarks = *p.DistinguishingMarks
+	}
+	if p.CollarDescription != nil {
+		animal.CollarDescription = *p.CollarDescription
+	}
+	if animal.Colors == nil {
+		animal.Colors = []string{}
 	}
 
 	return core.PostDetails{
@@ -42,22 +71,36 @@ func (p *UpdateRequestBodyPost) ToCorePostDetails() core.UpdateRequestBodyPost {
 	return core.UpdateRequestBodyPost{
 		Title:       p.Title,
 		Content:     p.Content,
-		Photo:       p.Photo,
 		AnimalType:  p.AnimalType,
 		Age:         p.Age,
 		Color:       p.Color,
 		Gender:      p.Gender,
 		Description: p.Description,
 		Status:      p.Status,
+		Latitude:    p.Latitude,
+		Longitude:   p.Longitude,
+
+		Name:                p.Name,
+		Breed:               p.Breed,
+		Size:                p.Size,
+		Colors:              p.Colors,
+		CoatPattern:         p.CoatPattern,
+		DistinguishingMarks: p.DistinguishingMarks,
+		MicrochipNumber:     p.MicrochipNumber,
+		TattooID:            p.TattooID,
+		IsSterilized:        p.IsSterilized,
+		IsVaccinated:        p.IsVaccinated,
+		HasCollar:           p.HasCollar,
+		CollarDescription:   p.CollarDescription,
 	}
 }
 
 // ToResponse converts a list of core.PostDetails to Response with pagination meta
-func ToResponse(meta pagination.Pagination, posts []core.PostDetails) Response {
+func ToResponse(meta pagination.Pagination, posts []core.PostDetails, access core.ContactsAccess) Response {
 	res := make([]PostResponse, len(posts))
 
 	for i, post := range posts {
-		res[i] = ToPostResponse(post)
+		res[i] = ToPostResponse(post, access)
 	}
 
 	return Response{
@@ -66,25 +109,211 @@ func ToResponse(meta pagination.Pagination, posts []core.PostDetails) Response {
 	}
 }
 
-// ToPostResponse converts core.PostDetails to PostResponse
-func ToPostResponse(post core.PostDetails) PostResponse {
+// ToPostResponse converts core.PostDetails to PostResponse with contacts of the author the viewer has access to
+func ToPostResponse(post core.PostDetails, access core.ContactsAccess) PostResponse {
 	return PostResponse{
-		Title:          post.Post.Title,
-		Content:        post.Post.Content,
-		AuthorUsername: post.Username,
-		CreatedAt:      post.Post.CreatedAt,
-		AnimalType:     post.Animal.AnimalType,
-		Age:            post.Animal.Age,
-		Color:          post.Animal.Color,
-		Gender:         post.Animal.Gender,
-		Description:    post.Animal.Description,
-		Status:         post.Animal.Status,
-		Photo:          post.Post.Photo,
-		IsFavourite:    false,
-		Comments:       0,
+		ID:                    post.Post.ID,
+		Title:                 post.Post.Title,
+		Content:               post.Post.Content,
+		AuthorUsername:        post.Username,
+		AuthorContacts:        user.ToContacts(post.Post.AuthorID, post.AuthorContacts, access),
+		CreatedAt:             post.Post.CreatedAt,
+		AnimalID:              post.Post.AnimalID,
+		AnimalType:            post.Animal.AnimalType,
+		Age:                   post.Animal.Age,
+		Color:                 post.Animal.Color,
+		Gender:                post.Animal.Gender,
+		Description:           post.Animal.Description,
+		Status:                post.Animal.Status,
+		Photos:                ToPhotoResponses(post.Photos),
+		IsFavourite:           false,
+		Comments:              0,
+		Likes:                 post.Likes.Count,
+		LikedByMe:             post.Likes.LikedByMe,
+		Latitude:              post.Post.Latitude,
+		Longitude:             post.Post.Longitude,
+		Distance:              post.Post.Distance,
+		PossibleDuplicates:    post.DuplicatePostIDs,
+		Highlight:             post.Post.Highlight,
+		AnimalDetailsResponse: ToAnimalDetailsResponse(post.Animal),
+		State:                 string(post.Post.State),
+		Resolution:            (*string)(post.Post.Resolution),
+		SuccessStory:          post.Post.SuccessStory,
+		ResolvedAt:            post.Post.ResolvedAt,
+		ArchivedAt:            post.Post.ArchivedAt,
+		BumpedAt:              post.Post.BumpedAt,
+		Draft:                 post.Post.Status == core.Draft,
+		PublishAt:             post.Post.PublishAt,
+	}
+}
+
+// ToCorePostDetails converts the draft to core.PostDetails of the author with draft status
+func (p *DraftRequestBodyPost) ToCorePostDetails(authorID int) core.PostDetails {
+	request := CreateRequestBodyPost(*p)
+	details := request.ToCorePostDetails(authorID)
+	details.Post.Status = core.Draft
+	return details
+}
+
+// ToCorePublishPost converts PublishPost to core.PublishPost of the author
+func (p *PublishPost) ToCorePublishPost(id, authorID int) core.PublishPost {
+	return core.PublishPost{
+		ID:        id,
+		AuthorID:  authorID,
+		PublishAt: p.PublishAt,
 	}
 }
 
+// ToCoreResolvePost converts ResolvePost to core.ResolvePost of the author
+func (p *ResolvePost) ToCoreResolvePost(id, authorID int) core.ResolvePost {
+	return core.ResolvePost{
+		ID:           id,
+		AuthorID:     authorID,
+		Resolution:   core.PostResolution(p.Resolution),
+		SuccessStory: p.SuccessStory,
+	}
+}
+
+// ToStatisticsResponse converts core.PostStatistics to StatisticsResponse
+func ToStatisticsResponse(statistics core.PostStatistics) StatisticsResponse {
+	return StatisticsResponse{
+		ActivePosts:       statistics.ActivePosts,
+		ResolvedPosts:     statistics.ResolvedPosts,
+		Reunited:          statistics.Resolutions[core.PostReunited],
+		Adopted:           statistics.Resolutions[core.PostAdopted],
+		ResolvedLastMonth: statistics.ResolvedLastMonth,
+	}
+}
+
+// ToRevisionResponse converts core.PostRevision to RevisionResponse, changes are computed against the previous revision
+func ToRevisionResponse(revision core.PostRevision, previous *core.PostRevision) RevisionResponse {
+	photoIDs := make([]int, len(revision.PhotoIDs))
+	for i, id := range revision.PhotoIDs {
+		photoIDs[i] = int(id)
+	}
+
+	changes := []string{}
+	if previous != nil {
+		changes = append(changes, revision.Changes(*previous)...)
+	}
+
+	colors := revision.Animal.Colors
+	if colors == nil {
+		colors = []string{}
+	}
+
+	return RevisionResponse{
+		Revision: revision.Revision,
+		EditorID: revision.EditorID,
+		Title:    revision.Title,
+		Content:  revision.Content,
+		PhotoIDs: photoIDs,
+		Animal: RevisionAnimalResponse{
+			Name:                revision.Animal.Name,
+			AnimalType:          revision.Animal.AnimalType,
+			Breed:               revision.Animal.Breed,
+			Size:                revision.Animal.Size,
+			Age:                 revision.Animal.Age,
+			Color:               revision.Animal.Color,
+			Colors:              colors,
+			CoatPattern:         revision.Animal.CoatPattern,
+			Gender:              revision.Animal.Gender,
+			Description:         revision.Animal.Description,
+			DistinguishingMarks: revision.Animal.DistinguishingMarks,
+			Status:              revision.Animal.Status,
+		},
+		Changes:   changes,
+		CreatedAt: revision.CreatedAt,
+	}
+}
+
+// ToRevisionsResponse converts revisions ordered from the first one to []RevisionResponse
+func ToRevisionsResponse(revisions []core.PostRevision) []RevisionResponse {
+	response := make([]RevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		var previous *core.PostRevision
+		if i > 0 {
+			previous = &revisions[i-1]
+		}
+		response[i] = ToRevisionResponse(revision, previous)
+	}
+
+	return response
+}
+
+// ToAnimalDetailsResponse converts details of core.Animal to AnimalDetailsResponse
+func ToAnimalDetailsResponse(animal core.Animal) AnimalDetailsResponse {
+	colors := []string(animal.Colors)
+	if colors == nil {
+		colors = []string{}
+	}
+
+	return AnimalDetailsResponse{
+		Name:                animal.Name,
+		Breed:               animal.Breed,
+		Size:                animal.Size,
+		Colors:              colors,
+		CoatPattern:         animal.CoatPattern,
+		DistinguishingMarks: animal.DistinguishingMarks,
+		HasMicrochip:        animal.MicrochipNumber != nil,
+		HasTattoo:           animal.TattooID != nil,
+		IsSterilized:        animal.IsSterilized,
+		IsVaccinated:        animal.IsVaccinated,
+		HasCollar:           animal.HasCollar,
+		CollarDescription:   animal.CollarDescription,
+	}
+}
+
+// ToSimilarPostResponses converts posts found by photo to SimilarPostResponse list
+func ToSimilarPostResponses(posts []core.SimilarPost, access core.ContactsAccess) []SimilarPostResponse {
+	res := make([]SimilarPostResponse, len(posts))
+
+	for i, post := range posts {
+		res[i] = SimilarPostResponse{
+			Post:     ToPostResponse(post.PostDetails, access),
+			Distance: post.Distance,
+		}
+	}
+
+	return res
+}
+
+// ToCoreSearchPostsByPhotoParams converts SearchByPhotoParams to core.SearchPostsByPhotoParams
+func (p *SearchByPhotoParams) ToCoreSearchPostsByPhotoParams() core.SearchPostsByPhotoParams {
+	return core.SearchPostsByPhotoParams{
+		MaxDistance: p.MaxDistance,
+		Limit:       p.Limit,
+	}
+}
+
+// ToPhotoResponses converts photos of the post to PhotoResponse list
+func ToPhotoResponses(photos []core.Media) []PhotoResponse {
+	res := make([]PhotoResponse, len(photos))
+
+	for i, photo := range photos {
+		variants := make([]PhotoVariantResponse, len(photo.Variants))
+		for j, variant := range photo.Variants {
+			variants[j] = PhotoVariantResponse{
+				Name:        string(variant.Name),
+				ContentType: variant.ContentType,
+				URL:         variant.URL,
+				Width:       variant.Width,
+				Height:      variant.Height,
+			}
+		}
+
+		res[i] = PhotoResponse{
+			ID:       photo.ID,
+			URL:      photo.URL,
+			Width:    photo.Width,
+			Height:   photo.Height,
+			Variants: variants,
+		}
+	}
+
+	return res
+}
+
 // ToCorePostFavourite converts user ID and post ID to core.PostFavourite
 func ToCorePostFavourite(userID, postID int) core.PostFavourite {
 	return core.PostFavourite{
@@ -94,16 +323,62 @@ func ToCorePostFavourite(userID, postID int) core.PostFavourite {
 }
 
 // ToCoreGetAllPostsParams converts GetAllPostsParams from model to core.GetAllPostsParams
-func (p *GetAllPostsParams) ToCoreGetAllPostsParams() core.GetAllPostsParams {
+func (p *GetAllPostsParams) ToCoreGetAllPostsParams() (core.GetAllPostsParams, error) {
 	if p == nil {
-		return core.GetAllPostsParams{}
+		return core.GetAllPostsParams{}, nil
+	}
+
+	cursor, err := pagination.DecodeCursor(p.Cursor)
+	if err != nil {
+		return core.GetAllPostsParams{}, err
 	}
-	return core.GetAllPostsParams{
+
+	params := core.GetAllPostsParams{
 		Limit:      &p.Limit,
 		Offset:     &p.Offset,
+		Cursor:     cursor,
+		Latitude:   p.Latitude,
+		Longitude:  p.Longitude,
+		AnimalID:   p.AnimalID,
 		Status:     p.Status,
 		AnimalType: p.AnimalType,
 		Gender:     p.Gender,
 		Color:      p.Color,
+		Query:      p.Query,
+
+		Breed:       p.Breed,
+		Size:        p.Size,
+		Colors:      p.Colors,
+		CoatPattern: p.CoatPattern,
+		Sterilized:  p.Sterilized,
+		Identifier:  p.Chip,
+	}
+
+	if p.Sort != nil {
+		params.Sort = core.PostSort(*p.Sort)
 	}
+
+	if p.State != nil {
+		state := core.PostState(*p.State)
+		params.State = &state
+	}
+
+	if p.Resolution != nil {
+		resolution := core.PostResolution(*p.Resolution)
+		params.Resolution = &resolution
+	}
+
+	return params, nil
+}
+
+// NextCursor returns cursor of the page after the given one, there is no next page when the given page is not full.
+// Posts sorted by relevance are paginated by offset only.
+func NextCursor(params core.GetAllPostsParams, posts []core.PostDetails) *string {
+	sort := params.SortOrDefault()
+	if sort == core.PostSortRelevance || params.Limit == nil || len(posts) == 0 || len(posts) < *params.Limit {
+		return nil
+	}
+
+	cursor := pagination.EncodeCursor(sort.Cursor(posts[len(posts)-1].Post))
+	return &cursor
 }
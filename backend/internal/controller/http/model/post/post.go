@@ -4,56 +4,216 @@ import (
 	"time"
 
 	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/user"
 )
 
 type (
 	// CreateRequestBodyPost is the structure used for creating a new post
 	CreateRequestBodyPost struct {
-		Title       string `form:"title" json:"title" validate:"required,max=200"`
-		Content     string `form:"content" json:"content" validate:"required,max=2000"`
-		Photo       []byte `form:"photo" json:"photo"`
-		AnimalType  string `form:"animal_type" json:"animal_type" validate:"required,oneof=dog cat"`
+		Title   string `form:"title" json:"title" validate:"required,max=200"`
+		Content string `form:"content" json:"content" validate:"required,max=2000"`
+		// AnimalID - registered animal the post is about, fields of the animal are ignored when it is set
+		AnimalID    *int   `form:"animal_id" json:"animal_id" validate:"omitempty,gt=0"`
+		AnimalType  string `form:"animal_type" json:"animal_type" validate:"required_without=AnimalID,omitempty,oneof=dog cat rabbit bird rodent other"`
 		Age         int    `form:"age" json:"age" validate:"gte=0"`
-		Color       string `form:"color" json:"color" validate:"required"`
-		Gender      string `form:"gender" json:"gender" validate:"required,oneof=male female"`
+		Color       string `form:"color" json:"color" validate:"required_without=AnimalID"`
+		Gender      string `form:"gender" json:"gender" validate:"required_without=AnimalID,omitempty,oneof=male female"`
 		Description string `form:"description" json:"description"`
-		Status      string `form:"status" json:"status" validate:"required,oneof=lost found need_home"`
+		Status      string `form:"status" json:"status" validate:"required_without=AnimalID,omitempty,oneof=lost found need_home"`
+		// Latitude, Longitude - place the animal was lost or found
+		Latitude  *float64 `form:"latitude" json:"latitude" validate:"omitempty,latitude,required_with=Longitude"`
+		Longitude *float64 `form:"longitude" json:"longitude" validate:"omitempty,longitude,required_with=Latitude"`
+		AnimalDetails
+	}
+
+	// DraftRequestBodyPost - draft is saved unfinished, completeness is checked when it is published.
+	// Fields must stay the same as in CreateRequestBodyPost.
+	DraftRequestBodyPost struct {
+		Title       string   `form:"title" json:"title" validate:"max=200"`
+		Content     string   `form:"content" json:"content" validate:"max=2000"`
+		AnimalID    *int     `form:"animal_id" json:"animal_id" validate:"omitempty,gt=0"`
+		AnimalType  string   `form:"animal_type" json:"animal_type" validate:"omitempty,oneof=dog cat rabbit bird rodent other"`
+		Age         int      `form:"age" json:"age" validate:"gte=0"`
+		Color       string   `form:"color" json:"color"`
+		Gender      string   `form:"gender" json:"gender" validate:"omitempty,oneof=male female"`
+		Description string   `form:"description" json:"description"`
+		Status      string   `form:"status" json:"status" validate:"omitempty,oneof=lost found need_home"`
+		Latitude    *float64 `form:"latitude" json:"latitude" validate:"omitempty,latitude,required_with=Longitude"`
+		Longitude   *float64 `form:"longitude" json:"longitude" validate:"omitempty,longitude,required_with=Latitude"`
+		AnimalDetails
+	}
+
+	// CreatePostQuery - draft=true saves the post as a draft visible only to the author
+	CreatePostQuery struct {
+		Draft bool `query:"draft"`
+	}
+
+	// AnimalDetails - details of the animal which help to recognize it, all of them are optional
+	AnimalDetails struct {
+		Name                *string  `form:"name" json:"name" validate:"omitempty,max=100"`
+		Breed               *string  `form:"breed" json:"breed" validate:"omitempty,max=100"`
+		Size                *string  `form:"size" json:"size" validate:"omitempty,oneof=small medium large"`
+		Colors              []string `form:"colors" json:"colors" validate:"omitempty,max=7,unique,dive,oneof=black white gray ginger cream brown fawn"`
+		CoatPattern         *string  `form:"coat_pattern" json:"coat_pattern" validate:"omitempty,oneof=solid bicolor tabby tortoiseshell calico colorpoint spotted brindle merle"`
+		DistinguishingMarks *string  `form:"distinguishing_marks" json:"distinguishing_marks" validate:"omitempty,max=1000"`
+		MicrochipNumber     *string  `form:"microchip_number" json:"microchip_number" validate:"omitempty,max=32,printascii"`
+		TattooID            *string  `form:"tattoo_id" json:"tattoo_id" validate:"omitempty,max=32,printascii"`
+		IsSterilized        *bool    `form:"is_sterilized" json:"is_sterilized"`
+		IsVaccinated        *bool    `form:"is_vaccinated" json:"is_vaccinated"`
+		HasCollar           *bool    `form:"has_collar" json:"has_collar"`
+		CollarDescription   *string  `form:"collar_description" json:"collar_description" validate:"omitempty,max=200"`
 	}
 
 	// PostResponse represents the structure of a post response with additional details
 	PostResponse struct {
-		Title          string    `form:"title" json:"title"`
-		Content        string    `form:"content" json:"content"`
-		AuthorUsername string    `form:"author_username" json:"author_username"`
-		CreatedAt      time.Time `form:"created_at " json:"created_at"`
-		Photo          []byte    `form:"photo" json:"photo"`
-		AnimalType     string    `form:"animal_type" json:"animal_type"`
-		Age            int       `form:"age" json:"age"`
-		Color          string    `form:"color" json:"color"`
-		Gender         string    `form:"gender" json:"gender"`
-		Description    string    `form:"description" json:"description"`
-		Status         string    `form:"status" json:"status"`
-		IsFavourite    bool      `form:"is_favourite" json:"is_favourite"`
-		Comments       int       `form:"comments" json:"comments"`
+		ID             int    `form:"id" json:"id"`
+		Title          string `form:"title" json:"title"`
+		Content        string `form:"content" json:"content"`
+		AuthorUsername string `form:"author_username" json:"author_username"`
+		// AuthorContacts - contacts of the author shown to the viewer by privacy settings of the author
+		AuthorContacts user.Contacts   `form:"author_contacts" json:"author_contacts"`
+		CreatedAt      time.Time       `form:"created_at " json:"created_at"`
+		Photos         []PhotoResponse `form:"photos" json:"photos"`
+		AnimalID       int             `form:"animal_id" json:"animal_id"`
+		AnimalType     string          `form:"animal_type" json:"animal_type"`
+		Age            int             `form:"age" json:"age"`
+		Color          string          `form:"color" json:"color"`
+		Gender         string          `form:"gender" json:"gender"`
+		Description    string          `form:"description" json:"description"`
+		Status         string          `form:"status" json:"status"`
+		IsFavourite    bool            `form:"is_favourite" json:"is_favourite"`
+		Comments       int             `form:"comments" json:"comments"`
+		Likes          int             `form:"likes" json:"likes"`
+		LikedByMe      bool            `form:"liked_by_me" json:"liked_by_me"` // Always false for anonymous users
+		Latitude       *float64        `form:"latitude" json:"latitude,omitempty"`
+		Longitude      *float64        `form:"longitude" json:"longitude,omitempty"`
+		// Distance - distance to the given point in kilometers, returned only when posts are sorted by distance
+		Distance *float64 `form:"distance" json:"distance,omitempty"`
+		AnimalDetailsResponse
+		// PossibleDuplicates - published posts with the same photos, returned only when photos are uploaded
+		PossibleDuplicates []int `form:"possible_duplicates" json:"possible_duplicates,omitempty"`
+		// Highlight - fragments of the content with words matching the search query in <mark> tags, returned only by search
+		Highlight *string `form:"highlight" json:"highlight,omitempty"`
+		// State - stage of the case: active, resolved or archived
+		State        string     `form:"state" json:"state"`
+		Resolution   *string    `form:"resolution" json:"resolution,omitempty"`
+		SuccessStory *string    `form:"success_story" json:"success_story,omitempty"`
+		ResolvedAt   *time.Time `form:"resolved_at" json:"resolved_at,omitempty"`
+		ArchivedAt   *time.Time `form:"archived_at" json:"archived_at,omitempty"`
+		// BumpedAt - when the post was created, renewed or bumped, newest posts are ordered by it
+		BumpedAt time.Time `form:"bumped_at" json:"bumped_at"`
+		// Draft - the post is visible only to the author until it is published
+		Draft     bool       `form:"draft" json:"draft"`
+		PublishAt *time.Time `form:"publish_at" json:"publish_at,omitempty"` // Scheduled publication of the draft
+	}
+
+	// PublishPost is the structure used for publishing the draft, the draft is published now when PublishAt is empty or past
+	PublishPost struct {
+		PublishAt *time.Time `json:"publish_at"`
+	}
+
+	// ResolvePost is the structure used for closing the case of the post
+	ResolvePost struct {
+		Resolution   string  `json:"resolution" validate:"required,oneof=reunited adopted"`
+		SuccessStory *string `json:"success_story" validate:"omitempty,max=4000"`
+	}
+
+	// StatisticsResponse represents public statistics of cases
+	StatisticsResponse struct {
+		ActivePosts       int `json:"active_posts"`
+		ResolvedPosts     int `json:"resolved_posts"`
+		Reunited          int `json:"reunited"`
+		Adopted           int `json:"adopted"`
+		ResolvedLastMonth int `json:"resolved_last_month"` // Posts resolved within the last 30 days
+	}
+
+	// RevisionResponse represents a saved version of the post
+	RevisionResponse struct {
+		Revision  int                    `json:"revision"`
+		EditorID  int                    `json:"editor_id"`
+		Title     string                 `json:"title"`
+		Content   string                 `json:"content"`
+		PhotoIDs  []int                  `json:"photo_ids"` // Files of replaced photos are removed, only their IDs remain
+		Animal    RevisionAnimalResponse `json:"animal"`
+		Changes   []string               `json:"changes"` // Fields changed since the previous revision: title, content, photos or animal
+		CreatedAt time.Time              `json:"created_at"`
+	}
+
+	// RevisionAnimalResponse represents public details of the animal saved with the revision
+	RevisionAnimalResponse struct {
+		Name                string   `json:"name,omitempty"`
+		AnimalType          string   `json:"animal_type"`
+		Breed               string   `json:"breed,omitempty"`
+		Size                *string  `json:"size,omitempty"`
+		Age                 int      `json:"age"`
+		Color               string   `json:"color"`
+		Colors              []string `json:"colors"`
+		CoatPattern         *string  `json:"coat_pattern,omitempty"`
+		Gender              string   `json:"gender"`
+		Description         string   `json:"description"`
+		DistinguishingMarks string   `json:"distinguishing_marks,omitempty"`
+		Status              string   `json:"status"`
+	}
+
+	// AnimalDetailsResponse represents details of the animal of the post.
+	// Microchip number and tattoo prove ownership of the animal, so they are never shown, posts are only found by them.
+	AnimalDetailsResponse struct {
+		Name                string   `json:"name,omitempty"`
+		Breed               string   `json:"breed,omitempty"`
+		Size                *string  `json:"size,omitempty"`
+		Colors              []string `json:"colors"`
+		CoatPattern         *string  `json:"coat_pattern,omitempty"`
+		DistinguishingMarks string   `json:"distinguishing_marks,omitempty"`
+		HasMicrochip        bool     `json:"has_microchip"`
+		HasTattoo           bool     `json:"has_tattoo"`
+		IsSterilized        *bool    `json:"is_sterilized,omitempty"`
+		IsVaccinated        *bool    `json:"is_vaccinated,omitempty"`
+		HasCollar           *bool    `json:"has_collar,omitempty"`
+		CollarDescription   string   `json:"collar_description,omitempty"`
+	}
+
+	// SimilarPostResponse represents post found by photo
+	SimilarPostResponse struct {
+		Post     PostResponse `json:"post"`
+		Distance int          `json:"distance"` // Difference between the closest photo of the post and the searched one, 0 is the same photo
+	}
+
+	// PhotoResponse represents photo of the post, the photo itself is downloaded by URL
+	PhotoResponse struct {
+		ID       int                    `json:"id"`
+		URL      string                 `json:"url"`
+		Width    int                    `json:"width"`
+		Height   int                    `json:"height"`
+		Variants []PhotoVariantResponse `json:"variants"`
+	}
+
+	// PhotoVariantResponse represents downscaled copy of the photo, every size is available in the format of the photo and WebP
+	PhotoVariantResponse struct {
+		Name        string `json:"name" example:"thumbnail"`
+		ContentType string `json:"content_type" example:"image/webp"`
+		URL         string `json:"url"`
+		Width       int    `json:"width"`
+		Height      int    `json:"height"`
 	}
 
 	// UpdatePost, UpdateRequestBodyPost used for updating an existing post
 	UpdatePost struct {
 		Title   *string `form:"title" json:"title" validate:"max=200"`
 		Content *string `form:"content" json:"content" validate:"max=2000"`
-		Photo   *[]byte `form:"photo" json:"photo"`
 	}
 
 	UpdateRequestBodyPost struct {
-		Title       *string `form:"title" json:"title" validate:"omitempty,max=200"`
-		Content     *string `form:"content" json:"content" validate:"omitempty,max=2000"`
-		Photo       *[]byte `form:"photo" json:"photo"`
-		AnimalType  *string `form:"animal_type" json:"animal_type" validate:"omitempty,oneof=dog cat"`
-		Age         *int    `form:"age" json:"age" validate:"omitempty,gte=0"`
-		Color       *string `form:"color" json:"color"`
-		Gender      *string `form:"gender" json:"gender" validate:"omitempty,oneof=male female"`
-		Description *string `form:"description" json:"description"`
-		Status      *string `form:"status" json:"status" validate:"omitempty,oneof=lost found need_home"`
+		Title       *string  `form:"title" json:"title" validate:"omitempty,max=200"`
+		Content     *string  `form:"content" json:"content" validate:"omitempty,max=2000"`
+		AnimalType  *string  `form:"animal_type" json:"animal_type" validate:"omitempty,oneof=dog cat rabbit bird rodent other"`
+		Age         *int     `form:"age" json:"age" validate:"omitempty,gte=0"`
+		Color       *string  `form:"color" json:"color"`
+		Gender      *string  `form:"gender" json:"gender" validate:"omitempty,oneof=male female"`
+		Description *string  `form:"description" json:"description"`
+		Status      *string  `form:"status" json:"status" validate:"omitempty,oneof=lost found need_home adopted"`
+		Latitude    *float64 `form:"latitude" json:"latitude" validate:"omitempty,latitude,required_with=Longitude"`
+		Longitude   *float64 `form:"longitude" json:"longitude" validate:"omitempty,longitude,required_with=Latitude"`
+		AnimalDetails
 	}
 
 	// Meta represents metadata about the pagination of posts
@@ -72,13 +232,33 @@ type (
 
 	// GetAllPostsParams represents the parameters for fetching a list of posts with filters
 	GetAllPostsParams struct {
-		Limit      int     `query:"limit" validate:"gt=0"`                                  // Limit on the number of posts to retrieve
-		Offset     int     `query:"offset" validate:"gte=0"`                                // Offset for pagination
-		Status     *string `query:"status" validate:"omitempty,oneof=lost found need_home"` // Filter by status of the associated animal
-		AnimalType *string `query:"animal_type" validate:"omitempty,oneof=dog cat"`         // Filter by type of the associated animal
-		Gender     *string `query:"gender" validate:"omitempty,oneof=male female"`          // Filter by gender of the associated animal
-		Color      *string `query:"color" validate:"omitempty"`                             // Filter by color of the associated animal
-		Location   *string `query:"location" validate:"omitempty"`                          // Filter by location of the associated animal
+		Limit       int      `query:"limit" validate:"gt=0"`   // Limit on the number of posts to retrieve
+		Offset      int      `query:"offset" validate:"gte=0"` // Offset for pagination, ignored when cursor is set
+		Cursor      *string  `query:"cursor"`                  // Cursor of the next page from the previous response
+		Sort        *string  `query:"sort" validate:"omitempty,oneof=newest oldest updated favourited nearest relevance"`
+		Latitude    *float64 `query:"lat" validate:"omitempty,latitude,required_with=Longitude"`                                                         // Point to sort posts by distance to
+		Longitude   *float64 `query:"lon" validate:"omitempty,longitude,required_with=Latitude"`                                                         // Point to sort posts by distance to
+		AnimalID    *int     `query:"animal_id" validate:"omitempty,gt=0"`                                                                               // Posts about the registered animal
+		Status      *string  `query:"status" validate:"omitempty,oneof=lost found need_home adopted"`                                                    // Filter by status of the associated animal
+		AnimalType  *string  `query:"animal_type" validate:"omitempty,oneof=dog cat rabbit bird rodent other"`                                           // Filter by type of the associated animal
+		Gender      *string  `query:"gender" validate:"omitempty,oneof=male female"`                                                                     // Filter by gender of the associated animal
+		Color       *string  `query:"color" validate:"omitempty"`                                                                                        // Filter by color of the associated animal
+		Location    *string  `query:"location" validate:"omitempty"`                                                                                     // Filter by location of the associated animal
+		Breed       *string  `query:"breed" validate:"omitempty,max=100"`                                                                                // Filter by breed, part of the breed matches
+		Size        *string  `query:"size" validate:"omitempty,oneof=small medium large"`                                                                // Filter by size of the animal
+		Colors      []string `query:"colors" validate:"omitempty,dive,oneof=black white gray ginger cream brown fawn"`                                   // Animals having any of the colors match
+		CoatPattern *string  `query:"coat_pattern" validate:"omitempty,oneof=solid bicolor tabby tortoiseshell calico colorpoint spotted brindle merle"` // Filter by coat pattern
+		Sterilized  *bool    `query:"sterilized"`                                                                                                        // Filter by sterilization of the animal
+		Chip        *string  `query:"chip" validate:"omitempty,max=32,printascii"`                                                                       // Microchip number or tattoo of the animal
+		Query       *string  `query:"q" validate:"omitempty,max=200"`                                                                                    // Search query, posts are ordered by relevance
+		State       *string  `query:"state" validate:"omitempty,oneof=active resolved archived"`                                                         // Filter by state of the post, active by default for all posts
+		Resolution  *string  `query:"resolution" validate:"omitempty,oneof=reunited adopted"`                                                            // Filter by resolution of the post
+	}
+
+	// SearchByPhotoParams represents the parameters of search of posts by photo, the photo itself is sent in the form
+	SearchByPhotoParams struct {
+		MaxDistance *int `query:"max_distance" validate:"omitempty,gte=0,lte=32"` // Maximal difference between photos, 0-32
+		Limit       *int `query:"limit" validate:"omitempty,gt=0,lte=50"`         // Maximal amount of posts
 	}
 
 	PathParams struct {
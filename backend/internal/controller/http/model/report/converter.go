@@ -12,5 +12,6 @@ func (r *CreateRequestBodyReport) ToCoreReport(userID int) core.Report {
 		ReportableID:   r.TargetID,
 		ReportableType: r.TargetType,
 		Reason:         core.ReportReason(r.Reason),
+		Description:    r.Description,
 	}
 }
@@ -3,8 +3,9 @@ package report
 type (
 	// CreateRequestBodyReport - represent body of create report request.
 	CreateRequestBodyReport struct {
-		TargetID   int    `json:"target_id" validate:"required,min=1"`
-		TargetType string `json:"target_type" validate:"required,oneof=post comment"`
-		Reason     string `json:"reason" validate:"required,oneof=spam violent_content violent_speech"`
+		TargetID    int     `json:"target_id" validate:"required,min=1"`
+		TargetType  string  `json:"target_type" validate:"required,oneof=post comment user vet_review keeper_review message"`
+		Reason      string  `json:"reason" validate:"required,oneof=spam violent_content violent_speech other"`
+		Description *string `json:"description" validate:"omitempty,max=1000"`
 	}
 )
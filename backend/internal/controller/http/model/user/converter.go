@@ -1,17 +1,25 @@
 package user
 
-import "github.com/kotopesp/sos-kotopes/internal/core"
+import (
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
 
 func (u *User) ToCoreUser() core.User {
 	return core.User{
 		Username:     u.Username,
 		PasswordHash: u.Password,
 		Description:  u.Description,
-		Photo:        u.Photo,
 		Firstname:    u.Firstname,
 		Lastname:     u.Lastname,
 	}
 }
+
+// ToCoreMediaUpload returns photo uploaded on signup, nil if there is no photo
+func (u *User) ToCoreMediaUpload() *core.MediaUpload {
+	return toCoreMediaUpload(u.Photo)
+}
+
 func (u *UpdateUser) ToCoreUpdateUser() core.UpdateUser {
 	if u == nil {
 		return core.UpdateUser{}
@@ -20,9 +28,12 @@ func (u *UpdateUser) ToCoreUpdateUser() core.UpdateUser {
 		Username:     u.Username,
 		PasswordHash: u.Password,
 		Description:  u.Description,
-		Photo:        u.Photo,
+		Photo:        toCoreMediaUpload(u.Photo),
 		Firstname:    u.Firstname,
 		Lastname:     u.Lastname,
+		Phone:        u.Phone,
+		Telegram:     u.Telegram,
+		VKLink:       u.VKLink,
 	}
 }
 
@@ -33,16 +44,149 @@ func (u *Login) ToCoreUser() core.User {
 	}
 }
 
-func ToResponseUser(user *core.User) ResponseUser {
+// ToResponseUser converts core.User to ResponseUser with contacts the viewer has access to
+func ToResponseUser(user *core.User, access core.ContactsAccess) ResponseUser {
 	if user == nil {
 		return ResponseUser{}
 	}
+	var photoURL, thumbnailURL *string
+	if user.Photo != nil {
+		photoURL = &user.Photo.URL
+		for i, variant := range user.Photo.Variants {
+			if variant.Name == core.MediaVariantThumbnail && variant.ContentType == user.Photo.ContentType {
+				thumbnailURL = &user.Photo.Variants[i].URL
+				break
+			}
+		}
+	}
 	return ResponseUser{
-		ID:          user.ID,
-		Username:    user.Username,
-		Lastname:    user.Lastname,
-		Firstname:   user.Firstname,
-		Photo:       user.Photo,
-		Description: user.Description,
+		ID:           user.ID,
+		Username:     user.Username,
+		Lastname:     user.Lastname,
+		Firstname:    user.Firstname,
+		PhotoURL:     photoURL,
+		ThumbnailURL: thumbnailURL,
+		Description:  user.Description,
+		Contacts:     ToContacts(user.ID, user.Contacts, access),
+	}
+}
+
+// ToContacts converts contacts of the owner to Contacts leaving out the ones hidden from the viewer
+func ToContacts(ownerID int, contacts core.Contacts, access core.ContactsAccess) Contacts {
+	visible := access.VisibleContacts(ownerID, contacts)
+	return Contacts{
+		Phone:    visible.Phone,
+		Telegram: visible.Telegram,
+		VKLink:   visible.VKLink,
+	}
+}
+
+// ToProfile converts core.UserProfile to Profile, hidden parts of the profile are null
+func ToProfile(profile core.UserProfile, access core.ContactsAccess) Profile {
+	roles := make([]ProfileRole, len(profile.Roles))
+	for i, role := range profile.Roles {
+		roles[i] = ProfileRole{
+			Name:        role.Name,
+			Description: role.Description,
+			Since:       role.CreatedAt,
+		}
+	}
+
+	badges := make([]string, len(profile.Badges))
+	for i, badge := range profile.Badges {
+		badges[i] = string(badge)
+	}
+
+	return Profile{
+		ResponseUser: ToResponseUser(&profile.User, access),
+		MemberSince:  profile.User.CreatedAt,
+		Roles:        roles,
+		Badges:       badges,
+		Statistics:   toStatistics(profile.Statistics),
+		LastSeenAt:   profile.LastSeenAt,
+	}
+}
+
+func toStatistics(statistics *core.UserStatistics) *Statistics {
+	if statistics == nil {
+		return nil
+	}
+
+	return &Statistics{
+		Posts: PostCounts{
+			Active:   statistics.Posts[core.PostActive],
+			Resolved: statistics.Posts[core.PostResolved],
+			Archived: statistics.Posts[core.PostArchived],
+		},
+		ResolvedCases: statistics.ResolvedCases,
+		Comments:      statistics.Comments,
+		KeeperRating:  toRating(statistics.KeeperRating),
+		VetRating:     toRating(statistics.VetRating),
+	}
+}
+
+func toRating(rating *core.Rating) *Rating {
+	if rating == nil {
+		return nil
+	}
+	return &Rating{Average: rating.Average, Count: rating.Count}
+}
+
+// ToCorePrivacySettings converts PrivacySettings of the user to core.PrivacySettings
+func (s *PrivacySettings) ToCorePrivacySettings(userID int) core.PrivacySettings {
+	return core.PrivacySettings{
+		UserID:     userID,
+		Statistics: core.Visibility(s.Statistics),
+		LastSeen:   core.Visibility(s.LastSeen),
+		Comments:   core.Visibility(s.Comments),
+		Phone:      core.Visibility(s.Phone),
+		Telegram:   core.Visibility(s.Telegram),
+		VKLink:     core.Visibility(s.VKLink),
+	}
+}
+
+// ToPrivacySettings converts core.PrivacySettings to PrivacySettings
+func ToPrivacySettings(settings core.PrivacySettings) PrivacySettings {
+	return PrivacySettings{
+		Statistics: string(settings.Statistics),
+		LastSeen:   string(settings.LastSeen),
+		Comments:   string(settings.Comments),
+		Phone:      string(settings.Phone),
+		Telegram:   string(settings.Telegram),
+		VKLink:     string(settings.VKLink),
+	}
+}
+
+// ToCoreGetFavouriteUsersParams converts GetFavouritesParams to core.GetFavouriteUsersParams of the user
+func (p *GetFavouritesParams) ToCoreGetFavouriteUsersParams(userID int) core.GetFavouriteUsersParams {
+	return core.GetFavouriteUsersParams{
+		UserID: userID,
+		Limit:  &p.Limit,
+		Offset: &p.Offset,
+		Sort:   core.FavouriteUsersSort(p.Sort),
+	}
+}
+
+// ToFavouritesResponse converts a list of core.FavouriteUser to FavouritesResponse with pagination meta
+func ToFavouritesResponse(meta pagination.Pagination, favourites []core.FavouriteUser, access core.ContactsAccess) FavouritesResponse {
+	users := make([]FavouriteUser, len(favourites))
+
+	for i := range favourites {
+		users[i] = FavouriteUser{
+			ResponseUser: ToResponseUser(&favourites[i].Person, access),
+			FavouritedAt: favourites[i].CreatedAt,
+		}
+	}
+
+	return FavouritesResponse{
+		Meta:  meta,
+		Users: users,
+	}
+}
+
+func toCoreMediaUpload(photo *[]byte) *core.MediaUpload {
+	if photo == nil {
+		return nil
 	}
+	return &core.MediaUpload{Data: *photo}
 }
@@ -1,5 +1,11 @@
 package user
 
+import (
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/pagination"
+)
+
 type (
 	Login struct {
 		Username string `form:"username" validate:"required,max=50,no_specials" example:"JackVorobey123"`
@@ -21,6 +27,10 @@ type (
 		Description *string `form:"description" validate:"omitempty,max=512"`
 		Photo       *[]byte
 		Password    *string `form:"password" validate:"omitempty,min=8,max=72,contains_digit,contains_uppercase"`
+		// Phone, Telegram and VKLink - contacts of the user, empty value removes the contact
+		Phone    *string `form:"phone" validate:"omitempty,e164" example:"+79991234567"`
+		Telegram *string `form:"telegram" validate:"omitempty,telegram_username" example:"jack_vorobey"`
+		VKLink   *string `form:"vk_link" validate:"omitempty,max=100,vk_link" example:"https://vk.com/jack_vorobey"`
 	}
 
 	ResponseUser struct {
@@ -29,12 +39,88 @@ type (
 		Firstname   *string `json:"firstname"`
 		Lastname    *string `json:"lastname"`
 		Description *string `json:"description"`
-		Photo       *[]byte `json:"photo"`
+		PhotoURL    *string `json:"photo_url"`
+		// ThumbnailURL - downscaled avatar in the format of the photo, missing for photos which could not be processed
+		ThumbnailURL *string `json:"thumbnail_url"`
+		Contacts
+	}
+
+	// Contacts - contacts of the user shown to the viewer by privacy settings of the user, hidden and missing contacts are null
+	Contacts struct {
+		Phone    *string `json:"phone" example:"+79991234567"`
+		Telegram *string `json:"telegram" example:"jack_vorobey"`
+		VKLink   *string `json:"vk_link" example:"https://vk.com/jack_vorobey"`
+	}
+
+	// Profile - the user with activity as the viewer sees it
+	Profile struct {
+		ResponseUser
+		MemberSince time.Time     `json:"member_since" example:"2021-09-01T12:00:00Z"`
+		Roles       []ProfileRole `json:"roles"`
+		Badges      []string      `json:"badges" example:"moderator,vk_verified"`
+		Statistics  *Statistics   `json:"statistics"`                                  // Null if the user hides statistics from the viewer
+		LastSeenAt  *time.Time    `json:"last_seen_at" example:"2021-09-01T12:00:00Z"` // Null if the user hides it from the viewer or was never seen
+	}
+
+	ProfileRole struct {
+		Name        string    `json:"name" example:"keeper"`
+		Description string    `json:"description"`
+		Since       time.Time `json:"since" example:"2021-09-01T12:00:00Z"`
+	}
+
+	// Statistics - activity of the user, only published content is counted
+	Statistics struct {
+		Posts         PostCounts `json:"posts"`
+		ResolvedCases int        `json:"resolved_cases" example:"3"` // Animals registered or kept by the user which were adopted or returned to the owner
+		Comments      int        `json:"comments" example:"42"`
+		KeeperRating  *Rating    `json:"keeper_rating"` // Null if nobody reviewed the user as keeper
+		VetRating     *Rating    `json:"vet_rating"`    // Null if nobody reviewed the user as vet
+	}
+
+	// PostCounts - published posts of the user by state
+	PostCounts struct {
+		Active   int `json:"active" example:"2"`
+		Resolved int `json:"resolved" example:"5"`
+		Archived int `json:"archived" example:"1"`
+	}
+
+	Rating struct {
+		Average float64 `json:"average" example:"4.5"`
+		Count   int     `json:"count" example:"12"`
+	}
+
+	// PrivacySettings - who sees parts of the profile of the current user: everyone, logged-in users or nobody,
+	// contacts may be also shown to users having a chat with the current user
+	PrivacySettings struct {
+		Statistics string `json:"statistics" validate:"required,oneof=public users nobody" example:"public"`
+		LastSeen   string `json:"last_seen" validate:"required,oneof=public users nobody" example:"users"`
+		Comments   string `json:"comments" validate:"required,oneof=public users nobody" example:"public"` // Comment history of the user
+		Phone      string `json:"phone" validate:"required,oneof=public users chats nobody" example:"chats"`
+		Telegram   string `json:"telegram" validate:"required,oneof=public users chats nobody" example:"users"`
+		VKLink     string `json:"vk_link" validate:"required,oneof=public users chats nobody" example:"nobody"`
+	}
+
+	// GetFavouritesParams represents the parameters for fetching favourite users
+	GetFavouritesParams struct {
+		Limit  int    `query:"limit" validate:"gt=0,lte=100"`
+		Offset int    `query:"offset" validate:"gte=0"`
+		Sort   string `query:"sort" validate:"omitempty,oneof=newest oldest username"` // Recently favourited first by default
+	}
+
+	// FavouriteUser represents the user favourited by the current user
+	FavouriteUser struct {
+		ResponseUser
+		FavouritedAt time.Time `json:"favourited_at" example:"2021-09-01T12:00:00Z"`
+	}
+
+	// FavouritesResponse represents the list of favourite users with pagination
+	FavouritesResponse struct {
+		Meta  pagination.Pagination `json:"meta"`
+		Users []FavouriteUser       `json:"users"`
 	}
 
-	GetFavourites struct {
-		Count  *int    `json:"count"`
-		Offset *int    `json:"offset"`
-		Sort   *string `json:"sort"`
+	// FavouritePathParams - the user to add to or remove from favourites
+	FavouritePathParams struct {
+		UserID int `params:"id" validate:"gt=0"`
 	}
 )
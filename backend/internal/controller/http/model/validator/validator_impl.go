@@ -15,6 +15,10 @@ var (
 	uppercase = regexp.MustCompile(`[A-Z]`).MatchString
 	digit     = regexp.MustCompile(`\d`).MatchString
 	alphaNum  = regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString
+	// telegramUsername - username without @ as Telegram allows it
+	telegramUsername = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{4,31}$`).MatchString
+	// vkLink - link to the page of the user or community in VK
+	vkLink = regexp.MustCompile(`^https://(m\.)?vk\.(com|ru)/[a-zA-Z0-9_.]+$`).MatchString
 )
 
 // custom validator tags
@@ -37,6 +41,18 @@ func customValidationOptions(ctx context.Context, validator *validatorPkg.Valida
 	if err != nil {
 		logger.Log().Fatal(ctx, err.Error())
 	}
+	err = validator.RegisterValidation("telegram_username", func(fl validatorPkg.FieldLevel) bool {
+		return telegramUsername(fl.Field().String())
+	})
+	if err != nil {
+		logger.Log().Fatal(ctx, err.Error())
+	}
+	err = validator.RegisterValidation("vk_link", func(fl validatorPkg.FieldLevel) bool {
+		return vkLink(fl.Field().String())
+	})
+	if err != nil {
+		logger.Log().Fatal(ctx, err.Error())
+	}
 }
 
 func New(ctx context.Context, validator *validatorPkg.Validate) FormValidatorService {
@@ -72,25 +72,33 @@ func (r *Router) getReportedPosts(ctx *fiber.Ctx) error {
 
 	posts := moderator.ToPostList(postAndReasons)
 
-	postDetails, err := r.postService.BuildPostDetailsList(ctx.UserContext(), posts, len(posts))
+	postDetails, err := r.postService.BuildPostDetailsList(ctx.UserContext(), posts, len(posts), userID)
 	if err != nil {
 		logger.Log().Error(ctx.UserContext(), err.Error())
 
 		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 	}
 
-	response := moderator.ToPostsForModerationResponse(postAndReasons, postDetails)
+	access, err := r.postsContactsAccess(ctx.UserContext(), userID, postDetails...)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	response := moderator.ToPostsForModerationResponse(postAndReasons, postDetails, access)
 
 	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(response))
 }
 
 // @Summary		Delete a post
-// @Description	Deletes a post
+// @Description	Deletes a post, reason of the deletion is shown to its author
 // @Tags			moderation
 // @Accept			json
 // @Produce		json
 //
-// @Param			id	path	string	true	"ID of the post to delete"
+// @Param			id		path	string	true	"ID of the post to delete"
+// @Param			reason	query	string	true	"Reason of the deletion shown to the author"	Enum(spam, violent_content, violent_speech, other)
+// @Param			comment	query	string	false	"Explanation for the author"
 //
 // @Success		200	"Post successfully deleted"
 // @Failure		400	{object}	model.Response							"Invalid request parameters"
@@ -130,7 +138,21 @@ func (r *Router) deletePostByModerator(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(model.ErrorResponse("Invalid query parameters"))
 	}
 
-	err = r.moderatorService.DeletePost(ctx.UserContext(), deleteRequest.PostID)
+	var decisionRequest moderator.ModerationDecisionRequest
+	fiberError, parseOrValidationError = parseQueryAndValidate(ctx, r.formValidator, &decisionRequest)
+	if fiberError != nil {
+		logger.Log().Error(ctx.UserContext(), fiberError.Error())
+
+		return fiberError
+	}
+
+	if parseOrValidationError != nil {
+		logger.Log().Error(ctx.UserContext(), parseOrValidationError.Error())
+
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(model.ErrorResponse("Invalid query parameters"))
+	}
+
+	err = r.moderatorService.DeletePost(ctx.UserContext(), decisionRequest.ToCoreModerationDecision(userID, deleteRequest.PostID))
 	if err != nil {
 		logger.Log().Error(ctx.UserContext(), err.Error())
 		if errors.Is(err, core.ErrPostNotFound) {
@@ -248,12 +270,59 @@ func (r *Router) getReportedComments(ctx *fiber.Ctx) error {
 	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(response))
 }
 
+// @Summary		Search comments
+// @Description	Full-text search over comments of all statuses, comments are ordered by relevance
+// @Tags			moderation
+// @Accept			json
+// @Produce		json
+// @Param			q		query		string													true	"Search query"	maxlength(200)
+// @Param			status	query		string													false	"Status of the comment"	Enum(published, on_moderation, deleted)
+// @Param			limit	query		int														true	"Limit"		minimum(1)	maximum(100)
+// @Param			offset	query		int														true	"Offset"	minimum(0)
+// @Success		200		{object}	model.Response{data=moderator.SearchCommentsResponse}	"Success"
+// @Failure		401		{object}	model.Response											"User is not authorized"
+// @Failure		403		{object}	model.Response											"Access denied"
+// @Failure		422		{object}	model.Response{data=validator.Response}					"Validation error"
+// @Failure		500		{object}	model.Response											"Internal server error"
+// @Security		ApiKeyAuthBasic
+// @Router			/moderation/comments/search [get]
+func (r *Router) searchComments(ctx *fiber.Ctx) error {
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	_, err = r.moderatorService.GetModerator(ctx.UserContext(), userID)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	var searchRequest moderator.SearchCommentsRequest
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &searchRequest)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	comments, total, err := r.moderatorService.SearchComments(ctx.UserContext(), searchRequest.ToCoreSearchCommentsParams())
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	response := moderator.ToSearchCommentsResponse(paginate(total, searchRequest.Limit, searchRequest.Offset), comments)
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(response))
+}
+
 // @Summary		Delete a comment
-// @Description	Deletes a comment
+// @Description	Deletes a comment, reason of the deletion is shown to its author
 // @Tags			moderation
 // @Accept			json
 // @Produce		json
-// @Param			id	path	string	true	"ID of the comment to delete"
+// @Param			id		path	string	true	"ID of the comment to delete"
+// @Param			reason	query	string	true	"Reason of the deletion shown to the author"	Enum(spam, violent_content, violent_speech, other)
+// @Param			comment	query	string	false	"Explanation for the author"
 // @Success		200	"Comment successfully deleted"
 // @Failure		400	{object}	model.Response							"Invalid request parameters"
 // @Failure		401	{object}	model.Response							"User is not authorized"
@@ -288,7 +357,19 @@ func (r *Router) deleteCommentByModerator(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(model.ErrorResponse("Invalid query parameters"))
 	}
 
-	err = r.moderatorService.DeleteComment(ctx.UserContext(), deleteRequest.CommentID)
+	var decisionRequest moderator.ModerationDecisionRequest
+	fiberError, parseOrValidationError = parseQueryAndValidate(ctx, r.formValidator, &decisionRequest)
+	if fiberError != nil {
+		logger.Log().Error(ctx.UserContext(), fiberError.Error())
+		return fiberError
+	}
+
+	if parseOrValidationError != nil {
+		logger.Log().Error(ctx.UserContext(), parseOrValidationError.Error())
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(model.ErrorResponse("Invalid query parameters"))
+	}
+
+	err = r.moderatorService.DeleteComment(ctx.UserContext(), decisionRequest.ToCoreModerationDecision(userID, deleteRequest.CommentID))
 	if err != nil {
 		logger.Log().Error(ctx.UserContext(), err.Error())
 		if errors.Is(err, core.ErrNoSuchComment) {
@@ -352,7 +433,7 @@ func (r *Router) approveCommentByModerator(ctx *fiber.Ctx) error {
 }
 
 // @Summary		Ban user
-// @Description	Bans a user by specified moderator. Requires moderator privileges.
+// @Description	Bans a user by specified moderator. Requires moderator privileges. Reason of the ban is shown to the user.
 // @Tags			moderation
 // @Accept			json
 // @Produce		json
@@ -409,3 +490,254 @@ func (r *Router) banUser(ctx *fiber.Ctx) error {
 
 	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse("User banned successfully"))
 }
+
+// @Summary		Get reported users, reviews or messages
+// @Description	Returns users, reviews or chat messages awaiting moderation along with their reports
+// @Tags			moderation
+// @Accept			json
+// @Produce		json
+// @Param			target_type	query		string														true	"Type of reported entities"	Enum(user, vet_review, keeper_review, message)
+// @Param			filter		query		string														true	"Sorting by report time"	Enum(ASC, DESC)
+// @Success		200			{object}	model.Response{data=[]moderator.ReportedTargetResponse}	"Success"
+// @Failure		400			{object}	model.Response												"Invalid request parameters"
+// @Failure		401			{object}	model.Response												"User is not authorized"
+// @Failure		403			{object}	model.Response												"Access denied"
+// @Failure		422			{object}	model.Response{data=validator.Response}						"Validation error"
+// @Failure		500			{object}	model.Response												"Internal server error"
+// @Security		ApiKeyAuthBasic
+// @Router			/moderation/reports [get]
+func (r *Router) getReportedTargets(ctx *fiber.Ctx) error {
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	_, err = r.moderatorService.GetModerator(ctx.UserContext(), userID)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	var targetsRequest moderator.GetReportedTargetsRequest
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &targetsRequest)
+	if fiberError != nil {
+		logger.Log().Error(ctx.UserContext(), fiberError.Error())
+		return fiberError
+	}
+
+	if parseOrValidationError != nil {
+		logger.Log().Error(ctx.UserContext(), parseOrValidationError.Error())
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(model.ErrorResponse("Invalid query parameters"))
+	}
+
+	targets, err := r.moderatorService.GetReportedTargets(ctx.UserContext(), targetsRequest.TargetType, core.Filter(targetsRequest.Filter))
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		if errors.Is(err, core.ErrInvalidReportableType) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	response := moderator.ToReportedTargetsResponse(targets)
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(response))
+}
+
+// @Summary		Delete reported review or message
+// @Description	Deletes reported review or chat message waiting in moderation queue and upholds its reports. Reported users are banned with /moderation/users/ban.
+// @Tags			moderation
+// @Accept			json
+// @Produce		json
+// @Param			target_type	path	string	true	"Type of the reported entity"	Enum(vet_review, keeper_review, message)
+// @Param			target_id	path	int		true	"ID of the reported entity"
+// @Param			reason		query	string	true	"Reason of the deletion shown to the author"	Enum(spam, violent_content, violent_speech, other)
+// @Param			comment		query	string	false	"Explanation for the author"
+// @Success		200	"Reported entity successfully deleted"
+// @Failure		400	{object}	model.Response							"Invalid request parameters"
+// @Failure		401	{object}	model.Response							"User is not authorized"
+// @Failure		403	{object}	model.Response							"Access denied"
+// @Failure		404	{object}	model.Response							"Reported entity not found or not on moderation"
+// @Failure		422	{object}	model.Response{data=validator.Response}	"Validation error"
+// @Failure		500	{object}	model.Response							"Internal server error"
+// @Security		ApiKeyAuthBasic
+// @Router			/moderation/reports/{target_type}/{target_id} [delete]
+func (r *Router) deleteReportedTarget(ctx *fiber.Ctx) error {
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	_, err = r.moderatorService.GetModerator(ctx.UserContext(), userID)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	var deleteRequest moderator.ReportedTargetRequest
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &deleteRequest)
+	if fiberError != nil {
+		logger.Log().Error(ctx.UserContext(), fiberError.Error())
+		return fiberError
+	}
+
+	if parseOrValidationError != nil {
+		logger.Log().Error(ctx.UserContext(), parseOrValidationError.Error())
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(model.ErrorResponse("Invalid query parameters"))
+	}
+
+	var decisionRequest moderator.ModerationDecisionRequest
+	fiberError, parseOrValidationError = parseQueryAndValidate(ctx, r.formValidator, &decisionRequest)
+	if fiberError != nil {
+		logger.Log().Error(ctx.UserContext(), fiberError.Error())
+		return fiberError
+	}
+
+	if parseOrValidationError != nil {
+		logger.Log().Error(ctx.UserContext(), parseOrValidationError.Error())
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(model.ErrorResponse("Invalid query parameters"))
+	}
+
+	decision := decisionRequest.ToCoreModerationDecision(userID, deleteRequest.TargetID)
+	decision.TargetType = deleteRequest.TargetType
+
+	err = r.moderatorService.DeleteReportedTarget(ctx.UserContext(), decision)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		if oneOfErrors(err, core.ErrNoSuchReview, core.ErrNoSuchMessage, core.ErrNotOnModeration) {
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		}
+		if errors.Is(err, core.ErrInvalidReportableType) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+// @Summary		Dismiss reports
+// @Description	Leaves reported user, review or chat message as is and rejects all its reports
+// @Tags			moderation
+// @Accept			json
+// @Produce		json
+// @Param			target_type	path	string	true	"Type of the reported entity"	Enum(user, vet_review, keeper_review, message)
+// @Param			target_id	path	int		true	"ID of the reported entity"
+// @Success		200	"Reports successfully dismissed"
+// @Failure		400	{object}	model.Response							"Invalid request parameters"
+// @Failure		401	{object}	model.Response							"User is not authorized"
+// @Failure		403	{object}	model.Response							"Access denied"
+// @Failure		404	{object}	model.Response							"Entity is not on moderation"
+// @Failure		422	{object}	model.Response{data=validator.Response}	"Validation error"
+// @Failure		500	{object}	model.Response							"Internal server error"
+// @Security		ApiKeyAuthBasic
+// @Router			/moderation/reports/{target_type}/{target_id} [patch]
+func (r *Router) dismissReports(ctx *fiber.Ctx) error {
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	_, err = r.moderatorService.GetModerator(ctx.UserContext(), userID)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	var dismissRequest moderator.ReportedTargetRequest
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &dismissRequest)
+	if fiberError != nil {
+		logger.Log().Error(ctx.UserContext(), fiberError.Error())
+		return fiberError
+	}
+
+	if parseOrValidationError != nil {
+		logger.Log().Error(ctx.UserContext(), parseOrValidationError.Error())
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(model.ErrorResponse("Invalid query parameters"))
+	}
+
+	err = r.moderatorService.DismissReports(ctx.UserContext(), dismissRequest.TargetID, dismissRequest.TargetType)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		if errors.Is(err, core.ErrNotOnModeration) {
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse("Reports successfully dismissed"))
+}
+
+// @Summary		Get moderation history
+// @Description	Returns decisions of moderators against the current user and their content with reasons
+// @Tags			moderation
+// @Produce		json
+// @Success		200	{object}	model.Response{data=[]moderator.ModerationDecisionResponse}	"Success"
+// @Failure		401	{object}	model.Response												"User is not authorized"
+// @Failure		500	{object}	model.Response												"Internal server error"
+// @Security		ApiKeyAuthBasic
+// @Router			/users/moderation-history [get]
+func (r *Router) getModerationHistory(ctx *fiber.Ctx) error {
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	decisions, err := r.moderatorService.GetUserModerationHistory(ctx.UserContext(), userID)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(moderator.ToModerationHistoryResponse(decisions)))
+}
+
+// @Summary		Get moderation status of the post
+// @Description	Explains author why their post is on moderation or was deleted
+// @Tags			post
+// @Produce		json
+// @Param			id	path		int															true	"Post ID"
+// @Success		200	{object}	model.Response{data=moderator.PostModerationResponse}	"Success"
+// @Failure		401	{object}	model.Response												"User is not authorized"
+// @Failure		403	{object}	model.Response												"User is not the author of the post"
+// @Failure		404	{object}	model.Response												"Post not found"
+// @Failure		422	{object}	model.Response{data=validator.Response}						"Validation error"
+// @Failure		500	{object}	model.Response												"Internal server error"
+// @Security		ApiKeyAuthBasic
+// @Router			/posts/{id}/moderation [get]
+func (r *Router) getPostModeration(ctx *fiber.Ctx) error {
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	var postRequest moderator.ModeratedPostRequest
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &postRequest)
+	if fiberError != nil {
+		logger.Log().Error(ctx.UserContext(), fiberError.Error())
+		return fiberError
+	}
+
+	if parseOrValidationError != nil {
+		logger.Log().Error(ctx.UserContext(), parseOrValidationError.Error())
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(model.ErrorResponse("Invalid query parameters"))
+	}
+
+	postModeration, err := r.moderatorService.GetPostModeration(ctx.UserContext(), userID, postRequest.PostID)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		switch {
+		case errors.Is(err, core.ErrPostNotFound):
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrPostAuthorIDMismatch):
+			return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(moderator.ToPostModerationResponse(postModeration)))
+}
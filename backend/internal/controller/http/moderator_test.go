@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
@@ -49,7 +50,7 @@ func TestGetReportedPosts(t *testing.T) {
 					}, nil).Once()
 
 				dependencies.postService.EXPECT().
-					BuildPostDetailsList(mock.Anything, mock.Anything, mock.Anything).
+					BuildPostDetailsList(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]core.PostDetails{{Post: core.Post{ID: 1}}}, nil).Once()
 			},
 			wantCode:     http.StatusOK,
@@ -69,7 +70,7 @@ func TestGetReportedPosts(t *testing.T) {
 					Return([]core.PostForModeration{}, nil).Once()
 
 				dependencies.postService.EXPECT().
-					BuildPostDetailsList(mock.Anything, mock.Anything, mock.Anything).
+					BuildPostDetailsList(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]core.PostDetails{}, nil).Once()
 			},
 			wantCode:     http.StatusOK,
@@ -170,7 +171,7 @@ func TestGetReportedPosts(t *testing.T) {
 					Return([]core.PostForModeration{{Post: core.Post{ID: 1}}}, nil).Once()
 
 				dependencies.postService.EXPECT().
-					BuildPostDetailsList(mock.Anything, mock.Anything, mock.Anything).
+					BuildPostDetailsList(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return(nil, errors.New("build error")).Once()
 			},
 			wantCode: http.StatusInternalServerError,
@@ -219,6 +220,7 @@ func TestDeletePostByModerator(t *testing.T) {
 		name          string
 		token         string
 		postID        int
+		query         string
 		mockBehaviour func()
 		wantCode      int
 	}{
@@ -226,6 +228,7 @@ func TestDeletePostByModerator(t *testing.T) {
 			name:   "success",
 			token:  token,
 			postID: 1,
+			query:  "?reason=spam",
 			mockBehaviour: func() {
 				dependencies.moderatorService.EXPECT().
 					GetModerator(mock.Anything, mock.Anything).
@@ -241,6 +244,7 @@ func TestDeletePostByModerator(t *testing.T) {
 			name:   "unauthorized",
 			token:  "",
 			postID: 1,
+			query:  "?reason=spam",
 			mockBehaviour: func() {
 			},
 			wantCode: http.StatusUnauthorized,
@@ -249,6 +253,7 @@ func TestDeletePostByModerator(t *testing.T) {
 			name:   "forbidden",
 			token:  token,
 			postID: 1,
+			query:  "?reason=spam",
 			mockBehaviour: func() {
 				dependencies.moderatorService.EXPECT().
 					GetModerator(mock.Anything, mock.Anything).
@@ -260,6 +265,18 @@ func TestDeletePostByModerator(t *testing.T) {
 			name:   "validation error",
 			token:  token,
 			postID: -1,
+			query:  "?reason=spam",
+			mockBehaviour: func() {
+				dependencies.moderatorService.EXPECT().
+					GetModerator(mock.Anything, mock.Anything).
+					Return(core.Moderator{}, nil).Once()
+			},
+			wantCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:   "missing reason",
+			token:  token,
+			postID: 1,
 			mockBehaviour: func() {
 				dependencies.moderatorService.EXPECT().
 					GetModerator(mock.Anything, mock.Anything).
@@ -271,6 +288,7 @@ func TestDeletePostByModerator(t *testing.T) {
 			name:   "post not found",
 			token:  token,
 			postID: 1,
+			query:  "?reason=spam",
 			mockBehaviour: func() {
 				dependencies.moderatorService.EXPECT().
 					GetModerator(mock.Anything, mock.Anything).
@@ -286,6 +304,7 @@ func TestDeletePostByModerator(t *testing.T) {
 			name:   "internal server error",
 			token:  token,
 			postID: 1,
+			query:  "?reason=spam",
 			mockBehaviour: func() {
 				dependencies.moderatorService.EXPECT().
 					GetModerator(mock.Anything, mock.Anything).
@@ -303,7 +322,7 @@ func TestDeletePostByModerator(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockBehaviour()
 
-			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf(route, tt.postID), http.NoBody)
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf(route, tt.postID)+tt.query, http.NoBody)
 			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
 
 			resp, err := app.Test(req, -1)
@@ -604,6 +623,130 @@ func TestGetReportedComments(t *testing.T) {
 	}
 }
 
+func TestSearchComments(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	const baseRoute = "/api/v1/moderation/comments/search"
+
+	deleted := core.Deleted
+	highlight := "злой <mark>кот</mark>"
+
+	tests := []struct {
+		name          string
+		token         string
+		queryParams   string
+		mockBehaviour func()
+		wantCode      int
+		wantTotal     int
+	}{
+		{
+			name:        "success",
+			token:       token,
+			queryParams: "q=кот&status=deleted&limit=10&offset=0",
+			mockBehaviour: func() {
+				dependencies.moderatorService.EXPECT().
+					GetModerator(mock.Anything, mock.Anything).
+					Return(core.Moderator{}, nil).Once()
+
+				dependencies.moderatorService.EXPECT().
+					SearchComments(mock.Anything, core.SearchCommentsParams{Query: "кот", Status: &deleted, Limit: 10}).
+					Return([]core.Comment{{ID: 1, Content: "злой кот", Status: core.Deleted, Highlight: &highlight}}, 1, nil).Once()
+			},
+			wantCode:  http.StatusOK,
+			wantTotal: 1,
+		},
+		{
+			name:          "unauthorized - missing token",
+			token:         "",
+			queryParams:   "q=кот&limit=10",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:        "forbidden - not a moderator",
+			token:       token,
+			queryParams: "q=кот&limit=10",
+			mockBehaviour: func() {
+				dependencies.moderatorService.EXPECT().
+					GetModerator(mock.Anything, mock.Anything).
+					Return(core.Moderator{}, core.ErrNoSuchModerator).Once()
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:        "validation error - missing query",
+			token:       token,
+			queryParams: "limit=10",
+			mockBehaviour: func() {
+				dependencies.moderatorService.EXPECT().
+					GetModerator(mock.Anything, mock.Anything).
+					Return(core.Moderator{}, nil).Once()
+			},
+			wantCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "validation error - invalid status",
+			token:       token,
+			queryParams: "q=кот&status=hidden&limit=10",
+			mockBehaviour: func() {
+				dependencies.moderatorService.EXPECT().
+					GetModerator(mock.Anything, mock.Anything).
+					Return(core.Moderator{}, nil).Once()
+			},
+			wantCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "internal error - search failed",
+			token:       token,
+			queryParams: "q=кот&limit=10",
+			mockBehaviour: func() {
+				dependencies.moderatorService.EXPECT().
+					GetModerator(mock.Anything, mock.Anything).
+					Return(core.Moderator{}, nil).Once()
+
+				dependencies.moderatorService.EXPECT().
+					SearchComments(mock.Anything, core.SearchCommentsParams{Query: "кот", Limit: 10}).
+					Return(nil, 0, errors.New("db error")).Once()
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			u := fmt.Sprintf("%s?%s", baseRoute, (&url.URL{RawQuery: tt.queryParams}).Query().Encode())
+
+			req := httptest.NewRequest(http.MethodGet, u, http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+
+			if tt.wantCode == http.StatusOK {
+				var data struct {
+					Data moderator.SearchCommentsResponse `json:"data"`
+				}
+				err = json.Unmarshal(body, &data)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantTotal, data.Data.Meta.Total)
+				require.Len(t, data.Data.Comments, 1)
+				assert.Equal(t, &highlight, data.Data.Comments[0].Highlight)
+			}
+		})
+	}
+}
+
 func TestDeleteCommentByModerator(t *testing.T) {
 	t.Parallel()
 	app, dependencies := newTestApp(t)
@@ -614,6 +757,7 @@ func TestDeleteCommentByModerator(t *testing.T) {
 		name          string
 		token         string
 		commentID     int
+		query         string
 		mockBehaviour func()
 		wantCode      int
 	}{
@@ -621,13 +765,14 @@ func TestDeleteCommentByModerator(t *testing.T) {
 			name:      "success",
 			token:     token,
 			commentID: 1,
+			query:     "?reason=spam",
 			mockBehaviour: func() {
 				dependencies.moderatorService.EXPECT().
 					GetModerator(mock.Anything, mock.Anything).
 					Return(core.Moderator{}, nil).Once()
 
 				dependencies.moderatorService.EXPECT().
-					DeleteComment(mock.Anything, 1).
+					DeleteComment(mock.Anything, mock.Anything).
 					Return(nil).Once()
 			},
 			wantCode: http.StatusOK,
@@ -636,6 +781,7 @@ func TestDeleteCommentByModerator(t *testing.T) {
 			name:          "unauthorized",
 			token:         "",
 			commentID:     1,
+			query:         "?reason=spam",
 			mockBehaviour: func() {},
 			wantCode:      http.StatusUnauthorized,
 		},
@@ -643,6 +789,7 @@ func TestDeleteCommentByModerator(t *testing.T) {
 			name:      "forbidden",
 			token:     token,
 			commentID: 1,
+			query:     "?reason=spam",
 			mockBehaviour: func() {
 				dependencies.moderatorService.EXPECT().
 					GetModerator(mock.Anything, mock.Anything).
@@ -654,6 +801,18 @@ func TestDeleteCommentByModerator(t *testing.T) {
 			name:      "validation error",
 			token:     token,
 			commentID: -1,
+			query:     "?reason=spam",
+			mockBehaviour: func() {
+				dependencies.moderatorService.EXPECT().
+					GetModerator(mock.Anything, mock.Anything).
+					Return(core.Moderator{}, nil).Once()
+			},
+			wantCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:      "missing reason",
+			token:     token,
+			commentID: 1,
 			mockBehaviour: func() {
 				dependencies.moderatorService.EXPECT().
 					GetModerator(mock.Anything, mock.Anything).
@@ -665,13 +824,14 @@ func TestDeleteCommentByModerator(t *testing.T) {
 			name:      "comment not found",
 			token:     token,
 			commentID: 1,
+			query:     "?reason=spam",
 			mockBehaviour: func() {
 				dependencies.moderatorService.EXPECT().
 					GetModerator(mock.Anything, mock.Anything).
 					Return(core.Moderator{}, nil).Once()
 
 				dependencies.moderatorService.EXPECT().
-					DeleteComment(mock.Anything, 1).
+					DeleteComment(mock.Anything, mock.Anything).
 					Return(core.ErrNoSuchComment).Once()
 			},
 			wantCode: http.StatusNotFound,
@@ -680,13 +840,14 @@ func TestDeleteCommentByModerator(t *testing.T) {
 			name:      "internal server error",
 			token:     token,
 			commentID: 1,
+			query:     "?reason=spam",
 			mockBehaviour: func() {
 				dependencies.moderatorService.EXPECT().
 					GetModerator(mock.Anything, mock.Anything).
 					Return(core.Moderator{}, nil).Once()
 
 				dependencies.moderatorService.EXPECT().
-					DeleteComment(mock.Anything, 1).
+					DeleteComment(mock.Anything, mock.Anything).
 					Return(errors.New("internal server error")).Once()
 			},
 			wantCode: http.StatusInternalServerError,
@@ -697,7 +858,7 @@ func TestDeleteCommentByModerator(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockBehaviour()
 
-			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf(route, tt.commentID), http.NoBody)
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf(route, tt.commentID)+tt.query, http.NoBody)
 			if tt.token != "" {
 				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
 			}
@@ -859,11 +1020,12 @@ func TestBanUser(t *testing.T) {
 			requestBody: moderator.BanUserRequest{
 				UserID:   1,
 				ReportID: func() *int { i := 5; return &i }(),
+				Reason:   "violent_content",
 			},
 			mockBehaviour: func() {
 				dependencies.moderatorService.On("GetModerator", mock.Anything, 1).Return(core.Moderator{}, nil).Once()
 				dependencies.moderatorService.On("BanUser", mock.Anything, mock.MatchedBy(func(record core.BannedUserRecord) bool {
-					return record.UserID == 1 && record.ModeratorID == 1 && *record.ReportID == 5
+					return record.UserID == 1 && record.ModeratorID == 1 && *record.ReportID == 5 && record.Reason == core.ViolentContent
 				})).Return(nil).Once()
 			},
 			wantCode: fiber.StatusOK,
@@ -874,6 +1036,7 @@ func TestBanUser(t *testing.T) {
 			requestBody: moderator.BanUserRequest{
 				UserID:   1,
 				ReportID: nil,
+				Reason:   "spam",
 			},
 			mockBehaviour: func() {
 				dependencies.moderatorService.On("GetModerator", mock.Anything, 1).Return(core.Moderator{}, nil).Once()
@@ -886,7 +1049,7 @@ func TestBanUser(t *testing.T) {
 		{
 			name:        "unauthorized - missing token",
 			token:       "",
-			requestBody: moderator.BanUserRequest{UserID: 1},
+			requestBody: moderator.BanUserRequest{UserID: 1, Reason: "spam"},
 			mockBehaviour: func() {
 			},
 			wantCode:  fiber.StatusUnauthorized,
@@ -895,7 +1058,7 @@ func TestBanUser(t *testing.T) {
 		{
 			name:        "forbidden - not a moderator",
 			token:       token,
-			requestBody: moderator.BanUserRequest{UserID: 1},
+			requestBody: moderator.BanUserRequest{UserID: 1, Reason: "spam"},
 			mockBehaviour: func() {
 				dependencies.moderatorService.On("GetModerator", mock.Anything, 1).Return(core.Moderator{}, core.ErrNoSuchModerator).Once()
 			},
@@ -922,10 +1085,20 @@ func TestBanUser(t *testing.T) {
 			wantCode:  fiber.StatusUnprocessableEntity,
 			wantError: "Invalid request body",
 		},
+		{
+			name:        "validation error - missing reason",
+			token:       token,
+			requestBody: map[string]interface{}{"user_id": 1},
+			mockBehaviour: func() {
+				dependencies.moderatorService.On("GetModerator", mock.Anything, 1).Return(core.Moderator{}, nil).Once()
+			},
+			wantCode:  fiber.StatusUnprocessableEntity,
+			wantError: "Invalid request body",
+		},
 		{
 			name:        "user not found",
 			token:       token,
-			requestBody: moderator.BanUserRequest{UserID: 999},
+			requestBody: moderator.BanUserRequest{UserID: 999, Reason: "spam"},
 			mockBehaviour: func() {
 				dependencies.moderatorService.On("GetModerator", mock.Anything, 1).Return(core.Moderator{}, nil).Once()
 				dependencies.moderatorService.On("BanUser", mock.Anything, mock.Anything).Return(core.ErrNoSuchUser).Once()
@@ -936,7 +1109,7 @@ func TestBanUser(t *testing.T) {
 		{
 			name:        "user already banned",
 			token:       token,
-			requestBody: moderator.BanUserRequest{UserID: 1},
+			requestBody: moderator.BanUserRequest{UserID: 1, Reason: "spam"},
 			mockBehaviour: func() {
 				dependencies.moderatorService.On("GetModerator", mock.Anything, 1).Return(core.Moderator{}, nil).Once()
 				dependencies.moderatorService.On("BanUser", mock.Anything, mock.Anything).Return(core.ErrUserAlreadyBanned).Once()
@@ -947,7 +1120,7 @@ func TestBanUser(t *testing.T) {
 		{
 			name:        "internal server error",
 			token:       token,
-			requestBody: moderator.BanUserRequest{UserID: 1},
+			requestBody: moderator.BanUserRequest{UserID: 1, Reason: "spam"},
 			mockBehaviour: func() {
 				dependencies.moderatorService.On("GetModerator", mock.Anything, 1).Return(core.Moderator{}, nil).Once()
 				dependencies.moderatorService.On("BanUser", mock.Anything, mock.Anything).Return(errors.New("database error")).Once()
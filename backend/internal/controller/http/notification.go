@@ -0,0 +1,81 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	notificationModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/notification"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// @Summary		Get notifications
+// @Tags			notification
+// @Description	Get notifications of the current user, the latest go first
+// @ID				get-notifications
+// @Produce		json
+// @Param			limit	query		int		true	"Limit"		minimum(1)	maximum(100)
+// @Param			offset	query		int		false	"Offset"	minimum(0)
+// @Param			unread	query		bool	false	"Only unread notifications"
+// @Success		200		{object}	model.Response{data=notification.Response}
+// @Failure		401		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/notifications [get]
+func (r *Router) getNotifications(ctx *fiber.Ctx) error {
+	var params notificationModel.GetNotificationsParams
+
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &params)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	notifications, total, unread, err := r.notificationService.GetNotifications(ctx.UserContext(), params.ToCoreGetNotificationsParams(userID))
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(
+		notificationModel.ToResponse(paginate(total, params.Limit, params.Offset), unread, notifications),
+	))
+}
+
+// @Summary		Mark notifications as read
+// @Tags			notification
+// @Description	Mark notifications of the current user as read, all notifications are marked when ids are empty
+// @ID				mark-notifications-read
+// @Accept			json
+// @Param			request	body	notification.MarkRead	true	"Notifications"
+// @Success		204
+// @Failure		401	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/notifications/read [post]
+func (r *Router) markNotificationsRead(ctx *fiber.Ctx) error {
+	var request notificationModel.MarkRead
+
+	fiberError, parseOrValidationError := parseBodyAndValidate(ctx, r.formValidator, &request)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	if err := r.notificationService.MarkNotificationsRead(ctx.UserContext(), userID, request.IDs); err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
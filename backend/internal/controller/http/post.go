@@ -14,17 +14,31 @@ import (
 
 // @Summary		Get all posts
 // @Tags			post
-// @Description	Get all posts
+// @Description	Get all posts, posts found by query are ordered by relevance and have highlighted fragments of the content
 // @ID				get-all-posts
 // @Accept			json
 // @Produce		json
 // @Param			limit		query		int		true	"Limit"		minimum(1)
-// @Param			offset		query		int		true	"Offset"	minimum(0)
+// @Param			offset		query		int		false	"Offset, ignored when cursor is set"	minimum(0)
+// @Param			cursor		query		string	false	"Cursor of the next page from meta of the previous response"
+// @Param			sort		query		string	false	"Sort, newest by default and relevance for search"	Enums(newest, oldest, updated, favourited, nearest, relevance)
+// @Param			lat			query		number	false	"Latitude of the point to sort posts by distance to"	minimum(-90)	maximum(90)
+// @Param			lon			query		number	false	"Longitude of the point to sort posts by distance to"	minimum(-180)	maximum(180)
+// @Param			animal_id	query		int		false	"Posts about the registered animal"	minimum(1)
 // @Param			status		query		string	false	"Status"
 // @Param			animal_type	query		string	false	"Animal type"
 // @Param			gender		query		string	false	"Gender"
 // @Param			color		query		string	false	"Color"
 // @Param			location	query		string	false	"Location"
+// @Param			breed		query		string	false	"Breed, part of the breed matches"	maxlength(100)
+// @Param			size		query		string	false	"Size"	Enums(small, medium, large)
+// @Param			colors		query		[]string	false	"Colors, animals having any of them match"	collectionFormat(multi)
+// @Param			coat_pattern	query	string	false	"Coat pattern"	Enums(solid, bicolor, tabby, tortoiseshell, calico, colorpoint, spotted, brindle, merle)
+// @Param			sterilized	query		bool	false	"Sterilization"
+// @Param			chip		query		string	false	"Microchip number or tattoo"	maxlength(32)
+// @Param			q			query		string	false	"Search query"	maxlength(200)
+// @Param			state		query		string	false	"State of the post, active by default"	Enums(active, resolved, archived)
+// @Param			resolution	query		string	false	"Resolution of the post"	Enums(reunited, adopted)
 // @Success		200			{object}	model.Response{data=post.Response}
 // @Failure		400			{object}	model.Response
 // @Failure		422			{object}	model.Response{data=validator.Response}
@@ -39,17 +53,37 @@ func (r *Router) getPosts(ctx *fiber.Ctx) error {
 		return fiberError
 	}
 
-	coreGetAllPostsParams := getAllPostsParams.ToCoreGetAllPostsParams()
+	coreGetAllPostsParams, err := getAllPostsParams.ToCoreGetAllPostsParams()
+	if err != nil {
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	// posts of users blocked or muted by the current user are hidden, likes are counted for the current user
+	if userID, err := getIDFromToken(ctx); err == nil {
+		coreGetAllPostsParams.ViewerID = userID
+	}
 
 	postsDetails, total, err := r.postService.GetAllPosts(ctx.UserContext(), coreGetAllPostsParams)
+	if err != nil {
+		if isPaginationError(err) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	access, err := r.postsContactsAccess(ctx.UserContext(), coreGetAllPostsParams.ViewerID, postsDetails...)
 	if err != nil {
 		logger.Log().Error(ctx.UserContext(), err.Error())
 		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 	}
 
 	pagination := paginate(total, getAllPostsParams.Limit, getAllPostsParams.Offset)
+	pagination.NextCursor = postModel.NextCursor(coreGetAllPostsParams, postsDetails)
 
-	response := postModel.ToResponse(pagination, postsDetails)
+	response := postModel.ToResponse(pagination, postsDetails, access)
 
 	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(response))
 }
@@ -62,12 +96,25 @@ func (r *Router) getPosts(ctx *fiber.Ctx) error {
 // @Produce		json
 // @Param			id			path		int		true	"User ID"	minimum(1)
 // @Param			limit		query		int		true	"Limit"		minimum(1)
-// @Param			offset		query		int		true	"Offset"	minimum(0)
+// @Param			offset		query		int		false	"Offset, ignored when cursor is set"	minimum(0)
+// @Param			cursor		query		string	false	"Cursor of the next page from meta of the previous response"
+// @Param			sort		query		string	false	"Sort, newest by default and relevance for search"	Enums(newest, oldest, updated, favourited, nearest, relevance)
+// @Param			lat			query		number	false	"Latitude of the point to sort posts by distance to"	minimum(-90)	maximum(90)
+// @Param			lon			query		number	false	"Longitude of the point to sort posts by distance to"	minimum(-180)	maximum(180)
+// @Param			animal_id	query		int		false	"Posts about the registered animal"	minimum(1)
 // @Param			status		query		string	false	"Status"
 // @Param			animal_type	query		string	false	"Animal type"
 // @Param			gender		query		string	false	"Gender"
 // @Param			color		query		string	false	"Color"
 // @Param			location	query		string	false	"Location"
+// @Param			breed		query		string	false	"Breed, part of the breed matches"	maxlength(100)
+// @Param			size		query		string	false	"Size"	Enums(small, medium, large)
+// @Param			colors		query		[]string	false	"Colors, animals having any of them match"	collectionFormat(multi)
+// @Param			coat_pattern	query	string	false	"Coat pattern"	Enums(solid, bicolor, tabby, tortoiseshell, calico, colorpoint, spotted, brindle, merle)
+// @Param			sterilized	query		bool	false	"Sterilization"
+// @Param			chip		query		string	false	"Microchip number or tattoo"	maxlength(32)
+// @Param			state		query		string	false	"State of the post"	Enums(active, resolved, archived)
+// @Param			resolution	query		string	false	"Resolution of the post"	Enums(reunited, adopted)
 // @Success		200			{object}	model.Response{data=post.Response}
 // @Failure		400			{object}	model.Response
 // @Failure		404			{object}	model.Response
@@ -88,19 +135,38 @@ func (r *Router) getUserPosts(ctx *fiber.Ctx) error {
 		return fiberError
 	}
 
-	postsDetails, total, err := r.postService.GetUserPosts(ctx.UserContext(), id)
+	coreGetAllPostsParams, err := getAllPostsParams.ToCoreGetAllPostsParams()
+	if err != nil {
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	coreGetAllPostsParams.ViewerID = getViewerID(ctx)
+
+	postsDetails, total, err := r.postService.GetUserPosts(ctx.UserContext(), id, coreGetAllPostsParams)
 	if err != nil {
 		switch {
 		case errors.Is(err, core.ErrNoSuchUser):
 			logger.Log().Debug(ctx.UserContext(), err.Error())
 			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case isPaginationError(err):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
 		default:
 			logger.Log().Error(ctx.UserContext(), err.Error())
 			return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 		}
 	}
+
+	access, err := r.postsContactsAccess(ctx.UserContext(), coreGetAllPostsParams.ViewerID, postsDetails...)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
 	pagination := paginate(total, getAllPostsParams.Limit, getAllPostsParams.Offset)
-	response := postModel.ToResponse(pagination, postsDetails)
+	pagination.NextCursor = postModel.NextCursor(coreGetAllPostsParams, postsDetails)
+	response := postModel.ToResponse(pagination, postsDetails, access)
 
 	return ctx.Status(fiber.StatusOK).JSON(response)
 }
@@ -126,7 +192,9 @@ func (r *Router) getPostByID(ctx *fiber.Ctx) error {
 		return fiberError
 	}
 
-	postDetails, err := r.postService.GetPostByID(ctx.UserContext(), pathParams.PostID)
+	viewerID := getViewerID(ctx)
+
+	postDetails, err := r.postService.GetPostByID(ctx.UserContext(), pathParams.PostID, viewerID)
 	if err != nil {
 		if errors.Is(err, core.ErrPostNotFound) {
 			logger.Log().Error(ctx.UserContext(), core.ErrPostNotFound.Error())
@@ -136,67 +204,183 @@ func (r *Router) getPostByID(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 	}
 
-	postResponse := postModel.ToPostResponse(postDetails)
+	access, err := r.postsContactsAccess(ctx.UserContext(), viewerID, postDetails)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	postResponse := postModel.ToPostResponse(postDetails, access)
 
 	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(postResponse))
 }
 
+// @Summary		Search posts by photo
+// @Tags			post
+// @Description	Search published posts with photos visually similar to the uploaded one, the most similar posts go first
+// @ID				search-posts-by-photo
+// @Accept			mpfd
+// @Produce		json
+// @Param			photo			formData	file	true	"Photo to search by"
+// @Param			max_distance	query		int		false	"Maximal difference between photos, 0 is the same photo"	minimum(0)	maximum(32)
+// @Param			limit			query		int		false	"Maximal amount of posts"									minimum(1)	maximum(50)
+// @Success		200				{object}	model.Response{data=[]post.SimilarPostResponse}
+// @Failure		400				{object}	model.Response
+// @Failure		422				{object}	model.Response{data=validator.Response}
+// @Failure		500				{object}	model.Response
+// @Router			/posts/search-by-photo [post]
+func (r *Router) searchPostsByPhoto(ctx *fiber.Ctx) error {
+	var params postModel.SearchByPhotoParams
+
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &params)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	photo, err := openPhoto(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+	if photo == nil {
+		logger.Log().Debug(ctx.UserContext(), model.ErrNoPhoto.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(model.ErrNoPhoto.Error()))
+	}
+
+	searchParams := params.ToCoreSearchPostsByPhotoParams()
+	searchParams.ViewerID = getViewerID(ctx)
+
+	similarPosts, err := r.postService.SearchPostsByPhoto(ctx.UserContext(), core.MediaUpload{Data: *photo}, searchParams)
+	if err != nil {
+		if isPhotoError(err) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	postsDetails := make([]core.PostDetails, len(similarPosts))
+	for i, similarPost := range similarPosts {
+		postsDetails[i] = similarPost.PostDetails
+	}
+
+	access, err := r.postsContactsAccess(ctx.UserContext(), searchParams.ViewerID, postsDetails...)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(postModel.ToSimilarPostResponses(similarPosts, access)))
+}
+
 // @Summary		Create a post
 // @Tags			post
 // @Description	Create a post
 // @ID				create-post
 // @Accept			json
 // @Produce		json
+// @Param			draft		query		bool	false	"Save the post as a draft visible only to the author, required fields and photos are checked when it is published"
 // @Param			title		formData	string	true	"Title"
 // @Param			content		formData	string	true	"Content"
-// @Param			animal_type	formData	string	true	"Animal type"
-// @Param			photo		formData	file	true	"Photo"
+// @Param			animal_id	formData	int		false	"Registered animal the post is about, fields of the animal are ignored when it is set"	minimum(1)
+// @Param			animal_type	formData	string	false	"Animal type"
+// @Param			photos		formData	file	true	"Photos in the order they are shown, up to 10"
 // @Param			age			formData	int		true	"Age"
-// @Param			color		formData	string	true	"Color"
-// @Param			gender		formData	string	true	"Gender"
+// @Param			color		formData	string	false	"Color"
+// @Param			gender		formData	string	false	"Gender"
 // @Param			description	formData	string	true	"Description"
-// @Param			status		formData	string	true	"Status"
+// @Param			status		formData	string	false	"Status"
+// @Param			name		formData	string	false	"Name"	maxlength(100)
+// @Param			breed		formData	string	false	"Breed"	maxlength(100)
+// @Param			size		formData	string	false	"Size"	Enums(small, medium, large)
+// @Param			colors		formData	[]string	false	"Colors"	collectionFormat(multi)
+// @Param			coat_pattern	formData	string	false	"Coat pattern"	Enums(solid, bicolor, tabby, tortoiseshell, calico, colorpoint, spotted, brindle, merle)
+// @Param			distinguishing_marks	formData	string	false	"Scars, unusual spots and other marks"	maxlength(1000)
+// @Param			microchip_number	formData	string	false	"Microchip number, never shown to other users"	maxlength(32)
+// @Param			tattoo_id	formData	string	false	"Identification tattoo, never shown to other users"	maxlength(32)
+// @Param			is_sterilized	formData	bool	false	"Is sterilized"
+// @Param			is_vaccinated	formData	bool	false	"Is vaccinated"
+// @Param			has_collar	formData	bool	false	"Has collar"
+// @Param			collar_description	formData	string	false	"Collar description"	maxlength(200)
 // @Success		201			{object}	model.Response{data=post.PostResponse}
 // @Failure		400			{object}	model.Response
 // @Failure		401			{object}	model.Response
+// @Failure		403			{object}	model.Response
 // @Failure		404			{object}	model.Response
 // @Failure		422			{object}	model.Response{data=validator.Response}
 // @Failure		500			{object}	model.Response
 // @Security		ApiKeyAuthBasic
 // @Router			/posts [post]
 func (r *Router) createPost(ctx *fiber.Ctx) error {
-	var postRequest postModel.CreateRequestBodyPost
+	authorID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	}
+
+	var query postModel.CreatePostQuery
 
-	fiberError, parseOrValidationError := parseBodyAndValidate(ctx, r.formValidator, &postRequest)
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &query)
 	if fiberError != nil || parseOrValidationError != nil {
 		return fiberError
 	}
 
-	authorID, err := getIDFromToken(ctx)
-	if err != nil {
-		logger.Log().Error(ctx.UserContext(), err.Error())
-		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	var corePostDetails core.PostDetails
+	if query.Draft {
+		var draftRequest postModel.DraftRequestBodyPost
+
+		fiberError, parseOrValidationError = parseBodyAndValidate(ctx, r.formValidator, &draftRequest)
+		if fiberError != nil || parseOrValidationError != nil {
+			return fiberError
+		}
+
+		corePostDetails = draftRequest.ToCorePostDetails(authorID)
+	} else {
+		var postRequest postModel.CreateRequestBodyPost
+
+		fiberError, parseOrValidationError = parseBodyAndValidate(ctx, r.formValidator, &postRequest)
+		if fiberError != nil || parseOrValidationError != nil {
+			return fiberError
+		}
+
+		corePostDetails = postRequest.ToCorePostDetails(authorID)
 	}
 
-	fileHeader, err := ctx.FormFile("photo") // TODO: check if photo = picture and check size
-	if err != nil {
+	photos, err := openPhotos(ctx)
+	switch {
+	case errors.Is(err, model.ErrInvalidBody):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	case err != nil:
 		logger.Log().Error(ctx.UserContext(), err.Error())
 		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 	}
 
-	corePostDetails := postRequest.ToCorePostDetails(authorID)
-
-	postDetails, err := r.postService.CreatePost(ctx.UserContext(), corePostDetails, fileHeader)
+	postDetails, err := r.postService.CreatePost(ctx.UserContext(), corePostDetails, photos)
 	if err != nil {
 		if errors.Is(err, core.ErrNoSuchUser) {
 			logger.Log().Error(ctx.UserContext(), core.ErrNoSuchUser.Error())
 			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(core.ErrNoSuchUser.Error()))
 		}
+		if errors.Is(err, core.ErrAnimalNotFound) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		}
+		if errors.Is(err, core.ErrAnimalAccessDenied) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+		}
+		if errors.Is(err, core.ErrNoPhotos) || errors.Is(err, core.ErrTooManyPhotos) || isPhotoError(err) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
 		logger.Log().Error(ctx.UserContext(), err.Error())
 		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 	}
 
-	postResponse := postModel.ToPostResponse(postDetails)
+	// the author sees own contacts
+	postResponse := postModel.ToPostResponse(postDetails, core.ContactsAccess{ViewerID: authorID})
 
 	return ctx.Status(fiber.StatusCreated).JSON(model.OKResponse(postResponse))
 }
@@ -211,12 +395,24 @@ func (r *Router) createPost(ctx *fiber.Ctx) error {
 // @Param			title		formData	string	false	"Title"
 // @Param			content		formData	string	false	"Content"
 // @Param			animal_type	formData	string	false	"Animal type"
-// @Param			photo		formData	file	false	"Photo"
+// @Param			photos		formData	file	false	"New photos of the post replacing current ones, up to 10"
 // @Param			age			formData	int		false	"Age"
 // @Param			color		formData	string	false	"Color"
 // @Param			gender		formData	string	false	"Gender"
 // @Param			description	formData	string	false	"Description"
 // @Param			status		formData	string	false	"Status"
+// @Param			name		formData	string	false	"Name"	maxlength(100)
+// @Param			breed		formData	string	false	"Breed"	maxlength(100)
+// @Param			size		formData	string	false	"Size"	Enums(small, medium, large)
+// @Param			colors		formData	[]string	false	"Colors"	collectionFormat(multi)
+// @Param			coat_pattern	formData	string	false	"Coat pattern"	Enums(solid, bicolor, tabby, tortoiseshell, calico, colorpoint, spotted, brindle, merle)
+// @Param			distinguishing_marks	formData	string	false	"Scars, unusual spots and other marks"	maxlength(1000)
+// @Param			microchip_number	formData	string	false	"Microchip number, never shown to other users"	maxlength(32)
+// @Param			tattoo_id	formData	string	false	"Identification tattoo, never shown to other users"	maxlength(32)
+// @Param			is_sterilized	formData	bool	false	"Is sterilized"
+// @Param			is_vaccinated	formData	bool	false	"Is vaccinated"
+// @Param			has_collar	formData	bool	false	"Has collar"
+// @Param			collar_description	formData	string	false	"Collar description"	maxlength(200)
 // @Success		200			{object}	model.Response{data=post.PostResponse}
 // @Failure		400			{object}	model.Response
 // @Failure		401			{object}	model.Response
@@ -246,6 +442,18 @@ func (r *Router) updatePost(ctx *fiber.Ctx) error {
 
 	coreUpdateRequestPost.ID = &pathParams.PostID
 
+	photos, err := openPhotos(ctx)
+	switch {
+	case errors.Is(err, model.ErrInvalidBody):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	case err != nil:
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	coreUpdateRequestPost.Photos = photos
+
 	userID, err := getIDFromToken(ctx)
 	if err != nil {
 		logger.Log().Error(ctx.UserContext(), err.Error())
@@ -262,12 +470,19 @@ func (r *Router) updatePost(ctx *fiber.Ctx) error {
 		case core.ErrPostAuthorIDMismatch:
 			logger.Log().Error(ctx.UserContext(), core.ErrPostAuthorIDMismatch.Error())
 			return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(core.ErrPostAuthorIDMismatch.Error()))
+		case core.ErrTooManyPhotos:
+			logger.Log().Debug(ctx.UserContext(), core.ErrTooManyPhotos.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(core.ErrTooManyPhotos.Error()))
+		}
+		if isPhotoError(err) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
 		}
 		logger.Log().Error(ctx.UserContext(), err.Error())
 		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 	}
 
-	postResponse := postModel.ToPostResponse(postDetails)
+	postResponse := postModel.ToPostResponse(postDetails, core.ContactsAccess{ViewerID: userID})
 
 	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(postResponse))
 }
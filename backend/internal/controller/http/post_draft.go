@@ -0,0 +1,108 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	postModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// @Summary		Get drafts
+// @Tags			post
+// @Description	Get drafts of the current user, including scheduled ones
+// @ID				get-drafts
+// @Produce		json
+// @Param			limit	query		int		true	"Limit"		minimum(1)
+// @Param			offset	query		int		false	"Offset, ignored when cursor is set"	minimum(0)
+// @Param			cursor	query		string	false	"Cursor of the next page from meta of the previous response"
+// @Param			sort	query		string	false	"Sort, newest by default"	Enums(newest, oldest, updated)
+// @Success		200		{object}	model.Response{data=post.Response}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/posts/drafts [get]
+func (r *Router) getDrafts(ctx *fiber.Ctx) error {
+	var getAllPostsParams postModel.GetAllPostsParams
+
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &getAllPostsParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	coreGetAllPostsParams, err := getAllPostsParams.ToCoreGetAllPostsParams()
+	if err != nil {
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	postsDetails, total, err := r.postService.GetDrafts(ctx.UserContext(), userID, coreGetAllPostsParams)
+	if err != nil {
+		if isPaginationError(err) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	pagination := paginate(total, getAllPostsParams.Limit, getAllPostsParams.Offset)
+	pagination.NextCursor = postModel.NextCursor(coreGetAllPostsParams, postsDetails)
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(postModel.ToResponse(pagination, postsDetails, core.ContactsAccess{ViewerID: userID})))
+}
+
+// @Summary		Publish a draft
+// @Tags			post
+// @Description	Publish the complete draft now or schedule its publication, the schedule may be changed until the draft is published
+// @ID				publish-post
+// @Accept			json
+// @Produce		json
+// @Param			id		path		int					true	"Post ID"	minimum(1)
+// @Param			request	body		post.PublishPost	false	"Time of publication, the draft is published now when it is empty or past"
+// @Success		200		{object}	model.Response{data=post.PostResponse}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		403		{object}	model.Response
+// @Failure		404		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/posts/{id}/publish [post]
+func (r *Router) publishPost(ctx *fiber.Ctx) error {
+	var pathParams postModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var request postModel.PublishPost
+	if len(ctx.Body()) > 0 {
+		fiberError, parseOrValidationError = parseBodyAndValidate(ctx, r.formValidator, &request)
+		if fiberError != nil || parseOrValidationError != nil {
+			return fiberError
+		}
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	postDetails, err := r.postService.PublishPost(ctx.UserContext(), request.ToCorePublishPost(pathParams.PostID, userID))
+	if err != nil {
+		return r.postLifecycleError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(postModel.ToPostResponse(postDetails, core.ContactsAccess{ViewerID: userID})))
+}
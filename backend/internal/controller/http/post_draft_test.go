@@ -0,0 +1,185 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePost_Draft(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	tests := []struct {
+		name          string
+		route         string
+		body          string
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:  "unfinished draft",
+			route: "/api/v1/posts?draft=true",
+			body:  `{"title": "Lost cat"}`,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					CreatePost(mock.Anything, mock.MatchedBy(func(d core.PostDetails) bool {
+						return d.Post.Status == core.Draft && d.Post.AuthorID == authorID && d.Post.Title == "Lost cat"
+					}), mock.Anything).
+					Return(core.PostDetails{Post: core.Post{ID: 1, Status: core.Draft}}, nil).Once()
+			},
+			wantCode: http.StatusCreated,
+		},
+		{
+			name:          "draft with invalid gender",
+			route:         "/api/v1/posts?draft=true",
+			body:          `{"title": "Lost cat", "gender": "unknown"}`,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "unfinished post",
+			route:         "/api/v1/posts",
+			body:          `{"title": "Lost cat"}`,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodPost, tt.route, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestGetDrafts(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	dependencies.postService.EXPECT().
+		GetDrafts(mock.Anything, authorID, mock.Anything).
+		Return([]core.PostDetails{{Post: core.Post{ID: 1, Status: core.Draft}}}, 1, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/drafts?limit=10", http.NoBody)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestPublishPost(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	const route = "/api/v1/posts/%d/publish"
+
+	tests := []struct {
+		name          string
+		postID        int
+		body          string
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:   "publish now",
+			postID: 1,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					PublishPost(mock.Anything, core.PublishPost{ID: 1, AuthorID: authorID}).
+					Return(core.PostDetails{Post: core.Post{ID: 1, Status: core.Published}}, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "schedule",
+			postID: 2,
+			body:   `{"publish_at": "2030-01-01T10:00:00Z"}`,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					PublishPost(mock.Anything, mock.MatchedBy(func(p core.PublishPost) bool {
+						return p.ID == 2 && p.PublishAt != nil && p.PublishAt.Year() == 2030
+					})).
+					Return(core.PostDetails{Post: core.Post{ID: 2, Status: core.Draft}}, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "incomplete draft",
+			postID: 3,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					PublishPost(mock.Anything, mock.MatchedBy(func(p core.PublishPost) bool { return p.ID == 3 })).
+					Return(core.PostDetails{}, fmt.Errorf("%w: photos", core.ErrDraftIncomplete)).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "not a draft",
+			postID: 4,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					PublishPost(mock.Anything, mock.MatchedBy(func(p core.PublishPost) bool { return p.ID == 4 })).
+					Return(core.PostDetails{}, core.ErrPostNotDraft).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "draft not found",
+			postID: 5,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					PublishPost(mock.Anything, mock.MatchedBy(func(p core.PublishPost) bool { return p.ID == 5 })).
+					Return(core.PostDetails{}, core.ErrPostNotFound).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:   "internal error",
+			postID: 6,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					PublishPost(mock.Anything, mock.MatchedBy(func(p core.PublishPost) bool { return p.ID == 6 })).
+					Return(core.PostDetails{}, errors.New("internal error")).Once()
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(route, tt.postID), strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
@@ -17,12 +17,23 @@ import (
 // @Accept			json
 // @Produce		json
 // @Param			limit		query		int		true	"Limit"		minimum(1)
-// @Param			offset		query		int		true	"Offset"	minimum(0)
+// @Param			offset		query		int		false	"Offset, ignored when cursor is set"	minimum(0)
+// @Param			cursor		query		string	false	"Cursor of the next page from meta of the previous response"
+// @Param			sort		query		string	false	"Sort, newest by default and relevance for search"	Enums(newest, oldest, updated, favourited, nearest, relevance)
+// @Param			lat			query		number	false	"Latitude of the point to sort posts by distance to"	minimum(-90)	maximum(90)
+// @Param			lon			query		number	false	"Longitude of the point to sort posts by distance to"	minimum(-180)	maximum(180)
+// @Param			animal_id	query		int		false	"Posts about the registered animal"	minimum(1)
 // @Param			status		query		string	false	"Status"
 // @Param			animal_type	query		string	false	"Animal type"
 // @Param			gender		query		string	false	"Gender"
 // @Param			color		query		string	false	"Color"
 // @Param			location	query		string	false	"Location"
+// @Param			breed		query		string	false	"Breed, part of the breed matches"	maxlength(100)
+// @Param			size		query		string	false	"Size"	Enums(small, medium, large)
+// @Param			colors		query		[]string	false	"Colors, animals having any of them match"	collectionFormat(multi)
+// @Param			coat_pattern	query	string	false	"Coat pattern"	Enums(solid, bicolor, tabby, tortoiseshell, calico, colorpoint, spotted, brindle, merle)
+// @Param			sterilized	query		bool	false	"Sterilization"
+// @Param			chip		query		string	false	"Microchip number or tattoo"	maxlength(32)
 // @Success		200			{object}	model.Response{data=post.Response}
 // @Failure		400			{object}	model.Response
 // @Failure		401			{object}	model.Response
@@ -46,19 +57,36 @@ func (r *Router) getFavouritePostsUserByID(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
 	}
 
-	postsDetails, total, err := r.postService.GetFavouritePosts(ctx.UserContext(), userID) // TODO: add params
+	coreGetAllPostsParams, err := getAllPostsParams.ToCoreGetAllPostsParams()
+	if err != nil {
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	postsDetails, total, err := r.postService.GetFavouritePosts(ctx.UserContext(), userID, coreGetAllPostsParams)
 	if err != nil {
 		if errors.Is(err, core.ErrPostNotFound) {
 			logger.Log().Error(ctx.UserContext(), core.ErrPostNotFound.Error())
 			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(core.ErrPostNotFound.Error()))
 		}
+		if isPaginationError(err) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	access, err := r.postsContactsAccess(ctx.UserContext(), userID, postsDetails...)
+	if err != nil {
 		logger.Log().Error(ctx.UserContext(), err.Error())
 		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 	}
 
 	pagination := paginate(total, getAllPostsParams.Limit, getAllPostsParams.Offset)
+	pagination.NextCursor = postModel.NextCursor(coreGetAllPostsParams, postsDetails)
 
-	response := postModel.ToResponse(pagination, postsDetails)
+	response := postModel.ToResponse(pagination, postsDetails, access)
 
 	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(response))
 }
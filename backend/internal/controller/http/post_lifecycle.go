@@ -0,0 +1,170 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	postModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// @Summary		Resolve a post
+// @Tags			post
+// @Description	Close the case of the post with optional success story: lost animal is reunited, animal which needs home is adopted
+// @ID				resolve-post
+// @Accept			json
+// @Produce		json
+// @Param			id		path		int					true	"Post ID"	minimum(1)
+// @Param			request	body		post.ResolvePost	true	"Resolution"
+// @Success		200		{object}	model.Response{data=post.PostResponse}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		403		{object}	model.Response
+// @Failure		404		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/posts/{id}/resolve [post]
+func (r *Router) resolvePost(ctx *fiber.Ctx) error {
+	var pathParams postModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var request postModel.ResolvePost
+
+	fiberError, parseOrValidationError = parseBodyAndValidate(ctx, r.formValidator, &request)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	postDetails, err := r.postService.ResolvePost(ctx.UserContext(), request.ToCoreResolvePost(pathParams.PostID, userID))
+	if err != nil {
+		return r.postLifecycleError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(postModel.ToPostResponse(postDetails, core.ContactsAccess{ViewerID: userID})))
+}
+
+// @Summary		Renew a post
+// @Tags			post
+// @Description	Return the archived post to active ones or keep the post from archiving after the author was reminded about it
+// @ID				renew-post
+// @Produce		json
+// @Param			id	path		int	true	"Post ID"	minimum(1)
+// @Success		200	{object}	model.Response{data=post.PostResponse}
+// @Failure		400	{object}	model.Response
+// @Failure		401	{object}	model.Response
+// @Failure		403	{object}	model.Response
+// @Failure		404	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/posts/{id}/renew [post]
+func (r *Router) renewPost(ctx *fiber.Ctx) error {
+	var pathParams postModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	postDetails, err := r.postService.RenewPost(ctx.UserContext(), core.Post{ID: pathParams.PostID, AuthorID: userID})
+	if err != nil {
+		return r.postLifecycleError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(postModel.ToPostResponse(postDetails, core.ContactsAccess{ViewerID: userID})))
+}
+
+// @Summary		Bump a post
+// @Tags			post
+// @Description	Move the active post to the top of the newest posts, the post may be bumped once a day
+// @ID				bump-post
+// @Produce		json
+// @Param			id	path		int	true	"Post ID"	minimum(1)
+// @Success		200	{object}	model.Response{data=post.PostResponse}
+// @Failure		400	{object}	model.Response
+// @Failure		401	{object}	model.Response
+// @Failure		403	{object}	model.Response
+// @Failure		404	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		429	{object}	model.Response
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/posts/{id}/bump [post]
+func (r *Router) bumpPost(ctx *fiber.Ctx) error {
+	var pathParams postModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	postDetails, err := r.postService.BumpPost(ctx.UserContext(), core.Post{ID: pathParams.PostID, AuthorID: userID})
+	if err != nil {
+		return r.postLifecycleError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(postModel.ToPostResponse(postDetails, core.ContactsAccess{ViewerID: userID})))
+}
+
+// @Summary		Get statistics
+// @Tags			post
+// @Description	Get public statistics of cases: active posts and resolved ones by resolution
+// @ID				get-statistics
+// @Produce		json
+// @Success		200	{object}	model.Response{data=post.StatisticsResponse}
+// @Failure		500	{object}	model.Response
+// @Router			/statistics [get]
+func (r *Router) getStatistics(ctx *fiber.Ctx) error {
+	statistics, err := r.postService.GetPostStatistics(ctx.UserContext())
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(postModel.ToStatisticsResponse(statistics)))
+}
+
+// postLifecycleError responds with the status matching the error of resolving, renewing or bumping the post
+func (r *Router) postLifecycleError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, core.ErrPostNotFound):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+	case errors.Is(err, core.ErrPostAuthorIDMismatch):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+	case errors.Is(err, core.ErrPostBumpedRecently):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusTooManyRequests).JSON(model.ErrorResponse(err.Error()))
+	case oneOfErrors(err, core.ErrPostNotActive, core.ErrPostNotExpiring, core.ErrInvalidPostResolution, core.ErrPostNotDraft, core.ErrDraftIncomplete):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	}
+	logger.Log().Error(ctx.UserContext(), err.Error())
+	return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+}
@@ -0,0 +1,171 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvePost(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	const route = "/api/v1/posts/%d/resolve"
+
+	story := "Murka is home"
+
+	tests := []struct {
+		name          string
+		postID        int
+		body          string
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:   "success",
+			postID: 1,
+			body:   `{"resolution": "reunited", "success_story": "Murka is home"}`,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					ResolvePost(mock.Anything, core.ResolvePost{ID: 1, AuthorID: authorID, Resolution: core.PostReunited, SuccessStory: &story}).
+					Return(core.PostDetails{Post: core.Post{ID: 1, State: core.PostResolved}}, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "resolution doesn't match animal",
+			postID: 2,
+			body:   `{"resolution": "adopted"}`,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					ResolvePost(mock.Anything, mock.MatchedBy(func(r core.ResolvePost) bool { return r.ID == 2 })).
+					Return(core.PostDetails{}, core.ErrInvalidPostResolution).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "not the author",
+			postID: 3,
+			body:   `{"resolution": "reunited"}`,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					ResolvePost(mock.Anything, mock.MatchedBy(func(r core.ResolvePost) bool { return r.ID == 3 })).
+					Return(core.PostDetails{}, core.ErrPostAuthorIDMismatch).Once()
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:   "post not found",
+			postID: 4,
+			body:   `{"resolution": "reunited"}`,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					ResolvePost(mock.Anything, mock.MatchedBy(func(r core.ResolvePost) bool { return r.ID == 4 })).
+					Return(core.PostDetails{}, core.ErrPostNotFound).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:   "internal error",
+			postID: 5,
+			body:   `{"resolution": "reunited"}`,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					ResolvePost(mock.Anything, mock.MatchedBy(func(r core.ResolvePost) bool { return r.ID == 5 })).
+					Return(core.PostDetails{}, errors.New("internal error")).Once()
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:          "invalid resolution",
+			postID:        1,
+			body:          `{"resolution": "found"}`,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(route, tt.postID), strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestBumpPost(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	const route = "/api/v1/posts/%d/bump"
+
+	tests := []struct {
+		name          string
+		postID        int
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:   "success",
+			postID: 1,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					BumpPost(mock.Anything, core.Post{ID: 1, AuthorID: authorID}).
+					Return(core.PostDetails{Post: core.Post{ID: 1}}, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "bumped recently",
+			postID: 2,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					BumpPost(mock.Anything, core.Post{ID: 2, AuthorID: authorID}).
+					Return(core.PostDetails{}, core.ErrPostBumpedRecently).Once()
+			},
+			wantCode: http.StatusTooManyRequests,
+		},
+		{
+			name:   "archived post",
+			postID: 3,
+			mockBehaviour: func() {
+				dependencies.postService.EXPECT().
+					BumpPost(mock.Anything, core.Post{ID: 3, AuthorID: authorID}).
+					Return(core.PostDetails{}, core.ErrPostNotActive).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(route, tt.postID), nil)
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
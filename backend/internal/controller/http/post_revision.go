@@ -0,0 +1,72 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	postModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// @Summary		Get revisions of post
+// @Tags			post
+// @Description	Get saved versions of the post from the first one, drafts have no revisions. Each revision lists fields changed since the previous one.
+// @Description	Only the author of the post and moderators see revisions, moderators see them while the post is on moderation or deleted too.
+// @ID				get-post-revisions
+// @Accept			json
+// @Produce		json
+// @Param			id	path		int	true	"Post ID"	minimum(1)
+// @Success		200	{object}	model.Response{data=[]post.RevisionResponse}
+// @Failure		400	{object}	model.Response
+// @Failure		401	{object}	model.Response
+// @Failure		403	{object}	model.Response
+// @Failure		404	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/posts/{id}/revisions [get]
+func (r *Router) getPostRevisions(ctx *fiber.Ctx) error {
+	var pathParams postModel.PathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	}
+
+	// moderators see revisions of any post, other users only of their own posts
+	_, err = r.moderatorService.GetModerator(ctx.UserContext(), userID)
+	if err != nil && !errors.Is(err, core.ErrNoSuchModerator) {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+	isModerator := err == nil
+
+	revisions, err := r.postService.GetPostRevisions(ctx.UserContext(), core.GetPostRevisionsParams{
+		PostID:      pathParams.PostID,
+		UserID:      userID,
+		IsModerator: isModerator,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, core.ErrPostNotFound):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+		case errors.Is(err, core.ErrPostAuthorIDMismatch):
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+		default:
+			logger.Log().Error(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+		}
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(postModel.ToRevisionsResponse(revisions)))
+}
@@ -0,0 +1,146 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	postModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+func TestGetPostRevisions(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	const route = "/api/v1/posts/%d/revisions"
+
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	revisions := []core.PostRevision{
+		{PostID: 1, Revision: 1, EditorID: authorID, Title: "Found a cat", Content: "Near the park", PhotoIDs: pq.Int64Array{1}, CreatedAt: created},
+		{PostID: 1, Revision: 2, EditorID: authorID, Title: "Found a cat", Content: "Near the central park", PhotoIDs: pq.Int64Array{2}, CreatedAt: created.Add(time.Hour)},
+	}
+
+	notModerator := func() {
+		dependencies.moderatorService.EXPECT().
+			GetModerator(mock.Anything, authorID).
+			Return(core.Moderator{}, core.ErrNoSuchModerator).Once()
+	}
+
+	tests := []struct {
+		name          string
+		postID        int
+		token         string
+		mockBehaviour func()
+		wantCode      int
+		wantChanges   [][]string
+	}{
+		{
+			name:   "author",
+			postID: 1,
+			token:  token,
+			mockBehaviour: func() {
+				notModerator()
+				dependencies.postService.EXPECT().
+					GetPostRevisions(mock.Anything, core.GetPostRevisionsParams{PostID: 1, UserID: authorID}).
+					Return(revisions, nil).Once()
+			},
+			wantCode:    http.StatusOK,
+			wantChanges: [][]string{{}, {core.RevisionFieldContent, core.RevisionFieldPhotos}},
+		},
+		{
+			name:   "moderator",
+			postID: 4,
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.moderatorService.EXPECT().
+					GetModerator(mock.Anything, authorID).
+					Return(core.Moderator{UserID: authorID}, nil).Once()
+				dependencies.postService.EXPECT().
+					GetPostRevisions(mock.Anything, core.GetPostRevisionsParams{PostID: 4, UserID: authorID, IsModerator: true}).
+					Return(revisions, nil).Once()
+			},
+			wantCode:    http.StatusOK,
+			wantChanges: [][]string{{}, {core.RevisionFieldContent, core.RevisionFieldPhotos}},
+		},
+		{
+			name:   "other user",
+			postID: 5,
+			token:  token,
+			mockBehaviour: func() {
+				notModerator()
+				dependencies.postService.EXPECT().
+					GetPostRevisions(mock.Anything, core.GetPostRevisionsParams{PostID: 5, UserID: authorID}).
+					Return(nil, core.ErrPostAuthorIDMismatch).Once()
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:          "anonymous user",
+			postID:        1,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:   "post not found",
+			postID: 2,
+			token:  token,
+			mockBehaviour: func() {
+				notModerator()
+				dependencies.postService.EXPECT().
+					GetPostRevisions(mock.Anything, core.GetPostRevisionsParams{PostID: 2, UserID: authorID}).
+					Return(nil, core.ErrPostNotFound).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:   "internal error",
+			postID: 3,
+			token:  token,
+			mockBehaviour: func() {
+				notModerator()
+				dependencies.postService.EXPECT().
+					GetPostRevisions(mock.Anything, core.GetPostRevisionsParams{PostID: 3, UserID: authorID}).
+					Return(nil, errors.New("internal error")).Once()
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(route, tt.postID), http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+			}
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+
+			if tt.wantChanges != nil {
+				var body struct {
+					Data []postModel.RevisionResponse `json:"data"`
+				}
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+				require.Len(t, body.Data, len(tt.wantChanges))
+				for i, changes := range tt.wantChanges {
+					assert.Equal(t, changes, body.Data[i].Changes)
+				}
+			}
+		})
+	}
+}
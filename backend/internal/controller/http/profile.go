@@ -0,0 +1,174 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	commentModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/comment"
+	userModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/user"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// @Summary		Get comments of the user
+// @Tags			user
+// @Description	Get the comment history of the user: published comments under published posts, the newest first
+// @ID				get-user-comments
+// @Produce		json
+// @Param			id		path		int	true	"User ID"	minimum(1)
+// @Param			limit	query		int	true	"Limit"		minimum(1)	maximum(100)
+// @Param			offset	query		int	false	"Offset"	minimum(0)
+// @Success		200		{object}	model.Response{data=comment.GetUserCommentsResponse}
+// @Failure		401		{object}	model.Response
+// @Failure		403		{object}	model.Response
+// @Failure		404		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/{id}/comments [get]
+func (r *Router) getUserComments(ctx *fiber.Ctx) error {
+	var params commentModel.GetUserCommentsParams
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &params)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	var pathParams commentModel.UserIDPathParams
+	fiberError, parseOrValidationError = parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	// anonymous users see comments without their likes and only if the user shows comments to everyone
+	viewerID := getViewerID(ctx)
+
+	comments, total, err := r.profileService.GetUserComments(ctx.UserContext(), params.ToCoreGetUserCommentsParams(pathParams.UserID, viewerID))
+	switch {
+	case errors.Is(err, core.ErrNoSuchUser):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+	case errors.Is(err, core.ErrUserCommentsHidden):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(err.Error()))
+	case err != nil:
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(
+		commentModel.ToGetUserCommentsResponse(comments, paginate(total, params.Limit, params.Offset)),
+	))
+}
+
+// @Summary		Get privacy settings
+// @Tags			user
+// @Description	Get who sees statistics, last seen moment, comments and contacts of the current user
+// @ID				get-privacy-settings
+// @Produce		json
+// @Success		200	{object}	model.Response{data=user.PrivacySettings}
+// @Failure		401	{object}	model.Response
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/privacy [get]
+func (r *Router) getPrivacySettings(ctx *fiber.Ctx) error {
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	}
+
+	settings, err := r.profileService.GetPrivacySettings(ctx.UserContext(), userID)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(userModel.ToPrivacySettings(settings)))
+}
+
+// @Summary		Update privacy settings
+// @Tags			user
+// @Description	Replace who sees statistics, last seen moment, comments and contacts of the current user
+// @ID				update-privacy-settings
+// @Accept			json
+// @Produce		json
+// @Param			request	body		user.PrivacySettings	true	"Privacy settings"
+// @Success		200		{object}	model.Response{data=user.PrivacySettings}
+// @Failure		400		{object}	model.Response
+// @Failure		401		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/privacy [put]
+func (r *Router) updatePrivacySettings(ctx *fiber.Ctx) error {
+	var settings userModel.PrivacySettings
+
+	fiberError, parseOrValidationError := parseBodyAndValidate(ctx, r.formValidator, &settings)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	}
+
+	updated, err := r.profileService.UpdatePrivacySettings(ctx.UserContext(), settings.ToCorePrivacySettings(userID))
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(userModel.ToPrivacySettings(updated)))
+}
+
+// getViewerID returns ID of the current user, 0 for anonymous users
+func getViewerID(ctx *fiber.Ctx) int {
+	if userID, err := getIDFromToken(ctx); err == nil {
+		return userID
+	}
+	return 0
+}
+
+// usersContactsAccess returns what the viewer needs to see contacts of the users
+func (r *Router) usersContactsAccess(ctx context.Context, viewerID int, users ...core.User) (core.ContactsAccess, error) {
+	ownerIDs := make([]int, 0, len(users))
+	for _, user := range users {
+		if !user.Contacts.IsEmpty() {
+			ownerIDs = append(ownerIDs, user.ID)
+		}
+	}
+	return r.contactsAccess(ctx, viewerID, ownerIDs)
+}
+
+// postsContactsAccess returns what the viewer needs to see contacts of authors of the posts
+func (r *Router) postsContactsAccess(ctx context.Context, viewerID int, posts ...core.PostDetails) (core.ContactsAccess, error) {
+	ownerIDs := make([]int, 0, len(posts))
+	for _, post := range posts {
+		if !post.AuthorContacts.IsEmpty() {
+			ownerIDs = append(ownerIDs, post.Post.AuthorID)
+		}
+	}
+	return r.contactsAccess(ctx, viewerID, ownerIDs)
+}
+
+// contactsAccess asks for privacy settings only if there are contacts of other users to show
+func (r *Router) contactsAccess(ctx context.Context, viewerID int, ownerIDs []int) (core.ContactsAccess, error) {
+	seen := make(map[int]bool, len(ownerIDs))
+	others := make([]int, 0, len(ownerIDs))
+	for _, id := range ownerIDs {
+		if id != viewerID && !seen[id] {
+			seen[id] = true
+			others = append(others, id)
+		}
+	}
+
+	if len(others) == 0 {
+		return core.ContactsAccess{ViewerID: viewerID}, nil
+	}
+
+	return r.profileService.GetContactsAccess(ctx, viewerID, others)
+}
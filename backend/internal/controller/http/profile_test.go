@@ -0,0 +1,483 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/comment"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/user"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserProfile(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	createdAt := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	lastSeenAt := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	profile := core.UserProfile{
+		User:   core.User{ID: 2, Username: "alice", CreatedAt: createdAt},
+		Roles:  []core.RoleDetails{{Name: core.Keeper, Description: "I keep cats", CreatedAt: createdAt}},
+		Badges: []core.Badge{core.BadgeVKVerified},
+		Statistics: &core.UserStatistics{
+			Posts:         map[core.PostState]int{core.PostActive: 2, core.PostResolved: 5},
+			ResolvedCases: 3,
+			Comments:      42,
+			KeeperRating:  &core.Rating{Average: 4.5, Count: 12},
+		},
+		LastSeenAt: &lastSeenAt,
+	}
+
+	tests := []struct {
+		name          string
+		route         string
+		token         string
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:  "logged-in user",
+			route: "/api/v1/users/2",
+			token: token,
+			mockBehaviour: func() {
+				dependencies.profileService.EXPECT().GetProfile(mock.Anything, 2, authorID).Return(profile, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:  "anonymous user",
+			route: "/api/v1/users/3",
+			mockBehaviour: func() {
+				dependencies.profileService.EXPECT().GetProfile(mock.Anything, 3, 0).Return(profile, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:  "user not found",
+			route: "/api/v1/users/4",
+			mockBehaviour: func() {
+				dependencies.profileService.EXPECT().GetProfile(mock.Anything, 4, 0).Return(core.UserProfile{}, core.ErrNoSuchUser).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:  "service error",
+			route: "/api/v1/users/5",
+			mockBehaviour: func() {
+				dependencies.profileService.EXPECT().GetProfile(mock.Anything, 5, 0).Return(core.UserProfile{}, errors.New("db error")).Once()
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:          "invalid id",
+			route:         "/api/v1/users/abc",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodGet, tt.route, http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+			}
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			require.Equal(t, tt.wantCode, resp.StatusCode)
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+
+			var body user.Profile
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, "alice", body.Username)
+			assert.True(t, createdAt.Equal(body.MemberSince))
+			assert.Equal(t, []user.ProfileRole{{Name: core.Keeper, Description: "I keep cats", Since: createdAt}}, body.Roles)
+			assert.Equal(t, []string{"vk_verified"}, body.Badges)
+			require.NotNil(t, body.Statistics)
+			assert.Equal(t, user.PostCounts{Active: 2, Resolved: 5}, body.Statistics.Posts)
+			assert.Equal(t, 3, body.Statistics.ResolvedCases)
+			assert.Equal(t, 42, body.Statistics.Comments)
+			assert.Equal(t, &user.Rating{Average: 4.5, Count: 12}, body.Statistics.KeeperRating)
+			assert.Nil(t, body.Statistics.VetRating)
+			require.NotNil(t, body.LastSeenAt)
+			assert.True(t, lastSeenAt.Equal(*body.LastSeenAt))
+		})
+	}
+}
+
+func TestGetUserProfile_HiddenParts(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	dependencies.profileService.EXPECT().
+		GetProfile(mock.Anything, 2, 0).
+		Return(core.UserProfile{User: core.User{ID: 2, Username: "alice"}}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/2", http.NoBody)
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Nil(t, body["statistics"])
+	assert.Nil(t, body["last_seen_at"])
+	assert.Equal(t, []interface{}{}, body["roles"])
+	assert.Equal(t, []interface{}{}, body["badges"])
+}
+
+func TestGetUserComments(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	tests := []struct {
+		name          string
+		route         string
+		token         string
+		mockBehaviour func()
+		wantCode      int
+		wantComments  int
+	}{
+		{
+			name:  "success",
+			route: "/api/v1/users/2/comments?limit=10&offset=0",
+			token: token,
+			mockBehaviour: func() {
+				dependencies.profileService.EXPECT().
+					GetUserComments(mock.Anything, mock.MatchedBy(func(p core.GetUserCommentsParams) bool {
+						return p.AuthorID == 2 && p.UserID == authorID && *p.Limit == 10 && *p.Offset == 0
+					})).
+					Return([]core.Comment{
+						{ID: 7, PostID: 3, AuthorID: 2, Author: core.User{ID: 2, Username: "alice"}, Content: "Found him!", Status: core.Published},
+					}, 1, nil).Once()
+			},
+			wantCode:     http.StatusOK,
+			wantComments: 1,
+		},
+		{
+			name:  "comments hidden",
+			route: "/api/v1/users/3/comments?limit=10",
+			mockBehaviour: func() {
+				dependencies.profileService.EXPECT().
+					GetUserComments(mock.Anything, mock.MatchedBy(func(p core.GetUserCommentsParams) bool { return p.AuthorID == 3 && p.UserID == 0 })).
+					Return(nil, 0, core.ErrUserCommentsHidden).Once()
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:  "user not found",
+			route: "/api/v1/users/4/comments?limit=10",
+			mockBehaviour: func() {
+				dependencies.profileService.EXPECT().
+					GetUserComments(mock.Anything, mock.MatchedBy(func(p core.GetUserCommentsParams) bool { return p.AuthorID == 4 })).
+					Return(nil, 0, core.ErrNoSuchUser).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:          "missing limit",
+			route:         "/api/v1/users/2/comments",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "invalid id",
+			route:         "/api/v1/users/0/comments?limit=10",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodGet, tt.route, http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+			}
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			require.Equal(t, tt.wantCode, resp.StatusCode)
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+
+			var body struct {
+				Data comment.GetUserCommentsResponse `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, 1, body.Data.Meta.Total)
+			require.Len(t, body.Data.Data, tt.wantComments)
+			assert.Equal(t, 3, body.Data.Data[0].PostID)
+			assert.Equal(t, "Found him!", body.Data.Data[0].Content)
+			assert.Equal(t, "alice", body.Data.Data[0].User.Username)
+		})
+	}
+}
+
+func TestGetPrivacySettings(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	dependencies.profileService.EXPECT().
+		GetPrivacySettings(mock.Anything, authorID).
+		Return(core.DefaultPrivacySettings(authorID), nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/privacy", http.NoBody)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Data user.PrivacySettings `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, user.PrivacySettings{
+		Statistics: "public",
+		LastSeen:   "users",
+		Comments:   "public",
+		Phone:      "nobody",
+		Telegram:   "nobody",
+		VKLink:     "nobody",
+	}, body.Data)
+}
+
+func TestUpdatePrivacySettings(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	tests := []struct {
+		name          string
+		body          string
+		token         string
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:  "success",
+			body:  `{"statistics":"users","last_seen":"nobody","comments":"public","phone":"chats","telegram":"users","vk_link":"public"}`,
+			token: token,
+			mockBehaviour: func() {
+				dependencies.profileService.EXPECT().
+					UpdatePrivacySettings(mock.Anything, core.PrivacySettings{
+						UserID:     authorID,
+						Statistics: core.VisibilityUsers,
+						LastSeen:   core.VisibilityNobody,
+						Comments:   core.VisibilityPublic,
+						Phone:      core.VisibilityChats,
+						Telegram:   core.VisibilityUsers,
+						VKLink:     core.VisibilityPublic,
+					}).
+					RunAndReturn(func(_ context.Context, s core.PrivacySettings) (core.PrivacySettings, error) {
+						return s, nil
+					}).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:          "unknown visibility",
+			body:          `{"statistics":"friends","last_seen":"nobody","comments":"public","phone":"nobody","telegram":"nobody","vk_link":"nobody"}`,
+			token:         token,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "chats visibility of statistics",
+			body:          `{"statistics":"chats","last_seen":"nobody","comments":"public","phone":"nobody","telegram":"nobody","vk_link":"nobody"}`,
+			token:         token,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "missing setting",
+			body:          `{"statistics":"users"}`,
+			token:         token,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "unauthorized",
+			body:          `{"statistics":"users","last_seen":"nobody","comments":"public"}`,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/users/privacy", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+			}
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestGetUserProfile_Contacts(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	phone, telegram, vkLink := "+79991234567", "jack_vorobey", "https://vk.com/jack_vorobey"
+	profile := core.UserProfile{User: core.User{
+		ID:       2,
+		Username: "alice",
+		Contacts: core.Contacts{Phone: &phone, Telegram: &telegram, VKLink: &vkLink},
+	}}
+
+	tests := []struct {
+		name          string
+		token         string
+		viewerID      int
+		access        core.ContactsAccess
+		wantPhone     *string
+		wantTelegram  *string
+		wantVKLink    *string
+		mockBehaviour func(viewerID int, access core.ContactsAccess)
+	}{
+		{
+			name:     "anonymous user sees public contacts",
+			viewerID: 0,
+			access: core.ContactsAccess{Settings: map[int]core.PrivacySettings{
+				2: {UserID: 2, Phone: core.VisibilityNobody, Telegram: core.VisibilityUsers, VKLink: core.VisibilityPublic},
+			}},
+			wantVKLink: &vkLink,
+		},
+		{
+			name:     "chat partner sees contacts shown to chats",
+			token:    token,
+			viewerID: authorID,
+			access: core.ContactsAccess{
+				ViewerID: authorID,
+				Settings: map[int]core.PrivacySettings{
+					2: {UserID: 2, Phone: core.VisibilityChats, Telegram: core.VisibilityUsers, VKLink: core.VisibilityNobody},
+				},
+				ChatPartners: map[int]bool{2: true},
+			},
+			wantPhone:    &phone,
+			wantTelegram: &telegram,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies.profileService.EXPECT().GetProfile(mock.Anything, 2, tt.viewerID).Return(profile, nil).Once()
+			dependencies.profileService.EXPECT().GetContactsAccess(mock.Anything, tt.viewerID, []int{2}).Return(tt.access, nil).Once()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/users/2", http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+			}
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var body user.Profile
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, tt.wantPhone, body.Phone)
+			assert.Equal(t, tt.wantTelegram, body.Telegram)
+			assert.Equal(t, tt.wantVKLink, body.VKLink)
+		})
+	}
+}
+
+func TestGetPostByID_AuthorContacts(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	phone := "+79991234567"
+	postDetails := core.PostDetails{
+		Post:           core.Post{ID: 7, AuthorID: 2},
+		Username:       "alice",
+		AuthorContacts: core.Contacts{Phone: &phone},
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		viewerID  int
+		phone     core.Visibility
+		wantPhone *string
+	}{
+		{
+			name:  "hidden from anonymous user",
+			phone: core.VisibilityUsers,
+		},
+		{
+			name:      "shown to logged-in user",
+			token:     token,
+			viewerID:  authorID,
+			phone:     core.VisibilityUsers,
+			wantPhone: &phone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies.postService.EXPECT().GetPostByID(mock.Anything, 7, tt.viewerID).Return(postDetails, nil).Once()
+			dependencies.profileService.EXPECT().GetContactsAccess(mock.Anything, tt.viewerID, []int{2}).
+				Return(core.ContactsAccess{
+					ViewerID: tt.viewerID,
+					Settings: map[int]core.PrivacySettings{2: {UserID: 2, Phone: tt.phone}},
+				}, nil).Once()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/7", http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+			}
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var body struct {
+				Data post.PostResponse `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, tt.wantPhone, body.Data.AuthorContacts.Phone)
+			assert.Nil(t, body.Data.AuthorContacts.Telegram)
+		})
+	}
+}
@@ -12,7 +12,7 @@ import (
 )
 
 // @Summary		Create a report
-// @Description	Create a report for a specific
+// @Description	Create a report for a post, comment, user, review or chat message. Reason "other" may be explained in description.
 // @Tags			reports
 // @Accept			json
 // @Produce		json
@@ -21,6 +21,7 @@ import (
 // @Success		201		"Report created successfully"
 // @Failure		400		{object}	model.Response							"Invalid request body"
 // @Failure		401		{object}	model.Response							"Unauthorized: Invalid or missing token"
+// @Failure		403		{object}	model.Response							"Reporter is not a member of the chat"
 // @Failure		404		{object}	model.Response							"Content not found"
 // @Failure		409		{object}	model.Response							"Conflict: Report already exists"
 // @Failure		422		{object}	model.Response{data=validator.Response}	"Validation error"
@@ -61,6 +62,11 @@ func (r *Router) createReport(ctx *fiber.Ctx) error {
 			return ctx.Status(fiber.StatusConflict).JSON(model.ErrorResponse(core.ErrDuplicateReport.Error()))
 		}
 
+		if errors.Is(err, core.ErrNotChatMember) {
+			logger.Log().Error(ctx.UserContext(), core.ErrNotChatMember.Error())
+			return ctx.Status(fiber.StatusForbidden).JSON(model.ErrorResponse(core.ErrNotChatMember.Error()))
+		}
+
 		if errors.Is(err, core.ErrInvalidReportableType) {
 			logger.Log().Error(ctx.UserContext(), core.ErrInvalidReportableType.Error())
 			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(core.ErrInvalidReportableType.Error()))
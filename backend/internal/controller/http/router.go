@@ -20,6 +20,13 @@ type Router struct {
 	reportService        core.ReportService
 	moderatorService     core.ModeratorService
 	userFavouriteService core.UserFavouriteService
+	mediaService         core.MediaService
+	animalService        core.AnimalService
+	adoptionService      core.AdoptionService
+	notificationService  core.NotificationService
+	blockService         core.BlockService
+	feedService          core.FeedService
+	profileService       core.ProfileService
 }
 
 func NewRouter(
@@ -31,19 +38,35 @@ func NewRouter(
 	roleService core.RoleService,
 	reportService core.ReportService,
 	moderatorService core.ModeratorService,
+	mediaService core.MediaService,
+	animalService core.AnimalService,
+	adoptionService core.AdoptionService,
+	notificationService core.NotificationService,
+	blockService core.BlockService,
+	userFavouriteService core.UserFavouriteService,
+	feedService core.FeedService,
+	profileService core.ProfileService,
 	formValidator validator.FormValidatorService,
 
 ) {
 	router := &Router{
-		app:              app,
-		formValidator:    formValidator,
-		authService:      authService,
-		postService:      postService,
-		userService:      userService,
-		roleService:      roleService,
-		commentService:   commentService,
-		moderatorService: moderatorService,
-		reportService:    reportService,
+		app:                  app,
+		formValidator:        formValidator,
+		authService:          authService,
+		postService:          postService,
+		userService:          userService,
+		roleService:          roleService,
+		commentService:       commentService,
+		moderatorService:     moderatorService,
+		reportService:        reportService,
+		mediaService:         mediaService,
+		animalService:        animalService,
+		adoptionService:      adoptionService,
+		notificationService:  notificationService,
+		blockService:         blockService,
+		userFavouriteService: userFavouriteService,
+		feedService:          feedService,
+		profileService:       profileService,
 	}
 
 	router.initRequestMiddlewares()
@@ -60,18 +83,34 @@ func (r *Router) initRoutes() {
 
 	v1 := r.app.Group("/api/v1")
 	// comment
-	v1.Get("/posts/:post_id/comments", r.getComments)
+	v1.Get("/posts/:post_id/comments", r.optionalAuthMiddleware(), r.getComments)
+	v1.Get("/posts/:post_id/comments/tree", r.optionalAuthMiddleware(), r.getCommentThreads)
+	v1.Get("/posts/:post_id/comments/:comment_id/replies", r.optionalAuthMiddleware(), r.getCommentReplies)
 	v1.Post("/posts/:post_id/comments", r.protectedMiddleware(), r.createComment)
 	v1.Patch("/posts/:post_id/comments/:comment_id", r.protectedMiddleware(), r.updateComment)
 	v1.Delete("/posts/:post_id/comments/:comment_id", r.protectedMiddleware(), r.deleteComment)
-
-	// favourites users todo
-	v1.Get("/users/favourites", r.protectedMiddleware(), r.GetFavouriteUsers)
-	v1.Post("/users/:id/favourites", r.AddUserToFavourites)
-	v1.Delete("/users/:id/favourites", r.DeleteUserFromFavourites)
+	v1.Post("/posts/:post_id/comments/:comment_id/likes", r.protectedMiddleware(), r.likeComment)
+	v1.Delete("/posts/:post_id/comments/:comment_id/likes", r.protectedMiddleware(), r.unlikeComment)
+
+	// favourite users
+	v1.Get("/users/favourites", r.protectedMiddleware(), r.getFavouriteUsers)
+	v1.Get("/users/favourites/posts", r.protectedMiddleware(), r.getFavouriteUsersPosts)
+	v1.Get("/users/moderation-history", r.protectedMiddleware(), r.getModerationHistory)
+	v1.Get("/users/blocked", r.protectedMiddleware(), r.getBlockedUsers)
+	v1.Post("/users/:id/favourites", r.protectedMiddleware(), r.addUserToFavourites)
+	v1.Delete("/users/:id/favourites", r.protectedMiddleware(), r.deleteUserFromFavourites)
+
+	// user blocks
+	v1.Post("/users/:id/block", r.protectedMiddleware(), r.blockUser)
+	v1.Delete("/users/:id/block", r.protectedMiddleware(), r.unblockUser)
+	v1.Post("/users/:id/mute", r.protectedMiddleware(), r.muteUser)
+	v1.Delete("/users/:id/mute", r.protectedMiddleware(), r.unmuteUser)
 
 	// users
-	v1.Get("/users/:id", r.getUser)
+	v1.Get("/users/privacy", r.protectedMiddleware(), r.getPrivacySettings)
+	v1.Put("/users/privacy", r.protectedMiddleware(), r.updatePrivacySettings)
+	v1.Get("/users/:id", r.optionalAuthMiddleware(), r.getUser)
+	v1.Get("/users/:id/comments", r.optionalAuthMiddleware(), r.getUserComments)
 	v1.Patch("/users", r.protectedMiddleware(), r.updateUser)
 
 	// user roles
@@ -92,14 +131,56 @@ func (r *Router) initRoutes() {
 	v1.Get("/auth/login/vk", r.loginVK)
 	v1.Get("/auth/login/vk/callback", r.callback)
 
+	// feed
+	v1.Get("/feed", r.protectedMiddleware(), r.getFeed)
+	v1.Get("/feed/preferences", r.protectedMiddleware(), r.getFeedPreferences)
+	v1.Put("/feed/preferences", r.protectedMiddleware(), r.updateFeedPreferences)
+
 	// posts
-	v1.Get("/posts", r.getPosts)
-	v1.Get("/users/:id/posts", r.getUserPosts)
+	v1.Get("/posts", r.optionalAuthMiddleware(), r.getPosts)
+	v1.Get("/users/:id/posts", r.optionalAuthMiddleware(), r.getUserPosts)
+	v1.Get("/users/:id/avatar", r.getUserAvatar)
 	v1.Get("/posts/favourites", r.protectedMiddleware(), r.getFavouritePostsUserByID) // gets all favourite posts from the user (there may be collisions with "/posts/:id")
-	v1.Get("/posts/:id", r.getPostByID)
+	v1.Get("/posts/drafts", r.protectedMiddleware(), r.getDrafts)
+	v1.Get("/posts/:id", r.optionalAuthMiddleware(), r.getPostByID)
+	v1.Get("/posts/:id/moderation", r.protectedMiddleware(), r.getPostModeration)
+	v1.Get("/posts/:id/photo", r.optionalAuthMiddleware(), r.getPostPhoto)
+	v1.Get("/posts/:id/revisions", r.protectedMiddleware(), r.getPostRevisions)
+
+	// media
+	v1.Get("/media/:id", r.optionalAuthMiddleware(), r.getMedia)
 	v1.Post("/posts", r.protectedMiddleware(), r.createPost)
+	v1.Post("/posts/search-by-photo", r.optionalAuthMiddleware(), r.searchPostsByPhoto)
 	v1.Patch("/posts/:id", r.protectedMiddleware(), r.updatePost)
 	v1.Delete("/posts/:id", r.protectedMiddleware(), r.deletePost)
+	v1.Post("/posts/:id/resolve", r.protectedMiddleware(), r.resolvePost)
+	v1.Post("/posts/:id/renew", r.protectedMiddleware(), r.renewPost)
+	v1.Post("/posts/:id/bump", r.protectedMiddleware(), r.bumpPost)
+	v1.Post("/posts/:id/publish", r.protectedMiddleware(), r.publishPost)
+	v1.Post("/posts/:id/likes", r.protectedMiddleware(), r.likePost)
+	v1.Delete("/posts/:id/likes", r.protectedMiddleware(), r.unlikePost)
+	v1.Get("/statistics", r.getStatistics)
+
+	// animals
+	v1.Get("/animals", r.getAnimals)
+	v1.Get("/animals/:id", r.getAnimalByID)
+	v1.Post("/animals", r.protectedMiddleware(), r.createAnimal)
+	v1.Patch("/animals/:id", r.protectedMiddleware(), r.updateAnimal)
+	v1.Put("/animals/:id/keeper", r.protectedMiddleware(), r.setAnimalKeeper)
+	v1.Get("/animals/:id/events", r.getAnimalEvents)
+	v1.Post("/animals/:id/events", r.protectedMiddleware(), r.createAnimalEvent)
+
+	// adoption
+	v1.Get("/adoption/questionnaire", r.getAdoptionQuestionnaire)
+	v1.Post("/animals/:id/adoption-applications", r.protectedMiddleware(), r.applyForAdoption)
+	v1.Get("/animals/:id/adoption-applications", r.protectedMiddleware(), r.getAnimalAdoptionApplications)
+	v1.Get("/adoption-applications", r.protectedMiddleware(), r.getUserAdoptionApplications)
+	v1.Get("/adoption-applications/:id", r.protectedMiddleware(), r.getAdoptionApplicationByID)
+	v1.Patch("/adoption-applications/:id", r.protectedMiddleware(), r.updateAdoptionApplication)
+
+	// notifications
+	v1.Get("/notifications", r.protectedMiddleware(), r.getNotifications)
+	v1.Post("/notifications/read", r.protectedMiddleware(), r.markNotificationsRead)
 
 	// favourites posts
 	v1.Post("/posts/:id/favourites", r.protectedMiddleware(), r.addFavouritePost)
@@ -113,9 +194,13 @@ func (r *Router) initRoutes() {
 	v1.Delete("/moderation/posts/:id", r.protectedMiddleware(), r.deletePostByModerator)
 	v1.Patch("/moderation/posts/:id", r.protectedMiddleware(), r.approvePostByModerator)
 	v1.Get("/moderation/comments", r.protectedMiddleware(), r.getReportedComments)
+	v1.Get("/moderation/comments/search", r.protectedMiddleware(), r.searchComments)
 	v1.Delete("/moderation/comments/:id", r.protectedMiddleware(), r.deleteCommentByModerator)
 	v1.Patch("/moderation/comments/:id", r.protectedMiddleware(), r.approveCommentByModerator)
 	v1.Post("/moderation/users/ban", r.protectedMiddleware(), r.banUser)
+	v1.Get("/moderation/reports", r.protectedMiddleware(), r.getReportedTargets)
+	v1.Delete("/moderation/reports/:target_type/:target_id", r.protectedMiddleware(), r.deleteReportedTarget)
+	v1.Patch("/moderation/reports/:target_type/:target_id", r.protectedMiddleware(), r.dismissReports)
 }
 
 // initRequestMiddlewares initializes all middlewares for http requests
@@ -12,11 +12,19 @@ import (
 
 type (
 	appDependencies struct {
-		authService      *mocks.MockAuthService
-		postService      *mocks.MockPostService
-		commentService   *mocks.MockCommentService
-		moderatorService *mocks.MockModeratorService
-		reportService    *mocks.MockReportService
+		authService          *mocks.MockAuthService
+		postService          *mocks.MockPostService
+		commentService       *mocks.MockCommentService
+		moderatorService     *mocks.MockModeratorService
+		reportService        *mocks.MockReportService
+		mediaService         *mocks.MockMediaService
+		animalService        *mocks.MockAnimalService
+		adoptionService      *mocks.MockAdoptionService
+		notificationService  *mocks.MockNotificationService
+		blockService         *mocks.MockBlockService
+		userFavouriteService *mocks.MockUserFavouriteService
+		feedService          *mocks.MockFeedService
+		profileService       *mocks.MockProfileService
 	}
 )
 
@@ -35,6 +43,14 @@ func newTestApp(t *testing.T) (*fiber.App, appDependencies) {
 	mockUserService := mocks.NewMockUserService(t)
 	mockReportService := mocks.NewMockReportService(t)
 	mockModeratorService := mocks.NewMockModeratorService(t)
+	mockMediaService := mocks.NewMockMediaService(t)
+	mockAnimalService := mocks.NewMockAnimalService(t)
+	mockAdoptionService := mocks.NewMockAdoptionService(t)
+	mockNotificationService := mocks.NewMockNotificationService(t)
+	mockBlockService := mocks.NewMockBlockService(t)
+	mockUserFavouriteService := mocks.NewMockUserFavouriteService(t)
+	mockFeedService := mocks.NewMockFeedService(t)
+	mockProfileService := mocks.NewMockProfileService(t)
 	formValidatorService := validator.New(ctx, baseValidator.New())
 
 	mockAuthService.On("GetJWTSecret").Return(secret)
@@ -49,14 +65,30 @@ func newTestApp(t *testing.T) (*fiber.App, appDependencies) {
 		mockRoleService,
 		mockReportService,
 		mockModeratorService,
+		mockMediaService,
+		mockAnimalService,
+		mockAdoptionService,
+		mockNotificationService,
+		mockBlockService,
+		mockUserFavouriteService,
+		mockFeedService,
+		mockProfileService,
 		formValidatorService,
 	)
 
 	return app, appDependencies{
-		authService:      mockAuthService,
-		postService:      mockPostService,
-		commentService:   mockCommentService,
-		moderatorService: mockModeratorService,
-		reportService:    mockReportService,
+		authService:          mockAuthService,
+		postService:          mockPostService,
+		commentService:       mockCommentService,
+		moderatorService:     mockModeratorService,
+		reportService:        mockReportService,
+		mediaService:         mockMediaService,
+		animalService:        mockAnimalService,
+		adoptionService:      mockAdoptionService,
+		notificationService:  mockNotificationService,
+		blockService:         mockBlockService,
+		userFavouriteService: mockUserFavouriteService,
+		feedService:          mockFeedService,
+		profileService:       mockProfileService,
 	}
 }
@@ -12,13 +12,14 @@ import (
 
 // @Summary		Get user by id
 // @Tags			user
-// @Description	Get user by id
+// @Description	Get profile of the user with roles, badges, activity statistics and last seen moment, statistics and last seen moment are null if the user hides them from the current user
 // @ID				get-user
 // @Accept			json
 // @Produce		json
 // @Param			id	path		int	true	"User ID"
-// @Success		200	{object}	model.Response{data=user.ResponseUser}
+// @Success		200	{object}	user.Profile
 // @Failure		400	{object}	model.Response
+// @Failure		401	{object}	model.Response
 // @Failure		404	{object}	model.Response
 //
 // @Failure		422	{object}	model.Response{data=validator.Response}
@@ -32,7 +33,11 @@ func (r *Router) getUser(ctx *fiber.Ctx) error {
 		logger.Log().Debug(ctx.UserContext(), err.Error())
 		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
 	}
-	currentUser, err := r.userService.GetUser(ctx.UserContext(), id)
+
+	// anonymous users see only public parts of the profile
+	viewerID := getViewerID(ctx)
+
+	profile, err := r.profileService.GetProfile(ctx.UserContext(), id, viewerID)
 	if err != nil {
 		switch {
 		case errors.Is(err, core.ErrNoSuchUser):
@@ -43,8 +48,14 @@ func (r *Router) getUser(ctx *fiber.Ctx) error {
 			return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 		}
 	}
-	responseUser := user.ToResponseUser(&currentUser)
-	return ctx.Status(fiber.StatusOK).JSON(responseUser)
+
+	access, err := r.usersContactsAccess(ctx.UserContext(), viewerID, profile.User)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(user.ToProfile(profile, access))
 }
 
 // @Summary		Update user
@@ -59,6 +70,9 @@ func (r *Router) getUser(ctx *fiber.Ctx) error {
 // @Param			description	formData	string	false	"Description"
 // @Param			photo		formData	file	false	"Photo"
 // @Param			password	formData	string	false	"Password"
+// @Param			phone		formData	string	false	"Phone in E.164 format, empty value removes it"
+// @Param			telegram	formData	string	false	"Telegram username without @, empty value removes it"
+// @Param			vk_link		formData	string	false	"Link to VK page, empty value removes it"
 // @Success		200			{object}	model.Response{data=user.ResponseUser}
 // @Failure		400			{object}	model.Response
 // @Failure		404			{object}	model.Response
@@ -72,19 +86,10 @@ func (r *Router) updateUser(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 	}
 	var update user.UpdateUser
-	update.Photo, err = openAndValidatePhoto(ctx)
+	update.Photo, err = openPhoto(ctx)
 	if err != nil {
-		switch {
-		case errors.Is(err, model.ErrInvalidPhotoSize):
-			logger.Log().Debug(ctx.UserContext(), err.Error())
-			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
-		case errors.Is(err, model.ErrInvalidExtension):
-			logger.Log().Debug(ctx.UserContext(), err.Error())
-			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
-		default:
-			logger.Log().Error(ctx.UserContext(), err.Error())
-			return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
-		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 	}
 
 	fiberError, parseOrValidationError := parseBodyAndValidate(ctx, r.formValidator, &update)
@@ -99,7 +104,7 @@ func (r *Router) updateUser(ctx *fiber.Ctx) error {
 		case errors.Is(err, core.ErrNoSuchUser):
 			logger.Log().Debug(ctx.UserContext(), err.Error())
 			return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
-		case errors.Is(err, core.ErrEmptyUpdateRequest):
+		case errors.Is(err, core.ErrEmptyUpdateRequest), isPhotoError(err):
 			logger.Log().Debug(ctx.UserContext(), err.Error())
 			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
 		default:
@@ -107,7 +112,7 @@ func (r *Router) updateUser(ctx *fiber.Ctx) error {
 			return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
 		}
 	}
-	responseUser := user.ToResponseUser(&updatedUser)
+	responseUser := user.ToResponseUser(&updatedUser, core.ContactsAccess{ViewerID: id})
 
 	return ctx.Status(fiber.StatusOK).JSON(responseUser)
 }
@@ -1,17 +1,205 @@
 package http
 
 import (
+	"errors"
+
 	"github.com/gofiber/fiber/v2"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model"
+	postModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+	userModel "github.com/kotopesp/sos-kotopes/internal/controller/http/model/user"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
 )
 
-func (r *Router) GetFavouriteUsers(ctx *fiber.Ctx) error {
-	return nil
+// @Summary		Get favourite users
+// @Tags			user
+// @Description	Get users favourited by the current user, recently favourited first by default
+// @ID				get-favourite-users
+// @Produce		json
+// @Param			limit	query		int		true	"Limit"		minimum(1)	maximum(100)
+// @Param			offset	query		int		false	"Offset"	minimum(0)
+// @Param			sort	query		string	false	"Sort"		Enums(newest, oldest, username)
+// @Success		200		{object}	model.Response{data=user.FavouritesResponse}
+// @Failure		401		{object}	model.Response
+// @Failure		422		{object}	model.Response{data=validator.Response}
+// @Failure		500		{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/favourites [get]
+func (r *Router) getFavouriteUsers(ctx *fiber.Ctx) error {
+	var params userModel.GetFavouritesParams
+
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &params)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	}
+
+	favourites, total, err := r.userFavouriteService.GetFavouriteUsers(ctx.UserContext(), params.ToCoreGetFavouriteUsersParams(userID))
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	users := make([]core.User, len(favourites))
+	for i := range favourites {
+		users[i] = favourites[i].Person
+	}
+
+	access, err := r.usersContactsAccess(ctx.UserContext(), userID, users...)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(
+		userModel.ToFavouritesResponse(paginate(total, params.Limit, params.Offset), favourites, access),
+	))
+}
+
+// @Summary		Get posts of favourite users
+// @Tags			post
+// @Description	Get the feed of published posts of users favourited by the current user, active posts by default
+// @ID				get-favourite-users-posts
+// @Produce		json
+// @Param			limit		query		int		true	"Limit"		minimum(1)
+// @Param			offset		query		int		false	"Offset, ignored when cursor is set"	minimum(0)
+// @Param			cursor		query		string	false	"Cursor of the next page from meta of the previous response"
+// @Param			sort		query		string	false	"Sort, newest by default"	Enums(newest, oldest, updated, nearest)
+// @Param			status		query		string	false	"Status"
+// @Param			animal_type	query		string	false	"Animal type"
+// @Param			gender		query		string	false	"Gender"
+// @Param			color		query		string	false	"Color"
+// @Param			location	query		string	false	"Location"
+// @Success		200			{object}	model.Response{data=post.Response}
+// @Failure		400			{object}	model.Response
+// @Failure		401			{object}	model.Response
+// @Failure		422			{object}	model.Response{data=validator.Response}
+// @Failure		500			{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/favourites/posts [get]
+func (r *Router) getFavouriteUsersPosts(ctx *fiber.Ctx) error {
+	var getAllPostsParams postModel.GetAllPostsParams
+
+	fiberError, parseOrValidationError := parseQueryAndValidate(ctx, r.formValidator, &getAllPostsParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	}
+
+	coreGetAllPostsParams, err := getAllPostsParams.ToCoreGetAllPostsParams()
+	if err != nil {
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	postsDetails, total, err := r.userFavouriteService.GetFavouriteUsersPosts(ctx.UserContext(), userID, coreGetAllPostsParams)
+	if err != nil {
+		if isPaginationError(err) {
+			logger.Log().Debug(ctx.UserContext(), err.Error())
+			return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+		}
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	access, err := r.postsContactsAccess(ctx.UserContext(), userID, postsDetails...)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	pagination := paginate(total, getAllPostsParams.Limit, getAllPostsParams.Offset)
+	pagination.NextCursor = postModel.NextCursor(coreGetAllPostsParams, postsDetails)
+
+	return ctx.Status(fiber.StatusOK).JSON(model.OKResponse(postModel.ToResponse(pagination, postsDetails, access)))
 }
 
-func (r *Router) AddUserToFavourites(ctx *fiber.Ctx) error {
-	return nil
+// @Summary		Add user to favourites
+// @Tags			user
+// @Description	Add the user to favourites of the current user to follow their posts
+// @ID				add-user-to-favourites
+// @Param			id	path	int	true	"User ID"	minimum(1)
+// @Success		204
+// @Failure		400	{object}	model.Response
+// @Failure		401	{object}	model.Response
+// @Failure		404	{object}	model.Response
+// @Failure		409	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/{id}/favourites [post]
+func (r *Router) addUserToFavourites(ctx *fiber.Ctx) error {
+	var pathParams userModel.FavouritePathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	}
+
+	err = r.userFavouriteService.AddUserToFavourite(ctx.UserContext(), pathParams.UserID, userID)
+	switch {
+	case errors.Is(err, core.ErrFavouriteYourself):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusBadRequest).JSON(model.ErrorResponse(err.Error()))
+	case errors.Is(err, core.ErrNoSuchUser):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusNotFound).JSON(model.ErrorResponse(err.Error()))
+	case errors.Is(err, core.ErrUserAlreadyInFavourites):
+		logger.Log().Debug(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusConflict).JSON(model.ErrorResponse(err.Error()))
+	case err != nil:
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
 }
 
-func (r *Router) DeleteUserFromFavourites(ctx *fiber.Ctx) error {
-	return nil
+// @Summary		Delete user from favourites
+// @Tags			user
+// @Description	Delete the user from favourites of the current user
+// @ID				delete-user-from-favourites
+// @Param			id	path	int	true	"User ID"	minimum(1)
+// @Success		204
+// @Failure		401	{object}	model.Response
+// @Failure		422	{object}	model.Response{data=validator.Response}
+// @Failure		500	{object}	model.Response
+// @Security		ApiKeyAuthBasic
+// @Router			/users/{id}/favourites [delete]
+func (r *Router) deleteUserFromFavourites(ctx *fiber.Ctx) error {
+	var pathParams userModel.FavouritePathParams
+
+	fiberError, parseOrValidationError := parseParamsAndValidate(ctx, r.formValidator, &pathParams)
+	if fiberError != nil || parseOrValidationError != nil {
+		return fiberError
+	}
+
+	userID, err := getIDFromToken(ctx)
+	if err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusUnauthorized).JSON(model.ErrorResponse(core.ErrFailedToGetAuthorIDFromToken))
+	}
+
+	if err := r.userFavouriteService.DeleteUserFromFavourite(ctx.UserContext(), pathParams.UserID, userID); err != nil {
+		logger.Log().Error(ctx.UserContext(), err.Error())
+		return ctx.Status(fiber.StatusInternalServerError).JSON(model.ErrorResponse(err.Error()))
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
 }
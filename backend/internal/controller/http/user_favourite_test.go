@@ -0,0 +1,211 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/post"
+	"github.com/kotopesp/sos-kotopes/internal/controller/http/model/user"
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeFavouriteUser(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	tests := []struct {
+		name          string
+		method        string
+		route         string
+		token         string
+		mockBehaviour func()
+		wantCode      int
+	}{
+		{
+			name:   "add",
+			method: http.MethodPost,
+			route:  "/api/v1/users/2/favourites",
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.userFavouriteService.EXPECT().
+					AddUserToFavourite(mock.Anything, 2, authorID).
+					Return(nil).Once()
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:   "add yourself",
+			method: http.MethodPost,
+			route:  "/api/v1/users/1/favourites",
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.userFavouriteService.EXPECT().
+					AddUserToFavourite(mock.Anything, 1, authorID).
+					Return(core.ErrFavouriteYourself).Once()
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "no such user",
+			method: http.MethodPost,
+			route:  "/api/v1/users/3/favourites",
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.userFavouriteService.EXPECT().
+					AddUserToFavourite(mock.Anything, 3, authorID).
+					Return(core.ErrNoSuchUser).Once()
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:   "already in favourites",
+			method: http.MethodPost,
+			route:  "/api/v1/users/4/favourites",
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.userFavouriteService.EXPECT().
+					AddUserToFavourite(mock.Anything, 4, authorID).
+					Return(core.ErrUserAlreadyInFavourites).Once()
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:   "delete",
+			method: http.MethodDelete,
+			route:  "/api/v1/users/2/favourites",
+			token:  token,
+			mockBehaviour: func() {
+				dependencies.userFavouriteService.EXPECT().
+					DeleteUserFromFavourite(mock.Anything, 2, authorID).
+					Return(nil).Once()
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:          "invalid user id",
+			method:        http.MethodPost,
+			route:         "/api/v1/users/0/favourites",
+			token:         token,
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "unauthorized add",
+			method:        http.MethodPost,
+			route:         "/api/v1/users/2/favourites",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:          "unauthorized delete",
+			method:        http.MethodDelete,
+			route:         "/api/v1/users/2/favourites",
+			mockBehaviour: func() {},
+			wantCode:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehaviour()
+
+			req := httptest.NewRequest(tt.method, tt.route, http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+			}
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestGetFavouriteUsers(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	favouritedAt := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	dependencies.userFavouriteService.EXPECT().
+		GetFavouriteUsers(mock.Anything, mock.MatchedBy(func(p core.GetFavouriteUsersParams) bool {
+			return p.UserID == authorID && *p.Limit == 10 && *p.Offset == 0 && p.Sort == core.FavouriteUsersSortUsername
+		})).
+		Return([]core.FavouriteUser{{
+			PersonID:  2,
+			Person:    core.User{ID: 2, Username: "alice"},
+			UserID:    authorID,
+			CreatedAt: favouritedAt,
+		}}, 1, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/favourites?limit=10&sort=username", http.NoBody)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Data user.FavouritesResponse `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, 1, body.Data.Meta.Total)
+	require.Len(t, body.Data.Users, 1)
+	assert.Equal(t, 2, body.Data.Users[0].ID)
+	assert.Equal(t, "alice", body.Data.Users[0].Username)
+	assert.True(t, favouritedAt.Equal(body.Data.Users[0].FavouritedAt))
+}
+
+func TestGetFavouriteUsers_InvalidSort(t *testing.T) {
+	t.Parallel()
+	app, _ := newTestApp(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/favourites?limit=10&sort=rating", http.NoBody)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+func TestGetFavouriteUsersPosts(t *testing.T) {
+	t.Parallel()
+	app, dependencies := newTestApp(t)
+
+	dependencies.userFavouriteService.EXPECT().
+		GetFavouriteUsersPosts(mock.Anything, authorID, mock.MatchedBy(func(p core.GetAllPostsParams) bool {
+			return *p.Limit == 10
+		})).
+		Return([]core.PostDetails{{
+			Post:     core.Post{ID: 5, AuthorID: 2, Title: "Found a cat"},
+			Username: "alice",
+		}}, 1, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/favourites/posts?limit=10", http.NoBody)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Data post.Response `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Data.Posts, 1)
+	assert.Equal(t, 5, body.Data.Posts[0].ID)
+}
@@ -0,0 +1,179 @@
+package core
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type (
+	// AdoptionApplication - application of the user to adopt the animal which needs home.
+	AdoptionApplication struct {
+		ID          int               `gorm:"column:id;primaryKey"`
+		AnimalID    int               `gorm:"column:animal_id"`
+		ApplicantID int               `gorm:"column:applicant_id"`
+		Status      AdoptionStatus    `gorm:"column:status;default:submitted"`
+		Answers     AdoptionAnswers   `gorm:"column:answers;type:jsonb"`
+		Applicant   ApplicantSnapshot `gorm:"column:applicant_snapshot;type:jsonb"` // Profile of the applicant at the moment of applying
+		KeeperNote  string            `gorm:"column:keeper_note"`                   // Note of the author or the keeper of the animal, never shown to the applicant
+		CreatedAt   time.Time         `gorm:"column:created_at"`
+		UpdatedAt   time.Time         `gorm:"column:updated_at"`
+		ClosedAt    *time.Time        `gorm:"column:closed_at"` // Time the application was approved, rejected or withdrawn
+	}
+
+	// AdoptionAnswers - answers of the applicant by keys of AdoptionQuestion.
+	AdoptionAnswers map[string]string
+
+	// ApplicantSnapshot - profile of the applicant copied to the application,
+	// so the keeper sees the profile the decision is made on even if the applicant changes it later.
+	ApplicantSnapshot struct {
+		Username     string    `json:"username"`
+		Firstname    *string   `json:"firstname,omitempty"`
+		Lastname     *string   `json:"lastname,omitempty"`
+		Description  *string   `json:"description,omitempty"`
+		RegisteredAt time.Time `json:"registered_at"`
+	}
+
+	// AdoptionQuestion - question of the questionnaire the applicant answers.
+	AdoptionQuestion struct {
+		Key       string `json:"key"`
+		Text      string `json:"text"`
+		Required  bool   `json:"required"`
+		MaxLength int    `json:"max_length"` // Maximal length of the answer in characters
+	}
+
+	// UpdateAdoptionApplication - change of the application made by the applicant or the keeper, nil fields are kept.
+	UpdateAdoptionApplication struct {
+		ID         int
+		UserID     int // User changing the application
+		Status     *AdoptionStatus
+		KeeperNote *string // Only the author and the keeper of the animal may change the note
+	}
+
+	// GetAdoptionApplicationsParams - filters and pagination of applications.
+	GetAdoptionApplicationsParams struct {
+		Limit       *int
+		Offset      *int
+		AnimalID    *int
+		ApplicantID *int
+		Status      *AdoptionStatus
+	}
+
+	AdoptionServiceConfig struct {
+		Questions []AdoptionQuestion // Questionnaire of applications
+	}
+
+	AdoptionStore interface {
+		CreateApplication(ctx context.Context, application AdoptionApplication) (AdoptionApplication, error)
+		GetApplicationByID(ctx context.Context, id int) (AdoptionApplication, error)
+		GetApplications(ctx context.Context, params GetAdoptionApplicationsParams) (applications []AdoptionApplication, total int, err error)
+		UpdateApplication(ctx context.Context, application AdoptionApplication) (AdoptionApplication, error)
+		// ApproveApplication approves the application, closes other open applications for the animal,
+		// marks the animal as adopted and records the adoption in case history of the animal.
+		ApproveApplication(ctx context.Context, application AdoptionApplication, approvedBy int) (AdoptionApplication, error)
+	}
+
+	AdoptionService interface {
+		GetQuestionnaire(ctx context.Context) []AdoptionQuestion
+		Apply(ctx context.Context, application AdoptionApplication) (AdoptionApplication, error)
+		GetApplication(ctx context.Context, userID, id int) (AdoptionApplication, error)
+		GetAnimalApplications(ctx context.Context, userID int, params GetAdoptionApplicationsParams) (applications []AdoptionApplication, total int, err error)
+		GetUserApplications(ctx context.Context, userID int, params GetAdoptionApplicationsParams) (applications []AdoptionApplication, total int, err error)
+		UpdateApplication(ctx context.Context, update UpdateAdoptionApplication) (AdoptionApplication, error)
+	}
+)
+
+// AdoptionStatus - stage of the application.
+type AdoptionStatus string
+
+const (
+	AdoptionSubmitted   AdoptionStatus = "submitted"
+	AdoptionUnderReview AdoptionStatus = "under_review"
+	AdoptionInterview   AdoptionStatus = "interview"
+	AdoptionApproved    AdoptionStatus = "approved"
+	AdoptionRejected    AdoptionStatus = "rejected"
+	AdoptionWithdrawn   AdoptionStatus = "withdrawn"
+)
+
+// Statuses of animals related to adoption: only animals which need home accept applications,
+// approved application marks the animal as adopted.
+const (
+	AnimalStatusNeedHome = "need_home"
+	AnimalStatusAdopted  = "adopted"
+)
+
+// OpenAdoptionStatuses - statuses of applications which are not decided yet, the applicant has one open application for the animal.
+var OpenAdoptionStatuses = []AdoptionStatus{AdoptionSubmitted, AdoptionUnderReview, AdoptionInterview}
+
+// keeperTransitions - statuses the author or the keeper of the animal may move the application to.
+var keeperTransitions = map[AdoptionStatus][]AdoptionStatus{
+	AdoptionSubmitted:   {AdoptionUnderReview, AdoptionInterview, AdoptionApproved, AdoptionRejected},
+	AdoptionUnderReview: {AdoptionInterview, AdoptionApproved, AdoptionRejected},
+	AdoptionInterview:   {AdoptionUnderReview, AdoptionApproved, AdoptionRejected},
+}
+
+// IsOpen reports whether the application is not decided yet.
+func (s AdoptionStatus) IsOpen() bool {
+	for _, open := range OpenAdoptionStatuses {
+		if s == open {
+			return true
+		}
+	}
+	return false
+}
+
+// CanMoveTo reports whether the application may be moved to the status by the keeper or, if byKeeper is false, by the applicant.
+// The applicant may only withdraw the open application.
+func (s AdoptionStatus) CanMoveTo(status AdoptionStatus, byKeeper bool) bool {
+	if !byKeeper {
+		return s.IsOpen() && status == AdoptionWithdrawn
+	}
+
+	for _, allowed := range keeperTransitions[s] {
+		if status == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// Value - answers are stored as JSON object.
+func (a AdoptionAnswers) Value() (driver.Value, error) {
+	if a == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(a)
+	return string(data), err
+}
+
+func (a *AdoptionAnswers) Scan(src interface{}) error {
+	return scanJSON(src, a)
+}
+
+// Value - snapshot is stored as JSON object.
+func (s ApplicantSnapshot) Value() (driver.Value, error) {
+	data, err := json.Marshal(s)
+	return string(data), err
+}
+
+func (s *ApplicantSnapshot) Scan(src interface{}) error {
+	return scanJSON(src, s)
+}
+
+// scanJSON decodes JSON column into dst.
+func scanJSON(src, dst interface{}) error {
+	switch data := src.(type) {
+	case []byte:
+		return json.Unmarshal(data, dst)
+	case string:
+		return json.Unmarshal([]byte(data), dst)
+	default:
+		return fmt.Errorf("unsupported type of JSON column: %T", src)
+	}
+}
+
+func (AdoptionApplication) TableName() string {
+	return "adoption_applications"
+}
@@ -2,39 +2,156 @@ package core
 
 import (
 	"context"
+	"strings"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 type (
 	Animal struct {
-		ID          int       `gorm:"column:id;primaryKey"` // Unique identifier for the animal
-		KeeperID    int       `gorm:"column:keeper_id"`     // ID of the person responsible for the animal
-		AnimalType  string    `gorm:"column:animal_type"`   // Type of the animal (dog, cat)
-		Age         int       `gorm:"column:age"`           // Age of the animal
-		Color       string    `gorm:"column:color"`         // Color of the animal
-		Gender      string    `gorm:"column:gender"`        // Gender of the animal (male, female)
-		Description string    `gorm:"column:description"`   // Description of the animal
-		Status      string    `gorm:"column:status"`        // Status of the animal (lost, found, need home)
-		CreatedAt   time.Time `gorm:"column:created_at"`    // Timestamp when the record was created
-		UpdatedAt   time.Time `gorm:"column:updated_at"`    // Timestamp when the record was last updated
+		ID                  int            `gorm:"column:id;primaryKey"`             // Unique identifier for the animal
+		AuthorID            *int           `gorm:"column:author_id"`                 // ID of the user who registered the animal
+		KeeperID            *int           `gorm:"column:keeper_id"`                 // ID of the keeper responsible for the animal, nil if nobody keeps it
+		KeeperUserID        *int           `gorm:"->;column:keeper_user_id"`         // ID of the user of the keeper, filled by AnimalStore
+		Name                string         `gorm:"column:name"`                      // Name of the animal, if known
+		AnimalType          string         `gorm:"column:animal_type"`               // Species of the animal (dog, cat, rabbit, bird, rodent, other)
+		Breed               string         `gorm:"column:breed"`                     // Breed of the animal, free text
+		Size                *string        `gorm:"column:size"`                      // Size of the animal (small, medium, large)
+		Age                 int            `gorm:"column:age"`                       // Age of the animal
+		Color               string         `gorm:"column:color"`                     // Color of the animal as described by the author
+		Colors              pq.StringArray `gorm:"column:colors;type:varchar(20)[]"` // Colors of the animal from AnimalColors, used for filtering
+		CoatPattern         *string        `gorm:"column:coat_pattern"`              // Pattern of the coat (solid, tabby, calico...)
+		Gender              string         `gorm:"column:gender"`                    // Gender of the animal (male, female)
+		Description         string         `gorm:"column:description"`               // Description of the animal
+		DistinguishingMarks string         `gorm:"column:distinguishing_marks"`      // Scars, missing ears, unusual spots and other marks
+		MicrochipNumber     *string        `gorm:"column:microchip_number"`          // Number of the microchip, normalized by NormalizeIdentifier
+		TattooID            *string        `gorm:"column:tattoo_id"`                 // Identification tattoo, normalized by NormalizeIdentifier
+		IsSterilized        *bool          `gorm:"column:is_sterilized"`             // Nil if unknown
+		IsVaccinated        *bool          `gorm:"column:is_vaccinated"`             // Nil if unknown
+		HasCollar           *bool          `gorm:"column:has_collar"`                // Nil if unknown
+		CollarDescription   string         `gorm:"column:collar_description"`        // Color of the collar, tag, address capsule
+		Status              string         `gorm:"column:status"`                    // Status of the animal (lost, found, need home, adopted)
+		Stage               *string        `gorm:"->;column:stage"`                  // Type of the latest event of the animal, nil if it has no events
+		CreatedAt           time.Time      `gorm:"column:created_at"`                // Timestamp when the record was created
+		UpdatedAt           time.Time      `gorm:"column:updated_at"`                // Timestamp when the record was last updated
 	}
 
+	// UpdateRequestBodyAnimal - changes of the animal, nil fields are kept.
 	UpdateRequestBodyAnimal struct {
-		AnimalType  string `gorm:"column:animal_type"`
-		Age         int    `gorm:"column:age"`
-		Color       string `gorm:"column:color"`
-		Gender      string `gorm:"column:gender"`
-		Description string `gorm:"column:description"`
-		Status      string `gorm:"column:status"`
+		ID                  int
+		UserID              int // User changing the animal, must be the author or the keeper
+		Name                *string
+		AnimalType          *string
+		Breed               *string
+		Size                *string
+		Age                 *int
+		Color               *string
+		Colors              []string
+		CoatPattern         *string
+		Gender              *string
+		Description         *string
+		DistinguishingMarks *string
+		MicrochipNumber     *string
+		TattooID            *string
+		IsSterilized        *bool
+		IsVaccinated        *bool
+		HasCollar           *bool
+		CollarDescription   *string
+		Status              *string
+	}
+
+	// GetAnimalsParams - filters and pagination of the list of animals.
+	GetAnimalsParams struct {
+		Limit        *int
+		Offset       *int
+		AuthorID     *int    // Animals registered by the user
+		KeeperUserID *int    // Animals kept by the user
+		Stage        *string // Type of the latest event of the animal
+		Status       *string
+		AnimalType   *string
+	}
+
+	// AnimalEvent - event of the case history of the animal, e.g. the animal was found or taken to a vet.
+	AnimalEvent struct {
+		ID         int       `gorm:"column:id;primaryKey"`
+		AnimalID   int       `gorm:"column:animal_id"`
+		AuthorID   int       `gorm:"column:author_id"` // User who recorded the event
+		Type       string    `gorm:"column:type"`      // One of AnimalEventTypes
+		Note       string    `gorm:"column:note"`
+		HappenedAt time.Time `gorm:"column:happened_at"` // Events may be recorded later than they happened
+		CreatedAt  time.Time `gorm:"column:created_at"`
+		Photos     []Media   `gorm:"-"` // Photos of the event ordered by position, filled by AnimalService
 	}
 
 	AnimalStore interface {
 		CreateAnimal(ctx context.Context, animal Animal) (Animal, error)
 		GetAnimalByID(ctx context.Context, id int) (Animal, error)
 		UpdateAnimal(ctx context.Context, animal Animal) (Animal, error)
+		GetAnimals(ctx context.Context, params GetAnimalsParams) (animals []Animal, total int, err error)
+		SetAnimalKeeper(ctx context.Context, id int, keeperUserID *int) error
+		CreateAnimalEvent(ctx context.Context, event AnimalEvent) (AnimalEvent, error)
+		DeleteAnimalEvent(ctx context.Context, event AnimalEvent) error
+		GetAnimalEvents(ctx context.Context, animalID int) (events []AnimalEvent, err error)
+	}
+
+	AnimalService interface {
+		GetAnimals(ctx context.Context, params GetAnimalsParams) (animals []Animal, total int, err error)
+		GetAnimalByID(ctx context.Context, id int) (Animal, error)
+		CreateAnimal(ctx context.Context, animal Animal) (Animal, error)
+		UpdateAnimal(ctx context.Context, update UpdateRequestBodyAnimal) (Animal, error)
+		SetAnimalKeeper(ctx context.Context, userID, id int, keeperUserID *int) (Animal, error)
+		GetAnimalEvents(ctx context.Context, animalID int) (events []AnimalEvent, err error)
+		AddAnimalEvent(ctx context.Context, event AnimalEvent, photos []MediaUpload) (AnimalEvent, error)
 	}
 )
 
+// AnimalEventTypes - events of the case history of the animal, type of the latest one is the stage of the animal.
+var AnimalEventTypes = []string{"found", "at_vet", "in_foster", "adopted", "returned_to_owner", "deceased"}
+
+// AnimalColors - vocabulary of colors of animals, color described by the author is kept as free text.
+var AnimalColors = []string{"black", "white", "gray", "ginger", "cream", "brown", "fawn"}
+
+// NormalizeIdentifier brings microchip number or tattoo to the form it is stored and searched in:
+// separators people write between groups of digits are removed and letters are uppercased.
+func NormalizeIdentifier(id string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '_', '/':
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(id)))
+}
+
+// NormalizeIdentifiers stores microchip number and tattoo in the form they are searched in, empty ones are removed.
+func (a *Animal) NormalizeIdentifiers() {
+	a.MicrochipNumber = normalizeIdentifier(a.MicrochipNumber)
+	a.TattooID = normalizeIdentifier(a.TattooID)
+}
+
+func normalizeIdentifier(id *string) *string {
+	if id == nil {
+		return nil
+	}
+
+	normalized := NormalizeIdentifier(*id)
+	if normalized == "" {
+		return nil
+	}
+
+	return &normalized
+}
+
+// ManagedBy reports whether the user may change the animal: the user registered it or keeps it.
+func (a Animal) ManagedBy(userID int) bool {
+	return a.AuthorID != nil && *a.AuthorID == userID || a.KeeperUserID != nil && *a.KeeperUserID == userID
+}
+
 func (Animal) TableName() string {
 	return "animals"
 }
+
+func (AnimalEvent) TableName() string {
+	return "animal_events"
+}
@@ -0,0 +1,41 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+func TestNormalizeIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{id: "643094100123456", want: "643094100123456"},
+		{id: " 643 094 100 123 456 ", want: "643094100123456"},
+		{id: "643-094.100_123/456", want: "643094100123456"},
+		{id: "ab 12-c", want: "AB12C"},
+		{id: " - ", want: ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, core.NormalizeIdentifier(tt.id), tt.id)
+	}
+}
+
+func TestAnimal_NormalizeIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	microchip, tattoo := "643-094-100", " / "
+	animal := core.Animal{MicrochipNumber: &microchip, TattooID: &tattoo}
+
+	animal.NormalizeIdentifiers()
+
+	assert.Equal(t, "643094100", *animal.MicrochipNumber)
+	// identifier without digits and letters is not stored
+	assert.Nil(t, animal.TattooID)
+}
@@ -21,7 +21,7 @@ type (
 	AuthService interface {
 		GetJWTSecret() []byte
 		LoginBasic(ctx context.Context, user User) (accessToken, refreshToken *string, err error)
-		SignupBasic(ctx context.Context, user User) error
+		SignupBasic(ctx context.Context, user User, photo *MediaUpload) error
 		Refresh(ctx context.Context, refreshSession RefreshSession) (accessToken, refreshToken *string, err error)
 		ConfigVK() *oauth2.Config
 		AuthorizeVK(ctx context.Context, token string) (accessToken, refreshToken *string, err error)
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// BlockType - kind of restriction the user puts on another user.
+	BlockType string
+
+	// UserBlock - the user blocked or muted another user.
+	UserBlock struct {
+		ID            int       `gorm:"column:id;primaryKey"`
+		UserID        int       `gorm:"column:user_id"`         // The user who blocked
+		BlockedUserID int       `gorm:"column:blocked_user_id"` // The user who is blocked
+		BlockedUser   User      `gorm:"foreignKey:BlockedUserID;references:ID"`
+		Type          BlockType `gorm:"column:type"`
+		CreatedAt     time.Time `gorm:"column:created_at"`
+	}
+
+	// GetBlockedUsersParams - filters and pagination of users blocked by the user, the latest blocks go first.
+	GetBlockedUsersParams struct {
+		UserID int
+		Type   *BlockType // Both blocked and muted users when nil
+		Limit  *int
+		Offset *int
+	}
+
+	BlockStore interface {
+		// BlockUser saves the block, repeated block of the same type keeps the first one
+		BlockUser(ctx context.Context, block UserBlock) error
+		UnblockUser(ctx context.Context, userID, blockedUserID int, blockType BlockType) error
+		GetBlockedUsers(ctx context.Context, params GetBlockedUsersParams) (blocks []UserBlock, total int, err error)
+		// GetHiddenUserIDs returns users blocked or muted by the user, their posts and comments are hidden from the user
+		GetHiddenUserIDs(ctx context.Context, userID int) (userIDs []int, err error)
+		// GetBlockingUserIDs returns which of userIDs blocked the user
+		GetBlockingUserIDs(ctx context.Context, userID int, userIDs []int) (blockingUserIDs []int, err error)
+	}
+
+	BlockService interface {
+		BlockUser(ctx context.Context, userID, blockedUserID int, blockType BlockType) error
+		UnblockUser(ctx context.Context, userID, blockedUserID int, blockType BlockType) error
+		GetBlockedUsers(ctx context.Context, params GetBlockedUsersParams) (blocks []UserBlock, total int, err error)
+	}
+)
+
+const (
+	// BlockTypeBlock - content of the blocked user is hidden, the blocked user can't comment posts of the blocker
+	// or mention them
+	BlockTypeBlock BlockType = "block"
+	// BlockTypeMute - content of the muted user is hidden, the muted user isn't restricted
+	BlockTypeMute BlockType = "mute"
+)
+
+func (UserBlock) TableName() string {
+	return "user_blocks"
+}
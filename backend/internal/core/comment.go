@@ -6,17 +6,24 @@ import (
 )
 
 type Comment struct {
-	ID        int           `gorm:"column:id" fake:"{number:1,100}"`
-	ParentID  *int          `gorm:"column:parent_id" fake:"{number:1,100}"`
-	ReplyID   *int          `gorm:"column:reply_id" fake:"{number:1,100}"`
-	PostID    int           `gorm:"column:posts_id" fake:"{number:1,100}"`
-	Status    ContentStatus `gorm:"column:status;default:published"`
-	AuthorID  int           `gorm:"column:author_id" fake:"{number:1,100}"`
-	Author    User          `gorm:"foreignKey:AuthorID;references:ID" fake:"skip"`
-	Content   string        `gorm:"column:content" fake:"{sentence:3}"`
-	DeletedAt time.Time     `gorm:"column:deleted_at" fake:"skip"`
-	CreatedAt time.Time     `gorm:"column:created_at" fake:"skip"`
-	UpdatedAt time.Time     `gorm:"column:updated_at" fake:"skip"`
+	ID               int              `gorm:"column:id" fake:"{number:1,100}"`
+	ParentID         *int             `gorm:"column:parent_id" fake:"{number:1,100}"`
+	ReplyID          *int             `gorm:"column:reply_id" fake:"{number:1,100}"`
+	PostID           int              `gorm:"column:posts_id" fake:"{number:1,100}"`
+	Status           ContentStatus    `gorm:"column:status;default:published"`
+	ModerationReason *string          `gorm:"column:moderation_reason" fake:"skip"`
+	AuthorID         int              `gorm:"column:author_id" fake:"{number:1,100}"`
+	Author           User             `gorm:"foreignKey:AuthorID;references:ID" fake:"skip"`
+	Content          string           `gorm:"column:content" fake:"{sentence:3}"`
+	DeletedAt        time.Time        `gorm:"column:deleted_at" fake:"skip"`
+	CreatedAt        time.Time        `gorm:"column:created_at" fake:"skip"`
+	UpdatedAt        time.Time        `gorm:"column:updated_at" fake:"skip"`
+	EditedAt         *time.Time       `gorm:"column:edited_at" fake:"skip"`       // Last change of the content by the author, nil for comments never edited
+	Highlight        *string          `gorm:"->;column:highlight" fake:"skip"`    // Fragment of the content matching search query, filled by search only
+	ThreadLikes      int              `gorm:"->;column:thread_likes" fake:"skip"` // Likes of the top-level comment of the thread, filled by listings sorted by CommentSortTop only
+	Likes            Likes            `gorm:"-" fake:"skip"`                      // Filled by CommentService.GetAllComments
+	Mentions         []CommentMention `gorm:"-" fake:"skip"`                      // Filled by CommentService listings, CreateComment and UpdateComment
+	Placeholder      string           `gorm:"-" fake:"skip"`                      // One of CommentPlaceholder* shown instead of the hidden content, set by CommentService listings
 }
 
 type CommentStore interface {
@@ -28,6 +35,17 @@ type CommentStore interface {
 	SendToModeration(ctx context.Context, commentID int) error
 	GetCommentsForModeration(ctx context.Context, filter Filter) ([]Comment, error)
 	ApproveCommentFromModeration(ctx context.Context, commentID int) error
+	CountUserCommentsSince(ctx context.Context, authorID int, since time.Time) (count int, err error)
+	SearchComments(ctx context.Context, params SearchCommentsParams) (data []Comment, total int, err error)
+	// GetRootComments returns top-level comments of the post, total is the amount of all top-level comments.
+	GetRootComments(ctx context.Context, params GetAllCommentsParams) (data []Comment, total int, err error)
+	// GetFirstReplies returns up to limit oldest replies of every top-level comment.
+	GetFirstReplies(ctx context.Context, parentIDs []int, limit int) (data []Comment, err error)
+	// CountReplies returns amount of replies by IDs of top-level comments, comments without replies are missing.
+	CountReplies(ctx context.Context, parentIDs []int) (counts map[int]int, err error)
+	GetReplies(ctx context.Context, params GetRepliesParams) (data []Comment, total int, err error)
+	// GetUserComments returns published comments of the user under published posts, the newest first.
+	GetUserComments(ctx context.Context, params GetUserCommentsParams) (data []Comment, total int, err error)
 }
 
 type CommentService interface {
@@ -35,16 +53,79 @@ type CommentService interface {
 	GetAllComments(ctx context.Context, params GetAllCommentsParams) (data []Comment, total int, err error)
 	UpdateComment(ctx context.Context, comments Comment) (data Comment, err error)
 	DeleteComment(ctx context.Context, comments Comment) error
+	// GetCommentThreads returns top-level comments of the post with their first replies, total is the amount of all threads.
+	GetCommentThreads(ctx context.Context, params GetAllCommentsParams) (data []CommentThread, total int, err error)
+	GetReplies(ctx context.Context, params GetRepliesParams) (data []Comment, total int, err error)
+	LikeComment(ctx context.Context, postID, commentID, userID int) (Likes, error)
+	UnlikeComment(ctx context.Context, postID, commentID, userID int) (Likes, error)
+	GetUserComments(ctx context.Context, params GetUserCommentsParams) (data []Comment, total int, err error)
+	// NotifyApprovedComment notifies users mentioned in the comment which was hidden by the content filter until moderator approved it.
+	NotifyApprovedComment(ctx context.Context, comment Comment)
+}
+
+type CommentServiceConfig struct {
+	EditWindow time.Duration // The author may edit the comment within this time after creation, 0 disables the limit
 }
 
 type GetAllCommentsParams struct {
 	PostID int
 	Limit  *int
-	Offset *int
+	Offset *int        // Ignored when Cursor is set
+	Cursor *Cursor     // Last comment of the previous page
+	Sort   CommentSort // CommentSortOldest by default
+	UserID int         // Current user to mark comments liked by, 0 for anonymous user
+	// Replies - amount of the first replies of every thread returned by GetCommentThreads
+	Replies int
+}
+
+// GetRepliesParams - pagination of replies of the top-level comment, replies go from the oldest.
+type GetRepliesParams struct {
+	PostID   int
+	ParentID int // Top-level comment
+	Limit    *int
+	Offset   *int    // Ignored when Cursor is set
+	Cursor   *Cursor // Last reply of the previous page
+	UserID   int     // Current user to mark replies liked by, 0 for anonymous user
+}
+
+// GetUserCommentsParams - pagination of the comment history of the author.
+type GetUserCommentsParams struct {
+	AuthorID int
+	Limit    *int
+	Offset   *int
+	UserID   int // Current user to mark comments liked by, 0 for anonymous user
+}
+
+// CommentThread - top-level comment with the first replies to it, the rest of replies are loaded by CommentService.GetReplies.
+type CommentThread struct {
+	Comment      Comment
+	Replies      []Comment
+	RepliesCount int // Amount of all replies of the thread
+}
+
+// SearchCommentsParams - parameters of full-text search over comments of all statuses, used by moderators.
+type SearchCommentsParams struct {
+	Query  string
+	Status *ContentStatus // Filter by status of the comment
+	Limit  int
+	Offset int
 }
 
 const AmountOfCommentsForModeration = 10
 
+// Amount of the first replies of every thread returned when the client doesn't set it.
+const (
+	DefaultThreadReplies = 3
+	MaxThreadReplies     = 20
+)
+
+// Placeholders of comments hidden from the current user, the comments stay in listings so replies to them remain readable.
+const (
+	CommentPlaceholderDeleted      = "comment deleted"
+	CommentPlaceholderOnModeration = "comment is on moderation"
+	CommentPlaceholderHidden       = "comment of blocked user"
+)
+
 // TableName table name in db for gorm
 func (Comment) TableName() string {
 	return "comments"
@@ -0,0 +1,36 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// FilteredContent is a text of post or comment checked before publication.
+	FilteredContent struct {
+		AuthorID    int    // ID of the author of the content
+		ContentType string // Type of the content, one of ReportableTypePost and ReportableTypeComment
+		Text        string // Text of the content, for posts it is title and content joined
+		New         bool   // New shows whether content is being created, activity rate is checked only for new content
+	}
+
+	// FilterVerdict is a result of the content check.
+	FilterVerdict struct {
+		Suspicious bool   // Suspicious content is sent to moderation instead of being published
+		Reason     string // Machine-generated reason why content is suspicious
+	}
+
+	ContentFilter interface {
+		Check(ctx context.Context, content FilteredContent) (verdict FilterVerdict, err error)
+	}
+
+	// ContentFilterConfig defines rules of the automatic content filter. Zero limits disable corresponding checks.
+	ContentFilterConfig struct {
+		StopWords        []string      // Words that are not allowed in content, matched regardless of word form
+		MaxLinks         int           // Content with more links is suspicious
+		MaxPhones        int           // Content with more phone numbers is suspicious
+		RateWindow       time.Duration // Window in which activity of the author is counted
+		PostRateLimit    int           // Amount of posts author can create within the window
+		CommentRateLimit int           // Amount of comments author can create within the window
+	}
+)
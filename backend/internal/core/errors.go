@@ -14,10 +14,25 @@ var (
 	ErrPostIsDeleted               = errors.New("post is deleted")
 	ErrPostAuthorIDMismatch        = errors.New("your user_id and db author_id mismatch")
 	ErrNoPostsWaitingForModeration = errors.New("no posts waiting for moderation")
+	ErrLocationRequired            = errors.New("latitude and longitude are required to sort posts by distance")
+	ErrPostNotActive               = errors.New("post is resolved or archived")
+	ErrInvalidPostResolution       = errors.New("resolution doesn't match status of the animal")
+	ErrPostBumpedRecently          = errors.New("post was bumped recently")
+	ErrPostNotExpiring             = errors.New("post isn't archived or about to be archived")
+	ErrPostNotDraft                = errors.New("post is not a draft")
+	ErrDraftIncomplete             = errors.New("draft is incomplete")
+
+	// pagination errors
+	ErrInvalidCursor = errors.New("invalid cursor")
 
 	// user errors
 	ErrFailedToGetAuthorIDFromToken = errors.New("failed to get author ID from token")
 	ErrUserIsBanned                 = errors.New("user is banned")
+	ErrBlockYourself                = errors.New("you can't block yourself")
+	ErrBlockedByUser                = errors.New("you are blocked by the user")
+	ErrFavouriteYourself            = errors.New("you can't add yourself to favourites")
+	ErrUserAlreadyInFavourites      = errors.New("user already added to favourites")
+	ErrUserCommentsHidden           = errors.New("the user hides their comments")
 
 	// Role errors
 	ErrInvalidRole      = errors.New("invalid role name")
@@ -25,7 +40,19 @@ var (
 	ErrNoFieldsToUpdate = errors.New("no fields to update")
 
 	// animal errors
-	ErrAnimalNotFound = errors.New("animal not found")
+	ErrAnimalNotFound      = errors.New("animal not found")
+	ErrAnimalAccessDenied  = errors.New("only the author and the keeper of the animal can change it")
+	ErrUserIsNotKeeper     = errors.New("user is not a keeper")
+	ErrAnimalEventInFuture = errors.New("event of the animal can't happen in the future")
+
+	// adoption errors
+	ErrAnimalNotAdoptable          = errors.New("animal doesn't need home")
+	ErrOwnAnimalAdoption           = errors.New("the author and the keeper of the animal can't apply for it")
+	ErrAdoptionApplicationExists   = errors.New("you already applied for the animal")
+	ErrAdoptionApplicationNotFound = errors.New("adoption application not found")
+	ErrInvalidAdoptionAnswers      = errors.New("invalid answers of the questionnaire")
+	ErrAdoptionStatusTransition    = errors.New("application can't be moved to this status")
+	ErrAdoptionAccessDenied        = errors.New("only the author and the keeper of the animal can do it")
 
 	// favourite errors
 	ErrPostAlreadyInFavourites = errors.New("post already added to favourites")
@@ -39,6 +66,7 @@ var (
 	ErrCommentPostIDMismatch          = errors.New("your posts_id and db posts_id mismatch")
 	ErrNoSuchComment                  = errors.New("no such comment")
 	ErrCommentIsDeleted               = errors.New("comment is deleted")
+	ErrCommentEditWindowExpired       = errors.New("comment can't be edited anymore")
 	ErrInvalidCommentParentID         = errors.New("invalid comment parent_id")
 	ErrReplyToCommentOfAnotherPost    = errors.New("reply to comment of another post")
 	ErrParentCommentNotFound          = errors.New("parent comment not found")
@@ -51,6 +79,7 @@ var (
 	ErrNoSuchModerator      = errors.New("moderator does not exist")
 	ErrGettingReportReasons = errors.New("error getting report reasons")
 	ErrUserAlreadyBanned    = errors.New("user already banned")
+	ErrNoModerationDecision = errors.New("no moderation decision")
 	// report errors
 	ErrToCreateReport  = errors.New("error creating report")
 	ErrDuplicateReport = errors.New("duplicate report")
@@ -59,4 +88,19 @@ var (
 	ErrTargetNotFound             = errors.New("target not found")
 	ErrInvalidReportableType      = errors.New("invalid reportable type")
 	ErrContentAlreadyOnModeration = errors.New("content already on moderation")
+	ErrNotChatMember              = errors.New("user is not a member of the chat")
+	ErrNoSuchReview               = errors.New("no such review")
+	ErrNoSuchMessage              = errors.New("no such message")
+	ErrNotOnModeration            = errors.New("target is not on moderation")
+
+	// media errors
+	ErrTooManyPhotos   = errors.New("too many photos")
+	ErrNoPhotos        = errors.New("at least one photo is required")
+	ErrBlobNotFound    = errors.New("blob not found")
+	ErrInvalidMediaKey = errors.New("invalid media key")
+	ErrPhotoTooLarge   = errors.New("photo is too large")
+	ErrInvalidPhoto    = errors.New("photo is not a valid image")
+	ErrPhotoFormat     = errors.New("unsupported photo format, jpeg, png and gif are allowed")
+	ErrPhotoDimensions = errors.New("photo dimensions are too large")
+	ErrMediaNotFound   = errors.New("photo not found")
 )
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type (
+	// FeedPreferences - what the user wants to see in the feed, empty list matches any value.
+	FeedPreferences struct {
+		UserID      int            `gorm:"column:user_id;primaryKey"`
+		AnimalTypes pq.StringArray `gorm:"column:animal_types;type:varchar(20)[]"`
+		Statuses    pq.StringArray `gorm:"column:statuses;type:varchar(20)[]"` // Statuses of animals, e.g. lost
+		Colors      pq.StringArray `gorm:"column:colors;type:varchar(20)[]"`   // Colors from AnimalColors, animals having any of them match
+		Latitude    *float64       `gorm:"column:latitude"`                    // Home of the user, posts around it are nearby
+		Longitude   *float64       `gorm:"column:longitude"`
+		Radius      *float64       `gorm:"column:radius"` // Kilometers around the location, FeedServiceConfig.Radius if nil
+		UpdatedAt   time.Time      `gorm:"column:updated_at"`
+	}
+
+	// FeedParams - the page of the feed of the user.
+	FeedParams struct {
+		UserID    int
+		Limit     *int
+		Cursor    *Cursor  // Last post of the previous page
+		Latitude  *float64 // Current location of the user, location of preferences is used if nil
+		Longitude *float64
+	}
+
+	// FeedWeights - score the post gets for every reason to be in the feed.
+	FeedWeights struct {
+		Nearby         float64
+		Preferences    float64
+		FavouriteUsers float64
+		Interactions   float64
+	}
+
+	// FeedQuery - the page of the feed with everything the store needs to score posts.
+	FeedQuery struct {
+		UserID           int
+		Limit            *int
+		Cursor           *Cursor
+		Preferences      FeedPreferences
+		Latitude         *float64 // Posts within Radius kilometers from the point are nearby, no posts are nearby if nil
+		Longitude        *float64
+		Radius           float64
+		Weights          FeedWeights
+		HalfLife         time.Duration // Score of the post halves every HalfLife after its bump, 0 disables decay
+		Now              time.Time     // Moment the age of posts is counted at, the same for every page of the feed
+		ExcludeAuthorIDs []int
+	}
+
+	// FeedPost - post of the feed with its score and reasons it got into the feed.
+	FeedPost struct {
+		PostDetails PostDetails
+		Score       float64
+		Reasons     []FeedReason
+		ScoredAt    time.Time // Moment the age of the post was counted at, the same for every page of the feed
+	}
+
+	FeedServiceConfig struct {
+		Radius   float64       // Default radius of nearby posts in kilometers
+		Weights  FeedWeights   // Weight 0 leaves posts of the reason out of the feed
+		HalfLife time.Duration // Time after which score of the post halves, 0 disables decay
+	}
+
+	// FeedStore - posts of the feed are published active posts of other users having at least one reason.
+	FeedStore interface {
+		// GetFeed returns posts of the feed ordered by score, PostDetails of posts contain the post only.
+		GetFeed(ctx context.Context, query FeedQuery) (posts []FeedPost, total int, err error)
+		// GetFeedPreferences returns empty preferences of the user who didn't save them.
+		GetFeedPreferences(ctx context.Context, userID int) (preferences FeedPreferences, err error)
+		SaveFeedPreferences(ctx context.Context, preferences FeedPreferences) (saved FeedPreferences, err error)
+	}
+
+	FeedService interface {
+		GetFeed(ctx context.Context, params FeedParams) (posts []FeedPost, total int, err error)
+		GetFeedPreferences(ctx context.Context, userID int) (preferences FeedPreferences, err error)
+		// UpdateFeedPreferences replaces preferences of the user.
+		UpdateFeedPreferences(ctx context.Context, preferences FeedPreferences) (updated FeedPreferences, err error)
+	}
+)
+
+// FeedReason - why the post got into the feed.
+type FeedReason string
+
+const (
+	FeedReasonNearby        FeedReason = "nearby"         // The post is close to the location of the user
+	FeedReasonPreferences   FeedReason = "preferences"    // The animal matches preferences of the user
+	FeedReasonFavouriteUser FeedReason = "favourite_user" // The author is in favourites of the user
+	FeedReasonInteraction   FeedReason = "interaction"    // The user liked, commented or favourited the post
+)
+
+// FeedSort - cursor of the feed points at the score of the last post and keeps the moment the first page was scored at.
+const FeedSort = "feed"
+
+// Cursor returns cursor pointing at the post of the feed.
+func (p FeedPost) Cursor() Cursor {
+	scoredAt, score := p.ScoredAt, p.Score
+	return Cursor{Sort: FeedSort, Time: &scoredAt, Number: &score, ID: p.PostDetails.Post.ID}
+}
+
+// HasAnimalPreferences reports whether the user chose animals they want to see.
+func (p FeedPreferences) HasAnimalPreferences() bool {
+	return len(p.AnimalTypes) > 0 || len(p.Statuses) > 0 || len(p.Colors) > 0
+}
+
+func (FeedPreferences) TableName() string {
+	return "feed_preferences"
+}
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	CommentLike struct {
+		ID        int       `gorm:"column:id;primaryKey"`
+		CommentID int       `gorm:"column:comment_id"`
+		UserID    int       `gorm:"column:user_id"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+	}
+
+	PostLike struct {
+		ID        int       `gorm:"column:id;primaryKey"`
+		PostID    int       `gorm:"column:post_id"`
+		UserID    int       `gorm:"column:user_id"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+	}
+
+	// Likes - engagement of the comment or the post.
+	Likes struct {
+		Count     int
+		LikedByMe bool // The current user liked it, always false for anonymous users
+	}
+
+	// LikeStore - the user likes the comment or the post at most once, repeated like and unlike of not liked are ignored.
+	LikeStore interface {
+		LikeComment(ctx context.Context, like CommentLike) error
+		UnlikeComment(ctx context.Context, commentID, userID int) error
+		// GetCommentsLikes returns likes by IDs of comments, userID 0 is anonymous user.
+		GetCommentsLikes(ctx context.Context, commentIDs []int, userID int) (map[int]Likes, error)
+		LikePost(ctx context.Context, like PostLike) error
+		UnlikePost(ctx context.Context, postID, userID int) error
+		// GetPostsLikes returns likes by IDs of posts, userID 0 is anonymous user.
+		GetPostsLikes(ctx context.Context, postIDs []int, userID int) (map[int]Likes, error)
+	}
+)
+
+func (CommentLike) TableName() string {
+	return "comment_likes"
+}
+
+func (PostLike) TableName() string {
+	return "post_likes"
+}
@@ -0,0 +1,169 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Media - metadata of the file stored in BlobStore. The file itself is never stored in the database.
+	Media struct {
+		ID          int            `gorm:"column:id;primaryKey"`
+		OwnerType   MediaOwnerType `gorm:"column:owner_type"`   // Type of the entity the file belongs to
+		OwnerID     int            `gorm:"column:owner_id"`     // ID of the entity the file belongs to
+		Position    int            `gorm:"column:position"`     // Order of the file among files of the owner
+		StorageKey  string         `gorm:"column:storage_key"`  // Key of the file in BlobStore
+		ContentType string         `gorm:"column:content_type"` // MIME type of the file
+		Size        int64          `gorm:"column:size"`         // Size of the file in bytes
+		Width       int            `gorm:"column:width"`
+		Height      int            `gorm:"column:height"`
+		Hash        *int64         `gorm:"column:phash"` // Perceptual hash of the photo, nil for files which are not photos
+		URL         string         `gorm:"-"`            // Public URL of the file, filled by MediaService
+		CreatedAt   time.Time      `gorm:"column:created_at"`
+		Variants    []MediaVariant `gorm:"foreignKey:MediaID"` // Downscaled copies of the file
+	}
+
+	// MediaVariant - downscaled copy of the photo, e.g. thumbnail in WebP format.
+	MediaVariant struct {
+		ID          int              `gorm:"column:id;primaryKey"`
+		MediaID     int              `gorm:"column:media_id"`
+		Name        MediaVariantName `gorm:"column:name"`
+		StorageKey  string           `gorm:"column:storage_key"`
+		ContentType string           `gorm:"column:content_type"`
+		Size        int64            `gorm:"column:size"`
+		Width       int              `gorm:"column:width"`
+		Height      int              `gorm:"column:height"`
+		URL         string           `gorm:"-"`
+	}
+
+	// MediaUpload - file uploaded by the user. Content is never stored as is, it is decoded and re-encoded.
+	MediaUpload struct {
+		Data []byte
+	}
+
+	// MediaFile - file served to clients: the photo itself or one of its variants.
+	MediaFile struct {
+		StorageKey  string
+		ContentType string
+		ModifiedAt  time.Time // Files are never changed after upload, so it is time of the upload
+		Private     bool      // Photo is visible to the requesting user only, e.g. photo of the draft, so shared caches must not keep it
+	}
+
+	// MediaFileParams - size and format of the requested photo.
+	MediaFileParams struct {
+		Variant  MediaVariantName // Empty for the photo itself
+		WebP     bool             // Whether WebP variant is requested instead of the format of the photo
+		ViewerID int              // User who requests the photo, 0 for anonymous requests
+	}
+
+	// MediaOwner - entity media belongs to, used to check who may see its media.
+	MediaOwner struct {
+		Status   string `gorm:"column:status"`    // Status of the post or the user, empty for events of animals
+		AuthorID int    `gorm:"column:author_id"` // Author of the post or the event, the user itself for avatars
+	}
+
+	// MediaMatch - entity which has a photo similar to the searched one.
+	MediaMatch struct {
+		OwnerID  int `gorm:"column:owner_id"`
+		Distance int `gorm:"column:distance"` // Hamming distance between hashes of the closest photo of the owner and the searched one
+	}
+
+	// LegacyBlob - photo moved out of BYTEA columns by migration which still has to be uploaded to BlobStore.
+	LegacyBlob struct {
+		MediaID    int    `gorm:"column:media_id"`
+		StorageKey string `gorm:"column:storage_key"`
+		Data       []byte `gorm:"column:data"`
+	}
+
+	MediaServiceConfig struct {
+		MaxPostPhotos  int   // Maximal amount of photos attached to a single post or event of the animal
+		MaxPhotoSize   int64 // Maximal size of uploaded photo in bytes
+		MaxPhotoPixels int   // Maximal width * height of uploaded photo, checked before the photo is decoded
+		MediumSize     int   // Longest side of medium variant
+		ThumbnailSize  int   // Longest side of thumbnail variant
+		Quality        int   // Quality of lossy encoding, 1-100
+	}
+
+	// BlobStore keeps content of the files, e.g. on local filesystem or in S3 compatible storage.
+	BlobStore interface {
+		Put(ctx context.Context, key, contentType string, data []byte) error
+		Get(ctx context.Context, key string) (data []byte, err error)
+		Delete(ctx context.Context, key string) error
+		URL(key string) string
+	}
+
+	MediaStore interface {
+		GetMediaByOwner(ctx context.Context, ownerType MediaOwnerType, ownerID int) (media []Media, err error)
+		GetMediaByOwners(ctx context.Context, ownerType MediaOwnerType, ownerIDs []int) (media []Media, err error)
+		ReplaceMedia(ctx context.Context, ownerType MediaOwnerType, ownerID int, media []Media) (replaced []Media, err error)
+		GetLegacyBlobs(ctx context.Context, limit int) (blobs []LegacyBlob, err error)
+		CompleteLegacyBlob(ctx context.Context, media Media) error
+		FindSimilarMedia(ctx context.Context, ownerType MediaOwnerType, hash int64, maxDistance, limit int) (matches []MediaMatch, err error)
+		GetMediaWithoutHash(ctx context.Context, afterID, limit int) (media []Media, err error)
+		SetMediaHash(ctx context.Context, id int, hash int64) error
+		GetMediaByID(ctx context.Context, id int) (media Media, err error)
+		GetMediaOwner(ctx context.Context, ownerType MediaOwnerType, ownerID int) (owner MediaOwner, err error)
+	}
+
+	MediaService interface {
+		SetPostPhotos(ctx context.Context, postID int, photos []MediaUpload) (media []Media, err error)
+		GetPostPhotos(ctx context.Context, postID int) (media []Media, err error)
+		GetPostsPhotos(ctx context.Context, postIDs []int) (media map[int][]Media, err error)
+		SetUserPhoto(ctx context.Context, userID int, photo MediaUpload) (media Media, err error)
+		GetUserPhoto(ctx context.Context, userID int) (media *Media, err error)
+		SetAnimalEventPhotos(ctx context.Context, eventID int, photos []MediaUpload) (media []Media, err error)
+		GetAnimalEventsPhotos(ctx context.Context, eventIDs []int) (media map[int][]Media, err error)
+		MoveLegacyBlobs(ctx context.Context) error
+		HashPhoto(photo MediaUpload) (hash int64, err error)
+		FindSimilarPhotos(ctx context.Context, ownerType MediaOwnerType, hash int64, maxDistance, limit int) (matches []MediaMatch, err error)
+		HashMissingPhotos(ctx context.Context) error
+		GetMediaFile(ctx context.Context, id int, params MediaFileParams) (file MediaFile, err error)
+		GetPostPhotoFile(ctx context.Context, postID, position int, params MediaFileParams) (file MediaFile, err error)
+		GetUserPhotoFile(ctx context.Context, userID int, params MediaFileParams) (file MediaFile, err error)
+		ReadMediaFile(ctx context.Context, file MediaFile) (data []byte, err error)
+	}
+)
+
+// MediaOwnerType - type of the entity media belongs to.
+type MediaOwnerType string
+
+const (
+	MediaOwnerPost MediaOwnerType = "post"
+	MediaOwnerUser MediaOwnerType = "user"
+	// MediaOwnerAnimalEvent - photos of the event of the case history of the animal
+	MediaOwnerAnimalEvent MediaOwnerType = "animal_event"
+)
+
+// MediaVariantName - size of the downscaled copy of the photo.
+type MediaVariantName string
+
+const (
+	MediaVariantMedium    MediaVariantName = "medium"
+	MediaVariantThumbnail MediaVariantName = "thumbnail"
+)
+
+// LegacyBlobsBatchSize - amount of legacy photos moved to BlobStore at once.
+const LegacyBlobsBatchSize = 20
+
+const (
+	// DuplicatePhotoDistance - maximal distance between hashes of photos considered to be the same photo.
+	DuplicatePhotoDistance = 4
+	// DefaultSimilarPhotoDistance - maximal distance between hashes of similar photos when the client doesn't set it.
+	DefaultSimilarPhotoDistance = 10
+	// MaxPhotoHashDistance - hashes have 64 bits, photos with more than half of different bits are not similar at all.
+	MaxPhotoHashDistance = 32
+	// DefaultSimilarPostsLimit - amount of posts found by photo when the client doesn't set it.
+	DefaultSimilarPostsLimit = 20
+)
+
+func (Media) TableName() string {
+	return "media"
+}
+
+func (MediaVariant) TableName() string {
+	return "media_variants"
+}
+
+func (LegacyBlob) TableName() string {
+	return "media_legacy_blobs"
+}
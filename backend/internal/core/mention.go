@@ -0,0 +1,76 @@
+package core
+
+import (
+	"context"
+	"regexp"
+	"time"
+	"unicode/utf8"
+)
+
+type (
+	// CommentMention - "@username" in content of the comment resolved to the user.
+	CommentMention struct {
+		ID        int       `gorm:"column:id;primaryKey"`
+		CommentID int       `gorm:"column:comment_id"`
+		UserID    int       `gorm:"column:user_id"`
+		Username  string    `gorm:"->;column:username"` // Current username of the user, filled by MentionStore.GetCommentsMentions
+		Offset    int       `gorm:"column:offset"`      // Position of "@username" in content in characters
+		Length    int       `gorm:"column:length"`      // Length of "@username" in characters
+		CreatedAt time.Time `gorm:"column:created_at"`
+	}
+
+	// Mention - "@username" found in the text.
+	Mention struct {
+		Username string
+		Offset   int // Position of "@" in the text in characters
+		Length   int // Length of "@username" in characters
+	}
+
+	MentionStore interface {
+		// SaveCommentMentions replaces mentions of the comment, empty mentions remove all of them.
+		SaveCommentMentions(ctx context.Context, commentID int, mentions []CommentMention) error
+		// GetCommentsMentions returns mentions by IDs of comments ordered by position, comments without mentions are missing.
+		GetCommentsMentions(ctx context.Context, commentIDs []int) (map[int][]CommentMention, error)
+	}
+)
+
+// MaxCommentMentions - maximal amount of users mentioned in the comment, the rest of mentions are left as plain text.
+const MaxCommentMentions = 10
+
+// mentionPattern - usernames consist of latin letters and digits, see validation of usernames.
+var mentionPattern = regexp.MustCompile(`@([a-zA-Z0-9]{1,50})`)
+
+// ParseMentions finds "@username" in the text. "@" following a letter or a digit, as in e-mail addresses, is not a mention.
+func ParseMentions(text string) []Mention {
+	var mentions []Mention
+	for _, match := range mentionPattern.FindAllStringSubmatchIndex(text, -1) {
+		start, end := match[0], match[1]
+		if start > 0 {
+			previous, _ := utf8.DecodeLastRuneInString(text[:start])
+			if previous == '_' || isASCIIAlphaNum(previous) {
+				continue
+			}
+		}
+		if end < len(text) {
+			next, _ := utf8.DecodeRuneInString(text[end:])
+			if next == '_' || next == '@' || next == '.' && end+1 < len(text) && isASCIIAlphaNum(rune(text[end+1])) {
+				continue // part of the longer word or e-mail address
+			}
+		}
+
+		mentions = append(mentions, Mention{
+			Username: text[match[2]:match[3]],
+			Offset:   utf8.RuneCountInString(text[:start]),
+			Length:   utf8.RuneCountInString(text[start:end]),
+		})
+	}
+	return mentions
+}
+
+func isASCIIAlphaNum(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+func (CommentMention) TableName() string {
+	return "comment_mentions"
+}
@@ -0,0 +1,32 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Message is a message sent by user to chat.
+	Message struct {
+		ID         int        `gorm:"column:id;primaryKey"`
+		UserID     int        `gorm:"column:user_id"`     // ID of the author of the message
+		ChatID     int        `gorm:"column:chat_id"`     // ID of the chat message was sent to
+		Content    string     `gorm:"column:content"`     // Text of the message
+		SenderName string     `gorm:"column:sender_name"` // Name of the author of the message
+		IsDeleted  bool       `gorm:"column:is_deleted"`  // IsDeleted shows whether message was deleted
+		DeletedAt  *time.Time `gorm:"column:deleted_at"`  // Timestamp when the message was deleted
+		CreatedAt  time.Time  `gorm:"column:created_at"`  // Timestamp when the message was created
+		UpdatedAt  time.Time  `gorm:"column:updated_at"`  // Timestamp when the message was last updated
+	}
+
+	MessageStore interface {
+		GetMessageByID(ctx context.Context, id int) (message Message, err error)
+		IsChatMember(ctx context.Context, chatID, userID int) (isMember bool, err error)
+		DeleteMessage(ctx context.Context, id int) (err error)
+	}
+)
+
+// TableName table name in db for gorm
+func (Message) TableName() string {
+	return "messages"
+}
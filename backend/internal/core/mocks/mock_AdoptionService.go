@@ -0,0 +1,391 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAdoptionService is an autogenerated mock type for the AdoptionService type
+type MockAdoptionService struct {
+	mock.Mock
+}
+
+type MockAdoptionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAdoptionService) EXPECT() *MockAdoptionService_Expecter {
+	return &MockAdoptionService_Expecter{mock: &_m.Mock}
+}
+
+// Apply provides a mock function with given fields: ctx, application
+func (_m *MockAdoptionService) Apply(ctx context.Context, application core.AdoptionApplication) (core.AdoptionApplication, error) {
+	ret := _m.Called(ctx, application)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Apply")
+	}
+
+	var r0 core.AdoptionApplication
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.AdoptionApplication) (core.AdoptionApplication, error)); ok {
+		return rf(ctx, application)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.AdoptionApplication) core.AdoptionApplication); ok {
+		r0 = rf(ctx, application)
+	} else {
+		r0 = ret.Get(0).(core.AdoptionApplication)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.AdoptionApplication) error); ok {
+		r1 = rf(ctx, application)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdoptionService_Apply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Apply'
+type MockAdoptionService_Apply_Call struct {
+	*mock.Call
+}
+
+// Apply is a helper method to define mock.On call
+//   - ctx context.Context
+//   - application core.AdoptionApplication
+func (_e *MockAdoptionService_Expecter) Apply(ctx interface{}, application interface{}) *MockAdoptionService_Apply_Call {
+	return &MockAdoptionService_Apply_Call{Call: _e.mock.On("Apply", ctx, application)}
+}
+
+func (_c *MockAdoptionService_Apply_Call) Run(run func(ctx context.Context, application core.AdoptionApplication)) *MockAdoptionService_Apply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.AdoptionApplication))
+	})
+	return _c
+}
+
+func (_c *MockAdoptionService_Apply_Call) Return(_a0 core.AdoptionApplication, _a1 error) *MockAdoptionService_Apply_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdoptionService_Apply_Call) RunAndReturn(run func(context.Context, core.AdoptionApplication) (core.AdoptionApplication, error)) *MockAdoptionService_Apply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnimalApplications provides a mock function with given fields: ctx, userID, params
+func (_m *MockAdoptionService) GetAnimalApplications(ctx context.Context, userID int, params core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error) {
+	ret := _m.Called(ctx, userID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnimalApplications")
+	}
+
+	var r0 []core.AdoptionApplication
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error)); ok {
+		return rf(ctx, userID, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAdoptionApplicationsParams) []core.AdoptionApplication); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.AdoptionApplication)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.GetAdoptionApplicationsParams) int); ok {
+		r1 = rf(ctx, userID, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, core.GetAdoptionApplicationsParams) error); ok {
+		r2 = rf(ctx, userID, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAdoptionService_GetAnimalApplications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnimalApplications'
+type MockAdoptionService_GetAnimalApplications_Call struct {
+	*mock.Call
+}
+
+// GetAnimalApplications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - params core.GetAdoptionApplicationsParams
+func (_e *MockAdoptionService_Expecter) GetAnimalApplications(ctx interface{}, userID interface{}, params interface{}) *MockAdoptionService_GetAnimalApplications_Call {
+	return &MockAdoptionService_GetAnimalApplications_Call{Call: _e.mock.On("GetAnimalApplications", ctx, userID, params)}
+}
+
+func (_c *MockAdoptionService_GetAnimalApplications_Call) Run(run func(ctx context.Context, userID int, params core.GetAdoptionApplicationsParams)) *MockAdoptionService_GetAnimalApplications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(core.GetAdoptionApplicationsParams))
+	})
+	return _c
+}
+
+func (_c *MockAdoptionService_GetAnimalApplications_Call) Return(applications []core.AdoptionApplication, total int, err error) *MockAdoptionService_GetAnimalApplications_Call {
+	_c.Call.Return(applications, total, err)
+	return _c
+}
+
+func (_c *MockAdoptionService_GetAnimalApplications_Call) RunAndReturn(run func(context.Context, int, core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error)) *MockAdoptionService_GetAnimalApplications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApplication provides a mock function with given fields: ctx, userID, id
+func (_m *MockAdoptionService) GetApplication(ctx context.Context, userID int, id int) (core.AdoptionApplication, error) {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApplication")
+	}
+
+	var r0 core.AdoptionApplication
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (core.AdoptionApplication, error)); ok {
+		return rf(ctx, userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) core.AdoptionApplication); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		r0 = ret.Get(0).(core.AdoptionApplication)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdoptionService_GetApplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApplication'
+type MockAdoptionService_GetApplication_Call struct {
+	*mock.Call
+}
+
+// GetApplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - id int
+func (_e *MockAdoptionService_Expecter) GetApplication(ctx interface{}, userID interface{}, id interface{}) *MockAdoptionService_GetApplication_Call {
+	return &MockAdoptionService_GetApplication_Call{Call: _e.mock.On("GetApplication", ctx, userID, id)}
+}
+
+func (_c *MockAdoptionService_GetApplication_Call) Run(run func(ctx context.Context, userID int, id int)) *MockAdoptionService_GetApplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockAdoptionService_GetApplication_Call) Return(_a0 core.AdoptionApplication, _a1 error) *MockAdoptionService_GetApplication_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdoptionService_GetApplication_Call) RunAndReturn(run func(context.Context, int, int) (core.AdoptionApplication, error)) *MockAdoptionService_GetApplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuestionnaire provides a mock function with given fields: ctx
+func (_m *MockAdoptionService) GetQuestionnaire(ctx context.Context) []core.AdoptionQuestion {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestionnaire")
+	}
+
+	var r0 []core.AdoptionQuestion
+	if rf, ok := ret.Get(0).(func(context.Context) []core.AdoptionQuestion); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.AdoptionQuestion)
+		}
+	}
+
+	return r0
+}
+
+// MockAdoptionService_GetQuestionnaire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionnaire'
+type MockAdoptionService_GetQuestionnaire_Call struct {
+	*mock.Call
+}
+
+// GetQuestionnaire is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAdoptionService_Expecter) GetQuestionnaire(ctx interface{}) *MockAdoptionService_GetQuestionnaire_Call {
+	return &MockAdoptionService_GetQuestionnaire_Call{Call: _e.mock.On("GetQuestionnaire", ctx)}
+}
+
+func (_c *MockAdoptionService_GetQuestionnaire_Call) Run(run func(ctx context.Context)) *MockAdoptionService_GetQuestionnaire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAdoptionService_GetQuestionnaire_Call) Return(_a0 []core.AdoptionQuestion) *MockAdoptionService_GetQuestionnaire_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAdoptionService_GetQuestionnaire_Call) RunAndReturn(run func(context.Context) []core.AdoptionQuestion) *MockAdoptionService_GetQuestionnaire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserApplications provides a mock function with given fields: ctx, userID, params
+func (_m *MockAdoptionService) GetUserApplications(ctx context.Context, userID int, params core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error) {
+	ret := _m.Called(ctx, userID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserApplications")
+	}
+
+	var r0 []core.AdoptionApplication
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error)); ok {
+		return rf(ctx, userID, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAdoptionApplicationsParams) []core.AdoptionApplication); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.AdoptionApplication)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.GetAdoptionApplicationsParams) int); ok {
+		r1 = rf(ctx, userID, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, core.GetAdoptionApplicationsParams) error); ok {
+		r2 = rf(ctx, userID, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAdoptionService_GetUserApplications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserApplications'
+type MockAdoptionService_GetUserApplications_Call struct {
+	*mock.Call
+}
+
+// GetUserApplications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - params core.GetAdoptionApplicationsParams
+func (_e *MockAdoptionService_Expecter) GetUserApplications(ctx interface{}, userID interface{}, params interface{}) *MockAdoptionService_GetUserApplications_Call {
+	return &MockAdoptionService_GetUserApplications_Call{Call: _e.mock.On("GetUserApplications", ctx, userID, params)}
+}
+
+func (_c *MockAdoptionService_GetUserApplications_Call) Run(run func(ctx context.Context, userID int, params core.GetAdoptionApplicationsParams)) *MockAdoptionService_GetUserApplications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(core.GetAdoptionApplicationsParams))
+	})
+	return _c
+}
+
+func (_c *MockAdoptionService_GetUserApplications_Call) Return(applications []core.AdoptionApplication, total int, err error) *MockAdoptionService_GetUserApplications_Call {
+	_c.Call.Return(applications, total, err)
+	return _c
+}
+
+func (_c *MockAdoptionService_GetUserApplications_Call) RunAndReturn(run func(context.Context, int, core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error)) *MockAdoptionService_GetUserApplications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateApplication provides a mock function with given fields: ctx, update
+func (_m *MockAdoptionService) UpdateApplication(ctx context.Context, update core.UpdateAdoptionApplication) (core.AdoptionApplication, error) {
+	ret := _m.Called(ctx, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateApplication")
+	}
+
+	var r0 core.AdoptionApplication
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.UpdateAdoptionApplication) (core.AdoptionApplication, error)); ok {
+		return rf(ctx, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.UpdateAdoptionApplication) core.AdoptionApplication); ok {
+		r0 = rf(ctx, update)
+	} else {
+		r0 = ret.Get(0).(core.AdoptionApplication)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.UpdateAdoptionApplication) error); ok {
+		r1 = rf(ctx, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdoptionService_UpdateApplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateApplication'
+type MockAdoptionService_UpdateApplication_Call struct {
+	*mock.Call
+}
+
+// UpdateApplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - update core.UpdateAdoptionApplication
+func (_e *MockAdoptionService_Expecter) UpdateApplication(ctx interface{}, update interface{}) *MockAdoptionService_UpdateApplication_Call {
+	return &MockAdoptionService_UpdateApplication_Call{Call: _e.mock.On("UpdateApplication", ctx, update)}
+}
+
+func (_c *MockAdoptionService_UpdateApplication_Call) Run(run func(ctx context.Context, update core.UpdateAdoptionApplication)) *MockAdoptionService_UpdateApplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.UpdateAdoptionApplication))
+	})
+	return _c
+}
+
+func (_c *MockAdoptionService_UpdateApplication_Call) Return(_a0 core.AdoptionApplication, _a1 error) *MockAdoptionService_UpdateApplication_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdoptionService_UpdateApplication_Call) RunAndReturn(run func(context.Context, core.UpdateAdoptionApplication) (core.AdoptionApplication, error)) *MockAdoptionService_UpdateApplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAdoptionService creates a new instance of MockAdoptionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAdoptionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAdoptionService {
+	mock := &MockAdoptionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
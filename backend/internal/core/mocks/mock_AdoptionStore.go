@@ -0,0 +1,332 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAdoptionStore is an autogenerated mock type for the AdoptionStore type
+type MockAdoptionStore struct {
+	mock.Mock
+}
+
+type MockAdoptionStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAdoptionStore) EXPECT() *MockAdoptionStore_Expecter {
+	return &MockAdoptionStore_Expecter{mock: &_m.Mock}
+}
+
+// ApproveApplication provides a mock function with given fields: ctx, application, approvedBy
+func (_m *MockAdoptionStore) ApproveApplication(ctx context.Context, application core.AdoptionApplication, approvedBy int) (core.AdoptionApplication, error) {
+	ret := _m.Called(ctx, application, approvedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveApplication")
+	}
+
+	var r0 core.AdoptionApplication
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.AdoptionApplication, int) (core.AdoptionApplication, error)); ok {
+		return rf(ctx, application, approvedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.AdoptionApplication, int) core.AdoptionApplication); ok {
+		r0 = rf(ctx, application, approvedBy)
+	} else {
+		r0 = ret.Get(0).(core.AdoptionApplication)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.AdoptionApplication, int) error); ok {
+		r1 = rf(ctx, application, approvedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdoptionStore_ApproveApplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveApplication'
+type MockAdoptionStore_ApproveApplication_Call struct {
+	*mock.Call
+}
+
+// ApproveApplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - application core.AdoptionApplication
+//   - approvedBy int
+func (_e *MockAdoptionStore_Expecter) ApproveApplication(ctx interface{}, application interface{}, approvedBy interface{}) *MockAdoptionStore_ApproveApplication_Call {
+	return &MockAdoptionStore_ApproveApplication_Call{Call: _e.mock.On("ApproveApplication", ctx, application, approvedBy)}
+}
+
+func (_c *MockAdoptionStore_ApproveApplication_Call) Run(run func(ctx context.Context, application core.AdoptionApplication, approvedBy int)) *MockAdoptionStore_ApproveApplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.AdoptionApplication), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockAdoptionStore_ApproveApplication_Call) Return(_a0 core.AdoptionApplication, _a1 error) *MockAdoptionStore_ApproveApplication_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdoptionStore_ApproveApplication_Call) RunAndReturn(run func(context.Context, core.AdoptionApplication, int) (core.AdoptionApplication, error)) *MockAdoptionStore_ApproveApplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateApplication provides a mock function with given fields: ctx, application
+func (_m *MockAdoptionStore) CreateApplication(ctx context.Context, application core.AdoptionApplication) (core.AdoptionApplication, error) {
+	ret := _m.Called(ctx, application)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateApplication")
+	}
+
+	var r0 core.AdoptionApplication
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.AdoptionApplication) (core.AdoptionApplication, error)); ok {
+		return rf(ctx, application)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.AdoptionApplication) core.AdoptionApplication); ok {
+		r0 = rf(ctx, application)
+	} else {
+		r0 = ret.Get(0).(core.AdoptionApplication)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.AdoptionApplication) error); ok {
+		r1 = rf(ctx, application)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdoptionStore_CreateApplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateApplication'
+type MockAdoptionStore_CreateApplication_Call struct {
+	*mock.Call
+}
+
+// CreateApplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - application core.AdoptionApplication
+func (_e *MockAdoptionStore_Expecter) CreateApplication(ctx interface{}, application interface{}) *MockAdoptionStore_CreateApplication_Call {
+	return &MockAdoptionStore_CreateApplication_Call{Call: _e.mock.On("CreateApplication", ctx, application)}
+}
+
+func (_c *MockAdoptionStore_CreateApplication_Call) Run(run func(ctx context.Context, application core.AdoptionApplication)) *MockAdoptionStore_CreateApplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.AdoptionApplication))
+	})
+	return _c
+}
+
+func (_c *MockAdoptionStore_CreateApplication_Call) Return(_a0 core.AdoptionApplication, _a1 error) *MockAdoptionStore_CreateApplication_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdoptionStore_CreateApplication_Call) RunAndReturn(run func(context.Context, core.AdoptionApplication) (core.AdoptionApplication, error)) *MockAdoptionStore_CreateApplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApplicationByID provides a mock function with given fields: ctx, id
+func (_m *MockAdoptionStore) GetApplicationByID(ctx context.Context, id int) (core.AdoptionApplication, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApplicationByID")
+	}
+
+	var r0 core.AdoptionApplication
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (core.AdoptionApplication, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) core.AdoptionApplication); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(core.AdoptionApplication)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdoptionStore_GetApplicationByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApplicationByID'
+type MockAdoptionStore_GetApplicationByID_Call struct {
+	*mock.Call
+}
+
+// GetApplicationByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockAdoptionStore_Expecter) GetApplicationByID(ctx interface{}, id interface{}) *MockAdoptionStore_GetApplicationByID_Call {
+	return &MockAdoptionStore_GetApplicationByID_Call{Call: _e.mock.On("GetApplicationByID", ctx, id)}
+}
+
+func (_c *MockAdoptionStore_GetApplicationByID_Call) Run(run func(ctx context.Context, id int)) *MockAdoptionStore_GetApplicationByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAdoptionStore_GetApplicationByID_Call) Return(_a0 core.AdoptionApplication, _a1 error) *MockAdoptionStore_GetApplicationByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdoptionStore_GetApplicationByID_Call) RunAndReturn(run func(context.Context, int) (core.AdoptionApplication, error)) *MockAdoptionStore_GetApplicationByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApplications provides a mock function with given fields: ctx, params
+func (_m *MockAdoptionStore) GetApplications(ctx context.Context, params core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApplications")
+	}
+
+	var r0 []core.AdoptionApplication
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetAdoptionApplicationsParams) []core.AdoptionApplication); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.AdoptionApplication)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetAdoptionApplicationsParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetAdoptionApplicationsParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAdoptionStore_GetApplications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApplications'
+type MockAdoptionStore_GetApplications_Call struct {
+	*mock.Call
+}
+
+// GetApplications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetAdoptionApplicationsParams
+func (_e *MockAdoptionStore_Expecter) GetApplications(ctx interface{}, params interface{}) *MockAdoptionStore_GetApplications_Call {
+	return &MockAdoptionStore_GetApplications_Call{Call: _e.mock.On("GetApplications", ctx, params)}
+}
+
+func (_c *MockAdoptionStore_GetApplications_Call) Run(run func(ctx context.Context, params core.GetAdoptionApplicationsParams)) *MockAdoptionStore_GetApplications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetAdoptionApplicationsParams))
+	})
+	return _c
+}
+
+func (_c *MockAdoptionStore_GetApplications_Call) Return(applications []core.AdoptionApplication, total int, err error) *MockAdoptionStore_GetApplications_Call {
+	_c.Call.Return(applications, total, err)
+	return _c
+}
+
+func (_c *MockAdoptionStore_GetApplications_Call) RunAndReturn(run func(context.Context, core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error)) *MockAdoptionStore_GetApplications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateApplication provides a mock function with given fields: ctx, application
+func (_m *MockAdoptionStore) UpdateApplication(ctx context.Context, application core.AdoptionApplication) (core.AdoptionApplication, error) {
+	ret := _m.Called(ctx, application)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateApplication")
+	}
+
+	var r0 core.AdoptionApplication
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.AdoptionApplication) (core.AdoptionApplication, error)); ok {
+		return rf(ctx, application)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.AdoptionApplication) core.AdoptionApplication); ok {
+		r0 = rf(ctx, application)
+	} else {
+		r0 = ret.Get(0).(core.AdoptionApplication)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.AdoptionApplication) error); ok {
+		r1 = rf(ctx, application)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdoptionStore_UpdateApplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateApplication'
+type MockAdoptionStore_UpdateApplication_Call struct {
+	*mock.Call
+}
+
+// UpdateApplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - application core.AdoptionApplication
+func (_e *MockAdoptionStore_Expecter) UpdateApplication(ctx interface{}, application interface{}) *MockAdoptionStore_UpdateApplication_Call {
+	return &MockAdoptionStore_UpdateApplication_Call{Call: _e.mock.On("UpdateApplication", ctx, application)}
+}
+
+func (_c *MockAdoptionStore_UpdateApplication_Call) Run(run func(ctx context.Context, application core.AdoptionApplication)) *MockAdoptionStore_UpdateApplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.AdoptionApplication))
+	})
+	return _c
+}
+
+func (_c *MockAdoptionStore_UpdateApplication_Call) Return(_a0 core.AdoptionApplication, _a1 error) *MockAdoptionStore_UpdateApplication_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdoptionStore_UpdateApplication_Call) RunAndReturn(run func(context.Context, core.AdoptionApplication) (core.AdoptionApplication, error)) *MockAdoptionStore_UpdateApplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAdoptionStore creates a new instance of MockAdoptionStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAdoptionStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAdoptionStore {
+	mock := &MockAdoptionStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,450 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAnimalService is an autogenerated mock type for the AnimalService type
+type MockAnimalService struct {
+	mock.Mock
+}
+
+type MockAnimalService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnimalService) EXPECT() *MockAnimalService_Expecter {
+	return &MockAnimalService_Expecter{mock: &_m.Mock}
+}
+
+// AddAnimalEvent provides a mock function with given fields: ctx, event, photos
+func (_m *MockAnimalService) AddAnimalEvent(ctx context.Context, event core.AnimalEvent, photos []core.MediaUpload) (core.AnimalEvent, error) {
+	ret := _m.Called(ctx, event, photos)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAnimalEvent")
+	}
+
+	var r0 core.AnimalEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.AnimalEvent, []core.MediaUpload) (core.AnimalEvent, error)); ok {
+		return rf(ctx, event, photos)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.AnimalEvent, []core.MediaUpload) core.AnimalEvent); ok {
+		r0 = rf(ctx, event, photos)
+	} else {
+		r0 = ret.Get(0).(core.AnimalEvent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.AnimalEvent, []core.MediaUpload) error); ok {
+		r1 = rf(ctx, event, photos)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnimalService_AddAnimalEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAnimalEvent'
+type MockAnimalService_AddAnimalEvent_Call struct {
+	*mock.Call
+}
+
+// AddAnimalEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event core.AnimalEvent
+//   - photos []core.MediaUpload
+func (_e *MockAnimalService_Expecter) AddAnimalEvent(ctx interface{}, event interface{}, photos interface{}) *MockAnimalService_AddAnimalEvent_Call {
+	return &MockAnimalService_AddAnimalEvent_Call{Call: _e.mock.On("AddAnimalEvent", ctx, event, photos)}
+}
+
+func (_c *MockAnimalService_AddAnimalEvent_Call) Run(run func(ctx context.Context, event core.AnimalEvent, photos []core.MediaUpload)) *MockAnimalService_AddAnimalEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.AnimalEvent), args[2].([]core.MediaUpload))
+	})
+	return _c
+}
+
+func (_c *MockAnimalService_AddAnimalEvent_Call) Return(_a0 core.AnimalEvent, _a1 error) *MockAnimalService_AddAnimalEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnimalService_AddAnimalEvent_Call) RunAndReturn(run func(context.Context, core.AnimalEvent, []core.MediaUpload) (core.AnimalEvent, error)) *MockAnimalService_AddAnimalEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAnimal provides a mock function with given fields: ctx, animal
+func (_m *MockAnimalService) CreateAnimal(ctx context.Context, animal core.Animal) (core.Animal, error) {
+	ret := _m.Called(ctx, animal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAnimal")
+	}
+
+	var r0 core.Animal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.Animal) (core.Animal, error)); ok {
+		return rf(ctx, animal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.Animal) core.Animal); ok {
+		r0 = rf(ctx, animal)
+	} else {
+		r0 = ret.Get(0).(core.Animal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.Animal) error); ok {
+		r1 = rf(ctx, animal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnimalService_CreateAnimal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAnimal'
+type MockAnimalService_CreateAnimal_Call struct {
+	*mock.Call
+}
+
+// CreateAnimal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - animal core.Animal
+func (_e *MockAnimalService_Expecter) CreateAnimal(ctx interface{}, animal interface{}) *MockAnimalService_CreateAnimal_Call {
+	return &MockAnimalService_CreateAnimal_Call{Call: _e.mock.On("CreateAnimal", ctx, animal)}
+}
+
+func (_c *MockAnimalService_CreateAnimal_Call) Run(run func(ctx context.Context, animal core.Animal)) *MockAnimalService_CreateAnimal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.Animal))
+	})
+	return _c
+}
+
+func (_c *MockAnimalService_CreateAnimal_Call) Return(_a0 core.Animal, _a1 error) *MockAnimalService_CreateAnimal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnimalService_CreateAnimal_Call) RunAndReturn(run func(context.Context, core.Animal) (core.Animal, error)) *MockAnimalService_CreateAnimal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnimalByID provides a mock function with given fields: ctx, id
+func (_m *MockAnimalService) GetAnimalByID(ctx context.Context, id int) (core.Animal, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnimalByID")
+	}
+
+	var r0 core.Animal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (core.Animal, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) core.Animal); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(core.Animal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnimalService_GetAnimalByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnimalByID'
+type MockAnimalService_GetAnimalByID_Call struct {
+	*mock.Call
+}
+
+// GetAnimalByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockAnimalService_Expecter) GetAnimalByID(ctx interface{}, id interface{}) *MockAnimalService_GetAnimalByID_Call {
+	return &MockAnimalService_GetAnimalByID_Call{Call: _e.mock.On("GetAnimalByID", ctx, id)}
+}
+
+func (_c *MockAnimalService_GetAnimalByID_Call) Run(run func(ctx context.Context, id int)) *MockAnimalService_GetAnimalByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAnimalService_GetAnimalByID_Call) Return(_a0 core.Animal, _a1 error) *MockAnimalService_GetAnimalByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnimalService_GetAnimalByID_Call) RunAndReturn(run func(context.Context, int) (core.Animal, error)) *MockAnimalService_GetAnimalByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnimalEvents provides a mock function with given fields: ctx, animalID
+func (_m *MockAnimalService) GetAnimalEvents(ctx context.Context, animalID int) ([]core.AnimalEvent, error) {
+	ret := _m.Called(ctx, animalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnimalEvents")
+	}
+
+	var r0 []core.AnimalEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.AnimalEvent, error)); ok {
+		return rf(ctx, animalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []core.AnimalEvent); ok {
+		r0 = rf(ctx, animalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.AnimalEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, animalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnimalService_GetAnimalEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnimalEvents'
+type MockAnimalService_GetAnimalEvents_Call struct {
+	*mock.Call
+}
+
+// GetAnimalEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - animalID int
+func (_e *MockAnimalService_Expecter) GetAnimalEvents(ctx interface{}, animalID interface{}) *MockAnimalService_GetAnimalEvents_Call {
+	return &MockAnimalService_GetAnimalEvents_Call{Call: _e.mock.On("GetAnimalEvents", ctx, animalID)}
+}
+
+func (_c *MockAnimalService_GetAnimalEvents_Call) Run(run func(ctx context.Context, animalID int)) *MockAnimalService_GetAnimalEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAnimalService_GetAnimalEvents_Call) Return(events []core.AnimalEvent, err error) *MockAnimalService_GetAnimalEvents_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockAnimalService_GetAnimalEvents_Call) RunAndReturn(run func(context.Context, int) ([]core.AnimalEvent, error)) *MockAnimalService_GetAnimalEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnimals provides a mock function with given fields: ctx, params
+func (_m *MockAnimalService) GetAnimals(ctx context.Context, params core.GetAnimalsParams) ([]core.Animal, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnimals")
+	}
+
+	var r0 []core.Animal
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetAnimalsParams) ([]core.Animal, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetAnimalsParams) []core.Animal); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Animal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetAnimalsParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetAnimalsParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAnimalService_GetAnimals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnimals'
+type MockAnimalService_GetAnimals_Call struct {
+	*mock.Call
+}
+
+// GetAnimals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetAnimalsParams
+func (_e *MockAnimalService_Expecter) GetAnimals(ctx interface{}, params interface{}) *MockAnimalService_GetAnimals_Call {
+	return &MockAnimalService_GetAnimals_Call{Call: _e.mock.On("GetAnimals", ctx, params)}
+}
+
+func (_c *MockAnimalService_GetAnimals_Call) Run(run func(ctx context.Context, params core.GetAnimalsParams)) *MockAnimalService_GetAnimals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetAnimalsParams))
+	})
+	return _c
+}
+
+func (_c *MockAnimalService_GetAnimals_Call) Return(animals []core.Animal, total int, err error) *MockAnimalService_GetAnimals_Call {
+	_c.Call.Return(animals, total, err)
+	return _c
+}
+
+func (_c *MockAnimalService_GetAnimals_Call) RunAndReturn(run func(context.Context, core.GetAnimalsParams) ([]core.Animal, int, error)) *MockAnimalService_GetAnimals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAnimalKeeper provides a mock function with given fields: ctx, userID, id, keeperUserID
+func (_m *MockAnimalService) SetAnimalKeeper(ctx context.Context, userID int, id int, keeperUserID *int) (core.Animal, error) {
+	ret := _m.Called(ctx, userID, id, keeperUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAnimalKeeper")
+	}
+
+	var r0 core.Animal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, *int) (core.Animal, error)); ok {
+		return rf(ctx, userID, id, keeperUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, *int) core.Animal); ok {
+		r0 = rf(ctx, userID, id, keeperUserID)
+	} else {
+		r0 = ret.Get(0).(core.Animal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, *int) error); ok {
+		r1 = rf(ctx, userID, id, keeperUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnimalService_SetAnimalKeeper_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAnimalKeeper'
+type MockAnimalService_SetAnimalKeeper_Call struct {
+	*mock.Call
+}
+
+// SetAnimalKeeper is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - id int
+//   - keeperUserID *int
+func (_e *MockAnimalService_Expecter) SetAnimalKeeper(ctx interface{}, userID interface{}, id interface{}, keeperUserID interface{}) *MockAnimalService_SetAnimalKeeper_Call {
+	return &MockAnimalService_SetAnimalKeeper_Call{Call: _e.mock.On("SetAnimalKeeper", ctx, userID, id, keeperUserID)}
+}
+
+func (_c *MockAnimalService_SetAnimalKeeper_Call) Run(run func(ctx context.Context, userID int, id int, keeperUserID *int)) *MockAnimalService_SetAnimalKeeper_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(*int))
+	})
+	return _c
+}
+
+func (_c *MockAnimalService_SetAnimalKeeper_Call) Return(_a0 core.Animal, _a1 error) *MockAnimalService_SetAnimalKeeper_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnimalService_SetAnimalKeeper_Call) RunAndReturn(run func(context.Context, int, int, *int) (core.Animal, error)) *MockAnimalService_SetAnimalKeeper_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAnimal provides a mock function with given fields: ctx, update
+func (_m *MockAnimalService) UpdateAnimal(ctx context.Context, update core.UpdateRequestBodyAnimal) (core.Animal, error) {
+	ret := _m.Called(ctx, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAnimal")
+	}
+
+	var r0 core.Animal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.UpdateRequestBodyAnimal) (core.Animal, error)); ok {
+		return rf(ctx, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.UpdateRequestBodyAnimal) core.Animal); ok {
+		r0 = rf(ctx, update)
+	} else {
+		r0 = ret.Get(0).(core.Animal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.UpdateRequestBodyAnimal) error); ok {
+		r1 = rf(ctx, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnimalService_UpdateAnimal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAnimal'
+type MockAnimalService_UpdateAnimal_Call struct {
+	*mock.Call
+}
+
+// UpdateAnimal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - update core.UpdateRequestBodyAnimal
+func (_e *MockAnimalService_Expecter) UpdateAnimal(ctx interface{}, update interface{}) *MockAnimalService_UpdateAnimal_Call {
+	return &MockAnimalService_UpdateAnimal_Call{Call: _e.mock.On("UpdateAnimal", ctx, update)}
+}
+
+func (_c *MockAnimalService_UpdateAnimal_Call) Run(run func(ctx context.Context, update core.UpdateRequestBodyAnimal)) *MockAnimalService_UpdateAnimal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.UpdateRequestBodyAnimal))
+	})
+	return _c
+}
+
+func (_c *MockAnimalService_UpdateAnimal_Call) Return(_a0 core.Animal, _a1 error) *MockAnimalService_UpdateAnimal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnimalService_UpdateAnimal_Call) RunAndReturn(run func(context.Context, core.UpdateRequestBodyAnimal) (core.Animal, error)) *MockAnimalService_UpdateAnimal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAnimalService creates a new instance of MockAnimalService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnimalService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnimalService {
+	mock := &MockAnimalService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -79,6 +79,110 @@ func (_c *MockAnimalStore_CreateAnimal_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// CreateAnimalEvent provides a mock function with given fields: ctx, event
+func (_m *MockAnimalStore) CreateAnimalEvent(ctx context.Context, event core.AnimalEvent) (core.AnimalEvent, error) {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAnimalEvent")
+	}
+
+	var r0 core.AnimalEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.AnimalEvent) (core.AnimalEvent, error)); ok {
+		return rf(ctx, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.AnimalEvent) core.AnimalEvent); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Get(0).(core.AnimalEvent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.AnimalEvent) error); ok {
+		r1 = rf(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnimalStore_CreateAnimalEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAnimalEvent'
+type MockAnimalStore_CreateAnimalEvent_Call struct {
+	*mock.Call
+}
+
+// CreateAnimalEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event core.AnimalEvent
+func (_e *MockAnimalStore_Expecter) CreateAnimalEvent(ctx interface{}, event interface{}) *MockAnimalStore_CreateAnimalEvent_Call {
+	return &MockAnimalStore_CreateAnimalEvent_Call{Call: _e.mock.On("CreateAnimalEvent", ctx, event)}
+}
+
+func (_c *MockAnimalStore_CreateAnimalEvent_Call) Run(run func(ctx context.Context, event core.AnimalEvent)) *MockAnimalStore_CreateAnimalEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.AnimalEvent))
+	})
+	return _c
+}
+
+func (_c *MockAnimalStore_CreateAnimalEvent_Call) Return(_a0 core.AnimalEvent, _a1 error) *MockAnimalStore_CreateAnimalEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnimalStore_CreateAnimalEvent_Call) RunAndReturn(run func(context.Context, core.AnimalEvent) (core.AnimalEvent, error)) *MockAnimalStore_CreateAnimalEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAnimalEvent provides a mock function with given fields: ctx, event
+func (_m *MockAnimalStore) DeleteAnimalEvent(ctx context.Context, event core.AnimalEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAnimalEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.AnimalEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAnimalStore_DeleteAnimalEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAnimalEvent'
+type MockAnimalStore_DeleteAnimalEvent_Call struct {
+	*mock.Call
+}
+
+// DeleteAnimalEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event core.AnimalEvent
+func (_e *MockAnimalStore_Expecter) DeleteAnimalEvent(ctx interface{}, event interface{}) *MockAnimalStore_DeleteAnimalEvent_Call {
+	return &MockAnimalStore_DeleteAnimalEvent_Call{Call: _e.mock.On("DeleteAnimalEvent", ctx, event)}
+}
+
+func (_c *MockAnimalStore_DeleteAnimalEvent_Call) Run(run func(ctx context.Context, event core.AnimalEvent)) *MockAnimalStore_DeleteAnimalEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.AnimalEvent))
+	})
+	return _c
+}
+
+func (_c *MockAnimalStore_DeleteAnimalEvent_Call) Return(_a0 error) *MockAnimalStore_DeleteAnimalEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAnimalStore_DeleteAnimalEvent_Call) RunAndReturn(run func(context.Context, core.AnimalEvent) error) *MockAnimalStore_DeleteAnimalEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAnimalByID provides a mock function with given fields: ctx, id
 func (_m *MockAnimalStore) GetAnimalByID(ctx context.Context, id int) (core.Animal, error) {
 	ret := _m.Called(ctx, id)
@@ -136,6 +240,179 @@ func (_c *MockAnimalStore_GetAnimalByID_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetAnimalEvents provides a mock function with given fields: ctx, animalID
+func (_m *MockAnimalStore) GetAnimalEvents(ctx context.Context, animalID int) ([]core.AnimalEvent, error) {
+	ret := _m.Called(ctx, animalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnimalEvents")
+	}
+
+	var r0 []core.AnimalEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.AnimalEvent, error)); ok {
+		return rf(ctx, animalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []core.AnimalEvent); ok {
+		r0 = rf(ctx, animalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.AnimalEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, animalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnimalStore_GetAnimalEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnimalEvents'
+type MockAnimalStore_GetAnimalEvents_Call struct {
+	*mock.Call
+}
+
+// GetAnimalEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - animalID int
+func (_e *MockAnimalStore_Expecter) GetAnimalEvents(ctx interface{}, animalID interface{}) *MockAnimalStore_GetAnimalEvents_Call {
+	return &MockAnimalStore_GetAnimalEvents_Call{Call: _e.mock.On("GetAnimalEvents", ctx, animalID)}
+}
+
+func (_c *MockAnimalStore_GetAnimalEvents_Call) Run(run func(ctx context.Context, animalID int)) *MockAnimalStore_GetAnimalEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAnimalStore_GetAnimalEvents_Call) Return(events []core.AnimalEvent, err error) *MockAnimalStore_GetAnimalEvents_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockAnimalStore_GetAnimalEvents_Call) RunAndReturn(run func(context.Context, int) ([]core.AnimalEvent, error)) *MockAnimalStore_GetAnimalEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnimals provides a mock function with given fields: ctx, params
+func (_m *MockAnimalStore) GetAnimals(ctx context.Context, params core.GetAnimalsParams) ([]core.Animal, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnimals")
+	}
+
+	var r0 []core.Animal
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetAnimalsParams) ([]core.Animal, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetAnimalsParams) []core.Animal); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Animal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetAnimalsParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetAnimalsParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAnimalStore_GetAnimals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnimals'
+type MockAnimalStore_GetAnimals_Call struct {
+	*mock.Call
+}
+
+// GetAnimals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetAnimalsParams
+func (_e *MockAnimalStore_Expecter) GetAnimals(ctx interface{}, params interface{}) *MockAnimalStore_GetAnimals_Call {
+	return &MockAnimalStore_GetAnimals_Call{Call: _e.mock.On("GetAnimals", ctx, params)}
+}
+
+func (_c *MockAnimalStore_GetAnimals_Call) Run(run func(ctx context.Context, params core.GetAnimalsParams)) *MockAnimalStore_GetAnimals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetAnimalsParams))
+	})
+	return _c
+}
+
+func (_c *MockAnimalStore_GetAnimals_Call) Return(animals []core.Animal, total int, err error) *MockAnimalStore_GetAnimals_Call {
+	_c.Call.Return(animals, total, err)
+	return _c
+}
+
+func (_c *MockAnimalStore_GetAnimals_Call) RunAndReturn(run func(context.Context, core.GetAnimalsParams) ([]core.Animal, int, error)) *MockAnimalStore_GetAnimals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAnimalKeeper provides a mock function with given fields: ctx, id, keeperUserID
+func (_m *MockAnimalStore) SetAnimalKeeper(ctx context.Context, id int, keeperUserID *int) error {
+	ret := _m.Called(ctx, id, keeperUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAnimalKeeper")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *int) error); ok {
+		r0 = rf(ctx, id, keeperUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAnimalStore_SetAnimalKeeper_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAnimalKeeper'
+type MockAnimalStore_SetAnimalKeeper_Call struct {
+	*mock.Call
+}
+
+// SetAnimalKeeper is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - keeperUserID *int
+func (_e *MockAnimalStore_Expecter) SetAnimalKeeper(ctx interface{}, id interface{}, keeperUserID interface{}) *MockAnimalStore_SetAnimalKeeper_Call {
+	return &MockAnimalStore_SetAnimalKeeper_Call{Call: _e.mock.On("SetAnimalKeeper", ctx, id, keeperUserID)}
+}
+
+func (_c *MockAnimalStore_SetAnimalKeeper_Call) Run(run func(ctx context.Context, id int, keeperUserID *int)) *MockAnimalStore_SetAnimalKeeper_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*int))
+	})
+	return _c
+}
+
+func (_c *MockAnimalStore_SetAnimalKeeper_Call) Return(_a0 error) *MockAnimalStore_SetAnimalKeeper_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAnimalStore_SetAnimalKeeper_Call) RunAndReturn(run func(context.Context, int, *int) error) *MockAnimalStore_SetAnimalKeeper_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateAnimal provides a mock function with given fields: ctx, animal
 func (_m *MockAnimalStore) UpdateAnimal(ctx context.Context, animal core.Animal) (core.Animal, error) {
 	ret := _m.Called(ctx, animal)
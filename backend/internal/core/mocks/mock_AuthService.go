@@ -7,7 +7,6 @@ import (
 
 	core "github.com/kotopesp/sos-kotopes/internal/core"
 	mock "github.com/stretchr/testify/mock"
-
 	oauth2 "golang.org/x/oauth2"
 )
 
@@ -322,17 +321,17 @@ func (_c *MockAuthService_Refresh_Call) RunAndReturn(run func(context.Context, c
 	return _c
 }
 
-// SignupBasic provides a mock function with given fields: ctx, user
-func (_m *MockAuthService) SignupBasic(ctx context.Context, user core.User) error {
-	ret := _m.Called(ctx, user)
+// SignupBasic provides a mock function with given fields: ctx, user, photo
+func (_m *MockAuthService) SignupBasic(ctx context.Context, user core.User, photo *core.MediaUpload) error {
+	ret := _m.Called(ctx, user, photo)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SignupBasic")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, core.User) error); ok {
-		r0 = rf(ctx, user)
+	if rf, ok := ret.Get(0).(func(context.Context, core.User, *core.MediaUpload) error); ok {
+		r0 = rf(ctx, user, photo)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -348,13 +347,14 @@ type MockAuthService_SignupBasic_Call struct {
 // SignupBasic is a helper method to define mock.On call
 //   - ctx context.Context
 //   - user core.User
-func (_e *MockAuthService_Expecter) SignupBasic(ctx interface{}, user interface{}) *MockAuthService_SignupBasic_Call {
-	return &MockAuthService_SignupBasic_Call{Call: _e.mock.On("SignupBasic", ctx, user)}
+//   - photo *core.MediaUpload
+func (_e *MockAuthService_Expecter) SignupBasic(ctx interface{}, user interface{}, photo interface{}) *MockAuthService_SignupBasic_Call {
+	return &MockAuthService_SignupBasic_Call{Call: _e.mock.On("SignupBasic", ctx, user, photo)}
 }
 
-func (_c *MockAuthService_SignupBasic_Call) Run(run func(ctx context.Context, user core.User)) *MockAuthService_SignupBasic_Call {
+func (_c *MockAuthService_SignupBasic_Call) Run(run func(ctx context.Context, user core.User, photo *core.MediaUpload)) *MockAuthService_SignupBasic_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(core.User))
+		run(args[0].(context.Context), args[1].(core.User), args[2].(*core.MediaUpload))
 	})
 	return _c
 }
@@ -364,7 +364,7 @@ func (_c *MockAuthService_SignupBasic_Call) Return(_a0 error) *MockAuthService_S
 	return _c
 }
 
-func (_c *MockAuthService_SignupBasic_Call) RunAndReturn(run func(context.Context, core.User) error) *MockAuthService_SignupBasic_Call {
+func (_c *MockAuthService_SignupBasic_Call) RunAndReturn(run func(context.Context, core.User, *core.MediaUpload) error) *MockAuthService_SignupBasic_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,237 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockBlobStore is an autogenerated mock type for the BlobStore type
+type MockBlobStore struct {
+	mock.Mock
+}
+
+type MockBlobStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBlobStore) EXPECT() *MockBlobStore_Expecter {
+	return &MockBlobStore_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, key
+func (_m *MockBlobStore) Delete(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBlobStore_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockBlobStore_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockBlobStore_Expecter) Delete(ctx interface{}, key interface{}) *MockBlobStore_Delete_Call {
+	return &MockBlobStore_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *MockBlobStore_Delete_Call) Run(run func(ctx context.Context, key string)) *MockBlobStore_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_Delete_Call) Return(_a0 error) *MockBlobStore_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlobStore_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockBlobStore_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, key
+func (_m *MockBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBlobStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockBlobStore_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockBlobStore_Expecter) Get(ctx interface{}, key interface{}) *MockBlobStore_Get_Call {
+	return &MockBlobStore_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *MockBlobStore_Get_Call) Run(run func(ctx context.Context, key string)) *MockBlobStore_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_Get_Call) Return(data []byte, err error) *MockBlobStore_Get_Call {
+	_c.Call.Return(data, err)
+	return _c
+}
+
+func (_c *MockBlobStore_Get_Call) RunAndReturn(run func(context.Context, string) ([]byte, error)) *MockBlobStore_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: ctx, key, contentType, data
+func (_m *MockBlobStore) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	ret := _m.Called(ctx, key, contentType, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) error); ok {
+		r0 = rf(ctx, key, contentType, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBlobStore_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type MockBlobStore_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - contentType string
+//   - data []byte
+func (_e *MockBlobStore_Expecter) Put(ctx interface{}, key interface{}, contentType interface{}, data interface{}) *MockBlobStore_Put_Call {
+	return &MockBlobStore_Put_Call{Call: _e.mock.On("Put", ctx, key, contentType, data)}
+}
+
+func (_c *MockBlobStore_Put_Call) Run(run func(ctx context.Context, key string, contentType string, data []byte)) *MockBlobStore_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_Put_Call) Return(_a0 error) *MockBlobStore_Put_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlobStore_Put_Call) RunAndReturn(run func(context.Context, string, string, []byte) error) *MockBlobStore_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// URL provides a mock function with given fields: key
+func (_m *MockBlobStore) URL(key string) string {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for URL")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockBlobStore_URL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'URL'
+type MockBlobStore_URL_Call struct {
+	*mock.Call
+}
+
+// URL is a helper method to define mock.On call
+//   - key string
+func (_e *MockBlobStore_Expecter) URL(key interface{}) *MockBlobStore_URL_Call {
+	return &MockBlobStore_URL_Call{Call: _e.mock.On("URL", key)}
+}
+
+func (_c *MockBlobStore_URL_Call) Run(run func(key string)) *MockBlobStore_URL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_URL_Call) Return(_a0 string) *MockBlobStore_URL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlobStore_URL_Call) RunAndReturn(run func(string) string) *MockBlobStore_URL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBlobStore creates a new instance of MockBlobStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBlobStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBlobStore {
+	mock := &MockBlobStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,201 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockBlockService is an autogenerated mock type for the BlockService type
+type MockBlockService struct {
+	mock.Mock
+}
+
+type MockBlockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBlockService) EXPECT() *MockBlockService_Expecter {
+	return &MockBlockService_Expecter{mock: &_m.Mock}
+}
+
+// BlockUser provides a mock function with given fields: ctx, userID, blockedUserID, blockType
+func (_m *MockBlockService) BlockUser(ctx context.Context, userID int, blockedUserID int, blockType core.BlockType) error {
+	ret := _m.Called(ctx, userID, blockedUserID, blockType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, core.BlockType) error); ok {
+		r0 = rf(ctx, userID, blockedUserID, blockType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBlockService_BlockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockUser'
+type MockBlockService_BlockUser_Call struct {
+	*mock.Call
+}
+
+// BlockUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - blockedUserID int
+//   - blockType core.BlockType
+func (_e *MockBlockService_Expecter) BlockUser(ctx interface{}, userID interface{}, blockedUserID interface{}, blockType interface{}) *MockBlockService_BlockUser_Call {
+	return &MockBlockService_BlockUser_Call{Call: _e.mock.On("BlockUser", ctx, userID, blockedUserID, blockType)}
+}
+
+func (_c *MockBlockService_BlockUser_Call) Run(run func(ctx context.Context, userID int, blockedUserID int, blockType core.BlockType)) *MockBlockService_BlockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(core.BlockType))
+	})
+	return _c
+}
+
+func (_c *MockBlockService_BlockUser_Call) Return(_a0 error) *MockBlockService_BlockUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlockService_BlockUser_Call) RunAndReturn(run func(context.Context, int, int, core.BlockType) error) *MockBlockService_BlockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlockedUsers provides a mock function with given fields: ctx, params
+func (_m *MockBlockService) GetBlockedUsers(ctx context.Context, params core.GetBlockedUsersParams) ([]core.UserBlock, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockedUsers")
+	}
+
+	var r0 []core.UserBlock
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetBlockedUsersParams) ([]core.UserBlock, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetBlockedUsersParams) []core.UserBlock); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.UserBlock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetBlockedUsersParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetBlockedUsersParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockBlockService_GetBlockedUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockedUsers'
+type MockBlockService_GetBlockedUsers_Call struct {
+	*mock.Call
+}
+
+// GetBlockedUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetBlockedUsersParams
+func (_e *MockBlockService_Expecter) GetBlockedUsers(ctx interface{}, params interface{}) *MockBlockService_GetBlockedUsers_Call {
+	return &MockBlockService_GetBlockedUsers_Call{Call: _e.mock.On("GetBlockedUsers", ctx, params)}
+}
+
+func (_c *MockBlockService_GetBlockedUsers_Call) Run(run func(ctx context.Context, params core.GetBlockedUsersParams)) *MockBlockService_GetBlockedUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetBlockedUsersParams))
+	})
+	return _c
+}
+
+func (_c *MockBlockService_GetBlockedUsers_Call) Return(blocks []core.UserBlock, total int, err error) *MockBlockService_GetBlockedUsers_Call {
+	_c.Call.Return(blocks, total, err)
+	return _c
+}
+
+func (_c *MockBlockService_GetBlockedUsers_Call) RunAndReturn(run func(context.Context, core.GetBlockedUsersParams) ([]core.UserBlock, int, error)) *MockBlockService_GetBlockedUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnblockUser provides a mock function with given fields: ctx, userID, blockedUserID, blockType
+func (_m *MockBlockService) UnblockUser(ctx context.Context, userID int, blockedUserID int, blockType core.BlockType) error {
+	ret := _m.Called(ctx, userID, blockedUserID, blockType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnblockUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, core.BlockType) error); ok {
+		r0 = rf(ctx, userID, blockedUserID, blockType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBlockService_UnblockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnblockUser'
+type MockBlockService_UnblockUser_Call struct {
+	*mock.Call
+}
+
+// UnblockUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - blockedUserID int
+//   - blockType core.BlockType
+func (_e *MockBlockService_Expecter) UnblockUser(ctx interface{}, userID interface{}, blockedUserID interface{}, blockType interface{}) *MockBlockService_UnblockUser_Call {
+	return &MockBlockService_UnblockUser_Call{Call: _e.mock.On("UnblockUser", ctx, userID, blockedUserID, blockType)}
+}
+
+func (_c *MockBlockService_UnblockUser_Call) Run(run func(ctx context.Context, userID int, blockedUserID int, blockType core.BlockType)) *MockBlockService_UnblockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(core.BlockType))
+	})
+	return _c
+}
+
+func (_c *MockBlockService_UnblockUser_Call) Return(_a0 error) *MockBlockService_UnblockUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlockService_UnblockUser_Call) RunAndReturn(run func(context.Context, int, int, core.BlockType) error) *MockBlockService_UnblockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBlockService creates a new instance of MockBlockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBlockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBlockService {
+	mock := &MockBlockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
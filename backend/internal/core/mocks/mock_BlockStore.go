@@ -0,0 +1,318 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockBlockStore is an autogenerated mock type for the BlockStore type
+type MockBlockStore struct {
+	mock.Mock
+}
+
+type MockBlockStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBlockStore) EXPECT() *MockBlockStore_Expecter {
+	return &MockBlockStore_Expecter{mock: &_m.Mock}
+}
+
+// BlockUser provides a mock function with given fields: ctx, block
+func (_m *MockBlockStore) BlockUser(ctx context.Context, block core.UserBlock) error {
+	ret := _m.Called(ctx, block)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.UserBlock) error); ok {
+		r0 = rf(ctx, block)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBlockStore_BlockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockUser'
+type MockBlockStore_BlockUser_Call struct {
+	*mock.Call
+}
+
+// BlockUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - block core.UserBlock
+func (_e *MockBlockStore_Expecter) BlockUser(ctx interface{}, block interface{}) *MockBlockStore_BlockUser_Call {
+	return &MockBlockStore_BlockUser_Call{Call: _e.mock.On("BlockUser", ctx, block)}
+}
+
+func (_c *MockBlockStore_BlockUser_Call) Run(run func(ctx context.Context, block core.UserBlock)) *MockBlockStore_BlockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.UserBlock))
+	})
+	return _c
+}
+
+func (_c *MockBlockStore_BlockUser_Call) Return(_a0 error) *MockBlockStore_BlockUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlockStore_BlockUser_Call) RunAndReturn(run func(context.Context, core.UserBlock) error) *MockBlockStore_BlockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlockedUsers provides a mock function with given fields: ctx, params
+func (_m *MockBlockStore) GetBlockedUsers(ctx context.Context, params core.GetBlockedUsersParams) ([]core.UserBlock, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockedUsers")
+	}
+
+	var r0 []core.UserBlock
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetBlockedUsersParams) ([]core.UserBlock, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetBlockedUsersParams) []core.UserBlock); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.UserBlock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetBlockedUsersParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetBlockedUsersParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockBlockStore_GetBlockedUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockedUsers'
+type MockBlockStore_GetBlockedUsers_Call struct {
+	*mock.Call
+}
+
+// GetBlockedUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetBlockedUsersParams
+func (_e *MockBlockStore_Expecter) GetBlockedUsers(ctx interface{}, params interface{}) *MockBlockStore_GetBlockedUsers_Call {
+	return &MockBlockStore_GetBlockedUsers_Call{Call: _e.mock.On("GetBlockedUsers", ctx, params)}
+}
+
+func (_c *MockBlockStore_GetBlockedUsers_Call) Run(run func(ctx context.Context, params core.GetBlockedUsersParams)) *MockBlockStore_GetBlockedUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetBlockedUsersParams))
+	})
+	return _c
+}
+
+func (_c *MockBlockStore_GetBlockedUsers_Call) Return(blocks []core.UserBlock, total int, err error) *MockBlockStore_GetBlockedUsers_Call {
+	_c.Call.Return(blocks, total, err)
+	return _c
+}
+
+func (_c *MockBlockStore_GetBlockedUsers_Call) RunAndReturn(run func(context.Context, core.GetBlockedUsersParams) ([]core.UserBlock, int, error)) *MockBlockStore_GetBlockedUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlockingUserIDs provides a mock function with given fields: ctx, userID, userIDs
+func (_m *MockBlockStore) GetBlockingUserIDs(ctx context.Context, userID int, userIDs []int) ([]int, error) {
+	ret := _m.Called(ctx, userID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockingUserIDs")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) ([]int, error)); ok {
+		return rf(ctx, userID, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) []int); ok {
+		r0 = rf(ctx, userID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, userID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBlockStore_GetBlockingUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockingUserIDs'
+type MockBlockStore_GetBlockingUserIDs_Call struct {
+	*mock.Call
+}
+
+// GetBlockingUserIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - userIDs []int
+func (_e *MockBlockStore_Expecter) GetBlockingUserIDs(ctx interface{}, userID interface{}, userIDs interface{}) *MockBlockStore_GetBlockingUserIDs_Call {
+	return &MockBlockStore_GetBlockingUserIDs_Call{Call: _e.mock.On("GetBlockingUserIDs", ctx, userID, userIDs)}
+}
+
+func (_c *MockBlockStore_GetBlockingUserIDs_Call) Run(run func(ctx context.Context, userID int, userIDs []int)) *MockBlockStore_GetBlockingUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *MockBlockStore_GetBlockingUserIDs_Call) Return(blockingUserIDs []int, err error) *MockBlockStore_GetBlockingUserIDs_Call {
+	_c.Call.Return(blockingUserIDs, err)
+	return _c
+}
+
+func (_c *MockBlockStore_GetBlockingUserIDs_Call) RunAndReturn(run func(context.Context, int, []int) ([]int, error)) *MockBlockStore_GetBlockingUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHiddenUserIDs provides a mock function with given fields: ctx, userID
+func (_m *MockBlockStore) GetHiddenUserIDs(ctx context.Context, userID int) ([]int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHiddenUserIDs")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBlockStore_GetHiddenUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHiddenUserIDs'
+type MockBlockStore_GetHiddenUserIDs_Call struct {
+	*mock.Call
+}
+
+// GetHiddenUserIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockBlockStore_Expecter) GetHiddenUserIDs(ctx interface{}, userID interface{}) *MockBlockStore_GetHiddenUserIDs_Call {
+	return &MockBlockStore_GetHiddenUserIDs_Call{Call: _e.mock.On("GetHiddenUserIDs", ctx, userID)}
+}
+
+func (_c *MockBlockStore_GetHiddenUserIDs_Call) Run(run func(ctx context.Context, userID int)) *MockBlockStore_GetHiddenUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockBlockStore_GetHiddenUserIDs_Call) Return(userIDs []int, err error) *MockBlockStore_GetHiddenUserIDs_Call {
+	_c.Call.Return(userIDs, err)
+	return _c
+}
+
+func (_c *MockBlockStore_GetHiddenUserIDs_Call) RunAndReturn(run func(context.Context, int) ([]int, error)) *MockBlockStore_GetHiddenUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnblockUser provides a mock function with given fields: ctx, userID, blockedUserID, blockType
+func (_m *MockBlockStore) UnblockUser(ctx context.Context, userID int, blockedUserID int, blockType core.BlockType) error {
+	ret := _m.Called(ctx, userID, blockedUserID, blockType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnblockUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, core.BlockType) error); ok {
+		r0 = rf(ctx, userID, blockedUserID, blockType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBlockStore_UnblockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnblockUser'
+type MockBlockStore_UnblockUser_Call struct {
+	*mock.Call
+}
+
+// UnblockUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - blockedUserID int
+//   - blockType core.BlockType
+func (_e *MockBlockStore_Expecter) UnblockUser(ctx interface{}, userID interface{}, blockedUserID interface{}, blockType interface{}) *MockBlockStore_UnblockUser_Call {
+	return &MockBlockStore_UnblockUser_Call{Call: _e.mock.On("UnblockUser", ctx, userID, blockedUserID, blockType)}
+}
+
+func (_c *MockBlockStore_UnblockUser_Call) Run(run func(ctx context.Context, userID int, blockedUserID int, blockType core.BlockType)) *MockBlockStore_UnblockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(core.BlockType))
+	})
+	return _c
+}
+
+func (_c *MockBlockStore_UnblockUser_Call) Return(_a0 error) *MockBlockStore_UnblockUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlockStore_UnblockUser_Call) RunAndReturn(run func(context.Context, int, int, core.BlockType) error) *MockBlockStore_UnblockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBlockStore creates a new instance of MockBlockStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBlockStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBlockStore {
+	mock := &MockBlockStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
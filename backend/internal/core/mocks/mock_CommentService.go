@@ -192,6 +192,356 @@ func (_c *MockCommentService_GetAllComments_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetCommentThreads provides a mock function with given fields: ctx, params
+func (_m *MockCommentService) GetCommentThreads(ctx context.Context, params core.GetAllCommentsParams) ([]core.CommentThread, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentThreads")
+	}
+
+	var r0 []core.CommentThread
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetAllCommentsParams) ([]core.CommentThread, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetAllCommentsParams) []core.CommentThread); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.CommentThread)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetAllCommentsParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetAllCommentsParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentService_GetCommentThreads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentThreads'
+type MockCommentService_GetCommentThreads_Call struct {
+	*mock.Call
+}
+
+// GetCommentThreads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetAllCommentsParams
+func (_e *MockCommentService_Expecter) GetCommentThreads(ctx interface{}, params interface{}) *MockCommentService_GetCommentThreads_Call {
+	return &MockCommentService_GetCommentThreads_Call{Call: _e.mock.On("GetCommentThreads", ctx, params)}
+}
+
+func (_c *MockCommentService_GetCommentThreads_Call) Run(run func(ctx context.Context, params core.GetAllCommentsParams)) *MockCommentService_GetCommentThreads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetAllCommentsParams))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_GetCommentThreads_Call) Return(data []core.CommentThread, total int, err error) *MockCommentService_GetCommentThreads_Call {
+	_c.Call.Return(data, total, err)
+	return _c
+}
+
+func (_c *MockCommentService_GetCommentThreads_Call) RunAndReturn(run func(context.Context, core.GetAllCommentsParams) ([]core.CommentThread, int, error)) *MockCommentService_GetCommentThreads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplies provides a mock function with given fields: ctx, params
+func (_m *MockCommentService) GetReplies(ctx context.Context, params core.GetRepliesParams) ([]core.Comment, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplies")
+	}
+
+	var r0 []core.Comment
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetRepliesParams) ([]core.Comment, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetRepliesParams) []core.Comment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetRepliesParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetRepliesParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentService_GetReplies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplies'
+type MockCommentService_GetReplies_Call struct {
+	*mock.Call
+}
+
+// GetReplies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetRepliesParams
+func (_e *MockCommentService_Expecter) GetReplies(ctx interface{}, params interface{}) *MockCommentService_GetReplies_Call {
+	return &MockCommentService_GetReplies_Call{Call: _e.mock.On("GetReplies", ctx, params)}
+}
+
+func (_c *MockCommentService_GetReplies_Call) Run(run func(ctx context.Context, params core.GetRepliesParams)) *MockCommentService_GetReplies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetRepliesParams))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_GetReplies_Call) Return(data []core.Comment, total int, err error) *MockCommentService_GetReplies_Call {
+	_c.Call.Return(data, total, err)
+	return _c
+}
+
+func (_c *MockCommentService_GetReplies_Call) RunAndReturn(run func(context.Context, core.GetRepliesParams) ([]core.Comment, int, error)) *MockCommentService_GetReplies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserComments provides a mock function with given fields: ctx, params
+func (_m *MockCommentService) GetUserComments(ctx context.Context, params core.GetUserCommentsParams) ([]core.Comment, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserComments")
+	}
+
+	var r0 []core.Comment
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetUserCommentsParams) ([]core.Comment, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetUserCommentsParams) []core.Comment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetUserCommentsParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetUserCommentsParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentService_GetUserComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserComments'
+type MockCommentService_GetUserComments_Call struct {
+	*mock.Call
+}
+
+// GetUserComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetUserCommentsParams
+func (_e *MockCommentService_Expecter) GetUserComments(ctx interface{}, params interface{}) *MockCommentService_GetUserComments_Call {
+	return &MockCommentService_GetUserComments_Call{Call: _e.mock.On("GetUserComments", ctx, params)}
+}
+
+func (_c *MockCommentService_GetUserComments_Call) Run(run func(ctx context.Context, params core.GetUserCommentsParams)) *MockCommentService_GetUserComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetUserCommentsParams))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_GetUserComments_Call) Return(data []core.Comment, total int, err error) *MockCommentService_GetUserComments_Call {
+	_c.Call.Return(data, total, err)
+	return _c
+}
+
+func (_c *MockCommentService_GetUserComments_Call) RunAndReturn(run func(context.Context, core.GetUserCommentsParams) ([]core.Comment, int, error)) *MockCommentService_GetUserComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LikeComment provides a mock function with given fields: ctx, postID, commentID, userID
+func (_m *MockCommentService) LikeComment(ctx context.Context, postID int, commentID int, userID int) (core.Likes, error) {
+	ret := _m.Called(ctx, postID, commentID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LikeComment")
+	}
+
+	var r0 core.Likes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) (core.Likes, error)); ok {
+		return rf(ctx, postID, commentID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) core.Likes); ok {
+		r0 = rf(ctx, postID, commentID, userID)
+	} else {
+		r0 = ret.Get(0).(core.Likes)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
+		r1 = rf(ctx, postID, commentID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentService_LikeComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LikeComment'
+type MockCommentService_LikeComment_Call struct {
+	*mock.Call
+}
+
+// LikeComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int
+//   - commentID int
+//   - userID int
+func (_e *MockCommentService_Expecter) LikeComment(ctx interface{}, postID interface{}, commentID interface{}, userID interface{}) *MockCommentService_LikeComment_Call {
+	return &MockCommentService_LikeComment_Call{Call: _e.mock.On("LikeComment", ctx, postID, commentID, userID)}
+}
+
+func (_c *MockCommentService_LikeComment_Call) Run(run func(ctx context.Context, postID int, commentID int, userID int)) *MockCommentService_LikeComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_LikeComment_Call) Return(_a0 core.Likes, _a1 error) *MockCommentService_LikeComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentService_LikeComment_Call) RunAndReturn(run func(context.Context, int, int, int) (core.Likes, error)) *MockCommentService_LikeComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyApprovedComment provides a mock function with given fields: ctx, comment
+func (_m *MockCommentService) NotifyApprovedComment(ctx context.Context, comment core.Comment) {
+	_m.Called(ctx, comment)
+}
+
+// MockCommentService_NotifyApprovedComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyApprovedComment'
+type MockCommentService_NotifyApprovedComment_Call struct {
+	*mock.Call
+}
+
+// NotifyApprovedComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment core.Comment
+func (_e *MockCommentService_Expecter) NotifyApprovedComment(ctx interface{}, comment interface{}) *MockCommentService_NotifyApprovedComment_Call {
+	return &MockCommentService_NotifyApprovedComment_Call{Call: _e.mock.On("NotifyApprovedComment", ctx, comment)}
+}
+
+func (_c *MockCommentService_NotifyApprovedComment_Call) Run(run func(ctx context.Context, comment core.Comment)) *MockCommentService_NotifyApprovedComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.Comment))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_NotifyApprovedComment_Call) Return() *MockCommentService_NotifyApprovedComment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCommentService_NotifyApprovedComment_Call) RunAndReturn(run func(context.Context, core.Comment)) *MockCommentService_NotifyApprovedComment_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UnlikeComment provides a mock function with given fields: ctx, postID, commentID, userID
+func (_m *MockCommentService) UnlikeComment(ctx context.Context, postID int, commentID int, userID int) (core.Likes, error) {
+	ret := _m.Called(ctx, postID, commentID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlikeComment")
+	}
+
+	var r0 core.Likes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) (core.Likes, error)); ok {
+		return rf(ctx, postID, commentID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) core.Likes); ok {
+		r0 = rf(ctx, postID, commentID, userID)
+	} else {
+		r0 = ret.Get(0).(core.Likes)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
+		r1 = rf(ctx, postID, commentID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentService_UnlikeComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlikeComment'
+type MockCommentService_UnlikeComment_Call struct {
+	*mock.Call
+}
+
+// UnlikeComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int
+//   - commentID int
+//   - userID int
+func (_e *MockCommentService_Expecter) UnlikeComment(ctx interface{}, postID interface{}, commentID interface{}, userID interface{}) *MockCommentService_UnlikeComment_Call {
+	return &MockCommentService_UnlikeComment_Call{Call: _e.mock.On("UnlikeComment", ctx, postID, commentID, userID)}
+}
+
+func (_c *MockCommentService_UnlikeComment_Call) Run(run func(ctx context.Context, postID int, commentID int, userID int)) *MockCommentService_UnlikeComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_UnlikeComment_Call) Return(_a0 core.Likes, _a1 error) *MockCommentService_UnlikeComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentService_UnlikeComment_Call) RunAndReturn(run func(context.Context, int, int, int) (core.Likes, error)) *MockCommentService_UnlikeComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateComment provides a mock function with given fields: ctx, comments
 func (_m *MockCommentService) UpdateComment(ctx context.Context, comments core.Comment) (core.Comment, error) {
 	ret := _m.Called(ctx, comments)
@@ -4,6 +4,7 @@ package core
 
 import (
 	context "context"
+	time "time"
 
 	core "github.com/kotopesp/sos-kotopes/internal/core"
 	mock "github.com/stretchr/testify/mock"
@@ -69,6 +70,123 @@ func (_c *MockCommentStore_ApproveCommentFromModeration_Call) RunAndReturn(run f
 	return _c
 }
 
+// CountReplies provides a mock function with given fields: ctx, parentIDs
+func (_m *MockCommentStore) CountReplies(ctx context.Context, parentIDs []int) (map[int]int, error) {
+	ret := _m.Called(ctx, parentIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReplies")
+	}
+
+	var r0 map[int]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (map[int]int, error)); ok {
+		return rf(ctx, parentIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) map[int]int); ok {
+		r0 = rf(ctx, parentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, parentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentStore_CountReplies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReplies'
+type MockCommentStore_CountReplies_Call struct {
+	*mock.Call
+}
+
+// CountReplies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentIDs []int
+func (_e *MockCommentStore_Expecter) CountReplies(ctx interface{}, parentIDs interface{}) *MockCommentStore_CountReplies_Call {
+	return &MockCommentStore_CountReplies_Call{Call: _e.mock.On("CountReplies", ctx, parentIDs)}
+}
+
+func (_c *MockCommentStore_CountReplies_Call) Run(run func(ctx context.Context, parentIDs []int)) *MockCommentStore_CountReplies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_CountReplies_Call) Return(counts map[int]int, err error) *MockCommentStore_CountReplies_Call {
+	_c.Call.Return(counts, err)
+	return _c
+}
+
+func (_c *MockCommentStore_CountReplies_Call) RunAndReturn(run func(context.Context, []int) (map[int]int, error)) *MockCommentStore_CountReplies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountUserCommentsSince provides a mock function with given fields: ctx, authorID, since
+func (_m *MockCommentStore) CountUserCommentsSince(ctx context.Context, authorID int, since time.Time) (int, error) {
+	ret := _m.Called(ctx, authorID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUserCommentsSince")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (int, error)); ok {
+		return rf(ctx, authorID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) int); ok {
+		r0 = rf(ctx, authorID, since)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, authorID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentStore_CountUserCommentsSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUserCommentsSince'
+type MockCommentStore_CountUserCommentsSince_Call struct {
+	*mock.Call
+}
+
+// CountUserCommentsSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID int
+//   - since time.Time
+func (_e *MockCommentStore_Expecter) CountUserCommentsSince(ctx interface{}, authorID interface{}, since interface{}) *MockCommentStore_CountUserCommentsSince_Call {
+	return &MockCommentStore_CountUserCommentsSince_Call{Call: _e.mock.On("CountUserCommentsSince", ctx, authorID, since)}
+}
+
+func (_c *MockCommentStore_CountUserCommentsSince_Call) Run(run func(ctx context.Context, authorID int, since time.Time)) *MockCommentStore_CountUserCommentsSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_CountUserCommentsSince_Call) Return(count int, err error) *MockCommentStore_CountUserCommentsSince_Call {
+	_c.Call.Return(count, err)
+	return _c
+}
+
+func (_c *MockCommentStore_CountUserCommentsSince_Call) RunAndReturn(run func(context.Context, int, time.Time) (int, error)) *MockCommentStore_CountUserCommentsSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateComment provides a mock function with given fields: ctx, comment
 func (_m *MockCommentStore) CreateComment(ctx context.Context, comment core.Comment) (core.Comment, error) {
 	ret := _m.Called(ctx, comment)
@@ -355,6 +473,330 @@ func (_c *MockCommentStore_GetCommentsForModeration_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetFirstReplies provides a mock function with given fields: ctx, parentIDs, limit
+func (_m *MockCommentStore) GetFirstReplies(ctx context.Context, parentIDs []int, limit int) ([]core.Comment, error) {
+	ret := _m.Called(ctx, parentIDs, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFirstReplies")
+	}
+
+	var r0 []core.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) ([]core.Comment, error)); ok {
+		return rf(ctx, parentIDs, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) []core.Comment); ok {
+		r0 = rf(ctx, parentIDs, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, int) error); ok {
+		r1 = rf(ctx, parentIDs, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentStore_GetFirstReplies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFirstReplies'
+type MockCommentStore_GetFirstReplies_Call struct {
+	*mock.Call
+}
+
+// GetFirstReplies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentIDs []int
+//   - limit int
+func (_e *MockCommentStore_Expecter) GetFirstReplies(ctx interface{}, parentIDs interface{}, limit interface{}) *MockCommentStore_GetFirstReplies_Call {
+	return &MockCommentStore_GetFirstReplies_Call{Call: _e.mock.On("GetFirstReplies", ctx, parentIDs, limit)}
+}
+
+func (_c *MockCommentStore_GetFirstReplies_Call) Run(run func(ctx context.Context, parentIDs []int, limit int)) *MockCommentStore_GetFirstReplies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_GetFirstReplies_Call) Return(data []core.Comment, err error) *MockCommentStore_GetFirstReplies_Call {
+	_c.Call.Return(data, err)
+	return _c
+}
+
+func (_c *MockCommentStore_GetFirstReplies_Call) RunAndReturn(run func(context.Context, []int, int) ([]core.Comment, error)) *MockCommentStore_GetFirstReplies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplies provides a mock function with given fields: ctx, params
+func (_m *MockCommentStore) GetReplies(ctx context.Context, params core.GetRepliesParams) ([]core.Comment, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplies")
+	}
+
+	var r0 []core.Comment
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetRepliesParams) ([]core.Comment, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetRepliesParams) []core.Comment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetRepliesParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetRepliesParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentStore_GetReplies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplies'
+type MockCommentStore_GetReplies_Call struct {
+	*mock.Call
+}
+
+// GetReplies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetRepliesParams
+func (_e *MockCommentStore_Expecter) GetReplies(ctx interface{}, params interface{}) *MockCommentStore_GetReplies_Call {
+	return &MockCommentStore_GetReplies_Call{Call: _e.mock.On("GetReplies", ctx, params)}
+}
+
+func (_c *MockCommentStore_GetReplies_Call) Run(run func(ctx context.Context, params core.GetRepliesParams)) *MockCommentStore_GetReplies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetRepliesParams))
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_GetReplies_Call) Return(data []core.Comment, total int, err error) *MockCommentStore_GetReplies_Call {
+	_c.Call.Return(data, total, err)
+	return _c
+}
+
+func (_c *MockCommentStore_GetReplies_Call) RunAndReturn(run func(context.Context, core.GetRepliesParams) ([]core.Comment, int, error)) *MockCommentStore_GetReplies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRootComments provides a mock function with given fields: ctx, params
+func (_m *MockCommentStore) GetRootComments(ctx context.Context, params core.GetAllCommentsParams) ([]core.Comment, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRootComments")
+	}
+
+	var r0 []core.Comment
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetAllCommentsParams) ([]core.Comment, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetAllCommentsParams) []core.Comment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetAllCommentsParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetAllCommentsParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentStore_GetRootComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRootComments'
+type MockCommentStore_GetRootComments_Call struct {
+	*mock.Call
+}
+
+// GetRootComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetAllCommentsParams
+func (_e *MockCommentStore_Expecter) GetRootComments(ctx interface{}, params interface{}) *MockCommentStore_GetRootComments_Call {
+	return &MockCommentStore_GetRootComments_Call{Call: _e.mock.On("GetRootComments", ctx, params)}
+}
+
+func (_c *MockCommentStore_GetRootComments_Call) Run(run func(ctx context.Context, params core.GetAllCommentsParams)) *MockCommentStore_GetRootComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetAllCommentsParams))
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_GetRootComments_Call) Return(data []core.Comment, total int, err error) *MockCommentStore_GetRootComments_Call {
+	_c.Call.Return(data, total, err)
+	return _c
+}
+
+func (_c *MockCommentStore_GetRootComments_Call) RunAndReturn(run func(context.Context, core.GetAllCommentsParams) ([]core.Comment, int, error)) *MockCommentStore_GetRootComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserComments provides a mock function with given fields: ctx, params
+func (_m *MockCommentStore) GetUserComments(ctx context.Context, params core.GetUserCommentsParams) ([]core.Comment, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserComments")
+	}
+
+	var r0 []core.Comment
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetUserCommentsParams) ([]core.Comment, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetUserCommentsParams) []core.Comment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetUserCommentsParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetUserCommentsParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentStore_GetUserComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserComments'
+type MockCommentStore_GetUserComments_Call struct {
+	*mock.Call
+}
+
+// GetUserComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetUserCommentsParams
+func (_e *MockCommentStore_Expecter) GetUserComments(ctx interface{}, params interface{}) *MockCommentStore_GetUserComments_Call {
+	return &MockCommentStore_GetUserComments_Call{Call: _e.mock.On("GetUserComments", ctx, params)}
+}
+
+func (_c *MockCommentStore_GetUserComments_Call) Run(run func(ctx context.Context, params core.GetUserCommentsParams)) *MockCommentStore_GetUserComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetUserCommentsParams))
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_GetUserComments_Call) Return(data []core.Comment, total int, err error) *MockCommentStore_GetUserComments_Call {
+	_c.Call.Return(data, total, err)
+	return _c
+}
+
+func (_c *MockCommentStore_GetUserComments_Call) RunAndReturn(run func(context.Context, core.GetUserCommentsParams) ([]core.Comment, int, error)) *MockCommentStore_GetUserComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchComments provides a mock function with given fields: ctx, params
+func (_m *MockCommentStore) SearchComments(ctx context.Context, params core.SearchCommentsParams) ([]core.Comment, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchComments")
+	}
+
+	var r0 []core.Comment
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.SearchCommentsParams) ([]core.Comment, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.SearchCommentsParams) []core.Comment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.SearchCommentsParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.SearchCommentsParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCommentStore_SearchComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchComments'
+type MockCommentStore_SearchComments_Call struct {
+	*mock.Call
+}
+
+// SearchComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.SearchCommentsParams
+func (_e *MockCommentStore_Expecter) SearchComments(ctx interface{}, params interface{}) *MockCommentStore_SearchComments_Call {
+	return &MockCommentStore_SearchComments_Call{Call: _e.mock.On("SearchComments", ctx, params)}
+}
+
+func (_c *MockCommentStore_SearchComments_Call) Run(run func(ctx context.Context, params core.SearchCommentsParams)) *MockCommentStore_SearchComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.SearchCommentsParams))
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_SearchComments_Call) Return(data []core.Comment, total int, err error) *MockCommentStore_SearchComments_Call {
+	_c.Call.Return(data, total, err)
+	return _c
+}
+
+func (_c *MockCommentStore_SearchComments_Call) RunAndReturn(run func(context.Context, core.SearchCommentsParams) ([]core.Comment, int, error)) *MockCommentStore_SearchComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendToModeration provides a mock function with given fields: ctx, commentID
 func (_m *MockCommentStore) SendToModeration(ctx context.Context, commentID int) error {
 	ret := _m.Called(ctx, commentID)
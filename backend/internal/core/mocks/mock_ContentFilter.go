@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockContentFilter is an autogenerated mock type for the ContentFilter type
+type MockContentFilter struct {
+	mock.Mock
+}
+
+type MockContentFilter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockContentFilter) EXPECT() *MockContentFilter_Expecter {
+	return &MockContentFilter_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx, content
+func (_m *MockContentFilter) Check(ctx context.Context, content core.FilteredContent) (core.FilterVerdict, error) {
+	ret := _m.Called(ctx, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 core.FilterVerdict
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.FilteredContent) (core.FilterVerdict, error)); ok {
+		return rf(ctx, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.FilteredContent) core.FilterVerdict); ok {
+		r0 = rf(ctx, content)
+	} else {
+		r0 = ret.Get(0).(core.FilterVerdict)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.FilteredContent) error); ok {
+		r1 = rf(ctx, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContentFilter_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockContentFilter_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content core.FilteredContent
+func (_e *MockContentFilter_Expecter) Check(ctx interface{}, content interface{}) *MockContentFilter_Check_Call {
+	return &MockContentFilter_Check_Call{Call: _e.mock.On("Check", ctx, content)}
+}
+
+func (_c *MockContentFilter_Check_Call) Run(run func(ctx context.Context, content core.FilteredContent)) *MockContentFilter_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.FilteredContent))
+	})
+	return _c
+}
+
+func (_c *MockContentFilter_Check_Call) Return(verdict core.FilterVerdict, err error) *MockContentFilter_Check_Call {
+	_c.Call.Return(verdict, err)
+	return _c
+}
+
+func (_c *MockContentFilter_Check_Call) RunAndReturn(run func(context.Context, core.FilteredContent) (core.FilterVerdict, error)) *MockContentFilter_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockContentFilter creates a new instance of MockContentFilter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockContentFilter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockContentFilter {
+	mock := &MockContentFilter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,217 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockFeedService is an autogenerated mock type for the FeedService type
+type MockFeedService struct {
+	mock.Mock
+}
+
+type MockFeedService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFeedService) EXPECT() *MockFeedService_Expecter {
+	return &MockFeedService_Expecter{mock: &_m.Mock}
+}
+
+// GetFeed provides a mock function with given fields: ctx, params
+func (_m *MockFeedService) GetFeed(ctx context.Context, params core.FeedParams) ([]core.FeedPost, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeed")
+	}
+
+	var r0 []core.FeedPost
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.FeedParams) ([]core.FeedPost, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.FeedParams) []core.FeedPost); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.FeedPost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.FeedParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.FeedParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockFeedService_GetFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeed'
+type MockFeedService_GetFeed_Call struct {
+	*mock.Call
+}
+
+// GetFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.FeedParams
+func (_e *MockFeedService_Expecter) GetFeed(ctx interface{}, params interface{}) *MockFeedService_GetFeed_Call {
+	return &MockFeedService_GetFeed_Call{Call: _e.mock.On("GetFeed", ctx, params)}
+}
+
+func (_c *MockFeedService_GetFeed_Call) Run(run func(ctx context.Context, params core.FeedParams)) *MockFeedService_GetFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.FeedParams))
+	})
+	return _c
+}
+
+func (_c *MockFeedService_GetFeed_Call) Return(posts []core.FeedPost, total int, err error) *MockFeedService_GetFeed_Call {
+	_c.Call.Return(posts, total, err)
+	return _c
+}
+
+func (_c *MockFeedService_GetFeed_Call) RunAndReturn(run func(context.Context, core.FeedParams) ([]core.FeedPost, int, error)) *MockFeedService_GetFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeedPreferences provides a mock function with given fields: ctx, userID
+func (_m *MockFeedService) GetFeedPreferences(ctx context.Context, userID int) (core.FeedPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedPreferences")
+	}
+
+	var r0 core.FeedPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (core.FeedPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) core.FeedPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(core.FeedPreferences)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFeedService_GetFeedPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeedPreferences'
+type MockFeedService_GetFeedPreferences_Call struct {
+	*mock.Call
+}
+
+// GetFeedPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockFeedService_Expecter) GetFeedPreferences(ctx interface{}, userID interface{}) *MockFeedService_GetFeedPreferences_Call {
+	return &MockFeedService_GetFeedPreferences_Call{Call: _e.mock.On("GetFeedPreferences", ctx, userID)}
+}
+
+func (_c *MockFeedService_GetFeedPreferences_Call) Run(run func(ctx context.Context, userID int)) *MockFeedService_GetFeedPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockFeedService_GetFeedPreferences_Call) Return(preferences core.FeedPreferences, err error) *MockFeedService_GetFeedPreferences_Call {
+	_c.Call.Return(preferences, err)
+	return _c
+}
+
+func (_c *MockFeedService_GetFeedPreferences_Call) RunAndReturn(run func(context.Context, int) (core.FeedPreferences, error)) *MockFeedService_GetFeedPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeedPreferences provides a mock function with given fields: ctx, preferences
+func (_m *MockFeedService) UpdateFeedPreferences(ctx context.Context, preferences core.FeedPreferences) (core.FeedPreferences, error) {
+	ret := _m.Called(ctx, preferences)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeedPreferences")
+	}
+
+	var r0 core.FeedPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.FeedPreferences) (core.FeedPreferences, error)); ok {
+		return rf(ctx, preferences)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.FeedPreferences) core.FeedPreferences); ok {
+		r0 = rf(ctx, preferences)
+	} else {
+		r0 = ret.Get(0).(core.FeedPreferences)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.FeedPreferences) error); ok {
+		r1 = rf(ctx, preferences)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFeedService_UpdateFeedPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeedPreferences'
+type MockFeedService_UpdateFeedPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateFeedPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - preferences core.FeedPreferences
+func (_e *MockFeedService_Expecter) UpdateFeedPreferences(ctx interface{}, preferences interface{}) *MockFeedService_UpdateFeedPreferences_Call {
+	return &MockFeedService_UpdateFeedPreferences_Call{Call: _e.mock.On("UpdateFeedPreferences", ctx, preferences)}
+}
+
+func (_c *MockFeedService_UpdateFeedPreferences_Call) Run(run func(ctx context.Context, preferences core.FeedPreferences)) *MockFeedService_UpdateFeedPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.FeedPreferences))
+	})
+	return _c
+}
+
+func (_c *MockFeedService_UpdateFeedPreferences_Call) Return(updated core.FeedPreferences, err error) *MockFeedService_UpdateFeedPreferences_Call {
+	_c.Call.Return(updated, err)
+	return _c
+}
+
+func (_c *MockFeedService_UpdateFeedPreferences_Call) RunAndReturn(run func(context.Context, core.FeedPreferences) (core.FeedPreferences, error)) *MockFeedService_UpdateFeedPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFeedService creates a new instance of MockFeedService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFeedService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFeedService {
+	mock := &MockFeedService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
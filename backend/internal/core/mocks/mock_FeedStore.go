@@ -0,0 +1,217 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockFeedStore is an autogenerated mock type for the FeedStore type
+type MockFeedStore struct {
+	mock.Mock
+}
+
+type MockFeedStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFeedStore) EXPECT() *MockFeedStore_Expecter {
+	return &MockFeedStore_Expecter{mock: &_m.Mock}
+}
+
+// GetFeed provides a mock function with given fields: ctx, query
+func (_m *MockFeedStore) GetFeed(ctx context.Context, query core.FeedQuery) ([]core.FeedPost, int, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeed")
+	}
+
+	var r0 []core.FeedPost
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.FeedQuery) ([]core.FeedPost, int, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.FeedQuery) []core.FeedPost); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.FeedPost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.FeedQuery) int); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.FeedQuery) error); ok {
+		r2 = rf(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockFeedStore_GetFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeed'
+type MockFeedStore_GetFeed_Call struct {
+	*mock.Call
+}
+
+// GetFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query core.FeedQuery
+func (_e *MockFeedStore_Expecter) GetFeed(ctx interface{}, query interface{}) *MockFeedStore_GetFeed_Call {
+	return &MockFeedStore_GetFeed_Call{Call: _e.mock.On("GetFeed", ctx, query)}
+}
+
+func (_c *MockFeedStore_GetFeed_Call) Run(run func(ctx context.Context, query core.FeedQuery)) *MockFeedStore_GetFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.FeedQuery))
+	})
+	return _c
+}
+
+func (_c *MockFeedStore_GetFeed_Call) Return(posts []core.FeedPost, total int, err error) *MockFeedStore_GetFeed_Call {
+	_c.Call.Return(posts, total, err)
+	return _c
+}
+
+func (_c *MockFeedStore_GetFeed_Call) RunAndReturn(run func(context.Context, core.FeedQuery) ([]core.FeedPost, int, error)) *MockFeedStore_GetFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeedPreferences provides a mock function with given fields: ctx, userID
+func (_m *MockFeedStore) GetFeedPreferences(ctx context.Context, userID int) (core.FeedPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedPreferences")
+	}
+
+	var r0 core.FeedPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (core.FeedPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) core.FeedPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(core.FeedPreferences)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFeedStore_GetFeedPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeedPreferences'
+type MockFeedStore_GetFeedPreferences_Call struct {
+	*mock.Call
+}
+
+// GetFeedPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockFeedStore_Expecter) GetFeedPreferences(ctx interface{}, userID interface{}) *MockFeedStore_GetFeedPreferences_Call {
+	return &MockFeedStore_GetFeedPreferences_Call{Call: _e.mock.On("GetFeedPreferences", ctx, userID)}
+}
+
+func (_c *MockFeedStore_GetFeedPreferences_Call) Run(run func(ctx context.Context, userID int)) *MockFeedStore_GetFeedPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockFeedStore_GetFeedPreferences_Call) Return(preferences core.FeedPreferences, err error) *MockFeedStore_GetFeedPreferences_Call {
+	_c.Call.Return(preferences, err)
+	return _c
+}
+
+func (_c *MockFeedStore_GetFeedPreferences_Call) RunAndReturn(run func(context.Context, int) (core.FeedPreferences, error)) *MockFeedStore_GetFeedPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveFeedPreferences provides a mock function with given fields: ctx, preferences
+func (_m *MockFeedStore) SaveFeedPreferences(ctx context.Context, preferences core.FeedPreferences) (core.FeedPreferences, error) {
+	ret := _m.Called(ctx, preferences)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveFeedPreferences")
+	}
+
+	var r0 core.FeedPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.FeedPreferences) (core.FeedPreferences, error)); ok {
+		return rf(ctx, preferences)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.FeedPreferences) core.FeedPreferences); ok {
+		r0 = rf(ctx, preferences)
+	} else {
+		r0 = ret.Get(0).(core.FeedPreferences)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.FeedPreferences) error); ok {
+		r1 = rf(ctx, preferences)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFeedStore_SaveFeedPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveFeedPreferences'
+type MockFeedStore_SaveFeedPreferences_Call struct {
+	*mock.Call
+}
+
+// SaveFeedPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - preferences core.FeedPreferences
+func (_e *MockFeedStore_Expecter) SaveFeedPreferences(ctx interface{}, preferences interface{}) *MockFeedStore_SaveFeedPreferences_Call {
+	return &MockFeedStore_SaveFeedPreferences_Call{Call: _e.mock.On("SaveFeedPreferences", ctx, preferences)}
+}
+
+func (_c *MockFeedStore_SaveFeedPreferences_Call) Run(run func(ctx context.Context, preferences core.FeedPreferences)) *MockFeedStore_SaveFeedPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.FeedPreferences))
+	})
+	return _c
+}
+
+func (_c *MockFeedStore_SaveFeedPreferences_Call) Return(saved core.FeedPreferences, err error) *MockFeedStore_SaveFeedPreferences_Call {
+	_c.Call.Return(saved, err)
+	return _c
+}
+
+func (_c *MockFeedStore_SaveFeedPreferences_Call) RunAndReturn(run func(context.Context, core.FeedPreferences) (core.FeedPreferences, error)) *MockFeedStore_SaveFeedPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFeedStore creates a new instance of MockFeedStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFeedStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFeedStore {
+	mock := &MockFeedStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
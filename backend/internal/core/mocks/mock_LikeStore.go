@@ -0,0 +1,347 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLikeStore is an autogenerated mock type for the LikeStore type
+type MockLikeStore struct {
+	mock.Mock
+}
+
+type MockLikeStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLikeStore) EXPECT() *MockLikeStore_Expecter {
+	return &MockLikeStore_Expecter{mock: &_m.Mock}
+}
+
+// GetCommentsLikes provides a mock function with given fields: ctx, commentIDs, userID
+func (_m *MockLikeStore) GetCommentsLikes(ctx context.Context, commentIDs []int, userID int) (map[int]core.Likes, error) {
+	ret := _m.Called(ctx, commentIDs, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentsLikes")
+	}
+
+	var r0 map[int]core.Likes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) (map[int]core.Likes, error)); ok {
+		return rf(ctx, commentIDs, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) map[int]core.Likes); ok {
+		r0 = rf(ctx, commentIDs, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]core.Likes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, int) error); ok {
+		r1 = rf(ctx, commentIDs, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLikeStore_GetCommentsLikes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentsLikes'
+type MockLikeStore_GetCommentsLikes_Call struct {
+	*mock.Call
+}
+
+// GetCommentsLikes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentIDs []int
+//   - userID int
+func (_e *MockLikeStore_Expecter) GetCommentsLikes(ctx interface{}, commentIDs interface{}, userID interface{}) *MockLikeStore_GetCommentsLikes_Call {
+	return &MockLikeStore_GetCommentsLikes_Call{Call: _e.mock.On("GetCommentsLikes", ctx, commentIDs, userID)}
+}
+
+func (_c *MockLikeStore_GetCommentsLikes_Call) Run(run func(ctx context.Context, commentIDs []int, userID int)) *MockLikeStore_GetCommentsLikes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockLikeStore_GetCommentsLikes_Call) Return(_a0 map[int]core.Likes, _a1 error) *MockLikeStore_GetCommentsLikes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLikeStore_GetCommentsLikes_Call) RunAndReturn(run func(context.Context, []int, int) (map[int]core.Likes, error)) *MockLikeStore_GetCommentsLikes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostsLikes provides a mock function with given fields: ctx, postIDs, userID
+func (_m *MockLikeStore) GetPostsLikes(ctx context.Context, postIDs []int, userID int) (map[int]core.Likes, error) {
+	ret := _m.Called(ctx, postIDs, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostsLikes")
+	}
+
+	var r0 map[int]core.Likes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) (map[int]core.Likes, error)); ok {
+		return rf(ctx, postIDs, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) map[int]core.Likes); ok {
+		r0 = rf(ctx, postIDs, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]core.Likes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, int) error); ok {
+		r1 = rf(ctx, postIDs, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLikeStore_GetPostsLikes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostsLikes'
+type MockLikeStore_GetPostsLikes_Call struct {
+	*mock.Call
+}
+
+// GetPostsLikes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postIDs []int
+//   - userID int
+func (_e *MockLikeStore_Expecter) GetPostsLikes(ctx interface{}, postIDs interface{}, userID interface{}) *MockLikeStore_GetPostsLikes_Call {
+	return &MockLikeStore_GetPostsLikes_Call{Call: _e.mock.On("GetPostsLikes", ctx, postIDs, userID)}
+}
+
+func (_c *MockLikeStore_GetPostsLikes_Call) Run(run func(ctx context.Context, postIDs []int, userID int)) *MockLikeStore_GetPostsLikes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockLikeStore_GetPostsLikes_Call) Return(_a0 map[int]core.Likes, _a1 error) *MockLikeStore_GetPostsLikes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLikeStore_GetPostsLikes_Call) RunAndReturn(run func(context.Context, []int, int) (map[int]core.Likes, error)) *MockLikeStore_GetPostsLikes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LikeComment provides a mock function with given fields: ctx, like
+func (_m *MockLikeStore) LikeComment(ctx context.Context, like core.CommentLike) error {
+	ret := _m.Called(ctx, like)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LikeComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.CommentLike) error); ok {
+		r0 = rf(ctx, like)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLikeStore_LikeComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LikeComment'
+type MockLikeStore_LikeComment_Call struct {
+	*mock.Call
+}
+
+// LikeComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - like core.CommentLike
+func (_e *MockLikeStore_Expecter) LikeComment(ctx interface{}, like interface{}) *MockLikeStore_LikeComment_Call {
+	return &MockLikeStore_LikeComment_Call{Call: _e.mock.On("LikeComment", ctx, like)}
+}
+
+func (_c *MockLikeStore_LikeComment_Call) Run(run func(ctx context.Context, like core.CommentLike)) *MockLikeStore_LikeComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.CommentLike))
+	})
+	return _c
+}
+
+func (_c *MockLikeStore_LikeComment_Call) Return(_a0 error) *MockLikeStore_LikeComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLikeStore_LikeComment_Call) RunAndReturn(run func(context.Context, core.CommentLike) error) *MockLikeStore_LikeComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LikePost provides a mock function with given fields: ctx, like
+func (_m *MockLikeStore) LikePost(ctx context.Context, like core.PostLike) error {
+	ret := _m.Called(ctx, like)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LikePost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.PostLike) error); ok {
+		r0 = rf(ctx, like)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLikeStore_LikePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LikePost'
+type MockLikeStore_LikePost_Call struct {
+	*mock.Call
+}
+
+// LikePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - like core.PostLike
+func (_e *MockLikeStore_Expecter) LikePost(ctx interface{}, like interface{}) *MockLikeStore_LikePost_Call {
+	return &MockLikeStore_LikePost_Call{Call: _e.mock.On("LikePost", ctx, like)}
+}
+
+func (_c *MockLikeStore_LikePost_Call) Run(run func(ctx context.Context, like core.PostLike)) *MockLikeStore_LikePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.PostLike))
+	})
+	return _c
+}
+
+func (_c *MockLikeStore_LikePost_Call) Return(_a0 error) *MockLikeStore_LikePost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLikeStore_LikePost_Call) RunAndReturn(run func(context.Context, core.PostLike) error) *MockLikeStore_LikePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlikeComment provides a mock function with given fields: ctx, commentID, userID
+func (_m *MockLikeStore) UnlikeComment(ctx context.Context, commentID int, userID int) error {
+	ret := _m.Called(ctx, commentID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlikeComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, commentID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLikeStore_UnlikeComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlikeComment'
+type MockLikeStore_UnlikeComment_Call struct {
+	*mock.Call
+}
+
+// UnlikeComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int
+//   - userID int
+func (_e *MockLikeStore_Expecter) UnlikeComment(ctx interface{}, commentID interface{}, userID interface{}) *MockLikeStore_UnlikeComment_Call {
+	return &MockLikeStore_UnlikeComment_Call{Call: _e.mock.On("UnlikeComment", ctx, commentID, userID)}
+}
+
+func (_c *MockLikeStore_UnlikeComment_Call) Run(run func(ctx context.Context, commentID int, userID int)) *MockLikeStore_UnlikeComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockLikeStore_UnlikeComment_Call) Return(_a0 error) *MockLikeStore_UnlikeComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLikeStore_UnlikeComment_Call) RunAndReturn(run func(context.Context, int, int) error) *MockLikeStore_UnlikeComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlikePost provides a mock function with given fields: ctx, postID, userID
+func (_m *MockLikeStore) UnlikePost(ctx context.Context, postID int, userID int) error {
+	ret := _m.Called(ctx, postID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlikePost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, postID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLikeStore_UnlikePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlikePost'
+type MockLikeStore_UnlikePost_Call struct {
+	*mock.Call
+}
+
+// UnlikePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int
+//   - userID int
+func (_e *MockLikeStore_Expecter) UnlikePost(ctx interface{}, postID interface{}, userID interface{}) *MockLikeStore_UnlikePost_Call {
+	return &MockLikeStore_UnlikePost_Call{Call: _e.mock.On("UnlikePost", ctx, postID, userID)}
+}
+
+func (_c *MockLikeStore_UnlikePost_Call) Run(run func(ctx context.Context, postID int, userID int)) *MockLikeStore_UnlikePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockLikeStore_UnlikePost_Call) Return(_a0 error) *MockLikeStore_UnlikePost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLikeStore_UnlikePost_Call) RunAndReturn(run func(context.Context, int, int) error) *MockLikeStore_UnlikePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLikeStore creates a new instance of MockLikeStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLikeStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLikeStore {
+	mock := &MockLikeStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
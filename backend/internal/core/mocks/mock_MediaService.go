@@ -0,0 +1,895 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMediaService is an autogenerated mock type for the MediaService type
+type MockMediaService struct {
+	mock.Mock
+}
+
+type MockMediaService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMediaService) EXPECT() *MockMediaService_Expecter {
+	return &MockMediaService_Expecter{mock: &_m.Mock}
+}
+
+// FindSimilarPhotos provides a mock function with given fields: ctx, ownerType, hash, maxDistance, limit
+func (_m *MockMediaService) FindSimilarPhotos(ctx context.Context, ownerType core.MediaOwnerType, hash int64, maxDistance int, limit int) ([]core.MediaMatch, error) {
+	ret := _m.Called(ctx, ownerType, hash, maxDistance, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSimilarPhotos")
+	}
+
+	var r0 []core.MediaMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, int64, int, int) ([]core.MediaMatch, error)); ok {
+		return rf(ctx, ownerType, hash, maxDistance, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, int64, int, int) []core.MediaMatch); ok {
+		r0 = rf(ctx, ownerType, hash, maxDistance, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.MediaMatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.MediaOwnerType, int64, int, int) error); ok {
+		r1 = rf(ctx, ownerType, hash, maxDistance, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_FindSimilarPhotos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSimilarPhotos'
+type MockMediaService_FindSimilarPhotos_Call struct {
+	*mock.Call
+}
+
+// FindSimilarPhotos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerType core.MediaOwnerType
+//   - hash int64
+//   - maxDistance int
+//   - limit int
+func (_e *MockMediaService_Expecter) FindSimilarPhotos(ctx interface{}, ownerType interface{}, hash interface{}, maxDistance interface{}, limit interface{}) *MockMediaService_FindSimilarPhotos_Call {
+	return &MockMediaService_FindSimilarPhotos_Call{Call: _e.mock.On("FindSimilarPhotos", ctx, ownerType, hash, maxDistance, limit)}
+}
+
+func (_c *MockMediaService_FindSimilarPhotos_Call) Run(run func(ctx context.Context, ownerType core.MediaOwnerType, hash int64, maxDistance int, limit int)) *MockMediaService_FindSimilarPhotos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.MediaOwnerType), args[2].(int64), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_FindSimilarPhotos_Call) Return(matches []core.MediaMatch, err error) *MockMediaService_FindSimilarPhotos_Call {
+	_c.Call.Return(matches, err)
+	return _c
+}
+
+func (_c *MockMediaService_FindSimilarPhotos_Call) RunAndReturn(run func(context.Context, core.MediaOwnerType, int64, int, int) ([]core.MediaMatch, error)) *MockMediaService_FindSimilarPhotos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnimalEventsPhotos provides a mock function with given fields: ctx, eventIDs
+func (_m *MockMediaService) GetAnimalEventsPhotos(ctx context.Context, eventIDs []int) (map[int][]core.Media, error) {
+	ret := _m.Called(ctx, eventIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnimalEventsPhotos")
+	}
+
+	var r0 map[int][]core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (map[int][]core.Media, error)); ok {
+		return rf(ctx, eventIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) map[int][]core.Media); ok {
+		r0 = rf(ctx, eventIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int][]core.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, eventIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_GetAnimalEventsPhotos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnimalEventsPhotos'
+type MockMediaService_GetAnimalEventsPhotos_Call struct {
+	*mock.Call
+}
+
+// GetAnimalEventsPhotos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventIDs []int
+func (_e *MockMediaService_Expecter) GetAnimalEventsPhotos(ctx interface{}, eventIDs interface{}) *MockMediaService_GetAnimalEventsPhotos_Call {
+	return &MockMediaService_GetAnimalEventsPhotos_Call{Call: _e.mock.On("GetAnimalEventsPhotos", ctx, eventIDs)}
+}
+
+func (_c *MockMediaService_GetAnimalEventsPhotos_Call) Run(run func(ctx context.Context, eventIDs []int)) *MockMediaService_GetAnimalEventsPhotos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_GetAnimalEventsPhotos_Call) Return(media map[int][]core.Media, err error) *MockMediaService_GetAnimalEventsPhotos_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaService_GetAnimalEventsPhotos_Call) RunAndReturn(run func(context.Context, []int) (map[int][]core.Media, error)) *MockMediaService_GetAnimalEventsPhotos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMediaFile provides a mock function with given fields: ctx, id, params
+func (_m *MockMediaService) GetMediaFile(ctx context.Context, id int, params core.MediaFileParams) (core.MediaFile, error) {
+	ret := _m.Called(ctx, id, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMediaFile")
+	}
+
+	var r0 core.MediaFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.MediaFileParams) (core.MediaFile, error)); ok {
+		return rf(ctx, id, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.MediaFileParams) core.MediaFile); ok {
+		r0 = rf(ctx, id, params)
+	} else {
+		r0 = ret.Get(0).(core.MediaFile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.MediaFileParams) error); ok {
+		r1 = rf(ctx, id, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_GetMediaFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaFile'
+type MockMediaService_GetMediaFile_Call struct {
+	*mock.Call
+}
+
+// GetMediaFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - params core.MediaFileParams
+func (_e *MockMediaService_Expecter) GetMediaFile(ctx interface{}, id interface{}, params interface{}) *MockMediaService_GetMediaFile_Call {
+	return &MockMediaService_GetMediaFile_Call{Call: _e.mock.On("GetMediaFile", ctx, id, params)}
+}
+
+func (_c *MockMediaService_GetMediaFile_Call) Run(run func(ctx context.Context, id int, params core.MediaFileParams)) *MockMediaService_GetMediaFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(core.MediaFileParams))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_GetMediaFile_Call) Return(file core.MediaFile, err error) *MockMediaService_GetMediaFile_Call {
+	_c.Call.Return(file, err)
+	return _c
+}
+
+func (_c *MockMediaService_GetMediaFile_Call) RunAndReturn(run func(context.Context, int, core.MediaFileParams) (core.MediaFile, error)) *MockMediaService_GetMediaFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostPhotoFile provides a mock function with given fields: ctx, postID, position, params
+func (_m *MockMediaService) GetPostPhotoFile(ctx context.Context, postID int, position int, params core.MediaFileParams) (core.MediaFile, error) {
+	ret := _m.Called(ctx, postID, position, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostPhotoFile")
+	}
+
+	var r0 core.MediaFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, core.MediaFileParams) (core.MediaFile, error)); ok {
+		return rf(ctx, postID, position, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, core.MediaFileParams) core.MediaFile); ok {
+		r0 = rf(ctx, postID, position, params)
+	} else {
+		r0 = ret.Get(0).(core.MediaFile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, core.MediaFileParams) error); ok {
+		r1 = rf(ctx, postID, position, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_GetPostPhotoFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostPhotoFile'
+type MockMediaService_GetPostPhotoFile_Call struct {
+	*mock.Call
+}
+
+// GetPostPhotoFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int
+//   - position int
+//   - params core.MediaFileParams
+func (_e *MockMediaService_Expecter) GetPostPhotoFile(ctx interface{}, postID interface{}, position interface{}, params interface{}) *MockMediaService_GetPostPhotoFile_Call {
+	return &MockMediaService_GetPostPhotoFile_Call{Call: _e.mock.On("GetPostPhotoFile", ctx, postID, position, params)}
+}
+
+func (_c *MockMediaService_GetPostPhotoFile_Call) Run(run func(ctx context.Context, postID int, position int, params core.MediaFileParams)) *MockMediaService_GetPostPhotoFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(core.MediaFileParams))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_GetPostPhotoFile_Call) Return(file core.MediaFile, err error) *MockMediaService_GetPostPhotoFile_Call {
+	_c.Call.Return(file, err)
+	return _c
+}
+
+func (_c *MockMediaService_GetPostPhotoFile_Call) RunAndReturn(run func(context.Context, int, int, core.MediaFileParams) (core.MediaFile, error)) *MockMediaService_GetPostPhotoFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostPhotos provides a mock function with given fields: ctx, postID
+func (_m *MockMediaService) GetPostPhotos(ctx context.Context, postID int) ([]core.Media, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostPhotos")
+	}
+
+	var r0 []core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.Media, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []core.Media); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_GetPostPhotos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostPhotos'
+type MockMediaService_GetPostPhotos_Call struct {
+	*mock.Call
+}
+
+// GetPostPhotos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int
+func (_e *MockMediaService_Expecter) GetPostPhotos(ctx interface{}, postID interface{}) *MockMediaService_GetPostPhotos_Call {
+	return &MockMediaService_GetPostPhotos_Call{Call: _e.mock.On("GetPostPhotos", ctx, postID)}
+}
+
+func (_c *MockMediaService_GetPostPhotos_Call) Run(run func(ctx context.Context, postID int)) *MockMediaService_GetPostPhotos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_GetPostPhotos_Call) Return(media []core.Media, err error) *MockMediaService_GetPostPhotos_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaService_GetPostPhotos_Call) RunAndReturn(run func(context.Context, int) ([]core.Media, error)) *MockMediaService_GetPostPhotos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostsPhotos provides a mock function with given fields: ctx, postIDs
+func (_m *MockMediaService) GetPostsPhotos(ctx context.Context, postIDs []int) (map[int][]core.Media, error) {
+	ret := _m.Called(ctx, postIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostsPhotos")
+	}
+
+	var r0 map[int][]core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (map[int][]core.Media, error)); ok {
+		return rf(ctx, postIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) map[int][]core.Media); ok {
+		r0 = rf(ctx, postIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int][]core.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, postIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_GetPostsPhotos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostsPhotos'
+type MockMediaService_GetPostsPhotos_Call struct {
+	*mock.Call
+}
+
+// GetPostsPhotos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postIDs []int
+func (_e *MockMediaService_Expecter) GetPostsPhotos(ctx interface{}, postIDs interface{}) *MockMediaService_GetPostsPhotos_Call {
+	return &MockMediaService_GetPostsPhotos_Call{Call: _e.mock.On("GetPostsPhotos", ctx, postIDs)}
+}
+
+func (_c *MockMediaService_GetPostsPhotos_Call) Run(run func(ctx context.Context, postIDs []int)) *MockMediaService_GetPostsPhotos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_GetPostsPhotos_Call) Return(media map[int][]core.Media, err error) *MockMediaService_GetPostsPhotos_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaService_GetPostsPhotos_Call) RunAndReturn(run func(context.Context, []int) (map[int][]core.Media, error)) *MockMediaService_GetPostsPhotos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserPhoto provides a mock function with given fields: ctx, userID
+func (_m *MockMediaService) GetUserPhoto(ctx context.Context, userID int) (*core.Media, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserPhoto")
+	}
+
+	var r0 *core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*core.Media, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *core.Media); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_GetUserPhoto_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserPhoto'
+type MockMediaService_GetUserPhoto_Call struct {
+	*mock.Call
+}
+
+// GetUserPhoto is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockMediaService_Expecter) GetUserPhoto(ctx interface{}, userID interface{}) *MockMediaService_GetUserPhoto_Call {
+	return &MockMediaService_GetUserPhoto_Call{Call: _e.mock.On("GetUserPhoto", ctx, userID)}
+}
+
+func (_c *MockMediaService_GetUserPhoto_Call) Run(run func(ctx context.Context, userID int)) *MockMediaService_GetUserPhoto_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_GetUserPhoto_Call) Return(media *core.Media, err error) *MockMediaService_GetUserPhoto_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaService_GetUserPhoto_Call) RunAndReturn(run func(context.Context, int) (*core.Media, error)) *MockMediaService_GetUserPhoto_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserPhotoFile provides a mock function with given fields: ctx, userID, params
+func (_m *MockMediaService) GetUserPhotoFile(ctx context.Context, userID int, params core.MediaFileParams) (core.MediaFile, error) {
+	ret := _m.Called(ctx, userID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserPhotoFile")
+	}
+
+	var r0 core.MediaFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.MediaFileParams) (core.MediaFile, error)); ok {
+		return rf(ctx, userID, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.MediaFileParams) core.MediaFile); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		r0 = ret.Get(0).(core.MediaFile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.MediaFileParams) error); ok {
+		r1 = rf(ctx, userID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_GetUserPhotoFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserPhotoFile'
+type MockMediaService_GetUserPhotoFile_Call struct {
+	*mock.Call
+}
+
+// GetUserPhotoFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - params core.MediaFileParams
+func (_e *MockMediaService_Expecter) GetUserPhotoFile(ctx interface{}, userID interface{}, params interface{}) *MockMediaService_GetUserPhotoFile_Call {
+	return &MockMediaService_GetUserPhotoFile_Call{Call: _e.mock.On("GetUserPhotoFile", ctx, userID, params)}
+}
+
+func (_c *MockMediaService_GetUserPhotoFile_Call) Run(run func(ctx context.Context, userID int, params core.MediaFileParams)) *MockMediaService_GetUserPhotoFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(core.MediaFileParams))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_GetUserPhotoFile_Call) Return(file core.MediaFile, err error) *MockMediaService_GetUserPhotoFile_Call {
+	_c.Call.Return(file, err)
+	return _c
+}
+
+func (_c *MockMediaService_GetUserPhotoFile_Call) RunAndReturn(run func(context.Context, int, core.MediaFileParams) (core.MediaFile, error)) *MockMediaService_GetUserPhotoFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HashMissingPhotos provides a mock function with given fields: ctx
+func (_m *MockMediaService) HashMissingPhotos(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HashMissingPhotos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMediaService_HashMissingPhotos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HashMissingPhotos'
+type MockMediaService_HashMissingPhotos_Call struct {
+	*mock.Call
+}
+
+// HashMissingPhotos is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockMediaService_Expecter) HashMissingPhotos(ctx interface{}) *MockMediaService_HashMissingPhotos_Call {
+	return &MockMediaService_HashMissingPhotos_Call{Call: _e.mock.On("HashMissingPhotos", ctx)}
+}
+
+func (_c *MockMediaService_HashMissingPhotos_Call) Run(run func(ctx context.Context)) *MockMediaService_HashMissingPhotos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_HashMissingPhotos_Call) Return(_a0 error) *MockMediaService_HashMissingPhotos_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMediaService_HashMissingPhotos_Call) RunAndReturn(run func(context.Context) error) *MockMediaService_HashMissingPhotos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HashPhoto provides a mock function with given fields: photo
+func (_m *MockMediaService) HashPhoto(photo core.MediaUpload) (int64, error) {
+	ret := _m.Called(photo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HashPhoto")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(core.MediaUpload) (int64, error)); ok {
+		return rf(photo)
+	}
+	if rf, ok := ret.Get(0).(func(core.MediaUpload) int64); ok {
+		r0 = rf(photo)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(core.MediaUpload) error); ok {
+		r1 = rf(photo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_HashPhoto_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HashPhoto'
+type MockMediaService_HashPhoto_Call struct {
+	*mock.Call
+}
+
+// HashPhoto is a helper method to define mock.On call
+//   - photo core.MediaUpload
+func (_e *MockMediaService_Expecter) HashPhoto(photo interface{}) *MockMediaService_HashPhoto_Call {
+	return &MockMediaService_HashPhoto_Call{Call: _e.mock.On("HashPhoto", photo)}
+}
+
+func (_c *MockMediaService_HashPhoto_Call) Run(run func(photo core.MediaUpload)) *MockMediaService_HashPhoto_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.MediaUpload))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_HashPhoto_Call) Return(hash int64, err error) *MockMediaService_HashPhoto_Call {
+	_c.Call.Return(hash, err)
+	return _c
+}
+
+func (_c *MockMediaService_HashPhoto_Call) RunAndReturn(run func(core.MediaUpload) (int64, error)) *MockMediaService_HashPhoto_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveLegacyBlobs provides a mock function with given fields: ctx
+func (_m *MockMediaService) MoveLegacyBlobs(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveLegacyBlobs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMediaService_MoveLegacyBlobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveLegacyBlobs'
+type MockMediaService_MoveLegacyBlobs_Call struct {
+	*mock.Call
+}
+
+// MoveLegacyBlobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockMediaService_Expecter) MoveLegacyBlobs(ctx interface{}) *MockMediaService_MoveLegacyBlobs_Call {
+	return &MockMediaService_MoveLegacyBlobs_Call{Call: _e.mock.On("MoveLegacyBlobs", ctx)}
+}
+
+func (_c *MockMediaService_MoveLegacyBlobs_Call) Run(run func(ctx context.Context)) *MockMediaService_MoveLegacyBlobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_MoveLegacyBlobs_Call) Return(_a0 error) *MockMediaService_MoveLegacyBlobs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMediaService_MoveLegacyBlobs_Call) RunAndReturn(run func(context.Context) error) *MockMediaService_MoveLegacyBlobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadMediaFile provides a mock function with given fields: ctx, file
+func (_m *MockMediaService) ReadMediaFile(ctx context.Context, file core.MediaFile) ([]byte, error) {
+	ret := _m.Called(ctx, file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadMediaFile")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaFile) ([]byte, error)); ok {
+		return rf(ctx, file)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaFile) []byte); ok {
+		r0 = rf(ctx, file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.MediaFile) error); ok {
+		r1 = rf(ctx, file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_ReadMediaFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMediaFile'
+type MockMediaService_ReadMediaFile_Call struct {
+	*mock.Call
+}
+
+// ReadMediaFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - file core.MediaFile
+func (_e *MockMediaService_Expecter) ReadMediaFile(ctx interface{}, file interface{}) *MockMediaService_ReadMediaFile_Call {
+	return &MockMediaService_ReadMediaFile_Call{Call: _e.mock.On("ReadMediaFile", ctx, file)}
+}
+
+func (_c *MockMediaService_ReadMediaFile_Call) Run(run func(ctx context.Context, file core.MediaFile)) *MockMediaService_ReadMediaFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.MediaFile))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_ReadMediaFile_Call) Return(data []byte, err error) *MockMediaService_ReadMediaFile_Call {
+	_c.Call.Return(data, err)
+	return _c
+}
+
+func (_c *MockMediaService_ReadMediaFile_Call) RunAndReturn(run func(context.Context, core.MediaFile) ([]byte, error)) *MockMediaService_ReadMediaFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAnimalEventPhotos provides a mock function with given fields: ctx, eventID, photos
+func (_m *MockMediaService) SetAnimalEventPhotos(ctx context.Context, eventID int, photos []core.MediaUpload) ([]core.Media, error) {
+	ret := _m.Called(ctx, eventID, photos)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAnimalEventPhotos")
+	}
+
+	var r0 []core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []core.MediaUpload) ([]core.Media, error)); ok {
+		return rf(ctx, eventID, photos)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []core.MediaUpload) []core.Media); ok {
+		r0 = rf(ctx, eventID, photos)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []core.MediaUpload) error); ok {
+		r1 = rf(ctx, eventID, photos)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_SetAnimalEventPhotos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAnimalEventPhotos'
+type MockMediaService_SetAnimalEventPhotos_Call struct {
+	*mock.Call
+}
+
+// SetAnimalEventPhotos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID int
+//   - photos []core.MediaUpload
+func (_e *MockMediaService_Expecter) SetAnimalEventPhotos(ctx interface{}, eventID interface{}, photos interface{}) *MockMediaService_SetAnimalEventPhotos_Call {
+	return &MockMediaService_SetAnimalEventPhotos_Call{Call: _e.mock.On("SetAnimalEventPhotos", ctx, eventID, photos)}
+}
+
+func (_c *MockMediaService_SetAnimalEventPhotos_Call) Run(run func(ctx context.Context, eventID int, photos []core.MediaUpload)) *MockMediaService_SetAnimalEventPhotos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]core.MediaUpload))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_SetAnimalEventPhotos_Call) Return(media []core.Media, err error) *MockMediaService_SetAnimalEventPhotos_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaService_SetAnimalEventPhotos_Call) RunAndReturn(run func(context.Context, int, []core.MediaUpload) ([]core.Media, error)) *MockMediaService_SetAnimalEventPhotos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPostPhotos provides a mock function with given fields: ctx, postID, photos
+func (_m *MockMediaService) SetPostPhotos(ctx context.Context, postID int, photos []core.MediaUpload) ([]core.Media, error) {
+	ret := _m.Called(ctx, postID, photos)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPostPhotos")
+	}
+
+	var r0 []core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []core.MediaUpload) ([]core.Media, error)); ok {
+		return rf(ctx, postID, photos)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []core.MediaUpload) []core.Media); ok {
+		r0 = rf(ctx, postID, photos)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []core.MediaUpload) error); ok {
+		r1 = rf(ctx, postID, photos)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_SetPostPhotos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPostPhotos'
+type MockMediaService_SetPostPhotos_Call struct {
+	*mock.Call
+}
+
+// SetPostPhotos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int
+//   - photos []core.MediaUpload
+func (_e *MockMediaService_Expecter) SetPostPhotos(ctx interface{}, postID interface{}, photos interface{}) *MockMediaService_SetPostPhotos_Call {
+	return &MockMediaService_SetPostPhotos_Call{Call: _e.mock.On("SetPostPhotos", ctx, postID, photos)}
+}
+
+func (_c *MockMediaService_SetPostPhotos_Call) Run(run func(ctx context.Context, postID int, photos []core.MediaUpload)) *MockMediaService_SetPostPhotos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]core.MediaUpload))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_SetPostPhotos_Call) Return(media []core.Media, err error) *MockMediaService_SetPostPhotos_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaService_SetPostPhotos_Call) RunAndReturn(run func(context.Context, int, []core.MediaUpload) ([]core.Media, error)) *MockMediaService_SetPostPhotos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserPhoto provides a mock function with given fields: ctx, userID, photo
+func (_m *MockMediaService) SetUserPhoto(ctx context.Context, userID int, photo core.MediaUpload) (core.Media, error) {
+	ret := _m.Called(ctx, userID, photo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserPhoto")
+	}
+
+	var r0 core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.MediaUpload) (core.Media, error)); ok {
+		return rf(ctx, userID, photo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.MediaUpload) core.Media); ok {
+		r0 = rf(ctx, userID, photo)
+	} else {
+		r0 = ret.Get(0).(core.Media)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.MediaUpload) error); ok {
+		r1 = rf(ctx, userID, photo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaService_SetUserPhoto_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserPhoto'
+type MockMediaService_SetUserPhoto_Call struct {
+	*mock.Call
+}
+
+// SetUserPhoto is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - photo core.MediaUpload
+func (_e *MockMediaService_Expecter) SetUserPhoto(ctx interface{}, userID interface{}, photo interface{}) *MockMediaService_SetUserPhoto_Call {
+	return &MockMediaService_SetUserPhoto_Call{Call: _e.mock.On("SetUserPhoto", ctx, userID, photo)}
+}
+
+func (_c *MockMediaService_SetUserPhoto_Call) Run(run func(ctx context.Context, userID int, photo core.MediaUpload)) *MockMediaService_SetUserPhoto_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(core.MediaUpload))
+	})
+	return _c
+}
+
+func (_c *MockMediaService_SetUserPhoto_Call) Return(media core.Media, err error) *MockMediaService_SetUserPhoto_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaService_SetUserPhoto_Call) RunAndReturn(run func(context.Context, int, core.MediaUpload) (core.Media, error)) *MockMediaService_SetUserPhoto_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMediaService creates a new instance of MockMediaService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMediaService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMediaService {
+	mock := &MockMediaService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,609 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMediaStore is an autogenerated mock type for the MediaStore type
+type MockMediaStore struct {
+	mock.Mock
+}
+
+type MockMediaStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMediaStore) EXPECT() *MockMediaStore_Expecter {
+	return &MockMediaStore_Expecter{mock: &_m.Mock}
+}
+
+// CompleteLegacyBlob provides a mock function with given fields: ctx, media
+func (_m *MockMediaStore) CompleteLegacyBlob(ctx context.Context, media core.Media) error {
+	ret := _m.Called(ctx, media)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteLegacyBlob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.Media) error); ok {
+		r0 = rf(ctx, media)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMediaStore_CompleteLegacyBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteLegacyBlob'
+type MockMediaStore_CompleteLegacyBlob_Call struct {
+	*mock.Call
+}
+
+// CompleteLegacyBlob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - media core.Media
+func (_e *MockMediaStore_Expecter) CompleteLegacyBlob(ctx interface{}, media interface{}) *MockMediaStore_CompleteLegacyBlob_Call {
+	return &MockMediaStore_CompleteLegacyBlob_Call{Call: _e.mock.On("CompleteLegacyBlob", ctx, media)}
+}
+
+func (_c *MockMediaStore_CompleteLegacyBlob_Call) Run(run func(ctx context.Context, media core.Media)) *MockMediaStore_CompleteLegacyBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.Media))
+	})
+	return _c
+}
+
+func (_c *MockMediaStore_CompleteLegacyBlob_Call) Return(_a0 error) *MockMediaStore_CompleteLegacyBlob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMediaStore_CompleteLegacyBlob_Call) RunAndReturn(run func(context.Context, core.Media) error) *MockMediaStore_CompleteLegacyBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSimilarMedia provides a mock function with given fields: ctx, ownerType, hash, maxDistance, limit
+func (_m *MockMediaStore) FindSimilarMedia(ctx context.Context, ownerType core.MediaOwnerType, hash int64, maxDistance int, limit int) ([]core.MediaMatch, error) {
+	ret := _m.Called(ctx, ownerType, hash, maxDistance, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSimilarMedia")
+	}
+
+	var r0 []core.MediaMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, int64, int, int) ([]core.MediaMatch, error)); ok {
+		return rf(ctx, ownerType, hash, maxDistance, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, int64, int, int) []core.MediaMatch); ok {
+		r0 = rf(ctx, ownerType, hash, maxDistance, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.MediaMatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.MediaOwnerType, int64, int, int) error); ok {
+		r1 = rf(ctx, ownerType, hash, maxDistance, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaStore_FindSimilarMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSimilarMedia'
+type MockMediaStore_FindSimilarMedia_Call struct {
+	*mock.Call
+}
+
+// FindSimilarMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerType core.MediaOwnerType
+//   - hash int64
+//   - maxDistance int
+//   - limit int
+func (_e *MockMediaStore_Expecter) FindSimilarMedia(ctx interface{}, ownerType interface{}, hash interface{}, maxDistance interface{}, limit interface{}) *MockMediaStore_FindSimilarMedia_Call {
+	return &MockMediaStore_FindSimilarMedia_Call{Call: _e.mock.On("FindSimilarMedia", ctx, ownerType, hash, maxDistance, limit)}
+}
+
+func (_c *MockMediaStore_FindSimilarMedia_Call) Run(run func(ctx context.Context, ownerType core.MediaOwnerType, hash int64, maxDistance int, limit int)) *MockMediaStore_FindSimilarMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.MediaOwnerType), args[2].(int64), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockMediaStore_FindSimilarMedia_Call) Return(matches []core.MediaMatch, err error) *MockMediaStore_FindSimilarMedia_Call {
+	_c.Call.Return(matches, err)
+	return _c
+}
+
+func (_c *MockMediaStore_FindSimilarMedia_Call) RunAndReturn(run func(context.Context, core.MediaOwnerType, int64, int, int) ([]core.MediaMatch, error)) *MockMediaStore_FindSimilarMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLegacyBlobs provides a mock function with given fields: ctx, limit
+func (_m *MockMediaStore) GetLegacyBlobs(ctx context.Context, limit int) ([]core.LegacyBlob, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLegacyBlobs")
+	}
+
+	var r0 []core.LegacyBlob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.LegacyBlob, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []core.LegacyBlob); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.LegacyBlob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaStore_GetLegacyBlobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLegacyBlobs'
+type MockMediaStore_GetLegacyBlobs_Call struct {
+	*mock.Call
+}
+
+// GetLegacyBlobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockMediaStore_Expecter) GetLegacyBlobs(ctx interface{}, limit interface{}) *MockMediaStore_GetLegacyBlobs_Call {
+	return &MockMediaStore_GetLegacyBlobs_Call{Call: _e.mock.On("GetLegacyBlobs", ctx, limit)}
+}
+
+func (_c *MockMediaStore_GetLegacyBlobs_Call) Run(run func(ctx context.Context, limit int)) *MockMediaStore_GetLegacyBlobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockMediaStore_GetLegacyBlobs_Call) Return(blobs []core.LegacyBlob, err error) *MockMediaStore_GetLegacyBlobs_Call {
+	_c.Call.Return(blobs, err)
+	return _c
+}
+
+func (_c *MockMediaStore_GetLegacyBlobs_Call) RunAndReturn(run func(context.Context, int) ([]core.LegacyBlob, error)) *MockMediaStore_GetLegacyBlobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMediaByID provides a mock function with given fields: ctx, id
+func (_m *MockMediaStore) GetMediaByID(ctx context.Context, id int) (core.Media, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMediaByID")
+	}
+
+	var r0 core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (core.Media, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) core.Media); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(core.Media)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaStore_GetMediaByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaByID'
+type MockMediaStore_GetMediaByID_Call struct {
+	*mock.Call
+}
+
+// GetMediaByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockMediaStore_Expecter) GetMediaByID(ctx interface{}, id interface{}) *MockMediaStore_GetMediaByID_Call {
+	return &MockMediaStore_GetMediaByID_Call{Call: _e.mock.On("GetMediaByID", ctx, id)}
+}
+
+func (_c *MockMediaStore_GetMediaByID_Call) Run(run func(ctx context.Context, id int)) *MockMediaStore_GetMediaByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockMediaStore_GetMediaByID_Call) Return(media core.Media, err error) *MockMediaStore_GetMediaByID_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaStore_GetMediaByID_Call) RunAndReturn(run func(context.Context, int) (core.Media, error)) *MockMediaStore_GetMediaByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMediaByOwner provides a mock function with given fields: ctx, ownerType, ownerID
+func (_m *MockMediaStore) GetMediaByOwner(ctx context.Context, ownerType core.MediaOwnerType, ownerID int) ([]core.Media, error) {
+	ret := _m.Called(ctx, ownerType, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMediaByOwner")
+	}
+
+	var r0 []core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, int) ([]core.Media, error)); ok {
+		return rf(ctx, ownerType, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, int) []core.Media); ok {
+		r0 = rf(ctx, ownerType, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.MediaOwnerType, int) error); ok {
+		r1 = rf(ctx, ownerType, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaStore_GetMediaByOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaByOwner'
+type MockMediaStore_GetMediaByOwner_Call struct {
+	*mock.Call
+}
+
+// GetMediaByOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerType core.MediaOwnerType
+//   - ownerID int
+func (_e *MockMediaStore_Expecter) GetMediaByOwner(ctx interface{}, ownerType interface{}, ownerID interface{}) *MockMediaStore_GetMediaByOwner_Call {
+	return &MockMediaStore_GetMediaByOwner_Call{Call: _e.mock.On("GetMediaByOwner", ctx, ownerType, ownerID)}
+}
+
+func (_c *MockMediaStore_GetMediaByOwner_Call) Run(run func(ctx context.Context, ownerType core.MediaOwnerType, ownerID int)) *MockMediaStore_GetMediaByOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.MediaOwnerType), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockMediaStore_GetMediaByOwner_Call) Return(media []core.Media, err error) *MockMediaStore_GetMediaByOwner_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaStore_GetMediaByOwner_Call) RunAndReturn(run func(context.Context, core.MediaOwnerType, int) ([]core.Media, error)) *MockMediaStore_GetMediaByOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMediaByOwners provides a mock function with given fields: ctx, ownerType, ownerIDs
+func (_m *MockMediaStore) GetMediaByOwners(ctx context.Context, ownerType core.MediaOwnerType, ownerIDs []int) ([]core.Media, error) {
+	ret := _m.Called(ctx, ownerType, ownerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMediaByOwners")
+	}
+
+	var r0 []core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, []int) ([]core.Media, error)); ok {
+		return rf(ctx, ownerType, ownerIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, []int) []core.Media); ok {
+		r0 = rf(ctx, ownerType, ownerIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.MediaOwnerType, []int) error); ok {
+		r1 = rf(ctx, ownerType, ownerIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaStore_GetMediaByOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaByOwners'
+type MockMediaStore_GetMediaByOwners_Call struct {
+	*mock.Call
+}
+
+// GetMediaByOwners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerType core.MediaOwnerType
+//   - ownerIDs []int
+func (_e *MockMediaStore_Expecter) GetMediaByOwners(ctx interface{}, ownerType interface{}, ownerIDs interface{}) *MockMediaStore_GetMediaByOwners_Call {
+	return &MockMediaStore_GetMediaByOwners_Call{Call: _e.mock.On("GetMediaByOwners", ctx, ownerType, ownerIDs)}
+}
+
+func (_c *MockMediaStore_GetMediaByOwners_Call) Run(run func(ctx context.Context, ownerType core.MediaOwnerType, ownerIDs []int)) *MockMediaStore_GetMediaByOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.MediaOwnerType), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *MockMediaStore_GetMediaByOwners_Call) Return(media []core.Media, err error) *MockMediaStore_GetMediaByOwners_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaStore_GetMediaByOwners_Call) RunAndReturn(run func(context.Context, core.MediaOwnerType, []int) ([]core.Media, error)) *MockMediaStore_GetMediaByOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMediaOwner provides a mock function with given fields: ctx, ownerType, ownerID
+func (_m *MockMediaStore) GetMediaOwner(ctx context.Context, ownerType core.MediaOwnerType, ownerID int) (core.MediaOwner, error) {
+	ret := _m.Called(ctx, ownerType, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMediaOwner")
+	}
+
+	var r0 core.MediaOwner
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, int) (core.MediaOwner, error)); ok {
+		return rf(ctx, ownerType, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, int) core.MediaOwner); ok {
+		r0 = rf(ctx, ownerType, ownerID)
+	} else {
+		r0 = ret.Get(0).(core.MediaOwner)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.MediaOwnerType, int) error); ok {
+		r1 = rf(ctx, ownerType, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaStore_GetMediaOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaOwner'
+type MockMediaStore_GetMediaOwner_Call struct {
+	*mock.Call
+}
+
+// GetMediaOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerType core.MediaOwnerType
+//   - ownerID int
+func (_e *MockMediaStore_Expecter) GetMediaOwner(ctx interface{}, ownerType interface{}, ownerID interface{}) *MockMediaStore_GetMediaOwner_Call {
+	return &MockMediaStore_GetMediaOwner_Call{Call: _e.mock.On("GetMediaOwner", ctx, ownerType, ownerID)}
+}
+
+func (_c *MockMediaStore_GetMediaOwner_Call) Run(run func(ctx context.Context, ownerType core.MediaOwnerType, ownerID int)) *MockMediaStore_GetMediaOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.MediaOwnerType), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockMediaStore_GetMediaOwner_Call) Return(owner core.MediaOwner, err error) *MockMediaStore_GetMediaOwner_Call {
+	_c.Call.Return(owner, err)
+	return _c
+}
+
+func (_c *MockMediaStore_GetMediaOwner_Call) RunAndReturn(run func(context.Context, core.MediaOwnerType, int) (core.MediaOwner, error)) *MockMediaStore_GetMediaOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMediaWithoutHash provides a mock function with given fields: ctx, afterID, limit
+func (_m *MockMediaStore) GetMediaWithoutHash(ctx context.Context, afterID int, limit int) ([]core.Media, error) {
+	ret := _m.Called(ctx, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMediaWithoutHash")
+	}
+
+	var r0 []core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]core.Media, error)); ok {
+		return rf(ctx, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []core.Media); ok {
+		r0 = rf(ctx, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaStore_GetMediaWithoutHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaWithoutHash'
+type MockMediaStore_GetMediaWithoutHash_Call struct {
+	*mock.Call
+}
+
+// GetMediaWithoutHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - afterID int
+//   - limit int
+func (_e *MockMediaStore_Expecter) GetMediaWithoutHash(ctx interface{}, afterID interface{}, limit interface{}) *MockMediaStore_GetMediaWithoutHash_Call {
+	return &MockMediaStore_GetMediaWithoutHash_Call{Call: _e.mock.On("GetMediaWithoutHash", ctx, afterID, limit)}
+}
+
+func (_c *MockMediaStore_GetMediaWithoutHash_Call) Run(run func(ctx context.Context, afterID int, limit int)) *MockMediaStore_GetMediaWithoutHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockMediaStore_GetMediaWithoutHash_Call) Return(media []core.Media, err error) *MockMediaStore_GetMediaWithoutHash_Call {
+	_c.Call.Return(media, err)
+	return _c
+}
+
+func (_c *MockMediaStore_GetMediaWithoutHash_Call) RunAndReturn(run func(context.Context, int, int) ([]core.Media, error)) *MockMediaStore_GetMediaWithoutHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceMedia provides a mock function with given fields: ctx, ownerType, ownerID, media
+func (_m *MockMediaStore) ReplaceMedia(ctx context.Context, ownerType core.MediaOwnerType, ownerID int, media []core.Media) ([]core.Media, error) {
+	ret := _m.Called(ctx, ownerType, ownerID, media)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceMedia")
+	}
+
+	var r0 []core.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, int, []core.Media) ([]core.Media, error)); ok {
+		return rf(ctx, ownerType, ownerID, media)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaOwnerType, int, []core.Media) []core.Media); ok {
+		r0 = rf(ctx, ownerType, ownerID, media)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.MediaOwnerType, int, []core.Media) error); ok {
+		r1 = rf(ctx, ownerType, ownerID, media)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaStore_ReplaceMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceMedia'
+type MockMediaStore_ReplaceMedia_Call struct {
+	*mock.Call
+}
+
+// ReplaceMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerType core.MediaOwnerType
+//   - ownerID int
+//   - media []core.Media
+func (_e *MockMediaStore_Expecter) ReplaceMedia(ctx interface{}, ownerType interface{}, ownerID interface{}, media interface{}) *MockMediaStore_ReplaceMedia_Call {
+	return &MockMediaStore_ReplaceMedia_Call{Call: _e.mock.On("ReplaceMedia", ctx, ownerType, ownerID, media)}
+}
+
+func (_c *MockMediaStore_ReplaceMedia_Call) Run(run func(ctx context.Context, ownerType core.MediaOwnerType, ownerID int, media []core.Media)) *MockMediaStore_ReplaceMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.MediaOwnerType), args[2].(int), args[3].([]core.Media))
+	})
+	return _c
+}
+
+func (_c *MockMediaStore_ReplaceMedia_Call) Return(replaced []core.Media, err error) *MockMediaStore_ReplaceMedia_Call {
+	_c.Call.Return(replaced, err)
+	return _c
+}
+
+func (_c *MockMediaStore_ReplaceMedia_Call) RunAndReturn(run func(context.Context, core.MediaOwnerType, int, []core.Media) ([]core.Media, error)) *MockMediaStore_ReplaceMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMediaHash provides a mock function with given fields: ctx, id, hash
+func (_m *MockMediaStore) SetMediaHash(ctx context.Context, id int, hash int64) error {
+	ret := _m.Called(ctx, id, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMediaHash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int64) error); ok {
+		r0 = rf(ctx, id, hash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMediaStore_SetMediaHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMediaHash'
+type MockMediaStore_SetMediaHash_Call struct {
+	*mock.Call
+}
+
+// SetMediaHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - hash int64
+func (_e *MockMediaStore_Expecter) SetMediaHash(ctx interface{}, id interface{}, hash interface{}) *MockMediaStore_SetMediaHash_Call {
+	return &MockMediaStore_SetMediaHash_Call{Call: _e.mock.On("SetMediaHash", ctx, id, hash)}
+}
+
+func (_c *MockMediaStore_SetMediaHash_Call) Run(run func(ctx context.Context, id int, hash int64)) *MockMediaStore_SetMediaHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockMediaStore_SetMediaHash_Call) Return(_a0 error) *MockMediaStore_SetMediaHash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMediaStore_SetMediaHash_Call) RunAndReturn(run func(context.Context, int, int64) error) *MockMediaStore_SetMediaHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMediaStore creates a new instance of MockMediaStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMediaStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMediaStore {
+	mock := &MockMediaStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
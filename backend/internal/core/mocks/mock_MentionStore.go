@@ -0,0 +1,144 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMentionStore is an autogenerated mock type for the MentionStore type
+type MockMentionStore struct {
+	mock.Mock
+}
+
+type MockMentionStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMentionStore) EXPECT() *MockMentionStore_Expecter {
+	return &MockMentionStore_Expecter{mock: &_m.Mock}
+}
+
+// GetCommentsMentions provides a mock function with given fields: ctx, commentIDs
+func (_m *MockMentionStore) GetCommentsMentions(ctx context.Context, commentIDs []int) (map[int][]core.CommentMention, error) {
+	ret := _m.Called(ctx, commentIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentsMentions")
+	}
+
+	var r0 map[int][]core.CommentMention
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (map[int][]core.CommentMention, error)); ok {
+		return rf(ctx, commentIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) map[int][]core.CommentMention); ok {
+		r0 = rf(ctx, commentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int][]core.CommentMention)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, commentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMentionStore_GetCommentsMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentsMentions'
+type MockMentionStore_GetCommentsMentions_Call struct {
+	*mock.Call
+}
+
+// GetCommentsMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentIDs []int
+func (_e *MockMentionStore_Expecter) GetCommentsMentions(ctx interface{}, commentIDs interface{}) *MockMentionStore_GetCommentsMentions_Call {
+	return &MockMentionStore_GetCommentsMentions_Call{Call: _e.mock.On("GetCommentsMentions", ctx, commentIDs)}
+}
+
+func (_c *MockMentionStore_GetCommentsMentions_Call) Run(run func(ctx context.Context, commentIDs []int)) *MockMentionStore_GetCommentsMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *MockMentionStore_GetCommentsMentions_Call) Return(_a0 map[int][]core.CommentMention, _a1 error) *MockMentionStore_GetCommentsMentions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMentionStore_GetCommentsMentions_Call) RunAndReturn(run func(context.Context, []int) (map[int][]core.CommentMention, error)) *MockMentionStore_GetCommentsMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveCommentMentions provides a mock function with given fields: ctx, commentID, mentions
+func (_m *MockMentionStore) SaveCommentMentions(ctx context.Context, commentID int, mentions []core.CommentMention) error {
+	ret := _m.Called(ctx, commentID, mentions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveCommentMentions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []core.CommentMention) error); ok {
+		r0 = rf(ctx, commentID, mentions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMentionStore_SaveCommentMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCommentMentions'
+type MockMentionStore_SaveCommentMentions_Call struct {
+	*mock.Call
+}
+
+// SaveCommentMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int
+//   - mentions []core.CommentMention
+func (_e *MockMentionStore_Expecter) SaveCommentMentions(ctx interface{}, commentID interface{}, mentions interface{}) *MockMentionStore_SaveCommentMentions_Call {
+	return &MockMentionStore_SaveCommentMentions_Call{Call: _e.mock.On("SaveCommentMentions", ctx, commentID, mentions)}
+}
+
+func (_c *MockMentionStore_SaveCommentMentions_Call) Run(run func(ctx context.Context, commentID int, mentions []core.CommentMention)) *MockMentionStore_SaveCommentMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]core.CommentMention))
+	})
+	return _c
+}
+
+func (_c *MockMentionStore_SaveCommentMentions_Call) Return(_a0 error) *MockMentionStore_SaveCommentMentions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMentionStore_SaveCommentMentions_Call) RunAndReturn(run func(context.Context, int, []core.CommentMention) error) *MockMentionStore_SaveCommentMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMentionStore creates a new instance of MockMentionStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMentionStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMentionStore {
+	mock := &MockMentionStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,199 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMessageStore is an autogenerated mock type for the MessageStore type
+type MockMessageStore struct {
+	mock.Mock
+}
+
+type MockMessageStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMessageStore) EXPECT() *MockMessageStore_Expecter {
+	return &MockMessageStore_Expecter{mock: &_m.Mock}
+}
+
+// DeleteMessage provides a mock function with given fields: ctx, id
+func (_m *MockMessageStore) DeleteMessage(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMessageStore_DeleteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessage'
+type MockMessageStore_DeleteMessage_Call struct {
+	*mock.Call
+}
+
+// DeleteMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockMessageStore_Expecter) DeleteMessage(ctx interface{}, id interface{}) *MockMessageStore_DeleteMessage_Call {
+	return &MockMessageStore_DeleteMessage_Call{Call: _e.mock.On("DeleteMessage", ctx, id)}
+}
+
+func (_c *MockMessageStore_DeleteMessage_Call) Run(run func(ctx context.Context, id int)) *MockMessageStore_DeleteMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockMessageStore_DeleteMessage_Call) Return(err error) *MockMessageStore_DeleteMessage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMessageStore_DeleteMessage_Call) RunAndReturn(run func(context.Context, int) error) *MockMessageStore_DeleteMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMessageByID provides a mock function with given fields: ctx, id
+func (_m *MockMessageStore) GetMessageByID(ctx context.Context, id int) (core.Message, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMessageByID")
+	}
+
+	var r0 core.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (core.Message, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) core.Message); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(core.Message)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMessageStore_GetMessageByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMessageByID'
+type MockMessageStore_GetMessageByID_Call struct {
+	*mock.Call
+}
+
+// GetMessageByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockMessageStore_Expecter) GetMessageByID(ctx interface{}, id interface{}) *MockMessageStore_GetMessageByID_Call {
+	return &MockMessageStore_GetMessageByID_Call{Call: _e.mock.On("GetMessageByID", ctx, id)}
+}
+
+func (_c *MockMessageStore_GetMessageByID_Call) Run(run func(ctx context.Context, id int)) *MockMessageStore_GetMessageByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockMessageStore_GetMessageByID_Call) Return(message core.Message, err error) *MockMessageStore_GetMessageByID_Call {
+	_c.Call.Return(message, err)
+	return _c
+}
+
+func (_c *MockMessageStore_GetMessageByID_Call) RunAndReturn(run func(context.Context, int) (core.Message, error)) *MockMessageStore_GetMessageByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsChatMember provides a mock function with given fields: ctx, chatID, userID
+func (_m *MockMessageStore) IsChatMember(ctx context.Context, chatID int, userID int) (bool, error) {
+	ret := _m.Called(ctx, chatID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsChatMember")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (bool, error)); ok {
+		return rf(ctx, chatID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) bool); ok {
+		r0 = rf(ctx, chatID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, chatID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMessageStore_IsChatMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsChatMember'
+type MockMessageStore_IsChatMember_Call struct {
+	*mock.Call
+}
+
+// IsChatMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int
+//   - userID int
+func (_e *MockMessageStore_Expecter) IsChatMember(ctx interface{}, chatID interface{}, userID interface{}) *MockMessageStore_IsChatMember_Call {
+	return &MockMessageStore_IsChatMember_Call{Call: _e.mock.On("IsChatMember", ctx, chatID, userID)}
+}
+
+func (_c *MockMessageStore_IsChatMember_Call) Run(run func(ctx context.Context, chatID int, userID int)) *MockMessageStore_IsChatMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockMessageStore_IsChatMember_Call) Return(isMember bool, err error) *MockMessageStore_IsChatMember_Call {
+	_c.Call.Return(isMember, err)
+	return _c
+}
+
+func (_c *MockMessageStore_IsChatMember_Call) RunAndReturn(run func(context.Context, int, int) (bool, error)) *MockMessageStore_IsChatMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMessageStore creates a new instance of MockMessageStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMessageStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMessageStore {
+	mock := &MockMessageStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -163,17 +163,17 @@ func (_c *MockModeratorService_BanUser_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
-// DeleteComment provides a mock function with given fields: ctx, commentID
-func (_m *MockModeratorService) DeleteComment(ctx context.Context, commentID int) error {
-	ret := _m.Called(ctx, commentID)
+// DeleteComment provides a mock function with given fields: ctx, decision
+func (_m *MockModeratorService) DeleteComment(ctx context.Context, decision core.ModerationDecision) error {
+	ret := _m.Called(ctx, decision)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteComment")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
-		r0 = rf(ctx, commentID)
+	if rf, ok := ret.Get(0).(func(context.Context, core.ModerationDecision) error); ok {
+		r0 = rf(ctx, decision)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -188,14 +188,14 @@ type MockModeratorService_DeleteComment_Call struct {
 
 // DeleteComment is a helper method to define mock.On call
 //   - ctx context.Context
-//   - commentID int
-func (_e *MockModeratorService_Expecter) DeleteComment(ctx interface{}, commentID interface{}) *MockModeratorService_DeleteComment_Call {
-	return &MockModeratorService_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, commentID)}
+//   - decision core.ModerationDecision
+func (_e *MockModeratorService_Expecter) DeleteComment(ctx interface{}, decision interface{}) *MockModeratorService_DeleteComment_Call {
+	return &MockModeratorService_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, decision)}
 }
 
-func (_c *MockModeratorService_DeleteComment_Call) Run(run func(ctx context.Context, commentID int)) *MockModeratorService_DeleteComment_Call {
+func (_c *MockModeratorService_DeleteComment_Call) Run(run func(ctx context.Context, decision core.ModerationDecision)) *MockModeratorService_DeleteComment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(core.ModerationDecision))
 	})
 	return _c
 }
@@ -205,22 +205,22 @@ func (_c *MockModeratorService_DeleteComment_Call) Return(_a0 error) *MockModera
 	return _c
 }
 
-func (_c *MockModeratorService_DeleteComment_Call) RunAndReturn(run func(context.Context, int) error) *MockModeratorService_DeleteComment_Call {
+func (_c *MockModeratorService_DeleteComment_Call) RunAndReturn(run func(context.Context, core.ModerationDecision) error) *MockModeratorService_DeleteComment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeletePost provides a mock function with given fields: ctx, ID
-func (_m *MockModeratorService) DeletePost(ctx context.Context, ID int) error {
-	ret := _m.Called(ctx, ID)
+// DeletePost provides a mock function with given fields: ctx, decision
+func (_m *MockModeratorService) DeletePost(ctx context.Context, decision core.ModerationDecision) error {
+	ret := _m.Called(ctx, decision)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeletePost")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
-		r0 = rf(ctx, ID)
+	if rf, ok := ret.Get(0).(func(context.Context, core.ModerationDecision) error); ok {
+		r0 = rf(ctx, decision)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -235,14 +235,14 @@ type MockModeratorService_DeletePost_Call struct {
 
 // DeletePost is a helper method to define mock.On call
 //   - ctx context.Context
-//   - ID int
-func (_e *MockModeratorService_Expecter) DeletePost(ctx interface{}, ID interface{}) *MockModeratorService_DeletePost_Call {
-	return &MockModeratorService_DeletePost_Call{Call: _e.mock.On("DeletePost", ctx, ID)}
+//   - decision core.ModerationDecision
+func (_e *MockModeratorService_Expecter) DeletePost(ctx interface{}, decision interface{}) *MockModeratorService_DeletePost_Call {
+	return &MockModeratorService_DeletePost_Call{Call: _e.mock.On("DeletePost", ctx, decision)}
 }
 
-func (_c *MockModeratorService_DeletePost_Call) Run(run func(ctx context.Context, ID int)) *MockModeratorService_DeletePost_Call {
+func (_c *MockModeratorService_DeletePost_Call) Run(run func(ctx context.Context, decision core.ModerationDecision)) *MockModeratorService_DeletePost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(core.ModerationDecision))
 	})
 	return _c
 }
@@ -252,7 +252,102 @@ func (_c *MockModeratorService_DeletePost_Call) Return(err error) *MockModerator
 	return _c
 }
 
-func (_c *MockModeratorService_DeletePost_Call) RunAndReturn(run func(context.Context, int) error) *MockModeratorService_DeletePost_Call {
+func (_c *MockModeratorService_DeletePost_Call) RunAndReturn(run func(context.Context, core.ModerationDecision) error) *MockModeratorService_DeletePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReportedTarget provides a mock function with given fields: ctx, decision
+func (_m *MockModeratorService) DeleteReportedTarget(ctx context.Context, decision core.ModerationDecision) error {
+	ret := _m.Called(ctx, decision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReportedTarget")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.ModerationDecision) error); ok {
+		r0 = rf(ctx, decision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockModeratorService_DeleteReportedTarget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReportedTarget'
+type MockModeratorService_DeleteReportedTarget_Call struct {
+	*mock.Call
+}
+
+// DeleteReportedTarget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - decision core.ModerationDecision
+func (_e *MockModeratorService_Expecter) DeleteReportedTarget(ctx interface{}, decision interface{}) *MockModeratorService_DeleteReportedTarget_Call {
+	return &MockModeratorService_DeleteReportedTarget_Call{Call: _e.mock.On("DeleteReportedTarget", ctx, decision)}
+}
+
+func (_c *MockModeratorService_DeleteReportedTarget_Call) Run(run func(ctx context.Context, decision core.ModerationDecision)) *MockModeratorService_DeleteReportedTarget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.ModerationDecision))
+	})
+	return _c
+}
+
+func (_c *MockModeratorService_DeleteReportedTarget_Call) Return(_a0 error) *MockModeratorService_DeleteReportedTarget_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockModeratorService_DeleteReportedTarget_Call) RunAndReturn(run func(context.Context, core.ModerationDecision) error) *MockModeratorService_DeleteReportedTarget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DismissReports provides a mock function with given fields: ctx, reportableID, reportableType
+func (_m *MockModeratorService) DismissReports(ctx context.Context, reportableID int, reportableType string) error {
+	ret := _m.Called(ctx, reportableID, reportableType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DismissReports")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, reportableID, reportableType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockModeratorService_DismissReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DismissReports'
+type MockModeratorService_DismissReports_Call struct {
+	*mock.Call
+}
+
+// DismissReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reportableID int
+//   - reportableType string
+func (_e *MockModeratorService_Expecter) DismissReports(ctx interface{}, reportableID interface{}, reportableType interface{}) *MockModeratorService_DismissReports_Call {
+	return &MockModeratorService_DismissReports_Call{Call: _e.mock.On("DismissReports", ctx, reportableID, reportableType)}
+}
+
+func (_c *MockModeratorService_DismissReports_Call) Run(run func(ctx context.Context, reportableID int, reportableType string)) *MockModeratorService_DismissReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockModeratorService_DismissReports_Call) Return(_a0 error) *MockModeratorService_DismissReports_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockModeratorService_DismissReports_Call) RunAndReturn(run func(context.Context, int, string) error) *MockModeratorService_DismissReports_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -373,6 +468,64 @@ func (_c *MockModeratorService_GetModerator_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetPostModeration provides a mock function with given fields: ctx, userID, postID
+func (_m *MockModeratorService) GetPostModeration(ctx context.Context, userID int, postID int) (core.PostModeration, error) {
+	ret := _m.Called(ctx, userID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostModeration")
+	}
+
+	var r0 core.PostModeration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (core.PostModeration, error)); ok {
+		return rf(ctx, userID, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) core.PostModeration); ok {
+		r0 = rf(ctx, userID, postID)
+	} else {
+		r0 = ret.Get(0).(core.PostModeration)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, userID, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockModeratorService_GetPostModeration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostModeration'
+type MockModeratorService_GetPostModeration_Call struct {
+	*mock.Call
+}
+
+// GetPostModeration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - postID int
+func (_e *MockModeratorService_Expecter) GetPostModeration(ctx interface{}, userID interface{}, postID interface{}) *MockModeratorService_GetPostModeration_Call {
+	return &MockModeratorService_GetPostModeration_Call{Call: _e.mock.On("GetPostModeration", ctx, userID, postID)}
+}
+
+func (_c *MockModeratorService_GetPostModeration_Call) Run(run func(ctx context.Context, userID int, postID int)) *MockModeratorService_GetPostModeration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockModeratorService_GetPostModeration_Call) Return(_a0 core.PostModeration, _a1 error) *MockModeratorService_GetPostModeration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockModeratorService_GetPostModeration_Call) RunAndReturn(run func(context.Context, int, int) (core.PostModeration, error)) *MockModeratorService_GetPostModeration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPostsForModeration provides a mock function with given fields: ctx, filter
 func (_m *MockModeratorService) GetPostsForModeration(ctx context.Context, filter core.Filter) ([]core.PostForModeration, error) {
 	ret := _m.Called(ctx, filter)
@@ -432,6 +585,191 @@ func (_c *MockModeratorService_GetPostsForModeration_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetReportedTargets provides a mock function with given fields: ctx, reportableType, filter
+func (_m *MockModeratorService) GetReportedTargets(ctx context.Context, reportableType string, filter core.Filter) ([]core.ReportedTarget, error) {
+	ret := _m.Called(ctx, reportableType, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReportedTargets")
+	}
+
+	var r0 []core.ReportedTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, core.Filter) ([]core.ReportedTarget, error)); ok {
+		return rf(ctx, reportableType, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, core.Filter) []core.ReportedTarget); ok {
+		r0 = rf(ctx, reportableType, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.ReportedTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, core.Filter) error); ok {
+		r1 = rf(ctx, reportableType, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockModeratorService_GetReportedTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReportedTargets'
+type MockModeratorService_GetReportedTargets_Call struct {
+	*mock.Call
+}
+
+// GetReportedTargets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reportableType string
+//   - filter core.Filter
+func (_e *MockModeratorService_Expecter) GetReportedTargets(ctx interface{}, reportableType interface{}, filter interface{}) *MockModeratorService_GetReportedTargets_Call {
+	return &MockModeratorService_GetReportedTargets_Call{Call: _e.mock.On("GetReportedTargets", ctx, reportableType, filter)}
+}
+
+func (_c *MockModeratorService_GetReportedTargets_Call) Run(run func(ctx context.Context, reportableType string, filter core.Filter)) *MockModeratorService_GetReportedTargets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(core.Filter))
+	})
+	return _c
+}
+
+func (_c *MockModeratorService_GetReportedTargets_Call) Return(_a0 []core.ReportedTarget, _a1 error) *MockModeratorService_GetReportedTargets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockModeratorService_GetReportedTargets_Call) RunAndReturn(run func(context.Context, string, core.Filter) ([]core.ReportedTarget, error)) *MockModeratorService_GetReportedTargets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserModerationHistory provides a mock function with given fields: ctx, userID
+func (_m *MockModeratorService) GetUserModerationHistory(ctx context.Context, userID int) ([]core.ModerationDecision, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserModerationHistory")
+	}
+
+	var r0 []core.ModerationDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.ModerationDecision, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []core.ModerationDecision); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.ModerationDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockModeratorService_GetUserModerationHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserModerationHistory'
+type MockModeratorService_GetUserModerationHistory_Call struct {
+	*mock.Call
+}
+
+// GetUserModerationHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockModeratorService_Expecter) GetUserModerationHistory(ctx interface{}, userID interface{}) *MockModeratorService_GetUserModerationHistory_Call {
+	return &MockModeratorService_GetUserModerationHistory_Call{Call: _e.mock.On("GetUserModerationHistory", ctx, userID)}
+}
+
+func (_c *MockModeratorService_GetUserModerationHistory_Call) Run(run func(ctx context.Context, userID int)) *MockModeratorService_GetUserModerationHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockModeratorService_GetUserModerationHistory_Call) Return(_a0 []core.ModerationDecision, _a1 error) *MockModeratorService_GetUserModerationHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockModeratorService_GetUserModerationHistory_Call) RunAndReturn(run func(context.Context, int) ([]core.ModerationDecision, error)) *MockModeratorService_GetUserModerationHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchComments provides a mock function with given fields: ctx, params
+func (_m *MockModeratorService) SearchComments(ctx context.Context, params core.SearchCommentsParams) ([]core.Comment, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchComments")
+	}
+
+	var r0 []core.Comment
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.SearchCommentsParams) ([]core.Comment, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.SearchCommentsParams) []core.Comment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.SearchCommentsParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.SearchCommentsParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockModeratorService_SearchComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchComments'
+type MockModeratorService_SearchComments_Call struct {
+	*mock.Call
+}
+
+// SearchComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.SearchCommentsParams
+func (_e *MockModeratorService_Expecter) SearchComments(ctx interface{}, params interface{}) *MockModeratorService_SearchComments_Call {
+	return &MockModeratorService_SearchComments_Call{Call: _e.mock.On("SearchComments", ctx, params)}
+}
+
+func (_c *MockModeratorService_SearchComments_Call) Run(run func(ctx context.Context, params core.SearchCommentsParams)) *MockModeratorService_SearchComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.SearchCommentsParams))
+	})
+	return _c
+}
+
+func (_c *MockModeratorService_SearchComments_Call) Return(comments []core.Comment, total int, err error) *MockModeratorService_SearchComments_Call {
+	_c.Call.Return(comments, total, err)
+	return _c
+}
+
+func (_c *MockModeratorService_SearchComments_Call) RunAndReturn(run func(context.Context, core.SearchCommentsParams) ([]core.Comment, int, error)) *MockModeratorService_SearchComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockModeratorService creates a new instance of MockModeratorService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockModeratorService(t interface {
@@ -22,6 +22,53 @@ func (_m *MockModeratorStore) EXPECT() *MockModeratorStore_Expecter {
 	return &MockModeratorStore_Expecter{mock: &_m.Mock}
 }
 
+// CreateDecision provides a mock function with given fields: ctx, decision
+func (_m *MockModeratorStore) CreateDecision(ctx context.Context, decision core.ModerationDecision) error {
+	ret := _m.Called(ctx, decision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDecision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.ModerationDecision) error); ok {
+		r0 = rf(ctx, decision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockModeratorStore_CreateDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDecision'
+type MockModeratorStore_CreateDecision_Call struct {
+	*mock.Call
+}
+
+// CreateDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - decision core.ModerationDecision
+func (_e *MockModeratorStore_Expecter) CreateDecision(ctx interface{}, decision interface{}) *MockModeratorStore_CreateDecision_Call {
+	return &MockModeratorStore_CreateDecision_Call{Call: _e.mock.On("CreateDecision", ctx, decision)}
+}
+
+func (_c *MockModeratorStore_CreateDecision_Call) Run(run func(ctx context.Context, decision core.ModerationDecision)) *MockModeratorStore_CreateDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.ModerationDecision))
+	})
+	return _c
+}
+
+func (_c *MockModeratorStore_CreateDecision_Call) Return(err error) *MockModeratorStore_CreateDecision_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModeratorStore_CreateDecision_Call) RunAndReturn(run func(context.Context, core.ModerationDecision) error) *MockModeratorStore_CreateDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateModerator provides a mock function with given fields: ctx, moderator
 func (_m *MockModeratorStore) CreateModerator(ctx context.Context, moderator core.Moderator) error {
 	ret := _m.Called(ctx, moderator)
@@ -126,6 +173,123 @@ func (_c *MockModeratorStore_GetModeratorByID_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetTargetDecision provides a mock function with given fields: ctx, targetType, targetID
+func (_m *MockModeratorStore) GetTargetDecision(ctx context.Context, targetType string, targetID int) (core.ModerationDecision, error) {
+	ret := _m.Called(ctx, targetType, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTargetDecision")
+	}
+
+	var r0 core.ModerationDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (core.ModerationDecision, error)); ok {
+		return rf(ctx, targetType, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) core.ModerationDecision); ok {
+		r0 = rf(ctx, targetType, targetID)
+	} else {
+		r0 = ret.Get(0).(core.ModerationDecision)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, targetType, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockModeratorStore_GetTargetDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTargetDecision'
+type MockModeratorStore_GetTargetDecision_Call struct {
+	*mock.Call
+}
+
+// GetTargetDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetType string
+//   - targetID int
+func (_e *MockModeratorStore_Expecter) GetTargetDecision(ctx interface{}, targetType interface{}, targetID interface{}) *MockModeratorStore_GetTargetDecision_Call {
+	return &MockModeratorStore_GetTargetDecision_Call{Call: _e.mock.On("GetTargetDecision", ctx, targetType, targetID)}
+}
+
+func (_c *MockModeratorStore_GetTargetDecision_Call) Run(run func(ctx context.Context, targetType string, targetID int)) *MockModeratorStore_GetTargetDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockModeratorStore_GetTargetDecision_Call) Return(decision core.ModerationDecision, err error) *MockModeratorStore_GetTargetDecision_Call {
+	_c.Call.Return(decision, err)
+	return _c
+}
+
+func (_c *MockModeratorStore_GetTargetDecision_Call) RunAndReturn(run func(context.Context, string, int) (core.ModerationDecision, error)) *MockModeratorStore_GetTargetDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserDecisions provides a mock function with given fields: ctx, userID
+func (_m *MockModeratorStore) GetUserDecisions(ctx context.Context, userID int) ([]core.ModerationDecision, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserDecisions")
+	}
+
+	var r0 []core.ModerationDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.ModerationDecision, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []core.ModerationDecision); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.ModerationDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockModeratorStore_GetUserDecisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDecisions'
+type MockModeratorStore_GetUserDecisions_Call struct {
+	*mock.Call
+}
+
+// GetUserDecisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockModeratorStore_Expecter) GetUserDecisions(ctx interface{}, userID interface{}) *MockModeratorStore_GetUserDecisions_Call {
+	return &MockModeratorStore_GetUserDecisions_Call{Call: _e.mock.On("GetUserDecisions", ctx, userID)}
+}
+
+func (_c *MockModeratorStore_GetUserDecisions_Call) Run(run func(ctx context.Context, userID int)) *MockModeratorStore_GetUserDecisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockModeratorStore_GetUserDecisions_Call) Return(decisions []core.ModerationDecision, err error) *MockModeratorStore_GetUserDecisions_Call {
+	_c.Call.Return(decisions, err)
+	return _c
+}
+
+func (_c *MockModeratorStore_GetUserDecisions_Call) RunAndReturn(run func(context.Context, int) ([]core.ModerationDecision, error)) *MockModeratorStore_GetUserDecisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockModeratorStore creates a new instance of MockModeratorStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockModeratorStore(t interface {
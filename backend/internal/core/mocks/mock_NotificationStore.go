@@ -0,0 +1,205 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockNotificationStore is an autogenerated mock type for the NotificationStore type
+type MockNotificationStore struct {
+	mock.Mock
+}
+
+type MockNotificationStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationStore) EXPECT() *MockNotificationStore_Expecter {
+	return &MockNotificationStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateNotifications provides a mock function with given fields: ctx, notifications
+func (_m *MockNotificationStore) CreateNotifications(ctx context.Context, notifications []core.Notification) error {
+	ret := _m.Called(ctx, notifications)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotifications")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []core.Notification) error); ok {
+		r0 = rf(ctx, notifications)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationStore_CreateNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotifications'
+type MockNotificationStore_CreateNotifications_Call struct {
+	*mock.Call
+}
+
+// CreateNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notifications []core.Notification
+func (_e *MockNotificationStore_Expecter) CreateNotifications(ctx interface{}, notifications interface{}) *MockNotificationStore_CreateNotifications_Call {
+	return &MockNotificationStore_CreateNotifications_Call{Call: _e.mock.On("CreateNotifications", ctx, notifications)}
+}
+
+func (_c *MockNotificationStore_CreateNotifications_Call) Run(run func(ctx context.Context, notifications []core.Notification)) *MockNotificationStore_CreateNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]core.Notification))
+	})
+	return _c
+}
+
+func (_c *MockNotificationStore_CreateNotifications_Call) Return(_a0 error) *MockNotificationStore_CreateNotifications_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationStore_CreateNotifications_Call) RunAndReturn(run func(context.Context, []core.Notification) error) *MockNotificationStore_CreateNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotifications provides a mock function with given fields: ctx, params
+func (_m *MockNotificationStore) GetNotifications(ctx context.Context, params core.GetNotificationsParams) ([]core.Notification, int, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotifications")
+	}
+
+	var r0 []core.Notification
+	var r1 int
+	var r2 int
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetNotificationsParams) ([]core.Notification, int, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetNotificationsParams) []core.Notification); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetNotificationsParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetNotificationsParams) int); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, core.GetNotificationsParams) error); ok {
+		r3 = rf(ctx, params)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// MockNotificationStore_GetNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotifications'
+type MockNotificationStore_GetNotifications_Call struct {
+	*mock.Call
+}
+
+// GetNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetNotificationsParams
+func (_e *MockNotificationStore_Expecter) GetNotifications(ctx interface{}, params interface{}) *MockNotificationStore_GetNotifications_Call {
+	return &MockNotificationStore_GetNotifications_Call{Call: _e.mock.On("GetNotifications", ctx, params)}
+}
+
+func (_c *MockNotificationStore_GetNotifications_Call) Run(run func(ctx context.Context, params core.GetNotificationsParams)) *MockNotificationStore_GetNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetNotificationsParams))
+	})
+	return _c
+}
+
+func (_c *MockNotificationStore_GetNotifications_Call) Return(notifications []core.Notification, total int, unread int, err error) *MockNotificationStore_GetNotifications_Call {
+	_c.Call.Return(notifications, total, unread, err)
+	return _c
+}
+
+func (_c *MockNotificationStore_GetNotifications_Call) RunAndReturn(run func(context.Context, core.GetNotificationsParams) ([]core.Notification, int, int, error)) *MockNotificationStore_GetNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkNotificationsRead provides a mock function with given fields: ctx, userID, ids
+func (_m *MockNotificationStore) MarkNotificationsRead(ctx context.Context, userID int, ids []int) error {
+	ret := _m.Called(ctx, userID, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNotificationsRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) error); ok {
+		r0 = rf(ctx, userID, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationStore_MarkNotificationsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNotificationsRead'
+type MockNotificationStore_MarkNotificationsRead_Call struct {
+	*mock.Call
+}
+
+// MarkNotificationsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - ids []int
+func (_e *MockNotificationStore_Expecter) MarkNotificationsRead(ctx interface{}, userID interface{}, ids interface{}) *MockNotificationStore_MarkNotificationsRead_Call {
+	return &MockNotificationStore_MarkNotificationsRead_Call{Call: _e.mock.On("MarkNotificationsRead", ctx, userID, ids)}
+}
+
+func (_c *MockNotificationStore_MarkNotificationsRead_Call) Run(run func(ctx context.Context, userID int, ids []int)) *MockNotificationStore_MarkNotificationsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationStore_MarkNotificationsRead_Call) Return(_a0 error) *MockNotificationStore_MarkNotificationsRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationStore_MarkNotificationsRead_Call) RunAndReturn(run func(context.Context, int, []int) error) *MockNotificationStore_MarkNotificationsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationStore creates a new instance of MockNotificationStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationStore {
+	mock := &MockNotificationStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -117,9 +117,9 @@ func (_c *MockPostFavouriteService_DeleteFromFavourites_Call) RunAndReturn(run f
 	return _c
 }
 
-// GetFavouritePosts provides a mock function with given fields: ctx, userID
-func (_m *MockPostFavouriteService) GetFavouritePosts(ctx context.Context, userID int) ([]core.PostDetails, int, error) {
-	ret := _m.Called(ctx, userID)
+// GetFavouritePosts provides a mock function with given fields: ctx, userID, params
+func (_m *MockPostFavouriteService) GetFavouritePosts(ctx context.Context, userID int, params core.GetAllPostsParams) ([]core.PostDetails, int, error) {
+	ret := _m.Called(ctx, userID, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetFavouritePosts")
@@ -128,25 +128,25 @@ func (_m *MockPostFavouriteService) GetFavouritePosts(ctx context.Context, userI
 	var r0 []core.PostDetails
 	var r1 int
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.PostDetails, int, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) ([]core.PostDetails, int, error)); ok {
+		return rf(ctx, userID, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) []core.PostDetails); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) []core.PostDetails); ok {
+		r0 = rf(ctx, userID, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]core.PostDetails)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) int); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.GetAllPostsParams) int); ok {
+		r1 = rf(ctx, userID, params)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
-		r2 = rf(ctx, userID)
+	if rf, ok := ret.Get(2).(func(context.Context, int, core.GetAllPostsParams) error); ok {
+		r2 = rf(ctx, userID, params)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -162,13 +162,14 @@ type MockPostFavouriteService_GetFavouritePosts_Call struct {
 // GetFavouritePosts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int
-func (_e *MockPostFavouriteService_Expecter) GetFavouritePosts(ctx interface{}, userID interface{}) *MockPostFavouriteService_GetFavouritePosts_Call {
-	return &MockPostFavouriteService_GetFavouritePosts_Call{Call: _e.mock.On("GetFavouritePosts", ctx, userID)}
+//   - params core.GetAllPostsParams
+func (_e *MockPostFavouriteService_Expecter) GetFavouritePosts(ctx interface{}, userID interface{}, params interface{}) *MockPostFavouriteService_GetFavouritePosts_Call {
+	return &MockPostFavouriteService_GetFavouritePosts_Call{Call: _e.mock.On("GetFavouritePosts", ctx, userID, params)}
 }
 
-func (_c *MockPostFavouriteService_GetFavouritePosts_Call) Run(run func(ctx context.Context, userID int)) *MockPostFavouriteService_GetFavouritePosts_Call {
+func (_c *MockPostFavouriteService_GetFavouritePosts_Call) Run(run func(ctx context.Context, userID int, params core.GetAllPostsParams)) *MockPostFavouriteService_GetFavouritePosts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(core.GetAllPostsParams))
 	})
 	return _c
 }
@@ -178,7 +179,7 @@ func (_c *MockPostFavouriteService_GetFavouritePosts_Call) Return(_a0 []core.Pos
 	return _c
 }
 
-func (_c *MockPostFavouriteService_GetFavouritePosts_Call) RunAndReturn(run func(context.Context, int) ([]core.PostDetails, int, error)) *MockPostFavouriteService_GetFavouritePosts_Call {
+func (_c *MockPostFavouriteService_GetFavouritePosts_Call) RunAndReturn(run func(context.Context, int, core.GetAllPostsParams) ([]core.PostDetails, int, error)) *MockPostFavouriteService_GetFavouritePosts_Call {
 	_c.Call.Return(run)
 	return _c
 }
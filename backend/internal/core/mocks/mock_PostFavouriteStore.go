@@ -117,9 +117,9 @@ func (_c *MockPostFavouriteStore_DeleteFromFavourites_Call) RunAndReturn(run fun
 	return _c
 }
 
-// GetFavouritePosts provides a mock function with given fields: ctx, userID, limit, offset
-func (_m *MockPostFavouriteStore) GetFavouritePosts(ctx context.Context, userID int, limit int, offset int) ([]core.Post, int, error) {
-	ret := _m.Called(ctx, userID, limit, offset)
+// GetFavouritePosts provides a mock function with given fields: ctx, userID, params
+func (_m *MockPostFavouriteStore) GetFavouritePosts(ctx context.Context, userID int, params core.GetAllPostsParams) ([]core.Post, int, error) {
+	ret := _m.Called(ctx, userID, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetFavouritePosts")
@@ -128,25 +128,25 @@ func (_m *MockPostFavouriteStore) GetFavouritePosts(ctx context.Context, userID
 	var r0 []core.Post
 	var r1 int
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) ([]core.Post, int, error)); ok {
-		return rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) ([]core.Post, int, error)); ok {
+		return rf(ctx, userID, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) []core.Post); ok {
-		r0 = rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) []core.Post); ok {
+		r0 = rf(ctx, userID, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]core.Post)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) int); ok {
-		r1 = rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.GetAllPostsParams) int); ok {
+		r1 = rf(ctx, userID, params)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int, int, int) error); ok {
-		r2 = rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(2).(func(context.Context, int, core.GetAllPostsParams) error); ok {
+		r2 = rf(ctx, userID, params)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -162,15 +162,14 @@ type MockPostFavouriteStore_GetFavouritePosts_Call struct {
 // GetFavouritePosts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int
-//   - limit int
-//   - offset int
-func (_e *MockPostFavouriteStore_Expecter) GetFavouritePosts(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *MockPostFavouriteStore_GetFavouritePosts_Call {
-	return &MockPostFavouriteStore_GetFavouritePosts_Call{Call: _e.mock.On("GetFavouritePosts", ctx, userID, limit, offset)}
+//   - params core.GetAllPostsParams
+func (_e *MockPostFavouriteStore_Expecter) GetFavouritePosts(ctx interface{}, userID interface{}, params interface{}) *MockPostFavouriteStore_GetFavouritePosts_Call {
+	return &MockPostFavouriteStore_GetFavouritePosts_Call{Call: _e.mock.On("GetFavouritePosts", ctx, userID, params)}
 }
 
-func (_c *MockPostFavouriteStore_GetFavouritePosts_Call) Run(run func(ctx context.Context, userID int, limit int, offset int)) *MockPostFavouriteStore_GetFavouritePosts_Call {
+func (_c *MockPostFavouriteStore_GetFavouritePosts_Call) Run(run func(ctx context.Context, userID int, params core.GetAllPostsParams)) *MockPostFavouriteStore_GetFavouritePosts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(core.GetAllPostsParams))
 	})
 	return _c
 }
@@ -180,7 +179,7 @@ func (_c *MockPostFavouriteStore_GetFavouritePosts_Call) Return(_a0 []core.Post,
 	return _c
 }
 
-func (_c *MockPostFavouriteStore_GetFavouritePosts_Call) RunAndReturn(run func(context.Context, int, int, int) ([]core.Post, int, error)) *MockPostFavouriteStore_GetFavouritePosts_Call {
+func (_c *MockPostFavouriteStore_GetFavouritePosts_Call) RunAndReturn(run func(context.Context, int, core.GetAllPostsParams) ([]core.Post, int, error)) *MockPostFavouriteStore_GetFavouritePosts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,8 +7,6 @@ import (
 
 	core "github.com/kotopesp/sos-kotopes/internal/core"
 	mock "github.com/stretchr/testify/mock"
-
-	multipart "mime/multipart"
 )
 
 // MockPostService is an autogenerated mock type for the PostService type
@@ -71,9 +69,9 @@ func (_c *MockPostService_AddToFavourites_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
-// BuildPostDetailsList provides a mock function with given fields: ctx, posts, total
-func (_m *MockPostService) BuildPostDetailsList(ctx context.Context, posts []core.Post, total int) ([]core.PostDetails, error) {
-	ret := _m.Called(ctx, posts, total)
+// BuildPostDetailsList provides a mock function with given fields: ctx, posts, total, viewerID
+func (_m *MockPostService) BuildPostDetailsList(ctx context.Context, posts []core.Post, total int, viewerID int) ([]core.PostDetails, error) {
+	ret := _m.Called(ctx, posts, total, viewerID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BuildPostDetailsList")
@@ -81,19 +79,19 @@ func (_m *MockPostService) BuildPostDetailsList(ctx context.Context, posts []cor
 
 	var r0 []core.PostDetails
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []core.Post, int) ([]core.PostDetails, error)); ok {
-		return rf(ctx, posts, total)
+	if rf, ok := ret.Get(0).(func(context.Context, []core.Post, int, int) ([]core.PostDetails, error)); ok {
+		return rf(ctx, posts, total, viewerID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []core.Post, int) []core.PostDetails); ok {
-		r0 = rf(ctx, posts, total)
+	if rf, ok := ret.Get(0).(func(context.Context, []core.Post, int, int) []core.PostDetails); ok {
+		r0 = rf(ctx, posts, total, viewerID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]core.PostDetails)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []core.Post, int) error); ok {
-		r1 = rf(ctx, posts, total)
+	if rf, ok := ret.Get(1).(func(context.Context, []core.Post, int, int) error); ok {
+		r1 = rf(ctx, posts, total, viewerID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -110,13 +108,14 @@ type MockPostService_BuildPostDetailsList_Call struct {
 //   - ctx context.Context
 //   - posts []core.Post
 //   - total int
-func (_e *MockPostService_Expecter) BuildPostDetailsList(ctx interface{}, posts interface{}, total interface{}) *MockPostService_BuildPostDetailsList_Call {
-	return &MockPostService_BuildPostDetailsList_Call{Call: _e.mock.On("BuildPostDetailsList", ctx, posts, total)}
+//   - viewerID int
+func (_e *MockPostService_Expecter) BuildPostDetailsList(ctx interface{}, posts interface{}, total interface{}, viewerID interface{}) *MockPostService_BuildPostDetailsList_Call {
+	return &MockPostService_BuildPostDetailsList_Call{Call: _e.mock.On("BuildPostDetailsList", ctx, posts, total, viewerID)}
 }
 
-func (_c *MockPostService_BuildPostDetailsList_Call) Run(run func(ctx context.Context, posts []core.Post, total int)) *MockPostService_BuildPostDetailsList_Call {
+func (_c *MockPostService_BuildPostDetailsList_Call) Run(run func(ctx context.Context, posts []core.Post, total int, viewerID int)) *MockPostService_BuildPostDetailsList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]core.Post), args[2].(int))
+		run(args[0].(context.Context), args[1].([]core.Post), args[2].(int), args[3].(int))
 	})
 	return _c
 }
@@ -126,14 +125,71 @@ func (_c *MockPostService_BuildPostDetailsList_Call) Return(_a0 []core.PostDetai
 	return _c
 }
 
-func (_c *MockPostService_BuildPostDetailsList_Call) RunAndReturn(run func(context.Context, []core.Post, int) ([]core.PostDetails, error)) *MockPostService_BuildPostDetailsList_Call {
+func (_c *MockPostService_BuildPostDetailsList_Call) RunAndReturn(run func(context.Context, []core.Post, int, int) ([]core.PostDetails, error)) *MockPostService_BuildPostDetailsList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BumpPost provides a mock function with given fields: ctx, post
+func (_m *MockPostService) BumpPost(ctx context.Context, post core.Post) (core.PostDetails, error) {
+	ret := _m.Called(ctx, post)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BumpPost")
+	}
+
+	var r0 core.PostDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.Post) (core.PostDetails, error)); ok {
+		return rf(ctx, post)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.Post) core.PostDetails); ok {
+		r0 = rf(ctx, post)
+	} else {
+		r0 = ret.Get(0).(core.PostDetails)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.Post) error); ok {
+		r1 = rf(ctx, post)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostService_BumpPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BumpPost'
+type MockPostService_BumpPost_Call struct {
+	*mock.Call
+}
+
+// BumpPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - post core.Post
+func (_e *MockPostService_Expecter) BumpPost(ctx interface{}, post interface{}) *MockPostService_BumpPost_Call {
+	return &MockPostService_BumpPost_Call{Call: _e.mock.On("BumpPost", ctx, post)}
+}
+
+func (_c *MockPostService_BumpPost_Call) Run(run func(ctx context.Context, post core.Post)) *MockPostService_BumpPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.Post))
+	})
+	return _c
+}
+
+func (_c *MockPostService_BumpPost_Call) Return(_a0 core.PostDetails, _a1 error) *MockPostService_BumpPost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostService_BumpPost_Call) RunAndReturn(run func(context.Context, core.Post) (core.PostDetails, error)) *MockPostService_BumpPost_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePost provides a mock function with given fields: ctx, postDetails, fileHeader
-func (_m *MockPostService) CreatePost(ctx context.Context, postDetails core.PostDetails, fileHeader *multipart.FileHeader) (core.PostDetails, error) {
-	ret := _m.Called(ctx, postDetails, fileHeader)
+// CreatePost provides a mock function with given fields: ctx, postDetails, photos
+func (_m *MockPostService) CreatePost(ctx context.Context, postDetails core.PostDetails, photos []core.MediaUpload) (core.PostDetails, error) {
+	ret := _m.Called(ctx, postDetails, photos)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreatePost")
@@ -141,17 +197,17 @@ func (_m *MockPostService) CreatePost(ctx context.Context, postDetails core.Post
 
 	var r0 core.PostDetails
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, core.PostDetails, *multipart.FileHeader) (core.PostDetails, error)); ok {
-		return rf(ctx, postDetails, fileHeader)
+	if rf, ok := ret.Get(0).(func(context.Context, core.PostDetails, []core.MediaUpload) (core.PostDetails, error)); ok {
+		return rf(ctx, postDetails, photos)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, core.PostDetails, *multipart.FileHeader) core.PostDetails); ok {
-		r0 = rf(ctx, postDetails, fileHeader)
+	if rf, ok := ret.Get(0).(func(context.Context, core.PostDetails, []core.MediaUpload) core.PostDetails); ok {
+		r0 = rf(ctx, postDetails, photos)
 	} else {
 		r0 = ret.Get(0).(core.PostDetails)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, core.PostDetails, *multipart.FileHeader) error); ok {
-		r1 = rf(ctx, postDetails, fileHeader)
+	if rf, ok := ret.Get(1).(func(context.Context, core.PostDetails, []core.MediaUpload) error); ok {
+		r1 = rf(ctx, postDetails, photos)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -167,14 +223,14 @@ type MockPostService_CreatePost_Call struct {
 // CreatePost is a helper method to define mock.On call
 //   - ctx context.Context
 //   - postDetails core.PostDetails
-//   - fileHeader *multipart.FileHeader
-func (_e *MockPostService_Expecter) CreatePost(ctx interface{}, postDetails interface{}, fileHeader interface{}) *MockPostService_CreatePost_Call {
-	return &MockPostService_CreatePost_Call{Call: _e.mock.On("CreatePost", ctx, postDetails, fileHeader)}
+//   - photos []core.MediaUpload
+func (_e *MockPostService_Expecter) CreatePost(ctx interface{}, postDetails interface{}, photos interface{}) *MockPostService_CreatePost_Call {
+	return &MockPostService_CreatePost_Call{Call: _e.mock.On("CreatePost", ctx, postDetails, photos)}
 }
 
-func (_c *MockPostService_CreatePost_Call) Run(run func(ctx context.Context, postDetails core.PostDetails, fileHeader *multipart.FileHeader)) *MockPostService_CreatePost_Call {
+func (_c *MockPostService_CreatePost_Call) Run(run func(ctx context.Context, postDetails core.PostDetails, photos []core.MediaUpload)) *MockPostService_CreatePost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(core.PostDetails), args[2].(*multipart.FileHeader))
+		run(args[0].(context.Context), args[1].(core.PostDetails), args[2].([]core.MediaUpload))
 	})
 	return _c
 }
@@ -184,7 +240,7 @@ func (_c *MockPostService_CreatePost_Call) Return(_a0 core.PostDetails, _a1 erro
 	return _c
 }
 
-func (_c *MockPostService_CreatePost_Call) RunAndReturn(run func(context.Context, core.PostDetails, *multipart.FileHeader) (core.PostDetails, error)) *MockPostService_CreatePost_Call {
+func (_c *MockPostService_CreatePost_Call) RunAndReturn(run func(context.Context, core.PostDetails, []core.MediaUpload) (core.PostDetails, error)) *MockPostService_CreatePost_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -284,6 +340,52 @@ func (_c *MockPostService_DeletePost_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ExpirePosts provides a mock function with given fields: ctx
+func (_m *MockPostService) ExpirePosts(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpirePosts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPostService_ExpirePosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpirePosts'
+type MockPostService_ExpirePosts_Call struct {
+	*mock.Call
+}
+
+// ExpirePosts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPostService_Expecter) ExpirePosts(ctx interface{}) *MockPostService_ExpirePosts_Call {
+	return &MockPostService_ExpirePosts_Call{Call: _e.mock.On("ExpirePosts", ctx)}
+}
+
+func (_c *MockPostService_ExpirePosts_Call) Run(run func(ctx context.Context)) *MockPostService_ExpirePosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPostService_ExpirePosts_Call) Return(_a0 error) *MockPostService_ExpirePosts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPostService_ExpirePosts_Call) RunAndReturn(run func(context.Context) error) *MockPostService_ExpirePosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllPosts provides a mock function with given fields: ctx, params
 func (_m *MockPostService) GetAllPosts(ctx context.Context, params core.GetAllPostsParams) ([]core.PostDetails, int, error) {
 	ret := _m.Called(ctx, params)
@@ -350,9 +452,76 @@ func (_c *MockPostService_GetAllPosts_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// GetFavouritePosts provides a mock function with given fields: ctx, userID
-func (_m *MockPostService) GetFavouritePosts(ctx context.Context, userID int) ([]core.PostDetails, int, error) {
-	ret := _m.Called(ctx, userID)
+// GetDrafts provides a mock function with given fields: ctx, authorID, params
+func (_m *MockPostService) GetDrafts(ctx context.Context, authorID int, params core.GetAllPostsParams) ([]core.PostDetails, int, error) {
+	ret := _m.Called(ctx, authorID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDrafts")
+	}
+
+	var r0 []core.PostDetails
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) ([]core.PostDetails, int, error)); ok {
+		return rf(ctx, authorID, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) []core.PostDetails); ok {
+		r0 = rf(ctx, authorID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.PostDetails)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.GetAllPostsParams) int); ok {
+		r1 = rf(ctx, authorID, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, core.GetAllPostsParams) error); ok {
+		r2 = rf(ctx, authorID, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockPostService_GetDrafts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDrafts'
+type MockPostService_GetDrafts_Call struct {
+	*mock.Call
+}
+
+// GetDrafts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID int
+//   - params core.GetAllPostsParams
+func (_e *MockPostService_Expecter) GetDrafts(ctx interface{}, authorID interface{}, params interface{}) *MockPostService_GetDrafts_Call {
+	return &MockPostService_GetDrafts_Call{Call: _e.mock.On("GetDrafts", ctx, authorID, params)}
+}
+
+func (_c *MockPostService_GetDrafts_Call) Run(run func(ctx context.Context, authorID int, params core.GetAllPostsParams)) *MockPostService_GetDrafts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(core.GetAllPostsParams))
+	})
+	return _c
+}
+
+func (_c *MockPostService_GetDrafts_Call) Return(posts []core.PostDetails, total int, err error) *MockPostService_GetDrafts_Call {
+	_c.Call.Return(posts, total, err)
+	return _c
+}
+
+func (_c *MockPostService_GetDrafts_Call) RunAndReturn(run func(context.Context, int, core.GetAllPostsParams) ([]core.PostDetails, int, error)) *MockPostService_GetDrafts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavouritePosts provides a mock function with given fields: ctx, userID, params
+func (_m *MockPostService) GetFavouritePosts(ctx context.Context, userID int, params core.GetAllPostsParams) ([]core.PostDetails, int, error) {
+	ret := _m.Called(ctx, userID, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetFavouritePosts")
@@ -361,25 +530,25 @@ func (_m *MockPostService) GetFavouritePosts(ctx context.Context, userID int) ([
 	var r0 []core.PostDetails
 	var r1 int
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.PostDetails, int, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) ([]core.PostDetails, int, error)); ok {
+		return rf(ctx, userID, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) []core.PostDetails); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) []core.PostDetails); ok {
+		r0 = rf(ctx, userID, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]core.PostDetails)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) int); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.GetAllPostsParams) int); ok {
+		r1 = rf(ctx, userID, params)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
-		r2 = rf(ctx, userID)
+	if rf, ok := ret.Get(2).(func(context.Context, int, core.GetAllPostsParams) error); ok {
+		r2 = rf(ctx, userID, params)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -395,13 +564,14 @@ type MockPostService_GetFavouritePosts_Call struct {
 // GetFavouritePosts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int
-func (_e *MockPostService_Expecter) GetFavouritePosts(ctx interface{}, userID interface{}) *MockPostService_GetFavouritePosts_Call {
-	return &MockPostService_GetFavouritePosts_Call{Call: _e.mock.On("GetFavouritePosts", ctx, userID)}
+//   - params core.GetAllPostsParams
+func (_e *MockPostService_Expecter) GetFavouritePosts(ctx interface{}, userID interface{}, params interface{}) *MockPostService_GetFavouritePosts_Call {
+	return &MockPostService_GetFavouritePosts_Call{Call: _e.mock.On("GetFavouritePosts", ctx, userID, params)}
 }
 
-func (_c *MockPostService_GetFavouritePosts_Call) Run(run func(ctx context.Context, userID int)) *MockPostService_GetFavouritePosts_Call {
+func (_c *MockPostService_GetFavouritePosts_Call) Run(run func(ctx context.Context, userID int, params core.GetAllPostsParams)) *MockPostService_GetFavouritePosts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(core.GetAllPostsParams))
 	})
 	return _c
 }
@@ -411,14 +581,14 @@ func (_c *MockPostService_GetFavouritePosts_Call) Return(_a0 []core.PostDetails,
 	return _c
 }
 
-func (_c *MockPostService_GetFavouritePosts_Call) RunAndReturn(run func(context.Context, int) ([]core.PostDetails, int, error)) *MockPostService_GetFavouritePosts_Call {
+func (_c *MockPostService_GetFavouritePosts_Call) RunAndReturn(run func(context.Context, int, core.GetAllPostsParams) ([]core.PostDetails, int, error)) *MockPostService_GetFavouritePosts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPostByID provides a mock function with given fields: ctx, id
-func (_m *MockPostService) GetPostByID(ctx context.Context, id int) (core.PostDetails, error) {
-	ret := _m.Called(ctx, id)
+// GetPostByID provides a mock function with given fields: ctx, id, viewerID
+func (_m *MockPostService) GetPostByID(ctx context.Context, id int, viewerID int) (core.PostDetails, error) {
+	ret := _m.Called(ctx, id, viewerID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPostByID")
@@ -426,17 +596,17 @@ func (_m *MockPostService) GetPostByID(ctx context.Context, id int) (core.PostDe
 
 	var r0 core.PostDetails
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (core.PostDetails, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (core.PostDetails, error)); ok {
+		return rf(ctx, id, viewerID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) core.PostDetails); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) core.PostDetails); ok {
+		r0 = rf(ctx, id, viewerID)
 	} else {
 		r0 = ret.Get(0).(core.PostDetails)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, id, viewerID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -452,13 +622,14 @@ type MockPostService_GetPostByID_Call struct {
 // GetPostByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
-func (_e *MockPostService_Expecter) GetPostByID(ctx interface{}, id interface{}) *MockPostService_GetPostByID_Call {
-	return &MockPostService_GetPostByID_Call{Call: _e.mock.On("GetPostByID", ctx, id)}
+//   - viewerID int
+func (_e *MockPostService_Expecter) GetPostByID(ctx interface{}, id interface{}, viewerID interface{}) *MockPostService_GetPostByID_Call {
+	return &MockPostService_GetPostByID_Call{Call: _e.mock.On("GetPostByID", ctx, id, viewerID)}
 }
 
-func (_c *MockPostService_GetPostByID_Call) Run(run func(ctx context.Context, id int)) *MockPostService_GetPostByID_Call {
+func (_c *MockPostService_GetPostByID_Call) Run(run func(ctx context.Context, id int, viewerID int)) *MockPostService_GetPostByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
 	})
 	return _c
 }
@@ -468,14 +639,129 @@ func (_c *MockPostService_GetPostByID_Call) Return(_a0 core.PostDetails, _a1 err
 	return _c
 }
 
-func (_c *MockPostService_GetPostByID_Call) RunAndReturn(run func(context.Context, int) (core.PostDetails, error)) *MockPostService_GetPostByID_Call {
+func (_c *MockPostService_GetPostByID_Call) RunAndReturn(run func(context.Context, int, int) (core.PostDetails, error)) *MockPostService_GetPostByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostRevisions provides a mock function with given fields: ctx, params
+func (_m *MockPostService) GetPostRevisions(ctx context.Context, params core.GetPostRevisionsParams) ([]core.PostRevision, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostRevisions")
+	}
+
+	var r0 []core.PostRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetPostRevisionsParams) ([]core.PostRevision, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetPostRevisionsParams) []core.PostRevision); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.PostRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetPostRevisionsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostService_GetPostRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostRevisions'
+type MockPostService_GetPostRevisions_Call struct {
+	*mock.Call
+}
+
+// GetPostRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetPostRevisionsParams
+func (_e *MockPostService_Expecter) GetPostRevisions(ctx interface{}, params interface{}) *MockPostService_GetPostRevisions_Call {
+	return &MockPostService_GetPostRevisions_Call{Call: _e.mock.On("GetPostRevisions", ctx, params)}
+}
+
+func (_c *MockPostService_GetPostRevisions_Call) Run(run func(ctx context.Context, params core.GetPostRevisionsParams)) *MockPostService_GetPostRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetPostRevisionsParams))
+	})
+	return _c
+}
+
+func (_c *MockPostService_GetPostRevisions_Call) Return(_a0 []core.PostRevision, _a1 error) *MockPostService_GetPostRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostService_GetPostRevisions_Call) RunAndReturn(run func(context.Context, core.GetPostRevisionsParams) ([]core.PostRevision, error)) *MockPostService_GetPostRevisions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserPosts provides a mock function with given fields: ctx, id
-func (_m *MockPostService) GetUserPosts(ctx context.Context, id int) ([]core.PostDetails, int, error) {
-	ret := _m.Called(ctx, id)
+// GetPostStatistics provides a mock function with given fields: ctx
+func (_m *MockPostService) GetPostStatistics(ctx context.Context) (core.PostStatistics, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostStatistics")
+	}
+
+	var r0 core.PostStatistics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (core.PostStatistics, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) core.PostStatistics); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(core.PostStatistics)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostService_GetPostStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostStatistics'
+type MockPostService_GetPostStatistics_Call struct {
+	*mock.Call
+}
+
+// GetPostStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPostService_Expecter) GetPostStatistics(ctx interface{}) *MockPostService_GetPostStatistics_Call {
+	return &MockPostService_GetPostStatistics_Call{Call: _e.mock.On("GetPostStatistics", ctx)}
+}
+
+func (_c *MockPostService_GetPostStatistics_Call) Run(run func(ctx context.Context)) *MockPostService_GetPostStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPostService_GetPostStatistics_Call) Return(_a0 core.PostStatistics, _a1 error) *MockPostService_GetPostStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostService_GetPostStatistics_Call) RunAndReturn(run func(context.Context) (core.PostStatistics, error)) *MockPostService_GetPostStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserPosts provides a mock function with given fields: ctx, id, params
+func (_m *MockPostService) GetUserPosts(ctx context.Context, id int, params core.GetAllPostsParams) ([]core.PostDetails, int, error) {
+	ret := _m.Called(ctx, id, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUserPosts")
@@ -484,25 +770,25 @@ func (_m *MockPostService) GetUserPosts(ctx context.Context, id int) ([]core.Pos
 	var r0 []core.PostDetails
 	var r1 int
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.PostDetails, int, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) ([]core.PostDetails, int, error)); ok {
+		return rf(ctx, id, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) []core.PostDetails); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) []core.PostDetails); ok {
+		r0 = rf(ctx, id, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]core.PostDetails)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) int); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.GetAllPostsParams) int); ok {
+		r1 = rf(ctx, id, params)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
-		r2 = rf(ctx, id)
+	if rf, ok := ret.Get(2).(func(context.Context, int, core.GetAllPostsParams) error); ok {
+		r2 = rf(ctx, id, params)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -518,13 +804,14 @@ type MockPostService_GetUserPosts_Call struct {
 // GetUserPosts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
-func (_e *MockPostService_Expecter) GetUserPosts(ctx interface{}, id interface{}) *MockPostService_GetUserPosts_Call {
-	return &MockPostService_GetUserPosts_Call{Call: _e.mock.On("GetUserPosts", ctx, id)}
+//   - params core.GetAllPostsParams
+func (_e *MockPostService_Expecter) GetUserPosts(ctx interface{}, id interface{}, params interface{}) *MockPostService_GetUserPosts_Call {
+	return &MockPostService_GetUserPosts_Call{Call: _e.mock.On("GetUserPosts", ctx, id, params)}
 }
 
-func (_c *MockPostService_GetUserPosts_Call) Run(run func(ctx context.Context, id int)) *MockPostService_GetUserPosts_Call {
+func (_c *MockPostService_GetUserPosts_Call) Run(run func(ctx context.Context, id int, params core.GetAllPostsParams)) *MockPostService_GetUserPosts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(core.GetAllPostsParams))
 	})
 	return _c
 }
@@ -534,7 +821,400 @@ func (_c *MockPostService_GetUserPosts_Call) Return(posts []core.PostDetails, co
 	return _c
 }
 
-func (_c *MockPostService_GetUserPosts_Call) RunAndReturn(run func(context.Context, int) ([]core.PostDetails, int, error)) *MockPostService_GetUserPosts_Call {
+func (_c *MockPostService_GetUserPosts_Call) RunAndReturn(run func(context.Context, int, core.GetAllPostsParams) ([]core.PostDetails, int, error)) *MockPostService_GetUserPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LikePost provides a mock function with given fields: ctx, postID, userID
+func (_m *MockPostService) LikePost(ctx context.Context, postID int, userID int) (core.Likes, error) {
+	ret := _m.Called(ctx, postID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LikePost")
+	}
+
+	var r0 core.Likes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (core.Likes, error)); ok {
+		return rf(ctx, postID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) core.Likes); ok {
+		r0 = rf(ctx, postID, userID)
+	} else {
+		r0 = ret.Get(0).(core.Likes)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, postID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostService_LikePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LikePost'
+type MockPostService_LikePost_Call struct {
+	*mock.Call
+}
+
+// LikePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int
+//   - userID int
+func (_e *MockPostService_Expecter) LikePost(ctx interface{}, postID interface{}, userID interface{}) *MockPostService_LikePost_Call {
+	return &MockPostService_LikePost_Call{Call: _e.mock.On("LikePost", ctx, postID, userID)}
+}
+
+func (_c *MockPostService_LikePost_Call) Run(run func(ctx context.Context, postID int, userID int)) *MockPostService_LikePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockPostService_LikePost_Call) Return(_a0 core.Likes, _a1 error) *MockPostService_LikePost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostService_LikePost_Call) RunAndReturn(run func(context.Context, int, int) (core.Likes, error)) *MockPostService_LikePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishPost provides a mock function with given fields: ctx, publish
+func (_m *MockPostService) PublishPost(ctx context.Context, publish core.PublishPost) (core.PostDetails, error) {
+	ret := _m.Called(ctx, publish)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishPost")
+	}
+
+	var r0 core.PostDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.PublishPost) (core.PostDetails, error)); ok {
+		return rf(ctx, publish)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.PublishPost) core.PostDetails); ok {
+		r0 = rf(ctx, publish)
+	} else {
+		r0 = ret.Get(0).(core.PostDetails)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.PublishPost) error); ok {
+		r1 = rf(ctx, publish)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostService_PublishPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishPost'
+type MockPostService_PublishPost_Call struct {
+	*mock.Call
+}
+
+// PublishPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - publish core.PublishPost
+func (_e *MockPostService_Expecter) PublishPost(ctx interface{}, publish interface{}) *MockPostService_PublishPost_Call {
+	return &MockPostService_PublishPost_Call{Call: _e.mock.On("PublishPost", ctx, publish)}
+}
+
+func (_c *MockPostService_PublishPost_Call) Run(run func(ctx context.Context, publish core.PublishPost)) *MockPostService_PublishPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.PublishPost))
+	})
+	return _c
+}
+
+func (_c *MockPostService_PublishPost_Call) Return(_a0 core.PostDetails, _a1 error) *MockPostService_PublishPost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostService_PublishPost_Call) RunAndReturn(run func(context.Context, core.PublishPost) (core.PostDetails, error)) *MockPostService_PublishPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishScheduledPosts provides a mock function with given fields: ctx
+func (_m *MockPostService) PublishScheduledPosts(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishScheduledPosts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPostService_PublishScheduledPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishScheduledPosts'
+type MockPostService_PublishScheduledPosts_Call struct {
+	*mock.Call
+}
+
+// PublishScheduledPosts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPostService_Expecter) PublishScheduledPosts(ctx interface{}) *MockPostService_PublishScheduledPosts_Call {
+	return &MockPostService_PublishScheduledPosts_Call{Call: _e.mock.On("PublishScheduledPosts", ctx)}
+}
+
+func (_c *MockPostService_PublishScheduledPosts_Call) Run(run func(ctx context.Context)) *MockPostService_PublishScheduledPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPostService_PublishScheduledPosts_Call) Return(_a0 error) *MockPostService_PublishScheduledPosts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPostService_PublishScheduledPosts_Call) RunAndReturn(run func(context.Context) error) *MockPostService_PublishScheduledPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewPost provides a mock function with given fields: ctx, post
+func (_m *MockPostService) RenewPost(ctx context.Context, post core.Post) (core.PostDetails, error) {
+	ret := _m.Called(ctx, post)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewPost")
+	}
+
+	var r0 core.PostDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.Post) (core.PostDetails, error)); ok {
+		return rf(ctx, post)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.Post) core.PostDetails); ok {
+		r0 = rf(ctx, post)
+	} else {
+		r0 = ret.Get(0).(core.PostDetails)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.Post) error); ok {
+		r1 = rf(ctx, post)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostService_RenewPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewPost'
+type MockPostService_RenewPost_Call struct {
+	*mock.Call
+}
+
+// RenewPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - post core.Post
+func (_e *MockPostService_Expecter) RenewPost(ctx interface{}, post interface{}) *MockPostService_RenewPost_Call {
+	return &MockPostService_RenewPost_Call{Call: _e.mock.On("RenewPost", ctx, post)}
+}
+
+func (_c *MockPostService_RenewPost_Call) Run(run func(ctx context.Context, post core.Post)) *MockPostService_RenewPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.Post))
+	})
+	return _c
+}
+
+func (_c *MockPostService_RenewPost_Call) Return(_a0 core.PostDetails, _a1 error) *MockPostService_RenewPost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostService_RenewPost_Call) RunAndReturn(run func(context.Context, core.Post) (core.PostDetails, error)) *MockPostService_RenewPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolvePost provides a mock function with given fields: ctx, resolve
+func (_m *MockPostService) ResolvePost(ctx context.Context, resolve core.ResolvePost) (core.PostDetails, error) {
+	ret := _m.Called(ctx, resolve)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolvePost")
+	}
+
+	var r0 core.PostDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.ResolvePost) (core.PostDetails, error)); ok {
+		return rf(ctx, resolve)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.ResolvePost) core.PostDetails); ok {
+		r0 = rf(ctx, resolve)
+	} else {
+		r0 = ret.Get(0).(core.PostDetails)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.ResolvePost) error); ok {
+		r1 = rf(ctx, resolve)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostService_ResolvePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolvePost'
+type MockPostService_ResolvePost_Call struct {
+	*mock.Call
+}
+
+// ResolvePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resolve core.ResolvePost
+func (_e *MockPostService_Expecter) ResolvePost(ctx interface{}, resolve interface{}) *MockPostService_ResolvePost_Call {
+	return &MockPostService_ResolvePost_Call{Call: _e.mock.On("ResolvePost", ctx, resolve)}
+}
+
+func (_c *MockPostService_ResolvePost_Call) Run(run func(ctx context.Context, resolve core.ResolvePost)) *MockPostService_ResolvePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.ResolvePost))
+	})
+	return _c
+}
+
+func (_c *MockPostService_ResolvePost_Call) Return(_a0 core.PostDetails, _a1 error) *MockPostService_ResolvePost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostService_ResolvePost_Call) RunAndReturn(run func(context.Context, core.ResolvePost) (core.PostDetails, error)) *MockPostService_ResolvePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchPostsByPhoto provides a mock function with given fields: ctx, photo, params
+func (_m *MockPostService) SearchPostsByPhoto(ctx context.Context, photo core.MediaUpload, params core.SearchPostsByPhotoParams) ([]core.SimilarPost, error) {
+	ret := _m.Called(ctx, photo, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchPostsByPhoto")
+	}
+
+	var r0 []core.SimilarPost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaUpload, core.SearchPostsByPhotoParams) ([]core.SimilarPost, error)); ok {
+		return rf(ctx, photo, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.MediaUpload, core.SearchPostsByPhotoParams) []core.SimilarPost); ok {
+		r0 = rf(ctx, photo, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.SimilarPost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.MediaUpload, core.SearchPostsByPhotoParams) error); ok {
+		r1 = rf(ctx, photo, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostService_SearchPostsByPhoto_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchPostsByPhoto'
+type MockPostService_SearchPostsByPhoto_Call struct {
+	*mock.Call
+}
+
+// SearchPostsByPhoto is a helper method to define mock.On call
+//   - ctx context.Context
+//   - photo core.MediaUpload
+//   - params core.SearchPostsByPhotoParams
+func (_e *MockPostService_Expecter) SearchPostsByPhoto(ctx interface{}, photo interface{}, params interface{}) *MockPostService_SearchPostsByPhoto_Call {
+	return &MockPostService_SearchPostsByPhoto_Call{Call: _e.mock.On("SearchPostsByPhoto", ctx, photo, params)}
+}
+
+func (_c *MockPostService_SearchPostsByPhoto_Call) Run(run func(ctx context.Context, photo core.MediaUpload, params core.SearchPostsByPhotoParams)) *MockPostService_SearchPostsByPhoto_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.MediaUpload), args[2].(core.SearchPostsByPhotoParams))
+	})
+	return _c
+}
+
+func (_c *MockPostService_SearchPostsByPhoto_Call) Return(_a0 []core.SimilarPost, _a1 error) *MockPostService_SearchPostsByPhoto_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostService_SearchPostsByPhoto_Call) RunAndReturn(run func(context.Context, core.MediaUpload, core.SearchPostsByPhotoParams) ([]core.SimilarPost, error)) *MockPostService_SearchPostsByPhoto_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlikePost provides a mock function with given fields: ctx, postID, userID
+func (_m *MockPostService) UnlikePost(ctx context.Context, postID int, userID int) (core.Likes, error) {
+	ret := _m.Called(ctx, postID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlikePost")
+	}
+
+	var r0 core.Likes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (core.Likes, error)); ok {
+		return rf(ctx, postID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) core.Likes); ok {
+		r0 = rf(ctx, postID, userID)
+	} else {
+		r0 = ret.Get(0).(core.Likes)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, postID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostService_UnlikePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlikePost'
+type MockPostService_UnlikePost_Call struct {
+	*mock.Call
+}
+
+// UnlikePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int
+//   - userID int
+func (_e *MockPostService_Expecter) UnlikePost(ctx interface{}, postID interface{}, userID interface{}) *MockPostService_UnlikePost_Call {
+	return &MockPostService_UnlikePost_Call{Call: _e.mock.On("UnlikePost", ctx, postID, userID)}
+}
+
+func (_c *MockPostService_UnlikePost_Call) Run(run func(ctx context.Context, postID int, userID int)) *MockPostService_UnlikePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockPostService_UnlikePost_Call) Return(_a0 core.Likes, _a1 error) *MockPostService_UnlikePost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostService_UnlikePost_Call) RunAndReturn(run func(context.Context, int, int) (core.Likes, error)) *MockPostService_UnlikePost_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4,6 +4,7 @@ package core
 
 import (
 	context "context"
+	time "time"
 
 	core "github.com/kotopesp/sos-kotopes/internal/core"
 	mock "github.com/stretchr/testify/mock"
@@ -69,6 +70,182 @@ func (_c *MockPostStore_ApprovePostFromModeration_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// ArchiveExpiredPosts provides a mock function with given fields: ctx, bumpedBefore, remindedBefore
+func (_m *MockPostStore) ArchiveExpiredPosts(ctx context.Context, bumpedBefore time.Time, remindedBefore time.Time) ([]core.Post, error) {
+	ret := _m.Called(ctx, bumpedBefore, remindedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveExpiredPosts")
+	}
+
+	var r0 []core.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]core.Post, error)); ok {
+		return rf(ctx, bumpedBefore, remindedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []core.Post); ok {
+		r0 = rf(ctx, bumpedBefore, remindedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, bumpedBefore, remindedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostStore_ArchiveExpiredPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveExpiredPosts'
+type MockPostStore_ArchiveExpiredPosts_Call struct {
+	*mock.Call
+}
+
+// ArchiveExpiredPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bumpedBefore time.Time
+//   - remindedBefore time.Time
+func (_e *MockPostStore_Expecter) ArchiveExpiredPosts(ctx interface{}, bumpedBefore interface{}, remindedBefore interface{}) *MockPostStore_ArchiveExpiredPosts_Call {
+	return &MockPostStore_ArchiveExpiredPosts_Call{Call: _e.mock.On("ArchiveExpiredPosts", ctx, bumpedBefore, remindedBefore)}
+}
+
+func (_c *MockPostStore_ArchiveExpiredPosts_Call) Run(run func(ctx context.Context, bumpedBefore time.Time, remindedBefore time.Time)) *MockPostStore_ArchiveExpiredPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPostStore_ArchiveExpiredPosts_Call) Return(posts []core.Post, err error) *MockPostStore_ArchiveExpiredPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostStore_ArchiveExpiredPosts_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]core.Post, error)) *MockPostStore_ArchiveExpiredPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BumpPost provides a mock function with given fields: ctx, id, bumpedBefore
+func (_m *MockPostStore) BumpPost(ctx context.Context, id int, bumpedBefore time.Time) (core.Post, error) {
+	ret := _m.Called(ctx, id, bumpedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BumpPost")
+	}
+
+	var r0 core.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (core.Post, error)); ok {
+		return rf(ctx, id, bumpedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) core.Post); ok {
+		r0 = rf(ctx, id, bumpedBefore)
+	} else {
+		r0 = ret.Get(0).(core.Post)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, id, bumpedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostStore_BumpPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BumpPost'
+type MockPostStore_BumpPost_Call struct {
+	*mock.Call
+}
+
+// BumpPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - bumpedBefore time.Time
+func (_e *MockPostStore_Expecter) BumpPost(ctx interface{}, id interface{}, bumpedBefore interface{}) *MockPostStore_BumpPost_Call {
+	return &MockPostStore_BumpPost_Call{Call: _e.mock.On("BumpPost", ctx, id, bumpedBefore)}
+}
+
+func (_c *MockPostStore_BumpPost_Call) Run(run func(ctx context.Context, id int, bumpedBefore time.Time)) *MockPostStore_BumpPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPostStore_BumpPost_Call) Return(post core.Post, err error) *MockPostStore_BumpPost_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostStore_BumpPost_Call) RunAndReturn(run func(context.Context, int, time.Time) (core.Post, error)) *MockPostStore_BumpPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountUserPostsSince provides a mock function with given fields: ctx, authorID, since
+func (_m *MockPostStore) CountUserPostsSince(ctx context.Context, authorID int, since time.Time) (int, error) {
+	ret := _m.Called(ctx, authorID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUserPostsSince")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (int, error)); ok {
+		return rf(ctx, authorID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) int); ok {
+		r0 = rf(ctx, authorID, since)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, authorID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostStore_CountUserPostsSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUserPostsSince'
+type MockPostStore_CountUserPostsSince_Call struct {
+	*mock.Call
+}
+
+// CountUserPostsSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID int
+//   - since time.Time
+func (_e *MockPostStore_Expecter) CountUserPostsSince(ctx interface{}, authorID interface{}, since interface{}) *MockPostStore_CountUserPostsSince_Call {
+	return &MockPostStore_CountUserPostsSince_Call{Call: _e.mock.On("CountUserPostsSince", ctx, authorID, since)}
+}
+
+func (_c *MockPostStore_CountUserPostsSince_Call) Run(run func(ctx context.Context, authorID int, since time.Time)) *MockPostStore_CountUserPostsSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPostStore_CountUserPostsSince_Call) Return(count int, err error) *MockPostStore_CountUserPostsSince_Call {
+	_c.Call.Return(count, err)
+	return _c
+}
+
+func (_c *MockPostStore_CountUserPostsSince_Call) RunAndReturn(run func(context.Context, int, time.Time) (int, error)) *MockPostStore_CountUserPostsSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePost provides a mock function with given fields: ctx, post
 func (_m *MockPostStore) CreatePost(ctx context.Context, post core.Post) (core.Post, error) {
 	ret := _m.Called(ctx, post)
@@ -239,6 +416,73 @@ func (_c *MockPostStore_GetAllPosts_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetDrafts provides a mock function with given fields: ctx, authorID, params
+func (_m *MockPostStore) GetDrafts(ctx context.Context, authorID int, params core.GetAllPostsParams) ([]core.Post, int, error) {
+	ret := _m.Called(ctx, authorID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDrafts")
+	}
+
+	var r0 []core.Post
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) ([]core.Post, int, error)); ok {
+		return rf(ctx, authorID, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) []core.Post); ok {
+		r0 = rf(ctx, authorID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.GetAllPostsParams) int); ok {
+		r1 = rf(ctx, authorID, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, core.GetAllPostsParams) error); ok {
+		r2 = rf(ctx, authorID, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockPostStore_GetDrafts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDrafts'
+type MockPostStore_GetDrafts_Call struct {
+	*mock.Call
+}
+
+// GetDrafts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID int
+//   - params core.GetAllPostsParams
+func (_e *MockPostStore_Expecter) GetDrafts(ctx interface{}, authorID interface{}, params interface{}) *MockPostStore_GetDrafts_Call {
+	return &MockPostStore_GetDrafts_Call{Call: _e.mock.On("GetDrafts", ctx, authorID, params)}
+}
+
+func (_c *MockPostStore_GetDrafts_Call) Run(run func(ctx context.Context, authorID int, params core.GetAllPostsParams)) *MockPostStore_GetDrafts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(core.GetAllPostsParams))
+	})
+	return _c
+}
+
+func (_c *MockPostStore_GetDrafts_Call) Return(posts []core.Post, total int, err error) *MockPostStore_GetDrafts_Call {
+	_c.Call.Return(posts, total, err)
+	return _c
+}
+
+func (_c *MockPostStore_GetDrafts_Call) RunAndReturn(run func(context.Context, int, core.GetAllPostsParams) ([]core.Post, int, error)) *MockPostStore_GetDrafts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPostByID provides a mock function with given fields: ctx, id
 func (_m *MockPostStore) GetPostByID(ctx context.Context, id int) (core.Post, error) {
 	ret := _m.Called(ctx, id)
@@ -296,6 +540,179 @@ func (_c *MockPostStore_GetPostByID_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetPostByIDAnyStatus provides a mock function with given fields: ctx, id
+func (_m *MockPostStore) GetPostByIDAnyStatus(ctx context.Context, id int) (core.Post, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostByIDAnyStatus")
+	}
+
+	var r0 core.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (core.Post, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) core.Post); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(core.Post)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostStore_GetPostByIDAnyStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostByIDAnyStatus'
+type MockPostStore_GetPostByIDAnyStatus_Call struct {
+	*mock.Call
+}
+
+// GetPostByIDAnyStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockPostStore_Expecter) GetPostByIDAnyStatus(ctx interface{}, id interface{}) *MockPostStore_GetPostByIDAnyStatus_Call {
+	return &MockPostStore_GetPostByIDAnyStatus_Call{Call: _e.mock.On("GetPostByIDAnyStatus", ctx, id)}
+}
+
+func (_c *MockPostStore_GetPostByIDAnyStatus_Call) Run(run func(ctx context.Context, id int)) *MockPostStore_GetPostByIDAnyStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockPostStore_GetPostByIDAnyStatus_Call) Return(post core.Post, err error) *MockPostStore_GetPostByIDAnyStatus_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostStore_GetPostByIDAnyStatus_Call) RunAndReturn(run func(context.Context, int) (core.Post, error)) *MockPostStore_GetPostByIDAnyStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostStatistics provides a mock function with given fields: ctx, resolvedSince
+func (_m *MockPostStore) GetPostStatistics(ctx context.Context, resolvedSince time.Time) (core.PostStatistics, error) {
+	ret := _m.Called(ctx, resolvedSince)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostStatistics")
+	}
+
+	var r0 core.PostStatistics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (core.PostStatistics, error)); ok {
+		return rf(ctx, resolvedSince)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) core.PostStatistics); ok {
+		r0 = rf(ctx, resolvedSince)
+	} else {
+		r0 = ret.Get(0).(core.PostStatistics)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, resolvedSince)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostStore_GetPostStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostStatistics'
+type MockPostStore_GetPostStatistics_Call struct {
+	*mock.Call
+}
+
+// GetPostStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resolvedSince time.Time
+func (_e *MockPostStore_Expecter) GetPostStatistics(ctx interface{}, resolvedSince interface{}) *MockPostStore_GetPostStatistics_Call {
+	return &MockPostStore_GetPostStatistics_Call{Call: _e.mock.On("GetPostStatistics", ctx, resolvedSince)}
+}
+
+func (_c *MockPostStore_GetPostStatistics_Call) Run(run func(ctx context.Context, resolvedSince time.Time)) *MockPostStore_GetPostStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPostStore_GetPostStatistics_Call) Return(statistics core.PostStatistics, err error) *MockPostStore_GetPostStatistics_Call {
+	_c.Call.Return(statistics, err)
+	return _c
+}
+
+func (_c *MockPostStore_GetPostStatistics_Call) RunAndReturn(run func(context.Context, time.Time) (core.PostStatistics, error)) *MockPostStore_GetPostStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostsByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockPostStore) GetPostsByIDs(ctx context.Context, ids []int) ([]core.Post, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostsByIDs")
+	}
+
+	var r0 []core.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]core.Post, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []core.Post); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostStore_GetPostsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostsByIDs'
+type MockPostStore_GetPostsByIDs_Call struct {
+	*mock.Call
+}
+
+// GetPostsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int
+func (_e *MockPostStore_Expecter) GetPostsByIDs(ctx interface{}, ids interface{}) *MockPostStore_GetPostsByIDs_Call {
+	return &MockPostStore_GetPostsByIDs_Call{Call: _e.mock.On("GetPostsByIDs", ctx, ids)}
+}
+
+func (_c *MockPostStore_GetPostsByIDs_Call) Run(run func(ctx context.Context, ids []int)) *MockPostStore_GetPostsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *MockPostStore_GetPostsByIDs_Call) Return(posts []core.Post, err error) *MockPostStore_GetPostsByIDs_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostStore_GetPostsByIDs_Call) RunAndReturn(run func(context.Context, []int) ([]core.Post, error)) *MockPostStore_GetPostsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPostsForModeration provides a mock function with given fields: ctx, filter
 func (_m *MockPostStore) GetPostsForModeration(ctx context.Context, filter core.Filter) ([]core.Post, error) {
 	ret := _m.Called(ctx, filter)
@@ -355,9 +772,68 @@ func (_c *MockPostStore_GetPostsForModeration_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// GetUserPosts provides a mock function with given fields: ctx, id
-func (_m *MockPostStore) GetUserPosts(ctx context.Context, id int) ([]core.Post, int, error) {
-	ret := _m.Called(ctx, id)
+// GetScheduledPosts provides a mock function with given fields: ctx, before
+func (_m *MockPostStore) GetScheduledPosts(ctx context.Context, before time.Time) ([]core.Post, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScheduledPosts")
+	}
+
+	var r0 []core.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]core.Post, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []core.Post); ok {
+		r0 = rf(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostStore_GetScheduledPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScheduledPosts'
+type MockPostStore_GetScheduledPosts_Call struct {
+	*mock.Call
+}
+
+// GetScheduledPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockPostStore_Expecter) GetScheduledPosts(ctx interface{}, before interface{}) *MockPostStore_GetScheduledPosts_Call {
+	return &MockPostStore_GetScheduledPosts_Call{Call: _e.mock.On("GetScheduledPosts", ctx, before)}
+}
+
+func (_c *MockPostStore_GetScheduledPosts_Call) Run(run func(ctx context.Context, before time.Time)) *MockPostStore_GetScheduledPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPostStore_GetScheduledPosts_Call) Return(posts []core.Post, err error) *MockPostStore_GetScheduledPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostStore_GetScheduledPosts_Call) RunAndReturn(run func(context.Context, time.Time) ([]core.Post, error)) *MockPostStore_GetScheduledPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserPosts provides a mock function with given fields: ctx, id, params
+func (_m *MockPostStore) GetUserPosts(ctx context.Context, id int, params core.GetAllPostsParams) ([]core.Post, int, error) {
+	ret := _m.Called(ctx, id, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUserPosts")
@@ -366,25 +842,25 @@ func (_m *MockPostStore) GetUserPosts(ctx context.Context, id int) ([]core.Post,
 	var r0 []core.Post
 	var r1 int
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.Post, int, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) ([]core.Post, int, error)); ok {
+		return rf(ctx, id, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) []core.Post); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) []core.Post); ok {
+		r0 = rf(ctx, id, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]core.Post)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) int); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.GetAllPostsParams) int); ok {
+		r1 = rf(ctx, id, params)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
-		r2 = rf(ctx, id)
+	if rf, ok := ret.Get(2).(func(context.Context, int, core.GetAllPostsParams) error); ok {
+		r2 = rf(ctx, id, params)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -400,13 +876,14 @@ type MockPostStore_GetUserPosts_Call struct {
 // GetUserPosts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
-func (_e *MockPostStore_Expecter) GetUserPosts(ctx interface{}, id interface{}) *MockPostStore_GetUserPosts_Call {
-	return &MockPostStore_GetUserPosts_Call{Call: _e.mock.On("GetUserPosts", ctx, id)}
+//   - params core.GetAllPostsParams
+func (_e *MockPostStore_Expecter) GetUserPosts(ctx interface{}, id interface{}, params interface{}) *MockPostStore_GetUserPosts_Call {
+	return &MockPostStore_GetUserPosts_Call{Call: _e.mock.On("GetUserPosts", ctx, id, params)}
 }
 
-func (_c *MockPostStore_GetUserPosts_Call) Run(run func(ctx context.Context, id int)) *MockPostStore_GetUserPosts_Call {
+func (_c *MockPostStore_GetUserPosts_Call) Run(run func(ctx context.Context, id int, params core.GetAllPostsParams)) *MockPostStore_GetUserPosts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(core.GetAllPostsParams))
 	})
 	return _c
 }
@@ -416,7 +893,181 @@ func (_c *MockPostStore_GetUserPosts_Call) Return(posts []core.Post, count int,
 	return _c
 }
 
-func (_c *MockPostStore_GetUserPosts_Call) RunAndReturn(run func(context.Context, int) ([]core.Post, int, error)) *MockPostStore_GetUserPosts_Call {
+func (_c *MockPostStore_GetUserPosts_Call) RunAndReturn(run func(context.Context, int, core.GetAllPostsParams) ([]core.Post, int, error)) *MockPostStore_GetUserPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishPost provides a mock function with given fields: ctx, post
+func (_m *MockPostStore) PublishPost(ctx context.Context, post core.Post) (core.Post, error) {
+	ret := _m.Called(ctx, post)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishPost")
+	}
+
+	var r0 core.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.Post) (core.Post, error)); ok {
+		return rf(ctx, post)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.Post) core.Post); ok {
+		r0 = rf(ctx, post)
+	} else {
+		r0 = ret.Get(0).(core.Post)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.Post) error); ok {
+		r1 = rf(ctx, post)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostStore_PublishPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishPost'
+type MockPostStore_PublishPost_Call struct {
+	*mock.Call
+}
+
+// PublishPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - post core.Post
+func (_e *MockPostStore_Expecter) PublishPost(ctx interface{}, post interface{}) *MockPostStore_PublishPost_Call {
+	return &MockPostStore_PublishPost_Call{Call: _e.mock.On("PublishPost", ctx, post)}
+}
+
+func (_c *MockPostStore_PublishPost_Call) Run(run func(ctx context.Context, post core.Post)) *MockPostStore_PublishPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.Post))
+	})
+	return _c
+}
+
+func (_c *MockPostStore_PublishPost_Call) Return(published core.Post, err error) *MockPostStore_PublishPost_Call {
+	_c.Call.Return(published, err)
+	return _c
+}
+
+func (_c *MockPostStore_PublishPost_Call) RunAndReturn(run func(context.Context, core.Post) (core.Post, error)) *MockPostStore_PublishPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemindExpiringPosts provides a mock function with given fields: ctx, bumpedBefore
+func (_m *MockPostStore) RemindExpiringPosts(ctx context.Context, bumpedBefore time.Time) ([]core.Post, error) {
+	ret := _m.Called(ctx, bumpedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemindExpiringPosts")
+	}
+
+	var r0 []core.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]core.Post, error)); ok {
+		return rf(ctx, bumpedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []core.Post); ok {
+		r0 = rf(ctx, bumpedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, bumpedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostStore_RemindExpiringPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemindExpiringPosts'
+type MockPostStore_RemindExpiringPosts_Call struct {
+	*mock.Call
+}
+
+// RemindExpiringPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bumpedBefore time.Time
+func (_e *MockPostStore_Expecter) RemindExpiringPosts(ctx interface{}, bumpedBefore interface{}) *MockPostStore_RemindExpiringPosts_Call {
+	return &MockPostStore_RemindExpiringPosts_Call{Call: _e.mock.On("RemindExpiringPosts", ctx, bumpedBefore)}
+}
+
+func (_c *MockPostStore_RemindExpiringPosts_Call) Run(run func(ctx context.Context, bumpedBefore time.Time)) *MockPostStore_RemindExpiringPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPostStore_RemindExpiringPosts_Call) Return(posts []core.Post, err error) *MockPostStore_RemindExpiringPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostStore_RemindExpiringPosts_Call) RunAndReturn(run func(context.Context, time.Time) ([]core.Post, error)) *MockPostStore_RemindExpiringPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SchedulePost provides a mock function with given fields: ctx, id, publishAt
+func (_m *MockPostStore) SchedulePost(ctx context.Context, id int, publishAt *time.Time) (core.Post, error) {
+	ret := _m.Called(ctx, id, publishAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SchedulePost")
+	}
+
+	var r0 core.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time) (core.Post, error)); ok {
+		return rf(ctx, id, publishAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time) core.Post); ok {
+		r0 = rf(ctx, id, publishAt)
+	} else {
+		r0 = ret.Get(0).(core.Post)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *time.Time) error); ok {
+		r1 = rf(ctx, id, publishAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostStore_SchedulePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SchedulePost'
+type MockPostStore_SchedulePost_Call struct {
+	*mock.Call
+}
+
+// SchedulePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - publishAt *time.Time
+func (_e *MockPostStore_Expecter) SchedulePost(ctx interface{}, id interface{}, publishAt interface{}) *MockPostStore_SchedulePost_Call {
+	return &MockPostStore_SchedulePost_Call{Call: _e.mock.On("SchedulePost", ctx, id, publishAt)}
+}
+
+func (_c *MockPostStore_SchedulePost_Call) Run(run func(ctx context.Context, id int, publishAt *time.Time)) *MockPostStore_SchedulePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPostStore_SchedulePost_Call) Return(post core.Post, err error) *MockPostStore_SchedulePost_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostStore_SchedulePost_Call) RunAndReturn(run func(context.Context, int, *time.Time) (core.Post, error)) *MockPostStore_SchedulePost_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,333 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProfileService is an autogenerated mock type for the ProfileService type
+type MockProfileService struct {
+	mock.Mock
+}
+
+type MockProfileService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProfileService) EXPECT() *MockProfileService_Expecter {
+	return &MockProfileService_Expecter{mock: &_m.Mock}
+}
+
+// GetContactsAccess provides a mock function with given fields: ctx, viewerID, ownerIDs
+func (_m *MockProfileService) GetContactsAccess(ctx context.Context, viewerID int, ownerIDs []int) (core.ContactsAccess, error) {
+	ret := _m.Called(ctx, viewerID, ownerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContactsAccess")
+	}
+
+	var r0 core.ContactsAccess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) (core.ContactsAccess, error)); ok {
+		return rf(ctx, viewerID, ownerIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) core.ContactsAccess); ok {
+		r0 = rf(ctx, viewerID, ownerIDs)
+	} else {
+		r0 = ret.Get(0).(core.ContactsAccess)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, viewerID, ownerIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileService_GetContactsAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContactsAccess'
+type MockProfileService_GetContactsAccess_Call struct {
+	*mock.Call
+}
+
+// GetContactsAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerID int
+//   - ownerIDs []int
+func (_e *MockProfileService_Expecter) GetContactsAccess(ctx interface{}, viewerID interface{}, ownerIDs interface{}) *MockProfileService_GetContactsAccess_Call {
+	return &MockProfileService_GetContactsAccess_Call{Call: _e.mock.On("GetContactsAccess", ctx, viewerID, ownerIDs)}
+}
+
+func (_c *MockProfileService_GetContactsAccess_Call) Run(run func(ctx context.Context, viewerID int, ownerIDs []int)) *MockProfileService_GetContactsAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *MockProfileService_GetContactsAccess_Call) Return(access core.ContactsAccess, err error) *MockProfileService_GetContactsAccess_Call {
+	_c.Call.Return(access, err)
+	return _c
+}
+
+func (_c *MockProfileService_GetContactsAccess_Call) RunAndReturn(run func(context.Context, int, []int) (core.ContactsAccess, error)) *MockProfileService_GetContactsAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivacySettings provides a mock function with given fields: ctx, userID
+func (_m *MockProfileService) GetPrivacySettings(ctx context.Context, userID int) (core.PrivacySettings, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivacySettings")
+	}
+
+	var r0 core.PrivacySettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (core.PrivacySettings, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) core.PrivacySettings); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(core.PrivacySettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileService_GetPrivacySettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivacySettings'
+type MockProfileService_GetPrivacySettings_Call struct {
+	*mock.Call
+}
+
+// GetPrivacySettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockProfileService_Expecter) GetPrivacySettings(ctx interface{}, userID interface{}) *MockProfileService_GetPrivacySettings_Call {
+	return &MockProfileService_GetPrivacySettings_Call{Call: _e.mock.On("GetPrivacySettings", ctx, userID)}
+}
+
+func (_c *MockProfileService_GetPrivacySettings_Call) Run(run func(ctx context.Context, userID int)) *MockProfileService_GetPrivacySettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockProfileService_GetPrivacySettings_Call) Return(settings core.PrivacySettings, err error) *MockProfileService_GetPrivacySettings_Call {
+	_c.Call.Return(settings, err)
+	return _c
+}
+
+func (_c *MockProfileService_GetPrivacySettings_Call) RunAndReturn(run func(context.Context, int) (core.PrivacySettings, error)) *MockProfileService_GetPrivacySettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfile provides a mock function with given fields: ctx, userID, viewerID
+func (_m *MockProfileService) GetProfile(ctx context.Context, userID int, viewerID int) (core.UserProfile, error) {
+	ret := _m.Called(ctx, userID, viewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfile")
+	}
+
+	var r0 core.UserProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (core.UserProfile, error)); ok {
+		return rf(ctx, userID, viewerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) core.UserProfile); ok {
+		r0 = rf(ctx, userID, viewerID)
+	} else {
+		r0 = ret.Get(0).(core.UserProfile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, userID, viewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileService_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockProfileService_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - viewerID int
+func (_e *MockProfileService_Expecter) GetProfile(ctx interface{}, userID interface{}, viewerID interface{}) *MockProfileService_GetProfile_Call {
+	return &MockProfileService_GetProfile_Call{Call: _e.mock.On("GetProfile", ctx, userID, viewerID)}
+}
+
+func (_c *MockProfileService_GetProfile_Call) Run(run func(ctx context.Context, userID int, viewerID int)) *MockProfileService_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockProfileService_GetProfile_Call) Return(profile core.UserProfile, err error) *MockProfileService_GetProfile_Call {
+	_c.Call.Return(profile, err)
+	return _c
+}
+
+func (_c *MockProfileService_GetProfile_Call) RunAndReturn(run func(context.Context, int, int) (core.UserProfile, error)) *MockProfileService_GetProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserComments provides a mock function with given fields: ctx, params
+func (_m *MockProfileService) GetUserComments(ctx context.Context, params core.GetUserCommentsParams) ([]core.Comment, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserComments")
+	}
+
+	var r0 []core.Comment
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetUserCommentsParams) ([]core.Comment, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetUserCommentsParams) []core.Comment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetUserCommentsParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetUserCommentsParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockProfileService_GetUserComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserComments'
+type MockProfileService_GetUserComments_Call struct {
+	*mock.Call
+}
+
+// GetUserComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params core.GetUserCommentsParams
+func (_e *MockProfileService_Expecter) GetUserComments(ctx interface{}, params interface{}) *MockProfileService_GetUserComments_Call {
+	return &MockProfileService_GetUserComments_Call{Call: _e.mock.On("GetUserComments", ctx, params)}
+}
+
+func (_c *MockProfileService_GetUserComments_Call) Run(run func(ctx context.Context, params core.GetUserCommentsParams)) *MockProfileService_GetUserComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetUserCommentsParams))
+	})
+	return _c
+}
+
+func (_c *MockProfileService_GetUserComments_Call) Return(comments []core.Comment, total int, err error) *MockProfileService_GetUserComments_Call {
+	_c.Call.Return(comments, total, err)
+	return _c
+}
+
+func (_c *MockProfileService_GetUserComments_Call) RunAndReturn(run func(context.Context, core.GetUserCommentsParams) ([]core.Comment, int, error)) *MockProfileService_GetUserComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePrivacySettings provides a mock function with given fields: ctx, settings
+func (_m *MockProfileService) UpdatePrivacySettings(ctx context.Context, settings core.PrivacySettings) (core.PrivacySettings, error) {
+	ret := _m.Called(ctx, settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePrivacySettings")
+	}
+
+	var r0 core.PrivacySettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.PrivacySettings) (core.PrivacySettings, error)); ok {
+		return rf(ctx, settings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.PrivacySettings) core.PrivacySettings); ok {
+		r0 = rf(ctx, settings)
+	} else {
+		r0 = ret.Get(0).(core.PrivacySettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.PrivacySettings) error); ok {
+		r1 = rf(ctx, settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileService_UpdatePrivacySettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePrivacySettings'
+type MockProfileService_UpdatePrivacySettings_Call struct {
+	*mock.Call
+}
+
+// UpdatePrivacySettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settings core.PrivacySettings
+func (_e *MockProfileService_Expecter) UpdatePrivacySettings(ctx interface{}, settings interface{}) *MockProfileService_UpdatePrivacySettings_Call {
+	return &MockProfileService_UpdatePrivacySettings_Call{Call: _e.mock.On("UpdatePrivacySettings", ctx, settings)}
+}
+
+func (_c *MockProfileService_UpdatePrivacySettings_Call) Run(run func(ctx context.Context, settings core.PrivacySettings)) *MockProfileService_UpdatePrivacySettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.PrivacySettings))
+	})
+	return _c
+}
+
+func (_c *MockProfileService_UpdatePrivacySettings_Call) Return(updated core.PrivacySettings, err error) *MockProfileService_UpdatePrivacySettings_Call {
+	_c.Call.Return(updated, err)
+	return _c
+}
+
+func (_c *MockProfileService_UpdatePrivacySettings_Call) RunAndReturn(run func(context.Context, core.PrivacySettings) (core.PrivacySettings, error)) *MockProfileService_UpdatePrivacySettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProfileService creates a new instance of MockProfileService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProfileService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProfileService {
+	mock := &MockProfileService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
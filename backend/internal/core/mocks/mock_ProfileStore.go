@@ -0,0 +1,386 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProfileStore is an autogenerated mock type for the ProfileStore type
+type MockProfileStore struct {
+	mock.Mock
+}
+
+type MockProfileStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProfileStore) EXPECT() *MockProfileStore_Expecter {
+	return &MockProfileStore_Expecter{mock: &_m.Mock}
+}
+
+// GetChatPartnerIDs provides a mock function with given fields: ctx, userID, userIDs
+func (_m *MockProfileStore) GetChatPartnerIDs(ctx context.Context, userID int, userIDs []int) ([]int, error) {
+	ret := _m.Called(ctx, userID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChatPartnerIDs")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) ([]int, error)); ok {
+		return rf(ctx, userID, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) []int); ok {
+		r0 = rf(ctx, userID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, userID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileStore_GetChatPartnerIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChatPartnerIDs'
+type MockProfileStore_GetChatPartnerIDs_Call struct {
+	*mock.Call
+}
+
+// GetChatPartnerIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - userIDs []int
+func (_e *MockProfileStore_Expecter) GetChatPartnerIDs(ctx interface{}, userID interface{}, userIDs interface{}) *MockProfileStore_GetChatPartnerIDs_Call {
+	return &MockProfileStore_GetChatPartnerIDs_Call{Call: _e.mock.On("GetChatPartnerIDs", ctx, userID, userIDs)}
+}
+
+func (_c *MockProfileStore_GetChatPartnerIDs_Call) Run(run func(ctx context.Context, userID int, userIDs []int)) *MockProfileStore_GetChatPartnerIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *MockProfileStore_GetChatPartnerIDs_Call) Return(partnerIDs []int, err error) *MockProfileStore_GetChatPartnerIDs_Call {
+	_c.Call.Return(partnerIDs, err)
+	return _c
+}
+
+func (_c *MockProfileStore_GetChatPartnerIDs_Call) RunAndReturn(run func(context.Context, int, []int) ([]int, error)) *MockProfileStore_GetChatPartnerIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivacySettings provides a mock function with given fields: ctx, userID
+func (_m *MockProfileStore) GetPrivacySettings(ctx context.Context, userID int) (core.PrivacySettings, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivacySettings")
+	}
+
+	var r0 core.PrivacySettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (core.PrivacySettings, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) core.PrivacySettings); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(core.PrivacySettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileStore_GetPrivacySettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivacySettings'
+type MockProfileStore_GetPrivacySettings_Call struct {
+	*mock.Call
+}
+
+// GetPrivacySettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockProfileStore_Expecter) GetPrivacySettings(ctx interface{}, userID interface{}) *MockProfileStore_GetPrivacySettings_Call {
+	return &MockProfileStore_GetPrivacySettings_Call{Call: _e.mock.On("GetPrivacySettings", ctx, userID)}
+}
+
+func (_c *MockProfileStore_GetPrivacySettings_Call) Run(run func(ctx context.Context, userID int)) *MockProfileStore_GetPrivacySettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockProfileStore_GetPrivacySettings_Call) Return(settings core.PrivacySettings, err error) *MockProfileStore_GetPrivacySettings_Call {
+	_c.Call.Return(settings, err)
+	return _c
+}
+
+func (_c *MockProfileStore_GetPrivacySettings_Call) RunAndReturn(run func(context.Context, int) (core.PrivacySettings, error)) *MockProfileStore_GetPrivacySettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserBadges provides a mock function with given fields: ctx, userID
+func (_m *MockProfileStore) GetUserBadges(ctx context.Context, userID int) ([]core.Badge, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserBadges")
+	}
+
+	var r0 []core.Badge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.Badge, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []core.Badge); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Badge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileStore_GetUserBadges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserBadges'
+type MockProfileStore_GetUserBadges_Call struct {
+	*mock.Call
+}
+
+// GetUserBadges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockProfileStore_Expecter) GetUserBadges(ctx interface{}, userID interface{}) *MockProfileStore_GetUserBadges_Call {
+	return &MockProfileStore_GetUserBadges_Call{Call: _e.mock.On("GetUserBadges", ctx, userID)}
+}
+
+func (_c *MockProfileStore_GetUserBadges_Call) Run(run func(ctx context.Context, userID int)) *MockProfileStore_GetUserBadges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockProfileStore_GetUserBadges_Call) Return(badges []core.Badge, err error) *MockProfileStore_GetUserBadges_Call {
+	_c.Call.Return(badges, err)
+	return _c
+}
+
+func (_c *MockProfileStore_GetUserBadges_Call) RunAndReturn(run func(context.Context, int) ([]core.Badge, error)) *MockProfileStore_GetUserBadges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserStatistics provides a mock function with given fields: ctx, userID
+func (_m *MockProfileStore) GetUserStatistics(ctx context.Context, userID int) (core.UserStatistics, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserStatistics")
+	}
+
+	var r0 core.UserStatistics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (core.UserStatistics, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) core.UserStatistics); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(core.UserStatistics)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileStore_GetUserStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserStatistics'
+type MockProfileStore_GetUserStatistics_Call struct {
+	*mock.Call
+}
+
+// GetUserStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockProfileStore_Expecter) GetUserStatistics(ctx interface{}, userID interface{}) *MockProfileStore_GetUserStatistics_Call {
+	return &MockProfileStore_GetUserStatistics_Call{Call: _e.mock.On("GetUserStatistics", ctx, userID)}
+}
+
+func (_c *MockProfileStore_GetUserStatistics_Call) Run(run func(ctx context.Context, userID int)) *MockProfileStore_GetUserStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockProfileStore_GetUserStatistics_Call) Return(statistics core.UserStatistics, err error) *MockProfileStore_GetUserStatistics_Call {
+	_c.Call.Return(statistics, err)
+	return _c
+}
+
+func (_c *MockProfileStore_GetUserStatistics_Call) RunAndReturn(run func(context.Context, int) (core.UserStatistics, error)) *MockProfileStore_GetUserStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsersPrivacySettings provides a mock function with given fields: ctx, userIDs
+func (_m *MockProfileStore) GetUsersPrivacySettings(ctx context.Context, userIDs []int) (map[int]core.PrivacySettings, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersPrivacySettings")
+	}
+
+	var r0 map[int]core.PrivacySettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (map[int]core.PrivacySettings, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) map[int]core.PrivacySettings); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]core.PrivacySettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileStore_GetUsersPrivacySettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersPrivacySettings'
+type MockProfileStore_GetUsersPrivacySettings_Call struct {
+	*mock.Call
+}
+
+// GetUsersPrivacySettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []int
+func (_e *MockProfileStore_Expecter) GetUsersPrivacySettings(ctx interface{}, userIDs interface{}) *MockProfileStore_GetUsersPrivacySettings_Call {
+	return &MockProfileStore_GetUsersPrivacySettings_Call{Call: _e.mock.On("GetUsersPrivacySettings", ctx, userIDs)}
+}
+
+func (_c *MockProfileStore_GetUsersPrivacySettings_Call) Run(run func(ctx context.Context, userIDs []int)) *MockProfileStore_GetUsersPrivacySettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *MockProfileStore_GetUsersPrivacySettings_Call) Return(settings map[int]core.PrivacySettings, err error) *MockProfileStore_GetUsersPrivacySettings_Call {
+	_c.Call.Return(settings, err)
+	return _c
+}
+
+func (_c *MockProfileStore_GetUsersPrivacySettings_Call) RunAndReturn(run func(context.Context, []int) (map[int]core.PrivacySettings, error)) *MockProfileStore_GetUsersPrivacySettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SavePrivacySettings provides a mock function with given fields: ctx, settings
+func (_m *MockProfileStore) SavePrivacySettings(ctx context.Context, settings core.PrivacySettings) (core.PrivacySettings, error) {
+	ret := _m.Called(ctx, settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePrivacySettings")
+	}
+
+	var r0 core.PrivacySettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.PrivacySettings) (core.PrivacySettings, error)); ok {
+		return rf(ctx, settings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.PrivacySettings) core.PrivacySettings); ok {
+		r0 = rf(ctx, settings)
+	} else {
+		r0 = ret.Get(0).(core.PrivacySettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.PrivacySettings) error); ok {
+		r1 = rf(ctx, settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProfileStore_SavePrivacySettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePrivacySettings'
+type MockProfileStore_SavePrivacySettings_Call struct {
+	*mock.Call
+}
+
+// SavePrivacySettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settings core.PrivacySettings
+func (_e *MockProfileStore_Expecter) SavePrivacySettings(ctx interface{}, settings interface{}) *MockProfileStore_SavePrivacySettings_Call {
+	return &MockProfileStore_SavePrivacySettings_Call{Call: _e.mock.On("SavePrivacySettings", ctx, settings)}
+}
+
+func (_c *MockProfileStore_SavePrivacySettings_Call) Run(run func(ctx context.Context, settings core.PrivacySettings)) *MockProfileStore_SavePrivacySettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.PrivacySettings))
+	})
+	return _c
+}
+
+func (_c *MockProfileStore_SavePrivacySettings_Call) Return(saved core.PrivacySettings, err error) *MockProfileStore_SavePrivacySettings_Call {
+	_c.Call.Return(saved, err)
+	return _c
+}
+
+func (_c *MockProfileStore_SavePrivacySettings_Call) RunAndReturn(run func(context.Context, core.PrivacySettings) (core.PrivacySettings, error)) *MockProfileStore_SavePrivacySettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProfileStore creates a new instance of MockProfileStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProfileStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProfileStore {
+	mock := &MockProfileStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -177,23 +177,260 @@ func (_c *MockReportStore_GetReportReasons_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
-// GetReportsCount provides a mock function with given fields: ctx, reportableID, reportableType
-func (_m *MockReportStore) GetReportsCount(ctx context.Context, reportableID int, reportableType string) (int, error) {
+// GetReportWeights provides a mock function with given fields: ctx, reportableID, reportableType
+func (_m *MockReportStore) GetReportWeights(ctx context.Context, reportableID int, reportableType string) (map[core.ReportReason]float64, error) {
 	ret := _m.Called(ctx, reportableID, reportableType)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetReportsCount")
+		panic("no return value specified for GetReportWeights")
 	}
 
-	var r0 int
+	var r0 map[core.ReportReason]float64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, string) (int, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (map[core.ReportReason]float64, error)); ok {
 		return rf(ctx, reportableID, reportableType)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, string) int); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) map[core.ReportReason]float64); ok {
 		r0 = rf(ctx, reportableID, reportableType)
 	} else {
-		r0 = ret.Get(0).(int)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[core.ReportReason]float64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, reportableID, reportableType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportStore_GetReportWeights_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReportWeights'
+type MockReportStore_GetReportWeights_Call struct {
+	*mock.Call
+}
+
+// GetReportWeights is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reportableID int
+//   - reportableType string
+func (_e *MockReportStore_Expecter) GetReportWeights(ctx interface{}, reportableID interface{}, reportableType interface{}) *MockReportStore_GetReportWeights_Call {
+	return &MockReportStore_GetReportWeights_Call{Call: _e.mock.On("GetReportWeights", ctx, reportableID, reportableType)}
+}
+
+func (_c *MockReportStore_GetReportWeights_Call) Run(run func(ctx context.Context, reportableID int, reportableType string)) *MockReportStore_GetReportWeights_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_GetReportWeights_Call) Return(weights map[core.ReportReason]float64, err error) *MockReportStore_GetReportWeights_Call {
+	_c.Call.Return(weights, err)
+	return _c
+}
+
+func (_c *MockReportStore_GetReportWeights_Call) RunAndReturn(run func(context.Context, int, string) (map[core.ReportReason]float64, error)) *MockReportStore_GetReportWeights_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReporterReputation provides a mock function with given fields: ctx, userID
+func (_m *MockReportStore) GetReporterReputation(ctx context.Context, userID int) (core.ReporterReputation, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReporterReputation")
+	}
+
+	var r0 core.ReporterReputation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (core.ReporterReputation, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) core.ReporterReputation); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(core.ReporterReputation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportStore_GetReporterReputation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReporterReputation'
+type MockReportStore_GetReporterReputation_Call struct {
+	*mock.Call
+}
+
+// GetReporterReputation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockReportStore_Expecter) GetReporterReputation(ctx interface{}, userID interface{}) *MockReportStore_GetReporterReputation_Call {
+	return &MockReportStore_GetReporterReputation_Call{Call: _e.mock.On("GetReporterReputation", ctx, userID)}
+}
+
+func (_c *MockReportStore_GetReporterReputation_Call) Run(run func(ctx context.Context, userID int)) *MockReportStore_GetReporterReputation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_GetReporterReputation_Call) Return(reputation core.ReporterReputation, err error) *MockReportStore_GetReporterReputation_Call {
+	_c.Call.Return(reputation, err)
+	return _c
+}
+
+func (_c *MockReportStore_GetReporterReputation_Call) RunAndReturn(run func(context.Context, int) (core.ReporterReputation, error)) *MockReportStore_GetReporterReputation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReports provides a mock function with given fields: ctx, reportableID, reportableType
+func (_m *MockReportStore) GetReports(ctx context.Context, reportableID int, reportableType string) ([]core.Report, error) {
+	ret := _m.Called(ctx, reportableID, reportableType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReports")
+	}
+
+	var r0 []core.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]core.Report, error)); ok {
+		return rf(ctx, reportableID, reportableType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []core.Report); ok {
+		r0 = rf(ctx, reportableID, reportableType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, reportableID, reportableType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportStore_GetReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReports'
+type MockReportStore_GetReports_Call struct {
+	*mock.Call
+}
+
+// GetReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reportableID int
+//   - reportableType string
+func (_e *MockReportStore_Expecter) GetReports(ctx interface{}, reportableID interface{}, reportableType interface{}) *MockReportStore_GetReports_Call {
+	return &MockReportStore_GetReports_Call{Call: _e.mock.On("GetReports", ctx, reportableID, reportableType)}
+}
+
+func (_c *MockReportStore_GetReports_Call) Run(run func(ctx context.Context, reportableID int, reportableType string)) *MockReportStore_GetReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_GetReports_Call) Return(reports []core.Report, err error) *MockReportStore_GetReports_Call {
+	_c.Call.Return(reports, err)
+	return _c
+}
+
+func (_c *MockReportStore_GetReports_Call) RunAndReturn(run func(context.Context, int, string) ([]core.Report, error)) *MockReportStore_GetReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTargetsForModeration provides a mock function with given fields: ctx, reportableType, filter
+func (_m *MockReportStore) GetTargetsForModeration(ctx context.Context, reportableType string, filter core.Filter) ([]core.ModerationTarget, error) {
+	ret := _m.Called(ctx, reportableType, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTargetsForModeration")
+	}
+
+	var r0 []core.ModerationTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, core.Filter) ([]core.ModerationTarget, error)); ok {
+		return rf(ctx, reportableType, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, core.Filter) []core.ModerationTarget); ok {
+		r0 = rf(ctx, reportableType, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.ModerationTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, core.Filter) error); ok {
+		r1 = rf(ctx, reportableType, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportStore_GetTargetsForModeration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTargetsForModeration'
+type MockReportStore_GetTargetsForModeration_Call struct {
+	*mock.Call
+}
+
+// GetTargetsForModeration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reportableType string
+//   - filter core.Filter
+func (_e *MockReportStore_Expecter) GetTargetsForModeration(ctx interface{}, reportableType interface{}, filter interface{}) *MockReportStore_GetTargetsForModeration_Call {
+	return &MockReportStore_GetTargetsForModeration_Call{Call: _e.mock.On("GetTargetsForModeration", ctx, reportableType, filter)}
+}
+
+func (_c *MockReportStore_GetTargetsForModeration_Call) Run(run func(ctx context.Context, reportableType string, filter core.Filter)) *MockReportStore_GetTargetsForModeration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(core.Filter))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_GetTargetsForModeration_Call) Return(targets []core.ModerationTarget, err error) *MockReportStore_GetTargetsForModeration_Call {
+	_c.Call.Return(targets, err)
+	return _c
+}
+
+func (_c *MockReportStore_GetTargetsForModeration_Call) RunAndReturn(run func(context.Context, string, core.Filter) ([]core.ModerationTarget, error)) *MockReportStore_GetTargetsForModeration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsOnModeration provides a mock function with given fields: ctx, reportableID, reportableType
+func (_m *MockReportStore) IsOnModeration(ctx context.Context, reportableID int, reportableType string) (bool, error) {
+	ret := _m.Called(ctx, reportableID, reportableType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsOnModeration")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (bool, error)); ok {
+		return rf(ctx, reportableID, reportableType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) bool); ok {
+		r0 = rf(ctx, reportableID, reportableType)
+	} else {
+		r0 = ret.Get(0).(bool)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
@@ -205,32 +442,177 @@ func (_m *MockReportStore) GetReportsCount(ctx context.Context, reportableID int
 	return r0, r1
 }
 
-// MockReportStore_GetReportsCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReportsCount'
-type MockReportStore_GetReportsCount_Call struct {
+// MockReportStore_IsOnModeration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsOnModeration'
+type MockReportStore_IsOnModeration_Call struct {
 	*mock.Call
 }
 
-// GetReportsCount is a helper method to define mock.On call
+// IsOnModeration is a helper method to define mock.On call
 //   - ctx context.Context
 //   - reportableID int
 //   - reportableType string
-func (_e *MockReportStore_Expecter) GetReportsCount(ctx interface{}, reportableID interface{}, reportableType interface{}) *MockReportStore_GetReportsCount_Call {
-	return &MockReportStore_GetReportsCount_Call{Call: _e.mock.On("GetReportsCount", ctx, reportableID, reportableType)}
+func (_e *MockReportStore_Expecter) IsOnModeration(ctx interface{}, reportableID interface{}, reportableType interface{}) *MockReportStore_IsOnModeration_Call {
+	return &MockReportStore_IsOnModeration_Call{Call: _e.mock.On("IsOnModeration", ctx, reportableID, reportableType)}
 }
 
-func (_c *MockReportStore_GetReportsCount_Call) Run(run func(ctx context.Context, reportableID int, reportableType string)) *MockReportStore_GetReportsCount_Call {
+func (_c *MockReportStore_IsOnModeration_Call) Run(run func(ctx context.Context, reportableID int, reportableType string)) *MockReportStore_IsOnModeration_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockReportStore_GetReportsCount_Call) Return(_a0 int, _a1 error) *MockReportStore_GetReportsCount_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockReportStore_IsOnModeration_Call) Return(onModeration bool, err error) *MockReportStore_IsOnModeration_Call {
+	_c.Call.Return(onModeration, err)
+	return _c
+}
+
+func (_c *MockReportStore_IsOnModeration_Call) RunAndReturn(run func(context.Context, int, string) (bool, error)) *MockReportStore_IsOnModeration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFromModeration provides a mock function with given fields: ctx, reportableID, reportableType
+func (_m *MockReportStore) RemoveFromModeration(ctx context.Context, reportableID int, reportableType string) error {
+	ret := _m.Called(ctx, reportableID, reportableType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromModeration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, reportableID, reportableType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_RemoveFromModeration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromModeration'
+type MockReportStore_RemoveFromModeration_Call struct {
+	*mock.Call
+}
+
+// RemoveFromModeration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reportableID int
+//   - reportableType string
+func (_e *MockReportStore_Expecter) RemoveFromModeration(ctx interface{}, reportableID interface{}, reportableType interface{}) *MockReportStore_RemoveFromModeration_Call {
+	return &MockReportStore_RemoveFromModeration_Call{Call: _e.mock.On("RemoveFromModeration", ctx, reportableID, reportableType)}
+}
+
+func (_c *MockReportStore_RemoveFromModeration_Call) Run(run func(ctx context.Context, reportableID int, reportableType string)) *MockReportStore_RemoveFromModeration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_RemoveFromModeration_Call) Return(err error) *MockReportStore_RemoveFromModeration_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReportStore_RemoveFromModeration_Call) RunAndReturn(run func(context.Context, int, string) error) *MockReportStore_RemoveFromModeration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveReports provides a mock function with given fields: ctx, reportableID, reportableType, upheld
+func (_m *MockReportStore) ResolveReports(ctx context.Context, reportableID int, reportableType string, upheld bool) error {
+	ret := _m.Called(ctx, reportableID, reportableType, upheld)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveReports")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, bool) error); ok {
+		r0 = rf(ctx, reportableID, reportableType, upheld)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_ResolveReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveReports'
+type MockReportStore_ResolveReports_Call struct {
+	*mock.Call
+}
+
+// ResolveReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reportableID int
+//   - reportableType string
+//   - upheld bool
+func (_e *MockReportStore_Expecter) ResolveReports(ctx interface{}, reportableID interface{}, reportableType interface{}, upheld interface{}) *MockReportStore_ResolveReports_Call {
+	return &MockReportStore_ResolveReports_Call{Call: _e.mock.On("ResolveReports", ctx, reportableID, reportableType, upheld)}
+}
+
+func (_c *MockReportStore_ResolveReports_Call) Run(run func(ctx context.Context, reportableID int, reportableType string, upheld bool)) *MockReportStore_ResolveReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_ResolveReports_Call) Return(err error) *MockReportStore_ResolveReports_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReportStore_ResolveReports_Call) RunAndReturn(run func(context.Context, int, string, bool) error) *MockReportStore_ResolveReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendToModeration provides a mock function with given fields: ctx, reportableID, reportableType
+func (_m *MockReportStore) SendToModeration(ctx context.Context, reportableID int, reportableType string) error {
+	ret := _m.Called(ctx, reportableID, reportableType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendToModeration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, reportableID, reportableType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_SendToModeration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendToModeration'
+type MockReportStore_SendToModeration_Call struct {
+	*mock.Call
+}
+
+// SendToModeration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reportableID int
+//   - reportableType string
+func (_e *MockReportStore_Expecter) SendToModeration(ctx interface{}, reportableID interface{}, reportableType interface{}) *MockReportStore_SendToModeration_Call {
+	return &MockReportStore_SendToModeration_Call{Call: _e.mock.On("SendToModeration", ctx, reportableID, reportableType)}
+}
+
+func (_c *MockReportStore_SendToModeration_Call) Run(run func(ctx context.Context, reportableID int, reportableType string)) *MockReportStore_SendToModeration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_SendToModeration_Call) Return(err error) *MockReportStore_SendToModeration_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockReportStore_GetReportsCount_Call) RunAndReturn(run func(context.Context, int, string) (int, error)) *MockReportStore_GetReportsCount_Call {
+func (_c *MockReportStore_SendToModeration_Call) RunAndReturn(run func(context.Context, int, string) error) *MockReportStore_SendToModeration_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockReviewStore is an autogenerated mock type for the ReviewStore type
+type MockReviewStore struct {
+	mock.Mock
+}
+
+type MockReviewStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReviewStore) EXPECT() *MockReviewStore_Expecter {
+	return &MockReviewStore_Expecter{mock: &_m.Mock}
+}
+
+// DeleteReview provides a mock function with given fields: ctx, reviewType, id
+func (_m *MockReviewStore) DeleteReview(ctx context.Context, reviewType core.ReviewType, id int) error {
+	ret := _m.Called(ctx, reviewType, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReview")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.ReviewType, int) error); ok {
+		r0 = rf(ctx, reviewType, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReviewStore_DeleteReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReview'
+type MockReviewStore_DeleteReview_Call struct {
+	*mock.Call
+}
+
+// DeleteReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reviewType core.ReviewType
+//   - id int
+func (_e *MockReviewStore_Expecter) DeleteReview(ctx interface{}, reviewType interface{}, id interface{}) *MockReviewStore_DeleteReview_Call {
+	return &MockReviewStore_DeleteReview_Call{Call: _e.mock.On("DeleteReview", ctx, reviewType, id)}
+}
+
+func (_c *MockReviewStore_DeleteReview_Call) Run(run func(ctx context.Context, reviewType core.ReviewType, id int)) *MockReviewStore_DeleteReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.ReviewType), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockReviewStore_DeleteReview_Call) Return(err error) *MockReviewStore_DeleteReview_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReviewStore_DeleteReview_Call) RunAndReturn(run func(context.Context, core.ReviewType, int) error) *MockReviewStore_DeleteReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReviewByID provides a mock function with given fields: ctx, reviewType, id
+func (_m *MockReviewStore) GetReviewByID(ctx context.Context, reviewType core.ReviewType, id int) (core.Review, error) {
+	ret := _m.Called(ctx, reviewType, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewByID")
+	}
+
+	var r0 core.Review
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.ReviewType, int) (core.Review, error)); ok {
+		return rf(ctx, reviewType, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.ReviewType, int) core.Review); ok {
+		r0 = rf(ctx, reviewType, id)
+	} else {
+		r0 = ret.Get(0).(core.Review)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.ReviewType, int) error); ok {
+		r1 = rf(ctx, reviewType, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReviewStore_GetReviewByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReviewByID'
+type MockReviewStore_GetReviewByID_Call struct {
+	*mock.Call
+}
+
+// GetReviewByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reviewType core.ReviewType
+//   - id int
+func (_e *MockReviewStore_Expecter) GetReviewByID(ctx interface{}, reviewType interface{}, id interface{}) *MockReviewStore_GetReviewByID_Call {
+	return &MockReviewStore_GetReviewByID_Call{Call: _e.mock.On("GetReviewByID", ctx, reviewType, id)}
+}
+
+func (_c *MockReviewStore_GetReviewByID_Call) Run(run func(ctx context.Context, reviewType core.ReviewType, id int)) *MockReviewStore_GetReviewByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.ReviewType), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockReviewStore_GetReviewByID_Call) Return(review core.Review, err error) *MockReviewStore_GetReviewByID_Call {
+	_c.Call.Return(review, err)
+	return _c
+}
+
+func (_c *MockReviewStore_GetReviewByID_Call) RunAndReturn(run func(context.Context, core.ReviewType, int) (core.Review, error)) *MockReviewStore_GetReviewByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReviewStore creates a new instance of MockReviewStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReviewStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReviewStore {
+	mock := &MockReviewStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,269 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package core
+
+import (
+	context "context"
+
+	core "github.com/kotopesp/sos-kotopes/internal/core"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRevisionStore is an autogenerated mock type for the RevisionStore type
+type MockRevisionStore struct {
+	mock.Mock
+}
+
+type MockRevisionStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRevisionStore) EXPECT() *MockRevisionStore_Expecter {
+	return &MockRevisionStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateCommentRevision provides a mock function with given fields: ctx, revision
+func (_m *MockRevisionStore) CreateCommentRevision(ctx context.Context, revision core.CommentRevision) (core.CommentRevision, error) {
+	ret := _m.Called(ctx, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCommentRevision")
+	}
+
+	var r0 core.CommentRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.CommentRevision) (core.CommentRevision, error)); ok {
+		return rf(ctx, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.CommentRevision) core.CommentRevision); ok {
+		r0 = rf(ctx, revision)
+	} else {
+		r0 = ret.Get(0).(core.CommentRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.CommentRevision) error); ok {
+		r1 = rf(ctx, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRevisionStore_CreateCommentRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCommentRevision'
+type MockRevisionStore_CreateCommentRevision_Call struct {
+	*mock.Call
+}
+
+// CreateCommentRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision core.CommentRevision
+func (_e *MockRevisionStore_Expecter) CreateCommentRevision(ctx interface{}, revision interface{}) *MockRevisionStore_CreateCommentRevision_Call {
+	return &MockRevisionStore_CreateCommentRevision_Call{Call: _e.mock.On("CreateCommentRevision", ctx, revision)}
+}
+
+func (_c *MockRevisionStore_CreateCommentRevision_Call) Run(run func(ctx context.Context, revision core.CommentRevision)) *MockRevisionStore_CreateCommentRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.CommentRevision))
+	})
+	return _c
+}
+
+func (_c *MockRevisionStore_CreateCommentRevision_Call) Return(_a0 core.CommentRevision, _a1 error) *MockRevisionStore_CreateCommentRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRevisionStore_CreateCommentRevision_Call) RunAndReturn(run func(context.Context, core.CommentRevision) (core.CommentRevision, error)) *MockRevisionStore_CreateCommentRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePostRevision provides a mock function with given fields: ctx, revision
+func (_m *MockRevisionStore) CreatePostRevision(ctx context.Context, revision core.PostRevision) (core.PostRevision, error) {
+	ret := _m.Called(ctx, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePostRevision")
+	}
+
+	var r0 core.PostRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.PostRevision) (core.PostRevision, error)); ok {
+		return rf(ctx, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.PostRevision) core.PostRevision); ok {
+		r0 = rf(ctx, revision)
+	} else {
+		r0 = ret.Get(0).(core.PostRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.PostRevision) error); ok {
+		r1 = rf(ctx, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRevisionStore_CreatePostRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePostRevision'
+type MockRevisionStore_CreatePostRevision_Call struct {
+	*mock.Call
+}
+
+// CreatePostRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision core.PostRevision
+func (_e *MockRevisionStore_Expecter) CreatePostRevision(ctx interface{}, revision interface{}) *MockRevisionStore_CreatePostRevision_Call {
+	return &MockRevisionStore_CreatePostRevision_Call{Call: _e.mock.On("CreatePostRevision", ctx, revision)}
+}
+
+func (_c *MockRevisionStore_CreatePostRevision_Call) Run(run func(ctx context.Context, revision core.PostRevision)) *MockRevisionStore_CreatePostRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.PostRevision))
+	})
+	return _c
+}
+
+func (_c *MockRevisionStore_CreatePostRevision_Call) Return(_a0 core.PostRevision, _a1 error) *MockRevisionStore_CreatePostRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRevisionStore_CreatePostRevision_Call) RunAndReturn(run func(context.Context, core.PostRevision) (core.PostRevision, error)) *MockRevisionStore_CreatePostRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCommentRevisions provides a mock function with given fields: ctx, commentID
+func (_m *MockRevisionStore) GetCommentRevisions(ctx context.Context, commentID int) ([]core.CommentRevision, error) {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentRevisions")
+	}
+
+	var r0 []core.CommentRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.CommentRevision, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []core.CommentRevision); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.CommentRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRevisionStore_GetCommentRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentRevisions'
+type MockRevisionStore_GetCommentRevisions_Call struct {
+	*mock.Call
+}
+
+// GetCommentRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int
+func (_e *MockRevisionStore_Expecter) GetCommentRevisions(ctx interface{}, commentID interface{}) *MockRevisionStore_GetCommentRevisions_Call {
+	return &MockRevisionStore_GetCommentRevisions_Call{Call: _e.mock.On("GetCommentRevisions", ctx, commentID)}
+}
+
+func (_c *MockRevisionStore_GetCommentRevisions_Call) Run(run func(ctx context.Context, commentID int)) *MockRevisionStore_GetCommentRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRevisionStore_GetCommentRevisions_Call) Return(revisions []core.CommentRevision, err error) *MockRevisionStore_GetCommentRevisions_Call {
+	_c.Call.Return(revisions, err)
+	return _c
+}
+
+func (_c *MockRevisionStore_GetCommentRevisions_Call) RunAndReturn(run func(context.Context, int) ([]core.CommentRevision, error)) *MockRevisionStore_GetCommentRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostRevisions provides a mock function with given fields: ctx, postID
+func (_m *MockRevisionStore) GetPostRevisions(ctx context.Context, postID int) ([]core.PostRevision, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostRevisions")
+	}
+
+	var r0 []core.PostRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]core.PostRevision, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []core.PostRevision); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.PostRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRevisionStore_GetPostRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostRevisions'
+type MockRevisionStore_GetPostRevisions_Call struct {
+	*mock.Call
+}
+
+// GetPostRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID int
+func (_e *MockRevisionStore_Expecter) GetPostRevisions(ctx interface{}, postID interface{}) *MockRevisionStore_GetPostRevisions_Call {
+	return &MockRevisionStore_GetPostRevisions_Call{Call: _e.mock.On("GetPostRevisions", ctx, postID)}
+}
+
+func (_c *MockRevisionStore_GetPostRevisions_Call) Run(run func(ctx context.Context, postID int)) *MockRevisionStore_GetPostRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRevisionStore_GetPostRevisions_Call) Return(revisions []core.PostRevision, err error) *MockRevisionStore_GetPostRevisions_Call {
+	_c.Call.Return(revisions, err)
+	return _c
+}
+
+func (_c *MockRevisionStore_GetPostRevisions_Call) RunAndReturn(run func(context.Context, int) ([]core.PostRevision, error)) *MockRevisionStore_GetPostRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRevisionStore creates a new instance of MockRevisionStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRevisionStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRevisionStore {
+	mock := &MockRevisionStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
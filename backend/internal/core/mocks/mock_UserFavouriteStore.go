@@ -118,34 +118,41 @@ func (_c *MockUserFavouriteStore_DeleteUserFromFavourite_Call) RunAndReturn(run
 	return _c
 }
 
-// GetFavouriteUsers provides a mock function with given fields: ctx, userID, params
-func (_m *MockUserFavouriteStore) GetFavouriteUsers(ctx context.Context, userID int, params core.GetFavourites) ([]core.User, error) {
-	ret := _m.Called(ctx, userID, params)
+// GetFavouriteUsers provides a mock function with given fields: ctx, params
+func (_m *MockUserFavouriteStore) GetFavouriteUsers(ctx context.Context, params core.GetFavouriteUsersParams) ([]core.FavouriteUser, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetFavouriteUsers")
 	}
 
-	var r0 []core.User
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetFavourites) ([]core.User, error)); ok {
-		return rf(ctx, userID, params)
+	var r0 []core.FavouriteUser
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetFavouriteUsersParams) ([]core.FavouriteUser, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetFavourites) []core.User); ok {
-		r0 = rf(ctx, userID, params)
+	if rf, ok := ret.Get(0).(func(context.Context, core.GetFavouriteUsersParams) []core.FavouriteUser); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]core.User)
+			r0 = ret.Get(0).([]core.FavouriteUser)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, core.GetFavourites) error); ok {
-		r1 = rf(ctx, userID, params)
+	if rf, ok := ret.Get(1).(func(context.Context, core.GetFavouriteUsersParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, core.GetFavouriteUsersParams) error); ok {
+		r2 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r2 = ret.Error(2)
 	}
 
-	return r0, r1
+	return r0, r1, r2
 }
 
 // MockUserFavouriteStore_GetFavouriteUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavouriteUsers'
@@ -155,25 +162,91 @@ type MockUserFavouriteStore_GetFavouriteUsers_Call struct {
 
 // GetFavouriteUsers is a helper method to define mock.On call
 //   - ctx context.Context
+//   - params core.GetFavouriteUsersParams
+func (_e *MockUserFavouriteStore_Expecter) GetFavouriteUsers(ctx interface{}, params interface{}) *MockUserFavouriteStore_GetFavouriteUsers_Call {
+	return &MockUserFavouriteStore_GetFavouriteUsers_Call{Call: _e.mock.On("GetFavouriteUsers", ctx, params)}
+}
+
+func (_c *MockUserFavouriteStore_GetFavouriteUsers_Call) Run(run func(ctx context.Context, params core.GetFavouriteUsersParams)) *MockUserFavouriteStore_GetFavouriteUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.GetFavouriteUsersParams))
+	})
+	return _c
+}
+
+func (_c *MockUserFavouriteStore_GetFavouriteUsers_Call) Return(favouriteUsers []core.FavouriteUser, total int, err error) *MockUserFavouriteStore_GetFavouriteUsers_Call {
+	_c.Call.Return(favouriteUsers, total, err)
+	return _c
+}
+
+func (_c *MockUserFavouriteStore_GetFavouriteUsers_Call) RunAndReturn(run func(context.Context, core.GetFavouriteUsersParams) ([]core.FavouriteUser, int, error)) *MockUserFavouriteStore_GetFavouriteUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavouriteUsersPosts provides a mock function with given fields: ctx, userID, params
+func (_m *MockUserFavouriteStore) GetFavouriteUsersPosts(ctx context.Context, userID int, params core.GetAllPostsParams) ([]core.Post, int, error) {
+	ret := _m.Called(ctx, userID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFavouriteUsersPosts")
+	}
+
+	var r0 []core.Post
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) ([]core.Post, int, error)); ok {
+		return rf(ctx, userID, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, core.GetAllPostsParams) []core.Post); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, core.GetAllPostsParams) int); ok {
+		r1 = rf(ctx, userID, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, core.GetAllPostsParams) error); ok {
+		r2 = rf(ctx, userID, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserFavouriteStore_GetFavouriteUsersPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavouriteUsersPosts'
+type MockUserFavouriteStore_GetFavouriteUsersPosts_Call struct {
+	*mock.Call
+}
+
+// GetFavouriteUsersPosts is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID int
-//   - params core.GetFavourites
-func (_e *MockUserFavouriteStore_Expecter) GetFavouriteUsers(ctx interface{}, userID interface{}, params interface{}) *MockUserFavouriteStore_GetFavouriteUsers_Call {
-	return &MockUserFavouriteStore_GetFavouriteUsers_Call{Call: _e.mock.On("GetFavouriteUsers", ctx, userID, params)}
+//   - params core.GetAllPostsParams
+func (_e *MockUserFavouriteStore_Expecter) GetFavouriteUsersPosts(ctx interface{}, userID interface{}, params interface{}) *MockUserFavouriteStore_GetFavouriteUsersPosts_Call {
+	return &MockUserFavouriteStore_GetFavouriteUsersPosts_Call{Call: _e.mock.On("GetFavouriteUsersPosts", ctx, userID, params)}
 }
 
-func (_c *MockUserFavouriteStore_GetFavouriteUsers_Call) Run(run func(ctx context.Context, userID int, params core.GetFavourites)) *MockUserFavouriteStore_GetFavouriteUsers_Call {
+func (_c *MockUserFavouriteStore_GetFavouriteUsersPosts_Call) Run(run func(ctx context.Context, userID int, params core.GetAllPostsParams)) *MockUserFavouriteStore_GetFavouriteUsersPosts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int), args[2].(core.GetFavourites))
+		run(args[0].(context.Context), args[1].(int), args[2].(core.GetAllPostsParams))
 	})
 	return _c
 }
 
-func (_c *MockUserFavouriteStore_GetFavouriteUsers_Call) Return(favouriteUsers []core.User, err error) *MockUserFavouriteStore_GetFavouriteUsers_Call {
-	_c.Call.Return(favouriteUsers, err)
+func (_c *MockUserFavouriteStore_GetFavouriteUsersPosts_Call) Return(posts []core.Post, total int, err error) *MockUserFavouriteStore_GetFavouriteUsersPosts_Call {
+	_c.Call.Return(posts, total, err)
 	return _c
 }
 
-func (_c *MockUserFavouriteStore_GetFavouriteUsers_Call) RunAndReturn(run func(context.Context, int, core.GetFavourites) ([]core.User, error)) *MockUserFavouriteStore_GetFavouriteUsers_Call {
+func (_c *MockUserFavouriteStore_GetFavouriteUsersPosts_Call) RunAndReturn(run func(context.Context, int, core.GetAllPostsParams) ([]core.Post, int, error)) *MockUserFavouriteStore_GetFavouriteUsersPosts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -11,28 +11,72 @@ type (
 		CreatedAt time.Time `gorm:"column:created_at"` // TimeStamp shows when this moderator was added
 	}
 
+	// ModerationDecision is a record of moderator action against user or their content.
+	ModerationDecision struct {
+		ID          int              `gorm:"column:id;primaryKey"`
+		UserID      int              `gorm:"column:user_id"`      // ID of the user affected by the decision
+		ModeratorID int              `gorm:"column:moderator_id"` // ID of the moderator who made the decision
+		TargetType  string           `gorm:"column:target_type"`  // Type of the moderated entity, one of reportable types
+		TargetID    int              `gorm:"column:target_id"`    // ID of the moderated entity
+		Action      ModerationAction `gorm:"column:action"`       // Action that was taken
+		Reason      ReportReason     `gorm:"column:reason"`       // Reason picked by the moderator
+		Comment     *string          `gorm:"column:comment"`      // Explanation of the moderator for the affected user
+		CreatedAt   time.Time        `gorm:"column:created_at"`   // Timestamp when the decision was made
+	}
+
+	// PostModeration shows author why their post is not published.
+	PostModeration struct {
+		Status       ContentStatus
+		FilterReason *string             // Reason of the automatic content filter
+		Decision     *ModerationDecision // Decision of the moderator, nil if post was not moderated
+	}
+
 	ModeratorStore interface {
 		GetModeratorByID(ctx context.Context, id int) (moderator Moderator, err error)
 		CreateModerator(ctx context.Context, moderator Moderator) (err error)
+		CreateDecision(ctx context.Context, decision ModerationDecision) (err error)
+		GetUserDecisions(ctx context.Context, userID int) (decisions []ModerationDecision, err error)
+		GetTargetDecision(ctx context.Context, targetType string, targetID int) (decision ModerationDecision, err error)
 	}
 	CommentForModeration struct {
-		Comment Comment
-		Reasons []string
+		Comment   Comment
+		Reasons   []string
+		Reports   []Report                // Reports with their details such as description
+		Revisions map[int]CommentRevision // Revisions which were live when the reports were filed by ID of the report
 	}
 
 	ModeratorService interface {
 		GetModerator(ctx context.Context, id int) (moderator Moderator, err error)
 		GetPostsForModeration(ctx context.Context, filter Filter) (posts []PostForModeration, err error)
-		DeletePost(ctx context.Context, ID int) (err error)
+		DeletePost(ctx context.Context, decision ModerationDecision) (err error)
 		ApprovePost(ctx context.Context, postID int) (err error)
-		DeleteComment(ctx context.Context, commentID int) error
+		DeleteComment(ctx context.Context, decision ModerationDecision) error
 		ApproveComment(ctx context.Context, commentID int) error
 		GetCommentsForModeration(ctx context.Context, filter Filter) ([]CommentForModeration, error)
 		BanUser(ctx context.Context, banRecord BannedUserRecord) error
+		GetReportedTargets(ctx context.Context, reportableType string, filter Filter) ([]ReportedTarget, error)
+		DismissReports(ctx context.Context, reportableID int, reportableType string) error
+		DeleteReportedTarget(ctx context.Context, decision ModerationDecision) error
+		GetUserModerationHistory(ctx context.Context, userID int) ([]ModerationDecision, error)
+		GetPostModeration(ctx context.Context, userID, postID int) (PostModeration, error)
+		SearchComments(ctx context.Context, params SearchCommentsParams) (comments []Comment, total int, err error)
 	}
+
+	// ModerationAction is an action moderator takes against user or their content.
+	ModerationAction string
+)
+
+const (
+	ActionDeleted ModerationAction = "deleted"
+	ActionBanned  ModerationAction = "banned"
 )
 
 // TableName table name in db for gorm
 func (Moderator) TableName() string {
 	return "moderators"
 }
+
+// TableName table name in db for gorm
+func (ModerationDecision) TableName() string {
+	return "moderation_decisions"
+}
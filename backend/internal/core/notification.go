@@ -0,0 +1,86 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Notification - event the user is told about, e.g. the post of the user is about to be archived.
+	// Clients render text of notifications by their type.
+	Notification struct {
+		ID         int        `gorm:"column:id;primaryKey"`
+		UserID     int        `gorm:"column:user_id"`     // Recipient of the notification
+		Type       string     `gorm:"column:type"`        // One of Notification* types
+		EntityType *string    `gorm:"column:entity_type"` // Type of the entity the notification is about, e.g. post
+		EntityID   *int       `gorm:"column:entity_id"`   // ID of the entity the notification is about
+		ReadAt     *time.Time `gorm:"column:read_at"`
+		CreatedAt  time.Time  `gorm:"column:created_at"`
+	}
+
+	// GetNotificationsParams - filters and pagination of notifications of the user.
+	GetNotificationsParams struct {
+		UserID int
+		Limit  *int
+		Offset *int
+		Unread bool // Only notifications which are not read yet
+	}
+
+	NotificationStore interface {
+		CreateNotifications(ctx context.Context, notifications []Notification) error
+		// GetNotifications retrieves notifications of the user from the newest one, total amount and amount of unread notifications
+		GetNotifications(ctx context.Context, params GetNotificationsParams) (notifications []Notification, total, unread int, err error)
+		// MarkNotificationsRead marks notifications of the user as read, all notifications are marked when ids are empty
+		MarkNotificationsRead(ctx context.Context, userID int, ids []int) error
+	}
+
+	NotificationService interface {
+		GetNotifications(ctx context.Context, params GetNotificationsParams) (notifications []Notification, total, unread int, err error)
+		MarkNotificationsRead(ctx context.Context, userID int, ids []int) error
+	}
+)
+
+// Types of notifications.
+const (
+	NotificationPostExpiring = "post_expiring" // The post will be archived soon unless the author renews it
+	NotificationPostArchived = "post_archived" // The post was archived, the author may renew it
+	// NotificationPostPublished, NotificationPostNotPublished - the scheduled draft was published or
+	// wasn't published because it was made incomplete after scheduling
+	NotificationPostPublished    = "post_published"
+	NotificationPostNotPublished = "post_not_published"
+	NotificationCommentMention   = "comment_mention" // The user was mentioned in the comment
+)
+
+// Types of entities notifications are about.
+const (
+	NotificationEntityPost    = "post"
+	NotificationEntityComment = "comment"
+)
+
+// PostNotification returns notification of the author about the post.
+func PostNotification(notificationType string, post Post) Notification {
+	entityType, entityID := NotificationEntityPost, post.ID
+
+	return Notification{
+		UserID:     post.AuthorID,
+		Type:       notificationType,
+		EntityType: &entityType,
+		EntityID:   &entityID,
+	}
+}
+
+// CommentNotification returns notification of the user about the comment.
+func CommentNotification(notificationType string, comment Comment, userID int) Notification {
+	entityType, entityID := NotificationEntityComment, comment.ID
+
+	return Notification{
+		UserID:     userID,
+		Type:       notificationType,
+		EntityType: &entityType,
+		EntityID:   &entityID,
+	}
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
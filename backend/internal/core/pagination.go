@@ -0,0 +1,71 @@
+package core
+
+import "time"
+
+type (
+	// PostSort - order of posts in listings.
+	PostSort string
+
+	// CommentSort - order of threads of comments, replies of a thread always go from the oldest.
+	CommentSort string
+
+	// Cursor - position of the last item of the previous page, the next page starts right after it.
+	// Unlike offset, cursor keeps pages stable when new items are added.
+	Cursor struct {
+		Sort     string     `json:"s"`           // Sort the cursor was issued for, cursor of other sort is invalid
+		Time     *time.Time `json:"t,omitempty"` // Bump, creation or update time of the last item
+		Number   *float64   `json:"n,omitempty"` // Amount of favourites or likes, or distance to the last post
+		ThreadID *int       `json:"r,omitempty"` // Top-level comment the last comment belongs to
+		ID       int        `json:"id"`          // ID of the last item, orders items with equal keys
+	}
+)
+
+const (
+	PostSortNewest     PostSort = "newest" // Recently bumped first, posts are bumped when they are created
+	PostSortOldest     PostSort = "oldest"
+	PostSortUpdated    PostSort = "updated"    // Recently updated first
+	PostSortFavourited PostSort = "favourited" // Most favourited first
+	PostSortNearest    PostSort = "nearest"    // Nearest to the given point first, posts without location are skipped
+	PostSortRelevance  PostSort = "relevance"  // Most relevant to the search query first, supports offset only
+
+	CommentSortOldest CommentSort = "oldest"
+	CommentSortNewest CommentSort = "newest"
+	CommentSortTop    CommentSort = "top" // Threads with the most liked top-level comment first
+)
+
+// Cursor returns cursor pointing at the post, Distance and FavouritesCount of the post are set by listings sorted by them.
+func (s PostSort) Cursor(post Post) Cursor {
+	cursor := Cursor{Sort: string(s), ID: post.ID}
+
+	switch s {
+	case PostSortNewest:
+		cursor.Time = &post.BumpedAt
+	case PostSortOldest:
+		cursor.Time = &post.CreatedAt
+	case PostSortUpdated:
+		cursor.Time = &post.UpdatedAt
+	case PostSortFavourited:
+		favourites := float64(post.FavouritesCount)
+		cursor.Number = &favourites
+	case PostSortNearest:
+		cursor.Number = post.Distance
+	}
+
+	return cursor
+}
+
+// Cursor returns cursor pointing at the comment.
+func (s CommentSort) Cursor(comment Comment) Cursor {
+	threadID := comment.ID
+	if comment.ParentID != nil {
+		threadID = *comment.ParentID
+	}
+
+	cursor := Cursor{Sort: string(s), ThreadID: &threadID, ID: comment.ID}
+	if s == CommentSortTop {
+		likes := float64(comment.ThreadLikes)
+		cursor.Number = &likes
+	}
+
+	return cursor
+}
@@ -2,22 +2,64 @@ package core
 
 import (
 	"context"
-	"mime/multipart"
+	"strings"
 	"time"
 )
 
 type (
 	Post struct {
-		ID        int           `gorm:"column:id;primaryKey"`            // Unique identifier for the post
-		AuthorID  int           `gorm:"column:author_id"`                // ID of the author of the post
-		AnimalID  int           `gorm:"column:animal_id"`                // ID of the associated animal
-		Title     string        `gorm:"column:title"`                    // Title of the post
-		Content   string        `gorm:"column:content"`                  // Content of the post
-		Photo     []byte        `gorm:"column:photo"`                    // Photo animal
-		Status    ContentStatus `gorm:"column:status;default:published"` // Status shows current status of post
-		CreatedAt time.Time     `gorm:"column:created_at"`               // Timestamp when the post was created
-		DeletedAt time.Time     `gorm:"column:deleted_at"`               // Timestamp when the post was deleted
-		UpdatedAt time.Time     `gorm:"column:updated_at"`               // Timestamp when the post was last updated
+		ID               int             `gorm:"column:id;primaryKey"`            // Unique identifier for the post
+		AuthorID         int             `gorm:"column:author_id"`                // ID of the author of the post
+		AnimalID         int             `gorm:"column:animal_id"`                // ID of the associated animal
+		Title            string          `gorm:"column:title"`                    // Title of the post
+		Content          string          `gorm:"column:content"`                  // Content of the post
+		Status           ContentStatus   `gorm:"column:status;default:published"` // Status shows current status of post
+		ModerationReason *string         `gorm:"column:moderation_reason"`        // Reason why post was sent to moderation by content filter
+		Latitude         *float64        `gorm:"column:latitude"`                 // Latitude of the place the animal was lost or found
+		Longitude        *float64        `gorm:"column:longitude"`                // Longitude of the place the animal was lost or found
+		CreatedAt        time.Time       `gorm:"column:created_at"`               // Timestamp when the post was created
+		DeletedAt        time.Time       `gorm:"column:deleted_at"`               // Timestamp when the post was deleted
+		UpdatedAt        time.Time       `gorm:"column:updated_at"`               // Timestamp when the post was last updated
+		Highlight        *string         `gorm:"->;column:highlight"`             // Fragment of the content matching search query, filled by search only
+		Distance         *float64        `gorm:"->;column:distance"`              // Distance to the point in kilometers, filled by listings sorted by PostSortNearest only
+		FavouritesCount  int             `gorm:"->;column:favourites_count"`      // Amount of users favourited the post, filled by listings sorted by PostSortFavourited only
+		State            PostState       `gorm:"column:state;default:active"`     // Stage of the case the post is about
+		Resolution       *PostResolution `gorm:"column:resolution"`               // How the case ended, set for resolved posts
+		SuccessStory     *string         `gorm:"column:success_story"`            // Story of the author about the end of the case
+		ResolvedAt       *time.Time      `gorm:"column:resolved_at"`              // Timestamp when the post was resolved
+		ArchivedAt       *time.Time      `gorm:"column:archived_at"`              // Timestamp when the post was archived
+		BumpedAt         time.Time       `gorm:"column:bumped_at"`                // Timestamp when the post was created, renewed or bumped, the post expires a lifetime after it
+		ExpiryRemindedAt *time.Time      `gorm:"column:expiry_reminded_at"`       // Timestamp when the author was reminded about expiry of the post
+		PublishAt        *time.Time      `gorm:"column:publish_at"`               // Timestamp when the draft is published, nil if the draft isn't scheduled
+	}
+
+	// PublishPost - the author publishes the draft now or schedules its publication.
+	PublishPost struct {
+		ID        int
+		AuthorID  int
+		PublishAt *time.Time // The draft is published now if nil or in the past
+	}
+
+	// ResolvePost - the author closes the case of the post.
+	ResolvePost struct {
+		ID           int
+		AuthorID     int
+		Resolution   PostResolution
+		SuccessStory *string
+	}
+
+	// PostStatistics - public statistics of cases.
+	PostStatistics struct {
+		ActivePosts       int // Published posts which are not resolved or archived
+		ResolvedPosts     int
+		Resolutions       map[PostResolution]int // Amount of resolved posts by resolution
+		ResolvedLastMonth int                    // Posts resolved within the last 30 days
+	}
+
+	PostServiceConfig struct {
+		Lifetime       time.Duration // Active post is archived when it isn't bumped or renewed for this time
+		ExpiryReminder time.Duration // The author is reminded this time before the post is archived
+		BumpInterval   time.Duration // Minimal time between bumps of the post
 	}
 
 	// PostDetails Post Details joins post, animal, username
@@ -25,43 +67,103 @@ type (
 		Post     Post
 		Animal   Animal
 		Username string
+		// AuthorContacts - all contacts of the author, responses show them according to ContactsAccess of the viewer
+		AuthorContacts Contacts
+		Photos         []Media // Photos of the post ordered by position
+		// DuplicatePostIDs - published posts with the same photos, filled when photos of the post are uploaded
+		DuplicatePostIDs []int
+		Likes            Likes
+	}
+
+	// SimilarPost - post found by photo.
+	SimilarPost struct {
+		PostDetails PostDetails
+		Distance    int // Hamming distance between hashes of the closest photo of the post and the searched one
+	}
+
+	// SearchPostsByPhotoParams - parameters of search of posts with similar photos.
+	SearchPostsByPhotoParams struct {
+		MaxDistance *int // Maximal distance between hashes of photos, DefaultSimilarPhotoDistance if nil
+		Limit       *int // Maximal amount of posts
+		ViewerID    int  // Likes of the posts are counted for the viewer, 0 for anonymous user
 	}
 
 	// UpdateRequestBodyPost represents the request body for updating a post.
 	UpdateRequestBodyPost struct {
-		ID          *int
-		AuthorID    *int
-		Title       *string
-		Content     *string
-		Photo       *[]byte
-		AnimalType  *string
-		Age         *int
-		Color       *string
-		Gender      *string
-		Description *string
-		Status      *string
+		ID                  *int
+		AuthorID            *int
+		Title               *string
+		Content             *string
+		Photos              []MediaUpload // New photos of the post, nil keeps current photos
+		AnimalType          *string
+		Age                 *int
+		Color               *string
+		Gender              *string
+		Description         *string
+		Status              *string
+		Latitude            *float64
+		Longitude           *float64
+		Name                *string
+		Breed               *string
+		Size                *string
+		Colors              []string
+		CoatPattern         *string
+		DistinguishingMarks *string
+		MicrochipNumber     *string
+		TattooID            *string
+		IsSterilized        *bool
+		IsVaccinated        *bool
+		HasCollar           *bool
+		CollarDescription   *string
 	}
 
 	// PostForModeration structure that holds post and list of reasons why this post was reported.
 	PostForModeration struct {
-		Post    Post
-		Reasons []string
+		Post      Post
+		Reasons   []string
+		Reports   []Report             // Reports with their details such as description
+		Revisions map[int]PostRevision // Revisions which were live when the reports were filed by ID of the report
+	}
+
+	// GetPostRevisionsParams - who asks for revisions of the post, edit history is shown to the author and moderators only
+	GetPostRevisionsParams struct {
+		PostID      int
+		UserID      int
+		IsModerator bool
 	}
 
 	// GetAllPostsParams are needed for processing posts in the database
 	GetAllPostsParams struct {
-		Limit      *int    // Limit on the number of posts to retrieve
-		Offset     *int    // Offset for pagination
-		Status     *string // Filter by status of the associated animal
-		AnimalType *string // Filter by type of the associated animal
-		Gender     *string // Filter by gender of the associated animal
-		Color      *string // Filter by color of the associated animal
-		Location   *string // Filter by location of the associated animal
+		Limit       *int            // Limit on the number of posts to retrieve
+		Offset      *int            // Offset for pagination, ignored when Cursor is set
+		Cursor      *Cursor         // Last post of the previous page
+		Sort        PostSort        // Order of posts, PostSortNewest or PostSortRelevance for search by default
+		Latitude    *float64        // Point to sort posts by distance to, required by PostSortNearest
+		Longitude   *float64        // Point to sort posts by distance to, required by PostSortNearest
+		AnimalID    *int            // Posts about the animal
+		Status      *string         // Filter by status of the associated animal
+		AnimalType  *string         // Filter by type of the associated animal
+		Gender      *string         // Filter by gender of the associated animal
+		Color       *string         // Filter by color of the associated animal
+		Location    *string         // Filter by location of the associated animal
+		Breed       *string         // Filter by breed of the associated animal, part of the breed matches
+		Size        *string         // Filter by size of the associated animal
+		Colors      []string        // Filter by colors of the associated animal, animals having any of the colors match
+		CoatPattern *string         // Filter by coat pattern of the associated animal
+		Sterilized  *bool           // Filter by sterilization of the associated animal
+		Identifier  *string         // Microchip number or tattoo of the associated animal, matches exactly after normalization
+		Query       *string         // Full-text search over title, content and description of the animal, results are ranked by relevance
+		State       *PostState      // Filter by state of the post, GetAllPosts lists active posts by default
+		Resolution  *PostResolution // Filter by resolution of the post
+		// ViewerID - current user, PostService.GetAllPosts hides posts of users blocked or muted by them
+		// and likes of posts are counted for them, 0 for anonymous user
+		ViewerID         int
+		ExcludeAuthorIDs []int // Posts of the authors are left out
 	}
 
 	PostStore interface {
 		GetAllPosts(ctx context.Context, params GetAllPostsParams) ([]Post, int, error)
-		GetUserPosts(ctx context.Context, id int) (posts []Post, count int, err error)
+		GetUserPosts(ctx context.Context, id int, params GetAllPostsParams) (posts []Post, count int, err error)
 		GetPostByID(ctx context.Context, id int) (Post, error)
 		CreatePost(ctx context.Context, post Post) (Post, error)
 		UpdatePost(ctx context.Context, post Post) (Post, error)
@@ -69,20 +171,114 @@ type (
 		SendToModeration(ctx context.Context, postID int) (err error)
 		ApprovePostFromModeration(ctx context.Context, postID int) (err error)
 		GetPostsForModeration(ctx context.Context, filter Filter) (posts []Post, err error)
+		CountUserPostsSince(ctx context.Context, authorID int, since time.Time) (count int, err error)
+		GetPostByIDAnyStatus(ctx context.Context, id int) (post Post, err error)
+		GetPostsByIDs(ctx context.Context, ids []int) (posts []Post, err error)
+		// BumpPost moves the active post to the top of the newest posts if it wasn't bumped after bumpedBefore.
+		BumpPost(ctx context.Context, id int, bumpedBefore time.Time) (post Post, err error)
+		// RemindExpiringPosts marks active posts bumped before bumpedBefore as reminded and returns them,
+		// posts are reminded once after every bump.
+		RemindExpiringPosts(ctx context.Context, bumpedBefore time.Time) (posts []Post, err error)
+		// ArchiveExpiredPosts archives active posts bumped before bumpedBefore whose authors were reminded before remindedBefore.
+		ArchiveExpiredPosts(ctx context.Context, bumpedBefore, remindedBefore time.Time) (posts []Post, err error)
+		GetPostStatistics(ctx context.Context, resolvedSince time.Time) (statistics PostStatistics, err error)
+		GetDrafts(ctx context.Context, authorID int, params GetAllPostsParams) (posts []Post, total int, err error)
+		// SchedulePost sets time the draft is published at, nil cancels the schedule.
+		SchedulePost(ctx context.Context, id int, publishAt *time.Time) (post Post, err error)
+		// PublishPost changes status of the draft to the status of the post and moves it to the top of the newest posts,
+		// ErrPostNotDraft is returned if the post was published meanwhile.
+		PublishPost(ctx context.Context, post Post) (published Post, err error)
+		// GetScheduledPosts returns drafts scheduled to be published before the moment.
+		GetScheduledPosts(ctx context.Context, before time.Time) (posts []Post, err error)
 	}
 
 	PostService interface {
 		GetAllPosts(ctx context.Context, params GetAllPostsParams) ([]PostDetails, int, error)
-		GetUserPosts(ctx context.Context, id int) (posts []PostDetails, count int, err error)
-		GetPostByID(ctx context.Context, id int) (PostDetails, error)
-		CreatePost(ctx context.Context, postDetails PostDetails, fileHeader *multipart.FileHeader) (PostDetails, error)
+		GetUserPosts(ctx context.Context, id int, params GetAllPostsParams) (posts []PostDetails, count int, err error)
+		// GetPostByID returns the post with likes counted for the viewer, 0 for anonymous user.
+		GetPostByID(ctx context.Context, id, viewerID int) (PostDetails, error)
+		CreatePost(ctx context.Context, postDetails PostDetails, photos []MediaUpload) (PostDetails, error)
 		UpdatePost(ctx context.Context, postUpdateRequest UpdateRequestBodyPost) (PostDetails, error)
 		DeletePost(ctx context.Context, post Post) error
-		BuildPostDetailsList(ctx context.Context, posts []Post, total int) ([]PostDetails, error)
+		// BuildPostDetailsList fetches details of the posts, likes are counted for the viewer, 0 for anonymous user.
+		BuildPostDetailsList(ctx context.Context, posts []Post, total, viewerID int) ([]PostDetails, error)
+		SearchPostsByPhoto(ctx context.Context, photo MediaUpload, params SearchPostsByPhotoParams) ([]SimilarPost, error)
+		ResolvePost(ctx context.Context, resolve ResolvePost) (PostDetails, error)
+		RenewPost(ctx context.Context, post Post) (PostDetails, error)
+		BumpPost(ctx context.Context, post Post) (PostDetails, error)
+		// ExpirePosts reminds authors about posts which are about to be archived and archives expired posts, run by the worker.
+		ExpirePosts(ctx context.Context) error
+		GetPostStatistics(ctx context.Context) (PostStatistics, error)
+		// GetPostRevisions returns revisions of the post from the first one, ErrPostNotFound for drafts,
+		// ErrPostAuthorIDMismatch if the user is neither the author nor a moderator.
+		GetPostRevisions(ctx context.Context, params GetPostRevisionsParams) ([]PostRevision, error)
+		GetDrafts(ctx context.Context, authorID int, params GetAllPostsParams) (posts []PostDetails, total int, err error)
+		PublishPost(ctx context.Context, publish PublishPost) (PostDetails, error)
+		// PublishScheduledPosts publishes drafts whose time has come, run by the worker.
+		PublishScheduledPosts(ctx context.Context) error
+		LikePost(ctx context.Context, postID, userID int) (Likes, error)
+		UnlikePost(ctx context.Context, postID, userID int) (Likes, error)
 		PostFavouriteService
 	}
 )
 
+// PostState - stage of the case the post is about.
+type PostState string
+
+const (
+	PostActive   PostState = "active"
+	PostResolved PostState = "resolved" // The case ended, e.g. the lost animal returned home
+	PostArchived PostState = "archived" // The post expired, the author may renew it
+)
+
+// PostResolution - how the case of the post ended.
+type PostResolution string
+
+const (
+	PostReunited PostResolution = "reunited" // The animal returned to its owner
+	PostAdopted  PostResolution = "adopted"  // The animal found a new home
+)
+
+// postResolutions - resolutions allowed for posts by status of their animals.
+var postResolutions = map[string][]PostResolution{
+	"lost":               {PostReunited},
+	"found":              {PostReunited, PostAdopted},
+	AnimalStatusNeedHome: {PostAdopted},
+	AnimalStatusAdopted:  {PostAdopted},
+}
+
+// CanResolve reports whether the post about the animal with the status may end with the resolution.
+func (r PostResolution) CanResolve(animalStatus string) bool {
+	for _, allowed := range postResolutions[animalStatus] {
+		if r == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// MissingForPublication returns fields the draft needs to be published, the same fields are required to create a post at once.
+func (d PostDetails) MissingForPublication() []string {
+	var missing []string
+	for _, field := range []struct {
+		name   string
+		filled bool
+	}{
+		{"title", d.Post.Title != ""},
+		{"content", d.Post.Content != ""},
+		{"photos", len(d.Photos) > 0},
+		{"animal_type", d.Animal.AnimalType != ""},
+		{"color", d.Animal.Color != ""},
+		{"gender", d.Animal.Gender != ""},
+		{"status", d.Animal.Status != ""},
+	} {
+		if !field.filled {
+			missing = append(missing, field.name)
+		}
+	}
+	return missing
+}
+
 // AmountOfPostsForModeration defines the maximum number of posts that can be fetched for moderation at once.
 const AmountOfPostsForModeration = 10
 
@@ -94,6 +290,23 @@ const (
 	FilterASC  Filter = "ASC"
 )
 
+// HasQuery reports whether posts are searched by a non-blank query.
+func (p GetAllPostsParams) HasQuery() bool {
+	return p.Query != nil && strings.TrimSpace(*p.Query) != ""
+}
+
+// SortOrDefault returns sort of the params, posts found by query are sorted by relevance by default and newest go first otherwise.
+func (p GetAllPostsParams) SortOrDefault() PostSort {
+	switch {
+	case p.Sort == "" && p.HasQuery():
+		return PostSortRelevance
+	case p.Sort == "" || p.Sort == PostSortRelevance && !p.HasQuery():
+		return PostSortNewest
+	default:
+		return p.Sort
+	}
+}
+
 func (Post) TableName() string {
 	return "posts"
 }
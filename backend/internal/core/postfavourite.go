@@ -14,13 +14,13 @@ type (
 	}
 
 	PostFavouriteStore interface {
-		GetFavouritePosts(ctx context.Context, userID, limit, offset int) ([]Post, int, error)
+		GetFavouritePosts(ctx context.Context, userID int, params GetAllPostsParams) ([]Post, int, error)
 		AddToFavourites(ctx context.Context, postFavourite PostFavourite) error
 		DeleteFromFavourites(ctx context.Context, postID, userID int) error
 	}
 
 	PostFavouriteService interface {
-		GetFavouritePosts(ctx context.Context, userID int) ([]PostDetails, int, error)
+		GetFavouritePosts(ctx context.Context, userID int, params GetAllPostsParams) ([]PostDetails, int, error)
 		AddToFavourites(ctx context.Context, postFavourite PostFavourite) error
 		DeleteFromFavourites(ctx context.Context, postID, userID int) error
 	}
@@ -0,0 +1,156 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Visibility - who sees the part of the profile, the owner of the profile always sees it.
+	Visibility string
+
+	// PrivacySettings - what parts of the profile the user shows to others.
+	PrivacySettings struct {
+		UserID     int        `gorm:"column:user_id;primaryKey"`
+		Statistics Visibility `gorm:"column:statistics"` // Counts of posts, resolved cases, comments and ratings
+		LastSeen   Visibility `gorm:"column:last_seen"`
+		Comments   Visibility `gorm:"column:comments"` // Comment history of the user
+		Phone      Visibility `gorm:"column:phone"`
+		Telegram   Visibility `gorm:"column:telegram"`
+		VKLink     Visibility `gorm:"column:vk_link"`
+		UpdatedAt  time.Time  `gorm:"column:updated_at"`
+	}
+
+	// ContactsAccess - what the viewer knows about owners of contacts to decide which contacts the viewer sees,
+	// the viewer sees all own contacts and none of contacts of users missing from Settings.
+	ContactsAccess struct {
+		ViewerID     int                     // 0 for anonymous users
+		Settings     map[int]PrivacySettings // Privacy settings of owners of contacts by their IDs
+		ChatPartners map[int]bool            // Owners of contacts having a chat with the viewer
+	}
+
+	// Rating - average grade of reviews about the user as keeper or vet.
+	Rating struct {
+		Average float64 `gorm:"column:average"`
+		Count   int     `gorm:"column:count"`
+	}
+
+	// UserStatistics - activity of the user, only published content is counted.
+	UserStatistics struct {
+		Posts         map[PostState]int // Published posts by state
+		ResolvedCases int               // Animals registered or kept by the user which were adopted or returned to the owner
+		Comments      int
+		KeeperRating  *Rating // Nil if nobody reviewed the user as keeper
+		VetRating     *Rating // Nil if nobody reviewed the user as vet
+	}
+
+	// Badge - what the user is verified as.
+	Badge string
+
+	// UserProfile - public profile of the user as the viewer sees it.
+	UserProfile struct {
+		User       User
+		Roles      []RoleDetails
+		Badges     []Badge
+		Statistics *UserStatistics // Nil if hidden from the viewer
+		LastSeenAt *time.Time      // Nil if hidden from the viewer or unknown
+	}
+
+	ProfileStore interface {
+		GetUserStatistics(ctx context.Context, userID int) (statistics UserStatistics, err error)
+		GetUserBadges(ctx context.Context, userID int) (badges []Badge, err error)
+		// GetPrivacySettings returns DefaultPrivacySettings of the user who didn't save them.
+		GetPrivacySettings(ctx context.Context, userID int) (settings PrivacySettings, err error)
+		SavePrivacySettings(ctx context.Context, settings PrivacySettings) (saved PrivacySettings, err error)
+		// GetUsersPrivacySettings returns privacy settings by IDs of the users, DefaultPrivacySettings for users who didn't save them.
+		GetUsersPrivacySettings(ctx context.Context, userIDs []int) (settings map[int]PrivacySettings, err error)
+		// GetChatPartnerIDs returns which of userIDs have a chat with the user.
+		GetChatPartnerIDs(ctx context.Context, userID int, userIDs []int) (partnerIDs []int, err error)
+	}
+
+	ProfileService interface {
+		// GetProfile returns the profile of the user with parts hidden from the viewer left out, viewerID is 0 for anonymous users.
+		GetProfile(ctx context.Context, userID, viewerID int) (profile UserProfile, err error)
+		// GetUserComments returns ErrUserCommentsHidden if the user hides comments from the viewer.
+		GetUserComments(ctx context.Context, params GetUserCommentsParams) (comments []Comment, total int, err error)
+		GetPrivacySettings(ctx context.Context, userID int) (settings PrivacySettings, err error)
+		// UpdatePrivacySettings replaces privacy settings of the user.
+		UpdatePrivacySettings(ctx context.Context, settings PrivacySettings) (updated PrivacySettings, err error)
+		// GetContactsAccess returns what the viewer needs to see contacts of the owners, viewerID is 0 for anonymous users.
+		GetContactsAccess(ctx context.Context, viewerID int, ownerIDs []int) (access ContactsAccess, err error)
+	}
+)
+
+const (
+	VisibilityPublic Visibility = "public" // Everyone including anonymous users
+	VisibilityUsers  Visibility = "users"  // Logged-in users
+	VisibilityChats  Visibility = "chats"  // Users having a chat with the owner, used by contacts only
+	VisibilityNobody Visibility = "nobody" // The owner only
+)
+
+const (
+	BadgeModerator  Badge = "moderator"
+	BadgeVKVerified Badge = "vk_verified" // The user signed up with VK account
+)
+
+// DefaultPrivacySettings - privacy settings of the user who never changed them.
+func DefaultPrivacySettings(userID int) PrivacySettings {
+	return PrivacySettings{
+		UserID:     userID,
+		Statistics: VisibilityPublic,
+		LastSeen:   VisibilityUsers,
+		Comments:   VisibilityPublic,
+		Phone:      VisibilityNobody,
+		Telegram:   VisibilityNobody,
+		VKLink:     VisibilityNobody,
+	}
+}
+
+// VisibleTo reports whether the viewer sees the part of the profile of the owner, viewerID is 0 for anonymous users.
+func (v Visibility) VisibleTo(ownerID, viewerID int) bool {
+	switch {
+	case viewerID != 0 && viewerID == ownerID:
+		return true
+	case v == VisibilityPublic:
+		return true
+	case v == VisibilityUsers:
+		return viewerID != 0
+	default:
+		return false
+	}
+}
+
+// VisibleContacts returns contacts of the owner the viewer sees, hidden contacts are nil.
+func (a ContactsAccess) VisibleContacts(ownerID int, contacts Contacts) Contacts {
+	if a.ViewerID != 0 && a.ViewerID == ownerID {
+		return contacts
+	}
+
+	settings, ok := a.Settings[ownerID]
+	if !ok {
+		return Contacts{}
+	}
+
+	var visible Contacts
+	if a.visible(settings.Phone, ownerID) {
+		visible.Phone = contacts.Phone
+	}
+	if a.visible(settings.Telegram, ownerID) {
+		visible.Telegram = contacts.Telegram
+	}
+	if a.visible(settings.VKLink, ownerID) {
+		visible.VKLink = contacts.VKLink
+	}
+	return visible
+}
+
+func (a ContactsAccess) visible(visibility Visibility, ownerID int) bool {
+	if visibility == VisibilityChats {
+		return a.ChatPartners[ownerID]
+	}
+	return visibility.VisibleTo(ownerID, a.ViewerID)
+}
+
+func (PrivacySettings) TableName() string {
+	return "privacy_settings"
+}
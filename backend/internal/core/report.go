@@ -11,22 +11,68 @@ type (
 		ID             int          `gorm:"column:id;primaryKey"`
 		UserID         int          `gorm:"column:user_id"`
 		Reason         ReportReason `gorm:"column:reason"`
+		Description    *string      `gorm:"column:description"`      // Free-text details of the report, mostly used with "other" reason
+		Weight         float64      `gorm:"column:weight;default:1"` // Weight of the report, depends on reporter trust
+		Resolved       bool         `gorm:"column:resolved"`         // Resolved shows whether moderator has already made a decision on the report
 		CreatedAt      time.Time    `gorm:"column:created_at"`
 		ReportableID   int          `gorm:"column:reportable_id"`
 		ReportableType string       `gorm:"column:reportable_type"`
 	}
 
+	// ReporterReputation holds history of moderation decisions on reports of the user.
+	ReporterReputation struct {
+		UserID          int       `gorm:"column:user_id;primaryKey"`
+		UpheldReports   int       `gorm:"column:upheld_reports"`   // Amount of reports that led to content removal
+		RejectedReports int       `gorm:"column:rejected_reports"` // Amount of reports on content that was approved
+		UpdatedAt       time.Time `gorm:"column:updated_at"`
+	}
+
+	// ModerationTarget is a reported entity without its own moderation status (user, review or message) waiting for moderator decision.
+	ModerationTarget struct {
+		ReportableID   int       `gorm:"column:reportable_id;primaryKey"`
+		ReportableType string    `gorm:"column:reportable_type;primaryKey"`
+		CreatedAt      time.Time `gorm:"column:created_at"`
+	}
+
+	// ReportedTarget holds reported entity with a short summary of its content and reports made on it.
+	ReportedTarget struct {
+		ReportableID   int
+		ReportableType string
+		AuthorID       int     // ID of the user who is responsible for the reported content
+		Content        *string // Content of the reported entity, nil for users
+		Reports        []Report
+	}
+
 	ReportStore interface {
 		CreateReport(ctx context.Context, report Report) (err error)
-		GetReportsCount(ctx context.Context, reportableID int, reportableType string) (int, error)
+		GetReportWeights(ctx context.Context, reportableID int, reportableType string) (weights map[ReportReason]float64, err error)
 		GetReportReasons(ctx context.Context, reportableID int, reportableType string) (reasons []string, err error)
 		DeleteAllReports(ctx context.Context, reportableID int, reportableType string) (err error)
+		GetReporterReputation(ctx context.Context, userID int) (reputation ReporterReputation, err error)
+		ResolveReports(ctx context.Context, reportableID int, reportableType string, upheld bool) (err error)
+		GetReports(ctx context.Context, reportableID int, reportableType string) (reports []Report, err error)
+		SendToModeration(ctx context.Context, reportableID int, reportableType string) (err error)
+		IsOnModeration(ctx context.Context, reportableID int, reportableType string) (onModeration bool, err error)
+		GetTargetsForModeration(ctx context.Context, reportableType string, filter Filter) (targets []ModerationTarget, err error)
+		RemoveFromModeration(ctx context.Context, reportableID int, reportableType string) (err error)
 	}
 
 	ReportService interface {
 		CreateReport(ctx context.Context, report Report) (err error)
 	}
 
+	// ReportServiceConfig defines how reports are weighted and when reported content is moved to moderation.
+	ReportServiceConfig struct {
+		DefaultThreshold   float64                             // Threshold used when there is no specific one for type and reason
+		Thresholds         map[string]map[ReportReason]float64 // Thresholds by reportable type and report reason
+		NewAccountAge      time.Duration                       // Accounts younger than this are treated as new
+		NewAccountWeight   float64                             // Multiplier for reports made from new accounts
+		MinReportWeight    float64                             // Lower bound of a single report weight
+		MaxReportWeight    float64                             // Upper bound of a single report weight
+		TrustedMinUpheld   int                                 // Amount of upheld reports needed to become a trusted reporter
+		TrustedMinAccuracy float64                             // Share of upheld reports needed to become a trusted reporter
+	}
+
 	// ReportReason is custom type that represents values that can be used for report reasons.
 	ReportReason string
 )
@@ -35,6 +81,7 @@ const (
 	Spam           ReportReason = "spam"
 	ViolentContent ReportReason = "violent_content"
 	ViolentSpeech  ReportReason = "violent_speech"
+	Other          ReportReason = "other"
 )
 
 // Status is a custom type that represents the current state of a post.
@@ -44,14 +91,48 @@ const (
 	Published    ContentStatus = "published"
 	Deleted      ContentStatus = "deleted"
 	OnModeration ContentStatus = "on_moderation"
+	Draft        ContentStatus = "draft" // Post saved by the author and not published yet, only the author sees it
 )
 
 const (
-	ReportableTypePost    = "post"
-	ReportableTypeComment = "comment"
+	ReportableTypePost         = "post"
+	ReportableTypeComment      = "comment"
+	ReportableTypeUser         = "user"
+	ReportableTypeVetReview    = "vet_review"
+	ReportableTypeKeeperReview = "keeper_review"
+	ReportableTypeMessage      = "message"
 )
 
-// ReportAmountThreshold defines the maximum number of reports a post can receive before it is moved to moderation.
+// ReviewTypeOf returns type of the review for reportable type, second value is false if reportable type is not a review.
+func ReviewTypeOf(reportableType string) (ReviewType, bool) {
+	switch reportableType {
+	case ReportableTypeVetReview:
+		return VetReview, true
+	case ReportableTypeKeeperReview:
+		return KeeperReview, true
+	default:
+		return "", false
+	}
+}
+
+// ReportAmountThreshold defines the default weighted amount of reports content can receive before it is moved to moderation.
 const ReportAmountThreshold = 15
 
+// Threshold returns weighted amount of reports with the given reason that moves content of the given type to moderation.
+func (c ReportServiceConfig) Threshold(reportableType string, reason ReportReason) float64 {
+	if threshold, ok := c.Thresholds[reportableType][reason]; ok && threshold > 0 {
+		return threshold
+	}
+
+	if c.DefaultThreshold > 0 {
+		return c.DefaultThreshold
+	}
+
+	return ReportAmountThreshold
+}
+
 func (Report) TableName() string { return "reports" }
+
+func (ReporterReputation) TableName() string { return "reporter_reputation" }
+
+func (ModerationTarget) TableName() string { return "moderation_queue" }
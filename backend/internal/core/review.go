@@ -0,0 +1,38 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Review is a review left by user about vet or keeper.
+	Review struct {
+		ID        int        `gorm:"column:id;primaryKey"`
+		AuthorID  int        `gorm:"column:author_id"`  // ID of the author of the review
+		Content   *string    `gorm:"column:content"`    // Text of the review
+		Grade     int        `gorm:"column:grade"`      // Grade given by the author
+		IsDeleted bool       `gorm:"column:is_deleted"` // IsDeleted shows whether review was deleted
+		DeletedAt *time.Time `gorm:"column:deleted_at"` // Timestamp when the review was deleted
+		CreatedAt time.Time  `gorm:"column:created_at"` // Timestamp when the review was created
+		UpdatedAt time.Time  `gorm:"column:updated_at"` // Timestamp when the review was last updated
+	}
+
+	ReviewStore interface {
+		GetReviewByID(ctx context.Context, reviewType ReviewType, id int) (review Review, err error)
+		DeleteReview(ctx context.Context, reviewType ReviewType, id int) (err error)
+	}
+
+	// ReviewType shows whom review is about.
+	ReviewType string
+)
+
+const (
+	VetReview    ReviewType = "vet"
+	KeeperReview ReviewType = "keeper"
+)
+
+// TableName returns table name in db for reviews of the type.
+func (t ReviewType) TableName() string {
+	return string(t) + "_reviews"
+}
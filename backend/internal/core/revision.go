@@ -0,0 +1,165 @@
+package core
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type (
+	// PostRevision - immutable copy of the post saved on creation and on every update.
+	PostRevision struct {
+		ID        int                `gorm:"column:id;primaryKey"`
+		PostID    int                `gorm:"column:post_id"`
+		Revision  int                `gorm:"column:revision"`  // Number of the revision starting from 1, assigned by RevisionStore
+		EditorID  int                `gorm:"column:editor_id"` // User who saved the revision
+		Title     string             `gorm:"column:title"`
+		Content   string             `gorm:"column:content"`
+		PhotoIDs  pq.Int64Array      `gorm:"column:photo_ids;type:integer[]"` // Photos ordered by position, files of replaced photos are removed
+		Animal    PostRevisionAnimal `gorm:"column:animal;type:jsonb"`
+		CreatedAt time.Time          `gorm:"column:created_at"`
+	}
+
+	// PostRevisionAnimal - public details of the animal of the post. Microchip and tattoo numbers are never copied,
+	// so revisions don't leak identifiers removed by the author.
+	PostRevisionAnimal struct {
+		Name                string   `json:"name"`
+		AnimalType          string   `json:"animal_type"`
+		Breed               string   `json:"breed"`
+		Size                *string  `json:"size"`
+		Age                 int      `json:"age"`
+		Color               string   `json:"color"`
+		Colors              []string `json:"colors"`
+		CoatPattern         *string  `json:"coat_pattern"`
+		Gender              string   `json:"gender"`
+		Description         string   `json:"description"`
+		DistinguishingMarks string   `json:"distinguishing_marks"`
+		Status              string   `json:"status"`
+	}
+
+	// CommentRevision - immutable copy of the comment saved on creation and on every update.
+	CommentRevision struct {
+		ID        int       `gorm:"column:id;primaryKey"`
+		CommentID int       `gorm:"column:comment_id"`
+		Revision  int       `gorm:"column:revision"` // Number of the revision starting from 1, assigned by RevisionStore
+		Content   string    `gorm:"column:content"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+	}
+
+	RevisionStore interface {
+		CreatePostRevision(ctx context.Context, revision PostRevision) (PostRevision, error)
+		// GetPostRevisions returns revisions of the post from the first one.
+		GetPostRevisions(ctx context.Context, postID int) (revisions []PostRevision, err error)
+		CreateCommentRevision(ctx context.Context, revision CommentRevision) (CommentRevision, error)
+		// GetCommentRevisions returns revisions of the comment from the first one.
+		GetCommentRevisions(ctx context.Context, commentID int) (revisions []CommentRevision, err error)
+	}
+)
+
+// Fields of the post compared by PostRevision.Changes.
+const (
+	RevisionFieldTitle   = "title"
+	RevisionFieldContent = "content"
+	RevisionFieldPhotos  = "photos"
+	RevisionFieldAnimal  = "animal"
+)
+
+// NewPostRevision copies the post, its animal and photos to a revision saved by the editor.
+func NewPostRevision(details PostDetails, editorID int) PostRevision {
+	photoIDs := make(pq.Int64Array, len(details.Photos))
+	for i, photo := range details.Photos {
+		photoIDs[i] = int64(photo.ID)
+	}
+
+	animal := details.Animal
+
+	return PostRevision{
+		PostID:   details.Post.ID,
+		EditorID: editorID,
+		Title:    details.Post.Title,
+		Content:  details.Post.Content,
+		PhotoIDs: photoIDs,
+		Animal: PostRevisionAnimal{
+			Name:                animal.Name,
+			AnimalType:          animal.AnimalType,
+			Breed:               animal.Breed,
+			Size:                animal.Size,
+			Age:                 animal.Age,
+			Color:               animal.Color,
+			Colors:              animal.Colors,
+			CoatPattern:         animal.CoatPattern,
+			Gender:              animal.Gender,
+			Description:         animal.Description,
+			DistinguishingMarks: animal.DistinguishingMarks,
+			Status:              animal.Status,
+		},
+	}
+}
+
+// Changes returns fields which differ from the previous revision.
+func (r PostRevision) Changes(previous PostRevision) []string {
+	var changes []string
+	if r.Title != previous.Title {
+		changes = append(changes, RevisionFieldTitle)
+	}
+	if r.Content != previous.Content {
+		changes = append(changes, RevisionFieldContent)
+	}
+	if !slices.Equal(r.PhotoIDs, previous.PhotoIDs) {
+		changes = append(changes, RevisionFieldPhotos)
+	}
+	if !r.Animal.equal(previous.Animal) {
+		changes = append(changes, RevisionFieldAnimal)
+	}
+	return changes
+}
+
+func (a PostRevisionAnimal) equal(other PostRevisionAnimal) bool {
+	left, _ := json.Marshal(a)
+	right, _ := json.Marshal(other)
+	return string(left) == string(right)
+}
+
+// PostRevisionAt returns the revision which was live at the moment, false if the moment is before the first known revision.
+// Revisions must be ordered from the first one.
+func PostRevisionAt(revisions []PostRevision, at time.Time) (PostRevision, bool) {
+	for i := len(revisions) - 1; i >= 0; i-- {
+		if !revisions[i].CreatedAt.After(at) {
+			return revisions[i], true
+		}
+	}
+	return PostRevision{}, false
+}
+
+// CommentRevisionAt returns the revision which was live at the moment, false if the moment is before the first known revision.
+// Revisions must be ordered from the first one.
+func CommentRevisionAt(revisions []CommentRevision, at time.Time) (CommentRevision, bool) {
+	for i := len(revisions) - 1; i >= 0; i-- {
+		if !revisions[i].CreatedAt.After(at) {
+			return revisions[i], true
+		}
+	}
+	return CommentRevision{}, false
+}
+
+// Value - details of the animal are stored as JSON object.
+func (a PostRevisionAnimal) Value() (driver.Value, error) {
+	data, err := json.Marshal(a)
+	return string(data), err
+}
+
+func (a *PostRevisionAnimal) Scan(src interface{}) error {
+	return scanJSON(src, a)
+}
+
+func (PostRevision) TableName() string {
+	return "post_revisions"
+}
+
+func (CommentRevision) TableName() string {
+	return "comment_revisions"
+}
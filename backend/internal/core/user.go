@@ -12,21 +12,33 @@ type (
 		Username     string     `gorm:"column:username"`
 		Firstname    *string    `gorm:"column:firstname"`
 		Lastname     *string    `gorm:"column:lastname"`
-		Photo        *[]byte    `gorm:"column:photo"`
+		Photo        *Media     `gorm:"-"`
 		PasswordHash string     `gorm:"column:password_hash"`
 		Description  *string    `gorm:"column:description"`
 		Status       UserStatus `gorm:"column:status;default:active"`
 		CreatedAt    time.Time  `gorm:"column:created_at"`
 		UpdatedAt    time.Time  `gorm:"column:updated_at"`
+		LastSeenAt   *time.Time `gorm:"column:last_seen_at"` // Last login or refresh of the session, nil if the user never logged in since it was tracked
+		Contacts     Contacts   `gorm:"embedded"`            // Shown to others according to PrivacySettings of the user
+	}
+
+	// Contacts - ways to reach the user besides chats, nil if the user didn't fill the contact.
+	Contacts struct {
+		Phone    *string `gorm:"column:phone"`    // Phone number in E.164 format
+		Telegram *string `gorm:"column:telegram"` // Telegram username without @
+		VKLink   *string `gorm:"column:vk_link"`  // Link to the VK page
 	}
 
 	UpdateUser struct {
-		Username     *string `gorm:"column:username"`
-		Firstname    *string `gorm:"column:firstname"`
-		Lastname     *string `gorm:"column:lastname"`
-		Description  *string `gorm:"column:description"`
-		Photo        *[]byte `gorm:"column:photo"`
-		PasswordHash *string `gorm:"column:password"`
+		Username     *string      `gorm:"column:username"`
+		Firstname    *string      `gorm:"column:firstname"`
+		Lastname     *string      `gorm:"column:lastname"`
+		Description  *string      `gorm:"column:description"`
+		Photo        *MediaUpload `gorm:"-"`
+		PasswordHash *string      `gorm:"column:password"`
+		Phone        *string      `gorm:"column:phone"` // Empty string removes the contact
+		Telegram     *string      `gorm:"column:telegram"`
+		VKLink       *string      `gorm:"column:vk_link"`
 	}
 
 	UserStore interface {
@@ -53,11 +65,13 @@ type (
 	}
 
 	BannedUserRecord struct {
-		ID          int       `gorm:"column:id"`
-		UserID      int       `gorm:"column:user_id"`
-		ModeratorID int       `gorm:"column:moderator_id"`
-		ReportID    *int      `gorm:"column:report_id"`
-		CreatedAt   time.Time `gorm:"column:created_at"`
+		ID          int          `gorm:"column:id"`
+		UserID      int          `gorm:"column:user_id"`
+		ModeratorID int          `gorm:"column:moderator_id"`
+		ReportID    *int         `gorm:"column:report_id"`
+		Reason      ReportReason `gorm:"column:reason"`
+		Comment     *string      `gorm:"column:comment"`
+		CreatedAt   time.Time    `gorm:"column:created_at"`
 	}
 )
 
@@ -78,6 +92,11 @@ var (
 	ErrEmptyUpdateRequest = errors.New("empty update request")
 )
 
+// IsEmpty reports whether the user filled none of the contacts.
+func (c Contacts) IsEmpty() bool {
+	return c.Phone == nil && c.Telegram == nil && c.VKLink == nil
+}
+
 // TableName table name in db for gorm
 func (User) TableName() string {
 	return "users"
@@ -1,30 +1,52 @@
 package core
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
-// todo
 type (
+	// FavouriteUser - the user follows the person, e.g. the volunteer whose posts they want to see.
 	FavouriteUser struct {
-		ID        int    `gorm:"column:id"`
-		personID  int    `gorm:"column:person_id"`
-		userID    int    `gorm:"column:user_id"`
-		createdAt string `gorm:"column:created_at"`
+		ID        int       `gorm:"column:id"`
+		PersonID  int       `gorm:"column:person_id"` // ID of the favourited user
+		Person    User      `gorm:"foreignKey:PersonID;references:ID"`
+		UserID    int       `gorm:"column:user_id"` // ID of the user who favourited the person
+		CreatedAt time.Time `gorm:"column:created_at"`
+	}
+
+	// GetFavouriteUsersParams - pagination and sorting of users favourited by the user.
+	GetFavouriteUsersParams struct {
+		UserID int
+		Limit  *int
+		Offset *int
+		Sort   FavouriteUsersSort // FavouriteUsersSortNewest by default
 	}
+
 	UserFavouriteStore interface {
 		AddUserToFavourite(ctx context.Context, personID int, userID int) (err error)
-		GetFavouriteUsers(ctx context.Context, userID int, params GetFavourites) (favouriteUsers []User, err error)
+		GetFavouriteUsers(ctx context.Context, params GetFavouriteUsersParams) (favouriteUsers []FavouriteUser, total int, err error)
 		DeleteUserFromFavourite(ctx context.Context, personID int, userID int) (err error)
+		// GetFavouriteUsersPosts retrieves published posts of users favourited by the user, active posts by default
+		GetFavouriteUsersPosts(ctx context.Context, userID int, params GetAllPostsParams) (posts []Post, total int, err error)
 	}
+
 	UserFavouriteService interface {
 		AddUserToFavourite(ctx context.Context, personID int, userID int) (err error)
-		GetFavouriteUsers(ctx context.Context, userID int, params GetFavourites) (favouriteUsers []User, err error)
+		GetFavouriteUsers(ctx context.Context, params GetFavouriteUsersParams) (favouriteUsers []FavouriteUser, total int, err error)
 		DeleteUserFromFavourite(ctx context.Context, personID int, userID int) (err error)
+		// GetFavouriteUsersPosts returns the feed of posts of users favourited by the user, the latest posts go first by default
+		GetFavouriteUsersPosts(ctx context.Context, userID int, params GetAllPostsParams) (posts []PostDetails, total int, err error)
 	}
-	GetFavourites struct {
-		Count  *int
-		Offset *int
-		Sort   *string
-	}
+)
+
+// FavouriteUsersSort - order of favourite users.
+type FavouriteUsersSort string
+
+const (
+	FavouriteUsersSortNewest   FavouriteUsersSort = "newest" // Recently favourited first
+	FavouriteUsersSortOldest   FavouriteUsersSort = "oldest"
+	FavouriteUsersSortUsername FavouriteUsersSort = "username" // Alphabetically by username
 )
 
 func (FavouriteUser) TableName() string {
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS reporter_reputation;
+
+ALTER TABLE IF EXISTS reports
+    DROP COLUMN IF EXISTS resolved,
+    DROP COLUMN IF EXISTS weight;
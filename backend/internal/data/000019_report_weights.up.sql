@@ -0,0 +1,10 @@
+ALTER TABLE IF EXISTS reports
+    ADD COLUMN IF NOT EXISTS weight   NUMERIC NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS resolved BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS reporter_reputation (
+    user_id          INTEGER PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    upheld_reports   INTEGER   NOT NULL DEFAULT 0,
+    rejected_reports INTEGER   NOT NULL DEFAULT 0,
+    updated_at       TIMESTAMP NOT NULL DEFAULT NOW()
+);
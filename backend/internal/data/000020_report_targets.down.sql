@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS moderation_queue;
+
+ALTER TABLE IF EXISTS reports
+    DROP COLUMN IF EXISTS description;
+
+DELETE FROM reports WHERE reason = 'other';
+
+ALTER TYPE report_reason
+    RENAME TO report_reason_old;
+CREATE TYPE report_reason
+    AS ENUM ('spam', 'violent_content', 'violent_speech');
+ALTER TABLE reports ALTER COLUMN reason
+    TYPE report_reason USING reason::text::report_reason;
+DROP TYPE report_reason_old;
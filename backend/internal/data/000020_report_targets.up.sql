@@ -0,0 +1,12 @@
+ALTER TYPE report_reason ADD VALUE IF NOT EXISTS 'other';
+
+ALTER TABLE IF EXISTS reports
+    ADD COLUMN IF NOT EXISTS description VARCHAR(1000);
+
+CREATE TABLE IF NOT EXISTS moderation_queue (
+    reportable_id INTEGER NOT NULL,
+    reportable_type VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (reportable_type, reportable_id)
+);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_comments_author_created;
+DROP INDEX IF EXISTS idx_posts_author_created;
+
+ALTER TABLE IF EXISTS comments
+    DROP COLUMN IF EXISTS moderation_reason;
+
+ALTER TABLE IF EXISTS posts
+    DROP COLUMN IF EXISTS moderation_reason;
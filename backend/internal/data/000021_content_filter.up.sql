@@ -0,0 +1,8 @@
+ALTER TABLE IF EXISTS posts
+    ADD COLUMN IF NOT EXISTS moderation_reason VARCHAR(255);
+
+ALTER TABLE IF EXISTS comments
+    ADD COLUMN IF NOT EXISTS moderation_reason VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_posts_author_created ON posts (author_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_comments_author_created ON comments (author_id, created_at);
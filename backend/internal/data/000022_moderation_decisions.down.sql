@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS moderation_decisions;
+
+ALTER TABLE IF EXISTS banned_users
+    DROP COLUMN IF EXISTS comment,
+    DROP COLUMN IF EXISTS reason;
@@ -0,0 +1,18 @@
+ALTER TABLE IF EXISTS banned_users
+    ADD COLUMN IF NOT EXISTS reason report_reason NOT NULL DEFAULT 'other',
+    ADD COLUMN IF NOT EXISTS comment VARCHAR(1000);
+
+CREATE TABLE IF NOT EXISTS moderation_decisions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    moderator_id INTEGER NOT NULL REFERENCES moderators (user_id),
+    target_type VARCHAR(20) NOT NULL,
+    target_id INTEGER NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    reason report_reason NOT NULL,
+    comment VARCHAR(1000),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_decisions_user ON moderation_decisions (user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_moderation_decisions_target ON moderation_decisions (target_type, target_id);
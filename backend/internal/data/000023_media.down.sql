@@ -0,0 +1,21 @@
+ALTER TABLE IF EXISTS posts
+    ADD COLUMN IF NOT EXISTS photo BYTEA;
+
+ALTER TABLE IF EXISTS users
+    ADD COLUMN IF NOT EXISTS photo BYTEA;
+
+-- Only photos which were not uploaded to blob storage yet can be restored.
+UPDATE posts
+SET photo = media_legacy_blobs.data
+FROM media
+JOIN media_legacy_blobs ON media_legacy_blobs.media_id = media.id
+WHERE media.owner_type = 'post' AND media.owner_id = posts.id;
+
+UPDATE users
+SET photo = media_legacy_blobs.data
+FROM media
+JOIN media_legacy_blobs ON media_legacy_blobs.media_id = media.id
+WHERE media.owner_type = 'user' AND media.owner_id = users.id;
+
+DROP TABLE IF EXISTS media_legacy_blobs;
+DROP TABLE IF EXISTS media;
@@ -0,0 +1,47 @@
+CREATE TABLE IF NOT EXISTS media (
+    id SERIAL PRIMARY KEY,
+    owner_type VARCHAR(20) NOT NULL,
+    owner_id INTEGER NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (owner_type, owner_id, position)
+);
+
+-- Content of photos moved out of BYTEA columns, application uploads it to blob storage on start and removes the rows.
+CREATE TABLE IF NOT EXISTS media_legacy_blobs (
+    media_id INTEGER PRIMARY KEY REFERENCES media (id) ON DELETE CASCADE,
+    data BYTEA NOT NULL
+);
+
+WITH moved AS (
+    INSERT INTO media (owner_type, owner_id, position, storage_key, content_type, size)
+    SELECT 'post', id, 0, 'posts/' || id || '/legacy', 'application/octet-stream', octet_length(photo)
+    FROM posts
+    WHERE photo IS NOT NULL AND octet_length(photo) > 0
+    RETURNING id, owner_id
+)
+INSERT INTO media_legacy_blobs (media_id, data)
+SELECT moved.id, posts.photo
+FROM moved
+JOIN posts ON posts.id = moved.owner_id;
+
+WITH moved AS (
+    INSERT INTO media (owner_type, owner_id, position, storage_key, content_type, size)
+    SELECT 'user', id, 0, 'users/' || id || '/legacy', 'application/octet-stream', octet_length(photo)
+    FROM users
+    WHERE photo IS NOT NULL AND octet_length(photo) > 0
+    RETURNING id, owner_id
+)
+INSERT INTO media_legacy_blobs (media_id, data)
+SELECT moved.id, users.photo
+FROM moved
+JOIN users ON users.id = moved.owner_id;
+
+ALTER TABLE IF EXISTS posts
+    DROP COLUMN IF EXISTS photo;
+
+ALTER TABLE IF EXISTS users
+    DROP COLUMN IF EXISTS photo;
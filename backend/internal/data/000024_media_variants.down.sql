@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS media_variants;
+
+ALTER TABLE IF EXISTS media
+    DROP COLUMN IF EXISTS width,
+    DROP COLUMN IF EXISTS height;
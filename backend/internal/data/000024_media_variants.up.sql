@@ -0,0 +1,16 @@
+ALTER TABLE IF EXISTS media
+    ADD COLUMN IF NOT EXISTS width INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS height INTEGER NOT NULL DEFAULT 0;
+
+-- Downscaled copies of photos, every photo has medium and thumbnail sizes in its own format and WebP.
+CREATE TABLE IF NOT EXISTS media_variants (
+    id SERIAL PRIMARY KEY,
+    media_id INTEGER NOT NULL REFERENCES media (id) ON DELETE CASCADE,
+    name VARCHAR(20) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    UNIQUE (media_id, name, content_type)
+);
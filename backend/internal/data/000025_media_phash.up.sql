@@ -0,0 +1,3 @@
+-- Perceptual hash (dHash) of photos, used to find similar photos and duplicates. NULL for files which are not photos.
+ALTER TABLE IF EXISTS media
+    ADD COLUMN IF NOT EXISTS phash BIGINT;
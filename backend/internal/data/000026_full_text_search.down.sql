@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_comments_content_trgm;
+DROP INDEX IF EXISTS idx_animals_description_trgm;
+DROP INDEX IF EXISTS idx_posts_content_trgm;
+DROP INDEX IF EXISTS idx_posts_title_trgm;
+
+DROP INDEX IF EXISTS idx_comments_search_vector;
+DROP INDEX IF EXISTS idx_animals_search_vector;
+DROP INDEX IF EXISTS idx_posts_search_vector;
+
+ALTER TABLE IF EXISTS comments DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE IF EXISTS animals DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE IF EXISTS posts DROP COLUMN IF EXISTS search_vector;
+
+DROP EXTENSION IF EXISTS pg_trgm;
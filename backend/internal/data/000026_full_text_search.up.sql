@@ -0,0 +1,32 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Posts are searched in Russian and English, matches in the title are more relevant than matches in the content.
+ALTER TABLE IF EXISTS posts
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(content, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(content, '')), 'B')
+    ) STORED;
+
+ALTER TABLE IF EXISTS animals
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(description, '')), 'C') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'C')
+    ) STORED;
+
+ALTER TABLE IF EXISTS comments
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        to_tsvector('russian', coalesce(content, '')) ||
+        to_tsvector('english', coalesce(content, ''))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_posts_search_vector ON posts USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_animals_search_vector ON animals USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_comments_search_vector ON comments USING GIN (search_vector);
+
+-- Trigram indexes find words with typos which full-text search misses.
+CREATE INDEX IF NOT EXISTS idx_posts_title_trgm ON posts USING GIN (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_posts_content_trgm ON posts USING GIN (content gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_animals_description_trgm ON animals USING GIN (description gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_comments_content_trgm ON comments USING GIN (content gin_trgm_ops);
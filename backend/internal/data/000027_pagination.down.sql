@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_comments_post_thread;
+DROP INDEX IF EXISTS idx_favourite_posts_post;
+DROP INDEX IF EXISTS idx_posts_updated_id;
+DROP INDEX IF EXISTS idx_posts_created_id;
+
+ALTER TABLE IF EXISTS posts
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude;
@@ -0,0 +1,10 @@
+-- Place the animal was lost or found, posts are sorted by distance to it.
+ALTER TABLE IF EXISTS posts
+    ADD COLUMN IF NOT EXISTS latitude  DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180);
+
+-- Keyset pagination reads posts and comments in the order of these indexes.
+CREATE INDEX IF NOT EXISTS idx_posts_created_id ON posts (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_posts_updated_id ON posts (updated_at, id);
+CREATE INDEX IF NOT EXISTS idx_favourite_posts_post ON favourite_posts (post_id);
+CREATE INDEX IF NOT EXISTS idx_comments_post_thread ON comments (posts_id, (COALESCE(parent_id, id)), id);
@@ -0,0 +1,30 @@
+DROP INDEX IF EXISTS idx_animals_search_vector;
+ALTER TABLE IF EXISTS animals DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE IF EXISTS animals
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(description, '')), 'C') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'C')
+    ) STORED;
+CREATE INDEX IF NOT EXISTS idx_animals_search_vector ON animals USING GIN (search_vector);
+
+DROP INDEX IF EXISTS idx_animals_colors;
+DROP INDEX IF EXISTS idx_animals_tattoo_id;
+DROP INDEX IF EXISTS idx_animals_microchip_number;
+
+ALTER TABLE IF EXISTS animals
+    DROP COLUMN IF EXISTS distinguishing_marks,
+    DROP COLUMN IF EXISTS collar_description,
+    DROP COLUMN IF EXISTS has_collar,
+    DROP COLUMN IF EXISTS is_vaccinated,
+    DROP COLUMN IF EXISTS is_sterilized,
+    DROP COLUMN IF EXISTS tattoo_id,
+    DROP COLUMN IF EXISTS microchip_number,
+    DROP COLUMN IF EXISTS colors,
+    DROP COLUMN IF EXISTS coat_pattern,
+    DROP COLUMN IF EXISTS size,
+    DROP COLUMN IF EXISTS breed;
+
+DROP TYPE IF EXISTS animal_coat_patterns;
+DROP TYPE IF EXISTS animal_sizes;
+
+-- Values can not be removed from enum in PostgreSQL, so animal_types keeps the added species.
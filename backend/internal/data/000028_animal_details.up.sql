@@ -0,0 +1,55 @@
+ALTER TYPE animal_types ADD VALUE IF NOT EXISTS 'rabbit';
+ALTER TYPE animal_types ADD VALUE IF NOT EXISTS 'bird';
+ALTER TYPE animal_types ADD VALUE IF NOT EXISTS 'rodent';
+ALTER TYPE animal_types ADD VALUE IF NOT EXISTS 'other';
+
+CREATE TYPE animal_sizes AS ENUM ('small', 'medium', 'large');
+
+CREATE TYPE animal_coat_patterns AS ENUM (
+    'solid', 'bicolor', 'tabby', 'tortoiseshell', 'calico', 'colorpoint', 'spotted', 'brindle', 'merle'
+);
+
+ALTER TABLE IF EXISTS animals
+    ADD COLUMN IF NOT EXISTS breed                VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS size                 animal_sizes,
+    ADD COLUMN IF NOT EXISTS coat_pattern         animal_coat_patterns,
+    ADD COLUMN IF NOT EXISTS colors               VARCHAR(20)[] NOT NULL DEFAULT '{}'
+        CHECK (colors <@ ARRAY ['black', 'white', 'gray', 'ginger', 'cream', 'brown', 'fawn']::VARCHAR(20)[]),
+    ADD COLUMN IF NOT EXISTS microchip_number     VARCHAR(32),
+    ADD COLUMN IF NOT EXISTS tattoo_id            VARCHAR(32),
+    ADD COLUMN IF NOT EXISTS is_sterilized        BOOLEAN,
+    ADD COLUMN IF NOT EXISTS is_vaccinated        BOOLEAN,
+    ADD COLUMN IF NOT EXISTS has_collar           BOOLEAN,
+    ADD COLUMN IF NOT EXISTS collar_description   VARCHAR(200),
+    ADD COLUMN IF NOT EXISTS distinguishing_marks VARCHAR(1000);
+
+-- Free-text colors of existing animals are mapped to the vocabulary by common words in Russian and English.
+UPDATE animals
+SET colors = ARRAY(
+    SELECT vocabulary.color
+    FROM (VALUES ('black', 'черн|black'),
+                 ('white', 'бел|white'),
+                 ('gray', 'сер|дым|голуб|gr[ae]y|blue|smoke'),
+                 ('ginger', 'рыж|ginger|orange|red'),
+                 ('cream', 'крем|беж|cream|beige'),
+                 ('brown', 'коричн|шокол|бур|brown|chocolate'),
+                 ('fawn', 'палев|песоч|fawn|sand')) AS vocabulary (color, pattern)
+    WHERE lower(animals.color) ~ vocabulary.pattern
+)
+WHERE color IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_animals_microchip_number ON animals (microchip_number);
+CREATE INDEX IF NOT EXISTS idx_animals_tattoo_id ON animals (tattoo_id);
+CREATE INDEX IF NOT EXISTS idx_animals_colors ON animals USING GIN (colors);
+
+-- Name, breed and distinguishing marks of the animal are found by full-text search of posts too.
+DROP INDEX IF EXISTS idx_animals_search_vector;
+ALTER TABLE IF EXISTS animals DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE IF EXISTS animals
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(name, '') || ' ' || coalesce(breed, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(name, '') || ' ' || coalesce(breed, '')), 'B') ||
+        setweight(to_tsvector('russian', coalesce(description, '') || ' ' || coalesce(distinguishing_marks, '')), 'C') ||
+        setweight(to_tsvector('english', coalesce(description, '') || ' ' || coalesce(distinguishing_marks, '')), 'C')
+    ) STORED;
+CREATE INDEX IF NOT EXISTS idx_animals_search_vector ON animals USING GIN (search_vector);
@@ -0,0 +1,14 @@
+-- files of the photos stay in blob storage, only their metadata is removed
+DELETE FROM media WHERE owner_type = 'animal_event';
+
+DROP INDEX IF EXISTS idx_posts_animal_id;
+DROP INDEX IF EXISTS idx_animals_keeper_id;
+DROP INDEX IF EXISTS idx_animals_author_id;
+
+DROP TABLE IF EXISTS animal_events;
+
+ALTER TABLE IF EXISTS animals
+    DROP COLUMN IF EXISTS stage,
+    DROP COLUMN IF EXISTS author_id;
+
+DROP TYPE IF EXISTS animal_event_types;
@@ -0,0 +1,38 @@
+CREATE TYPE animal_event_types AS ENUM ('found', 'at_vet', 'in_foster', 'adopted', 'returned_to_owner', 'deceased');
+
+ALTER TABLE IF EXISTS animals
+    ADD author_id INTEGER REFERENCES users (id),
+    ADD stage     animal_event_types;
+
+-- animals were created together with posts, so the author of the first post registered the animal
+UPDATE animals
+SET author_id = (
+    SELECT posts.author_id
+    FROM posts
+    WHERE posts.animal_id = animals.id
+    ORDER BY posts.created_at, posts.id
+    LIMIT 1
+);
+
+-- keeper_id was filled with ID of the author instead of ID of the keeper, only keepers who are the authors are kept
+UPDATE animals
+SET keeper_id = NULL
+WHERE keeper_id IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM keepers WHERE keepers.id = animals.keeper_id AND keepers.user_id = animals.author_id);
+
+CREATE TABLE IF NOT EXISTS
+    animal_events
+(
+    id          SERIAL PRIMARY KEY,
+    animal_id   INTEGER            NOT NULL REFERENCES animals (id) ON DELETE CASCADE,
+    author_id   INTEGER            NOT NULL REFERENCES users (id),
+    type        animal_event_types NOT NULL,
+    note        VARCHAR(2000)      NOT NULL DEFAULT '',
+    happened_at TIMESTAMP          NOT NULL DEFAULT NOW(),
+    created_at  TIMESTAMP          NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_animal_events_animal_happened ON animal_events (animal_id, happened_at, id);
+CREATE INDEX IF NOT EXISTS idx_animals_author_id ON animals (author_id);
+CREATE INDEX IF NOT EXISTS idx_animals_keeper_id ON animals (keeper_id);
+CREATE INDEX IF NOT EXISTS idx_posts_animal_id ON posts (animal_id);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS adoption_applications;
+
+DROP TYPE IF EXISTS adoption_statuses;
+
+-- values can't be removed from enum, adopted animals need home again
+UPDATE animals SET status = 'need_home' WHERE status = 'adopted';
@@ -0,0 +1,26 @@
+ALTER TYPE animal_statuses ADD VALUE IF NOT EXISTS 'adopted';
+
+CREATE TYPE adoption_statuses AS ENUM ('submitted', 'under_review', 'interview', 'approved', 'rejected', 'withdrawn');
+
+CREATE TABLE IF NOT EXISTS
+    adoption_applications
+(
+    id                 SERIAL PRIMARY KEY,
+    animal_id          INTEGER           NOT NULL REFERENCES animals (id) ON DELETE CASCADE,
+    applicant_id       INTEGER           NOT NULL REFERENCES users (id),
+    status             adoption_statuses NOT NULL DEFAULT 'submitted',
+    answers            JSONB             NOT NULL DEFAULT '{}',
+    applicant_snapshot JSONB             NOT NULL,
+    keeper_note        VARCHAR(2000)     NOT NULL DEFAULT '',
+    created_at         TIMESTAMP         NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMP         NOT NULL DEFAULT NOW(),
+    closed_at          TIMESTAMP
+);
+
+-- the applicant has at most one open application for the animal
+CREATE UNIQUE INDEX IF NOT EXISTS idx_adoption_applications_open
+    ON adoption_applications (animal_id, applicant_id)
+    WHERE status IN ('submitted', 'under_review', 'interview');
+
+CREATE INDEX IF NOT EXISTS idx_adoption_applications_animal_created ON adoption_applications (animal_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_adoption_applications_applicant_created ON adoption_applications (applicant_id, created_at);
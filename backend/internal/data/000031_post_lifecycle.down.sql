@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS notifications;
+
+DROP INDEX IF EXISTS idx_posts_resolved;
+DROP INDEX IF EXISTS idx_posts_state_bumped;
+DROP INDEX IF EXISTS idx_posts_bumped_id;
+
+ALTER TABLE IF EXISTS posts
+    DROP COLUMN IF EXISTS expiry_reminded_at,
+    DROP COLUMN IF EXISTS bumped_at,
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS resolved_at,
+    DROP COLUMN IF EXISTS success_story,
+    DROP COLUMN IF EXISTS resolution,
+    DROP COLUMN IF EXISTS state;
+
+DROP TYPE IF EXISTS post_resolutions;
+DROP TYPE IF EXISTS post_states;
@@ -0,0 +1,40 @@
+CREATE TYPE post_states AS ENUM ('active', 'resolved', 'archived');
+
+CREATE TYPE post_resolutions AS ENUM ('reunited', 'adopted');
+
+ALTER TABLE IF EXISTS posts
+    ADD COLUMN IF NOT EXISTS state              post_states NOT NULL DEFAULT 'active',
+    ADD COLUMN IF NOT EXISTS resolution         post_resolutions,
+    ADD COLUMN IF NOT EXISTS success_story      VARCHAR(4000),
+    ADD COLUMN IF NOT EXISTS resolved_at        TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS archived_at        TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS bumped_at          TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS expiry_reminded_at TIMESTAMP;
+
+-- posts are ordered by the last bump, so existing posts keep their order
+UPDATE posts
+SET bumped_at = created_at
+WHERE bumped_at IS NULL;
+
+ALTER TABLE IF EXISTS posts
+    ALTER COLUMN bumped_at SET NOT NULL,
+    ALTER COLUMN bumped_at SET DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS idx_posts_bumped_id ON posts (bumped_at, id);
+CREATE INDEX IF NOT EXISTS idx_posts_state_bumped ON posts (state, bumped_at);
+CREATE INDEX IF NOT EXISTS idx_posts_resolved ON posts (resolved_at) WHERE state = 'resolved';
+
+CREATE TABLE IF NOT EXISTS
+    notifications
+(
+    id          SERIAL PRIMARY KEY,
+    user_id     INTEGER     NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    type        VARCHAR(50) NOT NULL,
+    entity_type VARCHAR(50),
+    entity_id   INTEGER,
+    read_at     TIMESTAMP,
+    created_at  TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_created ON notifications (user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_notifications_user_unread ON notifications (user_id) WHERE read_at IS NULL;
@@ -0,0 +1,59 @@
+CREATE TABLE IF NOT EXISTS
+    post_revisions
+(
+    id         SERIAL PRIMARY KEY,
+    post_id    INTEGER   NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    revision   INTEGER   NOT NULL,
+    editor_id  INTEGER   NOT NULL REFERENCES users (id),
+    title      VARCHAR   NOT NULL,
+    content    VARCHAR   NOT NULL DEFAULT '',
+    photo_ids  INTEGER[] NOT NULL DEFAULT '{}', -- photos of the post ordered by position
+    animal     JSONB     NOT NULL DEFAULT '{}', -- public details of the animal, identifiers are never copied
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (post_id, revision)
+);
+
+CREATE TABLE IF NOT EXISTS
+    comment_revisions
+(
+    id         SERIAL PRIMARY KEY,
+    comment_id INTEGER   NOT NULL REFERENCES comments (id) ON DELETE CASCADE,
+    revision   INTEGER   NOT NULL,
+    content    VARCHAR   NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (comment_id, revision)
+);
+
+-- current content becomes the first revision, earlier content is lost
+INSERT INTO post_revisions (post_id, revision, editor_id, title, content, photo_ids, animal, created_at)
+SELECT posts.id,
+       1,
+       posts.author_id,
+       posts.title,
+       COALESCE(posts.content, ''),
+       COALESCE((SELECT array_agg(media.id ORDER BY media.position)
+                 FROM media
+                 WHERE media.owner_type = 'post' AND media.owner_id = posts.id), '{}'),
+       jsonb_build_object(
+               'name', animals.name,
+               'animal_type', animals.animal_type,
+               'breed', animals.breed,
+               'size', animals.size,
+               'age', animals.age,
+               'color', animals.color,
+               'colors', animals.colors,
+               'coat_pattern', animals.coat_pattern,
+               'gender', animals.gender,
+               'description', animals.description,
+               'distinguishing_marks', animals.distinguishing_marks,
+               'status', animals.status
+       ),
+       posts.updated_at
+FROM posts
+         JOIN animals ON animals.id = posts.animal_id
+ON CONFLICT DO NOTHING;
+
+INSERT INTO comment_revisions (comment_id, revision, content, created_at)
+SELECT id, 1, content, updated_at
+FROM comments
+ON CONFLICT DO NOTHING;
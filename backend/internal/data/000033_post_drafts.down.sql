@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_posts_author_status;
+DROP INDEX IF EXISTS idx_posts_publish_at;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS publish_at;
+
+-- values can't be removed from enum, drafts are never shown to other users
+UPDATE posts SET status = 'deleted', deleted_at = NOW() WHERE status = 'draft';
@@ -0,0 +1,8 @@
+ALTER TYPE status ADD VALUE IF NOT EXISTS 'draft';
+
+-- time the draft is published at by the worker, NULL for drafts which are not scheduled
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_posts_publish_at ON posts (publish_at) WHERE publish_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_posts_author_status ON posts (author_id, status);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS post_likes;
+
+ALTER TABLE comment_likes
+    DROP CONSTRAINT IF EXISTS unique_comment_like;
@@ -0,0 +1,21 @@
+-- duplicate likes are removed, so the constraint can be added
+DELETE
+FROM comment_likes duplicate
+    USING comment_likes original
+WHERE duplicate.comment_id = original.comment_id
+  AND duplicate.user_id = original.user_id
+  AND duplicate.id > original.id;
+
+ALTER TABLE comment_likes
+    DROP CONSTRAINT IF EXISTS unique_comment_like,
+    ADD CONSTRAINT unique_comment_like UNIQUE (comment_id, user_id);
+
+CREATE TABLE IF NOT EXISTS
+    post_likes
+(
+    id         SERIAL PRIMARY KEY,
+    post_id    INTEGER   NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    user_id    INTEGER   NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT unique_post_like UNIQUE (post_id, user_id)
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS
+    comment_mentions
+(
+    id         SERIAL PRIMARY KEY,
+    comment_id INTEGER   NOT NULL REFERENCES comments (id) ON DELETE CASCADE,
+    user_id    INTEGER   NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    "offset"   INTEGER   NOT NULL, -- position of "@username" in content of the comment in characters
+    length     INTEGER   NOT NULL, -- length of "@username" in characters
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (comment_id, "offset")
+);
+
+CREATE INDEX IF NOT EXISTS idx_comment_mentions_user_id ON comment_mentions (user_id);
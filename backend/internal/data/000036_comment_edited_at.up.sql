@@ -0,0 +1,2 @@
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS edited_at TIMESTAMP;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS user_blocks;
+
+DROP TYPE IF EXISTS block_types;
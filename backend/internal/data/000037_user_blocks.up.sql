@@ -0,0 +1,15 @@
+CREATE TYPE block_types AS ENUM ('block', 'mute');
+
+CREATE TABLE IF NOT EXISTS
+    user_blocks
+(
+    id              SERIAL PRIMARY KEY,
+    user_id         INTEGER     NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    blocked_user_id INTEGER     NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    type            block_types NOT NULL,
+    created_at      TIMESTAMP   NOT NULL DEFAULT NOW(),
+    CONSTRAINT unique_user_block UNIQUE (user_id, blocked_user_id, type),
+    CONSTRAINT check_user_block_self CHECK (user_id <> blocked_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocked_user_id ON user_blocks (blocked_user_id);
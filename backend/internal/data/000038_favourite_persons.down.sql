@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_favourite_persons_user_id;
+
+ALTER TABLE favourite_persons
+    DROP CONSTRAINT IF EXISTS unique_favourite_person;
@@ -0,0 +1,13 @@
+-- duplicate favourites are removed, so the constraint can be added
+DELETE
+FROM favourite_persons duplicate
+    USING favourite_persons original
+WHERE duplicate.person_id = original.person_id
+  AND duplicate.user_id = original.user_id
+  AND duplicate.id > original.id;
+
+ALTER TABLE favourite_persons
+    DROP CONSTRAINT IF EXISTS unique_favourite_person,
+    ADD CONSTRAINT unique_favourite_person UNIQUE (person_id, user_id);
+
+CREATE INDEX IF NOT EXISTS idx_favourite_persons_user_id ON favourite_persons (user_id, created_at);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS
+    feed_preferences
+(
+    user_id      INTEGER          NOT NULL PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    animal_types VARCHAR(20)[]    NOT NULL DEFAULT '{}',
+    statuses     VARCHAR(20)[]    NOT NULL DEFAULT '{}',
+    colors       VARCHAR(20)[]    NOT NULL DEFAULT '{}',
+    latitude     DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    longitude    DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    radius       DOUBLE PRECISION CHECK (radius > 0),
+    updated_at   TIMESTAMP        NOT NULL DEFAULT NOW(),
+    CONSTRAINT check_feed_preferences_location CHECK ((latitude IS NULL) = (longitude IS NULL))
+);
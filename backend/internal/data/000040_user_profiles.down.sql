@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS privacy_settings;
+
+DROP TYPE IF EXISTS visibility;
+
+ALTER TABLE IF EXISTS users
+    DROP COLUMN IF EXISTS last_seen_at;
@@ -0,0 +1,14 @@
+ALTER TABLE IF EXISTS users
+    ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMP;
+
+CREATE TYPE visibility AS ENUM ('public', 'users', 'nobody');
+
+CREATE TABLE IF NOT EXISTS
+    privacy_settings
+(
+    user_id    INTEGER PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    statistics visibility NOT NULL DEFAULT 'public',
+    last_seen  visibility NOT NULL DEFAULT 'users',
+    comments   visibility NOT NULL DEFAULT 'public',
+    updated_at TIMESTAMP  NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,11 @@
+ALTER TABLE IF EXISTS privacy_settings
+    DROP COLUMN IF EXISTS phone,
+    DROP COLUMN IF EXISTS telegram,
+    DROP COLUMN IF EXISTS vk_link;
+
+ALTER TABLE IF EXISTS users
+    DROP COLUMN IF EXISTS phone,
+    DROP COLUMN IF EXISTS telegram,
+    DROP COLUMN IF EXISTS vk_link;
+
+-- values can't be removed from enum, 'chats' was used only by the dropped columns
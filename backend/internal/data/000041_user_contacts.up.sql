@@ -0,0 +1,12 @@
+ALTER TYPE visibility ADD VALUE IF NOT EXISTS 'chats';
+
+ALTER TABLE IF EXISTS users
+    ADD COLUMN IF NOT EXISTS phone    VARCHAR(16),
+    ADD COLUMN IF NOT EXISTS telegram VARCHAR(32),
+    ADD COLUMN IF NOT EXISTS vk_link  VARCHAR(100);
+
+-- contacts are hidden until the user decides who sees them
+ALTER TABLE IF EXISTS privacy_settings
+    ADD COLUMN IF NOT EXISTS phone    visibility NOT NULL DEFAULT 'nobody',
+    ADD COLUMN IF NOT EXISTS telegram visibility NOT NULL DEFAULT 'nobody',
+    ADD COLUMN IF NOT EXISTS vk_link  visibility NOT NULL DEFAULT 'nobody';
@@ -0,0 +1 @@
+-- deleted revisions of drafts can't be restored
@@ -0,0 +1,4 @@
+-- drafts are private, their history starts when they are published
+DELETE
+FROM post_revisions
+WHERE post_id IN (SELECT id FROM posts WHERE status = 'draft');
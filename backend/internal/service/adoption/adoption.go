@@ -0,0 +1,201 @@
+package adoption
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+type service struct {
+	adoptionStore core.AdoptionStore
+	animalStore   core.AnimalStore
+	userStore     core.UserStore
+	config        core.AdoptionServiceConfig
+}
+
+// New initializes a new instance of service
+func New(
+	adoptionStore core.AdoptionStore,
+	animalStore core.AnimalStore,
+	userStore core.UserStore,
+	config core.AdoptionServiceConfig,
+) core.AdoptionService {
+	return &service{
+		adoptionStore: adoptionStore,
+		animalStore:   animalStore,
+		userStore:     userStore,
+		config:        config,
+	}
+}
+
+// GetQuestionnaire returns questions the applicant answers
+func (s *service) GetQuestionnaire(_ context.Context) []core.AdoptionQuestion {
+	return s.config.Questions
+}
+
+// Apply creates the application of the user for the animal which needs home, profile of the applicant is copied to the application
+func (s *service) Apply(ctx context.Context, application core.AdoptionApplication) (core.AdoptionApplication, error) {
+	animal, err := s.animalStore.GetAnimalByID(ctx, application.AnimalID)
+	if err != nil {
+		return core.AdoptionApplication{}, err
+	}
+
+	if animal.Status != core.AnimalStatusNeedHome {
+		return core.AdoptionApplication{}, core.ErrAnimalNotAdoptable
+	}
+	if animal.ManagedBy(application.ApplicantID) {
+		return core.AdoptionApplication{}, core.ErrOwnAnimalAdoption
+	}
+
+	if err := validateAnswers(s.config.Questions, application.Answers); err != nil {
+		return core.AdoptionApplication{}, err
+	}
+
+	applicant, err := s.userStore.GetUserByID(ctx, application.ApplicantID)
+	if err != nil {
+		return core.AdoptionApplication{}, err
+	}
+
+	application.Applicant = core.ApplicantSnapshot{
+		Username:     applicant.Username,
+		Firstname:    applicant.Firstname,
+		Lastname:     applicant.Lastname,
+		Description:  applicant.Description,
+		RegisteredAt: applicant.CreatedAt,
+	}
+	application.Status = core.AdoptionSubmitted
+	application.KeeperNote = ""
+	application.ClosedAt = nil
+
+	return s.adoptionStore.CreateApplication(ctx, application)
+}
+
+// GetApplication retrieves the application for the applicant or the author and the keeper of the animal,
+// the application is hidden from other users
+func (s *service) GetApplication(ctx context.Context, userID, id int) (core.AdoptionApplication, error) {
+	application, byKeeper, err := s.getAccessibleApplication(ctx, userID, id)
+	if err != nil {
+		return core.AdoptionApplication{}, err
+	}
+
+	if !byKeeper {
+		application.KeeperNote = ""
+	}
+
+	return application, nil
+}
+
+// GetAnimalApplications retrieves applications for the animal, only its author and keeper may see them
+func (s *service) GetAnimalApplications(ctx context.Context, userID int, params core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error) {
+	if params.AnimalID == nil {
+		return nil, 0, core.ErrAnimalNotFound
+	}
+
+	animal, err := s.animalStore.GetAnimalByID(ctx, *params.AnimalID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !animal.ManagedBy(userID) {
+		return nil, 0, core.ErrAdoptionAccessDenied
+	}
+
+	return s.adoptionStore.GetApplications(ctx, params)
+}
+
+// GetUserApplications retrieves applications of the user without notes of keepers
+func (s *service) GetUserApplications(ctx context.Context, userID int, params core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error) {
+	params.ApplicantID = &userID
+
+	applications, total, err := s.adoptionStore.GetApplications(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := range applications {
+		applications[i].KeeperNote = ""
+	}
+
+	return applications, total, nil
+}
+
+// UpdateApplication moves the application to another status or changes the note of the keeper.
+// The author and the keeper of the animal review the application, the applicant may only withdraw it.
+func (s *service) UpdateApplication(ctx context.Context, update core.UpdateAdoptionApplication) (core.AdoptionApplication, error) {
+	application, byKeeper, err := s.getAccessibleApplication(ctx, update.UserID, update.ID)
+	if err != nil {
+		return core.AdoptionApplication{}, err
+	}
+
+	if update.KeeperNote != nil {
+		if !byKeeper {
+			return core.AdoptionApplication{}, core.ErrAdoptionAccessDenied
+		}
+		application.KeeperNote = *update.KeeperNote
+	}
+
+	if update.Status != nil && *update.Status != application.Status {
+		// the keeper who applied before taking the animal can't decide on own application
+		if byKeeper && application.ApplicantID == update.UserID {
+			return core.AdoptionApplication{}, core.ErrOwnAnimalAdoption
+		}
+		if !application.Status.CanMoveTo(*update.Status, byKeeper) {
+			return core.AdoptionApplication{}, core.ErrAdoptionStatusTransition
+		}
+
+		if *update.Status == core.AdoptionApproved {
+			animal, err := s.animalStore.GetAnimalByID(ctx, application.AnimalID)
+			if err != nil {
+				return core.AdoptionApplication{}, err
+			}
+			if animal.Status != core.AnimalStatusNeedHome {
+				return core.AdoptionApplication{}, core.ErrAnimalNotAdoptable
+			}
+
+			return s.adoptionStore.ApproveApplication(ctx, application, update.UserID)
+		}
+
+		application.Status = *update.Status
+		if !application.Status.IsOpen() {
+			now := time.Now().UTC()
+			application.ClosedAt = &now
+		}
+	}
+
+	application, err = s.adoptionStore.UpdateApplication(ctx, application)
+	if err != nil {
+		return core.AdoptionApplication{}, err
+	}
+
+	if !byKeeper {
+		application.KeeperNote = ""
+	}
+
+	return application, nil
+}
+
+// getAccessibleApplication retrieves the application if the user is its applicant or manages the animal,
+// byKeeper reports the latter. The application is reported as missing to other users.
+func (s *service) getAccessibleApplication(ctx context.Context, userID, id int) (application core.AdoptionApplication, byKeeper bool, err error) {
+	application, err = s.adoptionStore.GetApplicationByID(ctx, id)
+	if err != nil {
+		return core.AdoptionApplication{}, false, err
+	}
+
+	animal, err := s.animalStore.GetAnimalByID(ctx, application.AnimalID)
+	if err != nil {
+		if errors.Is(err, core.ErrAnimalNotFound) {
+			return core.AdoptionApplication{}, false, core.ErrAdoptionApplicationNotFound
+		}
+		return core.AdoptionApplication{}, false, err
+	}
+
+	byKeeper = animal.ManagedBy(userID)
+	if !byKeeper && application.ApplicantID != userID {
+		return core.AdoptionApplication{}, false, core.ErrAdoptionApplicationNotFound
+	}
+
+	return application, byKeeper, nil
+}
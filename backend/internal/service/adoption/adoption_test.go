@@ -0,0 +1,250 @@
+package adoption_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/kotopesp/sos-kotopes/internal/service/adoption"
+)
+
+var config = core.AdoptionServiceConfig{
+	Questions: []core.AdoptionQuestion{
+		{Key: "housing", Text: "Housing?", Required: true, MaxLength: 10},
+		{Key: "alone_time", Text: "Alone time?", MaxLength: 10},
+	},
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func statusPtr(s core.AdoptionStatus) *core.AdoptionStatus {
+	return &s
+}
+
+func TestApply_Success(t *testing.T) {
+	ctx := context.TODO()
+	mockAdoptions := new(mocks.MockAdoptionStore)
+	mockAnimals := new(mocks.MockAnimalStore)
+	mockUsers := new(mocks.MockUserStore)
+
+	registeredAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1, AuthorID: intPtr(1), Status: core.AnimalStatusNeedHome}, nil)
+	mockUsers.On("GetUserByID", ctx, 2).Return(core.User{ID: 2, Username: "anna", CreatedAt: registeredAt}, nil)
+	mockAdoptions.On("CreateApplication", ctx, mock.MatchedBy(func(a core.AdoptionApplication) bool {
+		return a.Status == core.AdoptionSubmitted &&
+			a.Answers["housing"] == "flat" &&
+			a.Applicant.Username == "anna" && a.Applicant.RegisteredAt.Equal(registeredAt)
+	})).Return(core.AdoptionApplication{ID: 1}, nil)
+
+	svc := adoption.New(mockAdoptions, mockAnimals, mockUsers, config)
+
+	_, err := svc.Apply(ctx, core.AdoptionApplication{
+		AnimalID:    1,
+		ApplicantID: 2,
+		Answers:     core.AdoptionAnswers{"housing": " flat ", "alone_time": " "},
+	})
+	assert.NoError(t, err)
+
+	mockAdoptions.AssertExpectations(t)
+	mockUsers.AssertExpectations(t)
+}
+
+func TestApply_InvalidAnswers(t *testing.T) {
+	ctx := context.TODO()
+
+	tests := []struct {
+		name    string
+		answers core.AdoptionAnswers
+	}{
+		{name: "missing required", answers: core.AdoptionAnswers{"alone_time": "2 hours"}},
+		{name: "too long", answers: core.AdoptionAnswers{"housing": "a very big house"}},
+		{name: "unknown question", answers: core.AdoptionAnswers{"housing": "flat", "salary": "big"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAdoptions := new(mocks.MockAdoptionStore)
+			mockAnimals := new(mocks.MockAnimalStore)
+
+			mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1, AuthorID: intPtr(1), Status: core.AnimalStatusNeedHome}, nil)
+
+			svc := adoption.New(mockAdoptions, mockAnimals, nil, config)
+
+			_, err := svc.Apply(ctx, core.AdoptionApplication{AnimalID: 1, ApplicantID: 2, Answers: tt.answers})
+			assert.ErrorIs(t, err, core.ErrInvalidAdoptionAnswers)
+
+			mockAdoptions.AssertNotCalled(t, "CreateApplication", ctx, mock.Anything)
+		})
+	}
+}
+
+func TestApply_NotAdoptable(t *testing.T) {
+	ctx := context.TODO()
+	mockAnimals := new(mocks.MockAnimalStore)
+
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1, AuthorID: intPtr(1), Status: core.AnimalStatusAdopted}, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 2).Return(core.Animal{ID: 2, AuthorID: intPtr(1), Status: core.AnimalStatusNeedHome}, nil)
+
+	svc := adoption.New(nil, mockAnimals, nil, config)
+
+	_, err := svc.Apply(ctx, core.AdoptionApplication{AnimalID: 1, ApplicantID: 2})
+	assert.ErrorIs(t, err, core.ErrAnimalNotAdoptable)
+
+	_, err = svc.Apply(ctx, core.AdoptionApplication{AnimalID: 2, ApplicantID: 1})
+	assert.ErrorIs(t, err, core.ErrOwnAnimalAdoption)
+}
+
+func TestGetApplication_NoteHidden(t *testing.T) {
+	ctx := context.TODO()
+	mockAdoptions := new(mocks.MockAdoptionStore)
+	mockAnimals := new(mocks.MockAnimalStore)
+
+	application := core.AdoptionApplication{ID: 1, AnimalID: 1, ApplicantID: 2, KeeperNote: "calls back late"}
+
+	mockAdoptions.On("GetApplicationByID", ctx, 1).Return(application, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1, AuthorID: intPtr(1), KeeperUserID: intPtr(3)}, nil)
+
+	svc := adoption.New(mockAdoptions, mockAnimals, nil, config)
+
+	got, err := svc.GetApplication(ctx, 3, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "calls back late", got.KeeperNote)
+
+	got, err = svc.GetApplication(ctx, 2, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, got.KeeperNote)
+
+	_, err = svc.GetApplication(ctx, 4, 1)
+	assert.ErrorIs(t, err, core.ErrAdoptionApplicationNotFound)
+}
+
+func TestUpdateApplication_Transitions(t *testing.T) {
+	ctx := context.TODO()
+
+	tests := []struct {
+		name    string
+		current core.AdoptionStatus
+		userID  int
+		update  core.UpdateAdoptionApplication
+		wantErr error
+	}{
+		{
+			name:    "keeper moves to interview",
+			current: core.AdoptionSubmitted,
+			userID:  1,
+			update:  core.UpdateAdoptionApplication{Status: statusPtr(core.AdoptionInterview)},
+		},
+		{
+			name:    "applicant withdraws",
+			current: core.AdoptionUnderReview,
+			userID:  2,
+			update:  core.UpdateAdoptionApplication{Status: statusPtr(core.AdoptionWithdrawn)},
+		},
+		{
+			name:    "applicant can't approve",
+			current: core.AdoptionSubmitted,
+			userID:  2,
+			update:  core.UpdateAdoptionApplication{Status: statusPtr(core.AdoptionApproved)},
+			wantErr: core.ErrAdoptionStatusTransition,
+		},
+		{
+			name:    "rejected is final",
+			current: core.AdoptionRejected,
+			userID:  1,
+			update:  core.UpdateAdoptionApplication{Status: statusPtr(core.AdoptionUnderReview)},
+			wantErr: core.ErrAdoptionStatusTransition,
+		},
+		{
+			name:    "keeper can't withdraw",
+			current: core.AdoptionSubmitted,
+			userID:  1,
+			update:  core.UpdateAdoptionApplication{Status: statusPtr(core.AdoptionWithdrawn)},
+			wantErr: core.ErrAdoptionStatusTransition,
+		},
+		{
+			name:    "applicant can't write note",
+			current: core.AdoptionSubmitted,
+			userID:  2,
+			update:  core.UpdateAdoptionApplication{KeeperNote: &[]string{"nice"}[0]},
+			wantErr: core.ErrAdoptionAccessDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAdoptions := new(mocks.MockAdoptionStore)
+			mockAnimals := new(mocks.MockAnimalStore)
+
+			mockAdoptions.On("GetApplicationByID", ctx, 1).
+				Return(core.AdoptionApplication{ID: 1, AnimalID: 1, ApplicantID: 2, Status: tt.current}, nil)
+			mockAnimals.On("GetAnimalByID", ctx, 1).
+				Return(core.Animal{ID: 1, AuthorID: intPtr(1), Status: core.AnimalStatusNeedHome}, nil)
+			mockAdoptions.On("UpdateApplication", ctx, mock.MatchedBy(func(a core.AdoptionApplication) bool {
+				return a.Status == *tt.update.Status && (a.ClosedAt != nil) == !a.Status.IsOpen()
+			})).Return(func(_ context.Context, a core.AdoptionApplication) (core.AdoptionApplication, error) {
+				return a, nil
+			}).Maybe()
+
+			svc := adoption.New(mockAdoptions, mockAnimals, nil, config)
+
+			tt.update.ID = 1
+			tt.update.UserID = tt.userID
+			got, err := svc.UpdateApplication(ctx, tt.update)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockAdoptions.AssertNotCalled(t, "UpdateApplication", ctx, mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, *tt.update.Status, got.Status)
+		})
+	}
+}
+
+func TestUpdateApplication_Approve(t *testing.T) {
+	ctx := context.TODO()
+	mockAdoptions := new(mocks.MockAdoptionStore)
+	mockAnimals := new(mocks.MockAnimalStore)
+
+	application := core.AdoptionApplication{ID: 1, AnimalID: 1, ApplicantID: 2, Status: core.AdoptionInterview}
+
+	mockAdoptions.On("GetApplicationByID", ctx, 1).Return(application, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1, KeeperUserID: intPtr(3), Status: core.AnimalStatusNeedHome}, nil)
+	mockAdoptions.On("ApproveApplication", ctx, application, 3).
+		Return(core.AdoptionApplication{ID: 1, Status: core.AdoptionApproved}, nil)
+
+	svc := adoption.New(mockAdoptions, mockAnimals, nil, config)
+
+	got, err := svc.UpdateApplication(ctx, core.UpdateAdoptionApplication{ID: 1, UserID: 3, Status: statusPtr(core.AdoptionApproved)})
+	assert.NoError(t, err)
+	assert.Equal(t, core.AdoptionApproved, got.Status)
+
+	mockAdoptions.AssertExpectations(t)
+	mockAdoptions.AssertNotCalled(t, "UpdateApplication", ctx, mock.Anything)
+}
+
+func TestUpdateApplication_ApproveAdoptedAnimal(t *testing.T) {
+	ctx := context.TODO()
+	mockAdoptions := new(mocks.MockAdoptionStore)
+	mockAnimals := new(mocks.MockAnimalStore)
+
+	mockAdoptions.On("GetApplicationByID", ctx, 1).
+		Return(core.AdoptionApplication{ID: 1, AnimalID: 1, ApplicantID: 2, Status: core.AdoptionSubmitted}, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1, AuthorID: intPtr(1), Status: core.AnimalStatusAdopted}, nil)
+
+	svc := adoption.New(mockAdoptions, mockAnimals, nil, config)
+
+	_, err := svc.UpdateApplication(ctx, core.UpdateAdoptionApplication{ID: 1, UserID: 1, Status: statusPtr(core.AdoptionApproved)})
+	assert.ErrorIs(t, err, core.ErrAnimalNotAdoptable)
+
+	mockAdoptions.AssertNotCalled(t, "ApproveApplication", ctx, mock.Anything, mock.Anything)
+}
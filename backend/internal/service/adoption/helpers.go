@@ -0,0 +1,41 @@
+package adoption
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+// validateAnswers checks that every required question is answered, answers fit their questions and there are no unknown answers.
+// Answers are trimmed in place.
+func validateAnswers(questions []core.AdoptionQuestion, answers core.AdoptionAnswers) error {
+	known := make(map[string]bool, len(questions))
+
+	for _, question := range questions {
+		known[question.Key] = true
+
+		answer := strings.TrimSpace(answers[question.Key])
+		if answer == "" {
+			if question.Required {
+				return fmt.Errorf("%w: answer to %q is required", core.ErrInvalidAdoptionAnswers, question.Key)
+			}
+			delete(answers, question.Key)
+			continue
+		}
+
+		if question.MaxLength > 0 && utf8.RuneCountInString(answer) > question.MaxLength {
+			return fmt.Errorf("%w: answer to %q is longer than %d characters", core.ErrInvalidAdoptionAnswers, question.Key, question.MaxLength)
+		}
+		answers[question.Key] = answer
+	}
+
+	for key := range answers {
+		if !known[key] {
+			return fmt.Errorf("%w: unknown question %q", core.ErrInvalidAdoptionAnswers, key)
+		}
+	}
+
+	return nil
+}
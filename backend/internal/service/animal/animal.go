@@ -0,0 +1,151 @@
+package animal
+
+import (
+	"context"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// eventClockSkew - events are recorded right after they happen, so clocks of clients may be slightly ahead of the server.
+const eventClockSkew = 5 * time.Minute
+
+type service struct {
+	animalStore  core.AnimalStore
+	mediaService core.MediaService
+}
+
+// New initializes a new instance of service
+func New(animalStore core.AnimalStore, mediaService core.MediaService) core.AnimalService {
+	return &service{
+		animalStore:  animalStore,
+		mediaService: mediaService,
+	}
+}
+
+// GetAnimals retrieves animals matching the filters
+func (s *service) GetAnimals(ctx context.Context, params core.GetAnimalsParams) ([]core.Animal, int, error) {
+	return s.animalStore.GetAnimals(ctx, params)
+}
+
+// GetAnimalByID retrieves the animal by its ID
+func (s *service) GetAnimalByID(ctx context.Context, id int) (core.Animal, error) {
+	return s.animalStore.GetAnimalByID(ctx, id)
+}
+
+// CreateAnimal registers the animal without a post, AuthorID of the animal must be set
+func (s *service) CreateAnimal(ctx context.Context, animal core.Animal) (core.Animal, error) {
+	animal.NormalizeIdentifiers()
+	if animal.Colors == nil {
+		animal.Colors = []string{}
+	}
+
+	return s.animalStore.CreateAnimal(ctx, animal)
+}
+
+// UpdateAnimal changes the animal, only its author and keeper may do it
+func (s *service) UpdateAnimal(ctx context.Context, update core.UpdateRequestBodyAnimal) (core.Animal, error) {
+	animal, err := s.getManagedAnimal(ctx, update.ID, update.UserID)
+	if err != nil {
+		return core.Animal{}, err
+	}
+
+	animal = applyUpdate(animal, update)
+	animal.NormalizeIdentifiers()
+
+	return s.animalStore.UpdateAnimal(ctx, animal)
+}
+
+// SetAnimalKeeper assigns the animal to the keeper, nil keeper leaves the animal without keeper.
+// The current keeper may pass the animal to another keeper as well as the author.
+func (s *service) SetAnimalKeeper(ctx context.Context, userID, id int, keeperUserID *int) (core.Animal, error) {
+	if _, err := s.getManagedAnimal(ctx, id, userID); err != nil {
+		return core.Animal{}, err
+	}
+
+	if err := s.animalStore.SetAnimalKeeper(ctx, id, keeperUserID); err != nil {
+		return core.Animal{}, err
+	}
+
+	return s.animalStore.GetAnimalByID(ctx, id)
+}
+
+// GetAnimalEvents retrieves case history of the animal with photos of the events
+func (s *service) GetAnimalEvents(ctx context.Context, animalID int) ([]core.AnimalEvent, error) {
+	if _, err := s.animalStore.GetAnimalByID(ctx, animalID); err != nil {
+		return nil, err
+	}
+
+	events, err := s.animalStore.GetAnimalEvents(ctx, animalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	eventIDs := make([]int, len(events))
+	for i, event := range events {
+		eventIDs[i] = event.ID
+	}
+
+	photos, err := s.mediaService.GetAnimalEventsPhotos(ctx, eventIDs)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	for i := range events {
+		events[i].Photos = photos[events[i].ID]
+	}
+
+	return events, nil
+}
+
+// AddAnimalEvent records the event in case history of the animal, only its author and keeper may do it
+func (s *service) AddAnimalEvent(ctx context.Context, event core.AnimalEvent, photos []core.MediaUpload) (core.AnimalEvent, error) {
+	if event.HappenedAt.After(time.Now().Add(eventClockSkew)) {
+		return core.AnimalEvent{}, core.ErrAnimalEventInFuture
+	}
+
+	if _, err := s.getManagedAnimal(ctx, event.AnimalID, event.AuthorID); err != nil {
+		return core.AnimalEvent{}, err
+	}
+
+	event, err := s.animalStore.CreateAnimalEvent(ctx, event)
+	if err != nil {
+		return core.AnimalEvent{}, err
+	}
+
+	if len(photos) == 0 {
+		return event, nil
+	}
+
+	event.Photos, err = s.mediaService.SetAnimalEventPhotos(ctx, event.ID, photos)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		// event is removed, so the user may record it again with other photos
+		if deleteErr := s.animalStore.DeleteAnimalEvent(ctx, event); deleteErr != nil {
+			logger.Log().Error(ctx, deleteErr.Error())
+		}
+		return core.AnimalEvent{}, err
+	}
+
+	return event, nil
+}
+
+// getManagedAnimal retrieves the animal the user may change
+func (s *service) getManagedAnimal(ctx context.Context, id, userID int) (core.Animal, error) {
+	animal, err := s.animalStore.GetAnimalByID(ctx, id)
+	if err != nil {
+		return core.Animal{}, err
+	}
+
+	if !animal.ManagedBy(userID) {
+		return core.Animal{}, core.ErrAnimalAccessDenied
+	}
+
+	return animal, nil
+}
@@ -0,0 +1,140 @@
+package animal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/kotopesp/sos-kotopes/internal/service/animal"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestUpdateAnimal_ByKeeper(t *testing.T) {
+	ctx := context.TODO()
+	mockAnimals := new(mocks.MockAnimalStore)
+
+	name, chip := "Murka", " 643-0941 "
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1, AuthorID: intPtr(1), KeeperUserID: intPtr(2)}, nil)
+	mockAnimals.On("UpdateAnimal", ctx, mock.MatchedBy(func(a core.Animal) bool {
+		return a.Name == name && a.MicrochipNumber != nil && *a.MicrochipNumber == "6430941"
+	})).Return(core.Animal{ID: 1, Name: name}, nil)
+
+	svc := animal.New(mockAnimals, nil)
+
+	updated, err := svc.UpdateAnimal(ctx, core.UpdateRequestBodyAnimal{ID: 1, UserID: 2, Name: &name, MicrochipNumber: &chip})
+	assert.NoError(t, err)
+	assert.Equal(t, name, updated.Name)
+
+	mockAnimals.AssertExpectations(t)
+}
+
+func TestUpdateAnimal_AccessDenied(t *testing.T) {
+	ctx := context.TODO()
+	mockAnimals := new(mocks.MockAnimalStore)
+
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1, AuthorID: intPtr(1)}, nil)
+
+	svc := animal.New(mockAnimals, nil)
+
+	_, err := svc.UpdateAnimal(ctx, core.UpdateRequestBodyAnimal{ID: 1, UserID: 3})
+	assert.ErrorIs(t, err, core.ErrAnimalAccessDenied)
+
+	mockAnimals.AssertNotCalled(t, "UpdateAnimal", ctx, mock.Anything)
+}
+
+func TestSetAnimalKeeper_NotKeeper(t *testing.T) {
+	ctx := context.TODO()
+	mockAnimals := new(mocks.MockAnimalStore)
+
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1, AuthorID: intPtr(1)}, nil)
+	mockAnimals.On("SetAnimalKeeper", ctx, 1, intPtr(5)).Return(core.ErrUserIsNotKeeper)
+
+	svc := animal.New(mockAnimals, nil)
+
+	_, err := svc.SetAnimalKeeper(ctx, 1, 1, intPtr(5))
+	assert.ErrorIs(t, err, core.ErrUserIsNotKeeper)
+
+	mockAnimals.AssertExpectations(t)
+}
+
+func TestAddAnimalEvent_InFuture(t *testing.T) {
+	ctx := context.TODO()
+	mockAnimals := new(mocks.MockAnimalStore)
+
+	svc := animal.New(mockAnimals, nil)
+
+	_, err := svc.AddAnimalEvent(ctx, core.AnimalEvent{
+		AnimalID:   1,
+		AuthorID:   1,
+		Type:       "at_vet",
+		HappenedAt: time.Now().Add(time.Hour),
+	}, nil)
+	assert.ErrorIs(t, err, core.ErrAnimalEventInFuture)
+
+	mockAnimals.AssertNotCalled(t, "CreateAnimalEvent", ctx, mock.Anything)
+}
+
+func TestAddAnimalEvent_PhotosFailed(t *testing.T) {
+	ctx := context.TODO()
+	mockAnimals := new(mocks.MockAnimalStore)
+	mockMedia := new(mocks.MockMediaService)
+
+	event := core.AnimalEvent{AnimalID: 1, AuthorID: 1, Type: "found"}
+	created := event
+	created.ID = 7
+	photos := []core.MediaUpload{{Data: []byte("not a photo")}}
+
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1, AuthorID: intPtr(1)}, nil)
+	mockAnimals.On("CreateAnimalEvent", ctx, event).Return(created, nil)
+	mockMedia.On("SetAnimalEventPhotos", ctx, 7, photos).Return(nil, core.ErrInvalidPhoto)
+	mockAnimals.On("DeleteAnimalEvent", ctx, created).Return(nil)
+
+	svc := animal.New(mockAnimals, mockMedia)
+
+	_, err := svc.AddAnimalEvent(ctx, event, photos)
+	assert.ErrorIs(t, err, core.ErrInvalidPhoto)
+
+	mockAnimals.AssertExpectations(t)
+	mockMedia.AssertExpectations(t)
+}
+
+func TestGetAnimalEvents_WithPhotos(t *testing.T) {
+	ctx := context.TODO()
+	mockAnimals := new(mocks.MockAnimalStore)
+	mockMedia := new(mocks.MockMediaService)
+
+	events := []core.AnimalEvent{{ID: 1, AnimalID: 1, Type: "found"}, {ID: 2, AnimalID: 1, Type: "at_vet"}}
+	photo := core.Media{ID: 10, OwnerType: core.MediaOwnerAnimalEvent, OwnerID: 2}
+
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1}, nil)
+	mockAnimals.On("GetAnimalEvents", ctx, 1).Return(events, nil)
+	mockMedia.On("GetAnimalEventsPhotos", ctx, []int{1, 2}).Return(map[int][]core.Media{2: {photo}}, nil)
+
+	svc := animal.New(mockAnimals, mockMedia)
+
+	got, err := svc.GetAnimalEvents(ctx, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, got[0].Photos)
+	assert.Equal(t, []core.Media{photo}, got[1].Photos)
+}
+
+func TestGetAnimalEvents_AnimalNotFound(t *testing.T) {
+	ctx := context.TODO()
+	mockAnimals := new(mocks.MockAnimalStore)
+
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{}, core.ErrAnimalNotFound)
+
+	svc := animal.New(mockAnimals, nil)
+
+	_, err := svc.GetAnimalEvents(ctx, 1)
+	assert.True(t, errors.Is(err, core.ErrAnimalNotFound))
+}
@@ -0,0 +1,80 @@
+package animal
+
+import "github.com/kotopesp/sos-kotopes/internal/core"
+
+// applyUpdate sets fields of the animal present in the update
+func applyUpdate(animal core.Animal, update core.UpdateRequestBodyAnimal) core.Animal {
+	if update.Name != nil {
+		animal.Name = *update.Name
+	}
+
+	if update.AnimalType != nil {
+		animal.AnimalType = *update.AnimalType
+	}
+
+	if update.Breed != nil {
+		animal.Breed = *update.Breed
+	}
+
+	if update.Size != nil {
+		animal.Size = update.Size
+	}
+
+	if update.Age != nil {
+		animal.Age = *update.Age
+	}
+
+	if update.Color != nil {
+		animal.Color = *update.Color
+	}
+
+	if update.Colors != nil {
+		animal.Colors = update.Colors
+	}
+
+	if update.CoatPattern != nil {
+		animal.CoatPattern = update.CoatPattern
+	}
+
+	if update.Gender != nil {
+		animal.Gender = *update.Gender
+	}
+
+	if update.Description != nil {
+		animal.Description = *update.Description
+	}
+
+	if update.DistinguishingMarks != nil {
+		animal.DistinguishingMarks = *update.DistinguishingMarks
+	}
+
+	if update.MicrochipNumber != nil {
+		animal.MicrochipNumber = update.MicrochipNumber
+	}
+
+	if update.TattooID != nil {
+		animal.TattooID = update.TattooID
+	}
+
+	if update.IsSterilized != nil {
+		animal.IsSterilized = update.IsSterilized
+	}
+
+	if update.IsVaccinated != nil {
+		animal.IsVaccinated = update.IsVaccinated
+	}
+
+	if update.HasCollar != nil {
+		animal.HasCollar = update.HasCollar
+	}
+
+	if update.CollarDescription != nil {
+		animal.CollarDescription = *update.CollarDescription
+	}
+
+	if update.Status != nil {
+		animal.Status = *update.Status
+	}
+
+	return animal
+}
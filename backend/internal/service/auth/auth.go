@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/kotopesp/sos-kotopes/internal/core"
 	refreshsession "github.com/kotopesp/sos-kotopes/internal/store/refresh_session"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -23,17 +24,20 @@ var authProvidersPasswordPlugs = map[string]string{
 type service struct {
 	userStore           core.UserStore
 	refreshSessionStore core.RefreshSessionStore
+	mediaService        core.MediaService
 	authServiceConfig   core.AuthServiceConfig
 }
 
 func New(
 	userStore core.UserStore,
 	refreshSessionStore core.RefreshSessionStore,
+	mediaService core.MediaService,
 	authServiceConfig core.AuthServiceConfig,
 ) core.AuthService {
 	return &service{
 		userStore:           userStore,
 		refreshSessionStore: refreshSessionStore,
+		mediaService:        mediaService,
 		authServiceConfig:   authServiceConfig,
 	}
 }
@@ -104,7 +108,7 @@ func (s *service) LoginBasic(ctx context.Context, user core.User) (accessToken,
 }
 
 // SignupBasic Signup through username and password (can be additional fields)
-func (s *service) SignupBasic(ctx context.Context, user core.User) error {
+func (s *service) SignupBasic(ctx context.Context, user core.User, photo *core.MediaUpload) error {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.PasswordHash), bcryptCost)
 	if err != nil {
 		return err
@@ -112,10 +116,18 @@ func (s *service) SignupBasic(ctx context.Context, user core.User) error {
 
 	user.PasswordHash = string(hashedPassword)
 
-	if _, err := s.userStore.CreateUser(ctx, user); err != nil {
+	userID, err := s.userStore.CreateUser(ctx, user)
+	if err != nil {
 		return err
 	}
 
+	// user is already created, so failed photo upload doesn't fail signup, photo can be uploaded later
+	if photo != nil {
+		if _, err := s.mediaService.SetUserPhoto(ctx, userID, *photo); err != nil {
+			logger.Log().Error(ctx, "Failed to save user photo: "+err.Error())
+		}
+	}
+
 	return nil
 }
 
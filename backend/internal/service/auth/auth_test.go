@@ -68,7 +68,7 @@ func TestLoginBasic(t *testing.T) {
 	mockRefreshSessionStore := mocks.NewMockRefreshSessionStore(t)
 	ctx := context.Background()
 
-	authService := New(mockUserStore, mockRefreshSessionStore, core.AuthServiceConfig{
+	authService := New(mockUserStore, mockRefreshSessionStore, nil, core.AuthServiceConfig{
 		JWTSecret:            secret,
 		AccessTokenLifetime:  accessTokenLifetime,
 		RefreshTokenLifetime: refreshTokenLifetime,
@@ -254,7 +254,7 @@ func TestSignupBasic(t *testing.T) {
 	mockRefreshSessionStore := mocks.NewMockRefreshSessionStore(t)
 	ctx := context.Background()
 
-	authService := New(mockUserStore, mockRefreshSessionStore, core.AuthServiceConfig{
+	authService := New(mockUserStore, mockRefreshSessionStore, nil, core.AuthServiceConfig{
 		JWTSecret:            secret,
 		AccessTokenLifetime:  accessTokenLifetime,
 		RefreshTokenLifetime: refreshTokenLifetime,
@@ -310,12 +310,35 @@ func TestSignupBasic(t *testing.T) {
 				).Return(1, tt.addUserRet2).Once()
 			}
 
-			err := authService.SignupBasic(ctx, tt.signupBasicArg2)
+			err := authService.SignupBasic(ctx, tt.signupBasicArg2, nil)
 			assert.ErrorIs(t, tt.wantErr, err)
 		})
 	}
 }
 
+func TestSignupBasic_WithPhoto(t *testing.T) {
+	t.Parallel()
+	mockUserStore := mocks.NewMockUserStore(t)
+	mockRefreshSessionStore := mocks.NewMockRefreshSessionStore(t)
+	mockMediaService := mocks.NewMockMediaService(t)
+	ctx := context.Background()
+
+	authService := New(mockUserStore, mockRefreshSessionStore, mockMediaService, core.AuthServiceConfig{
+		JWTSecret:            secret,
+		AccessTokenLifetime:  accessTokenLifetime,
+		RefreshTokenLifetime: refreshTokenLifetime,
+	})
+
+	photo := core.MediaUpload{Data: []byte{137, 80, 78, 71}}
+
+	mockUserStore.On("CreateUser", ctx, mock.AnythingOfType("core.User")).Return(7, nil).Once()
+	mockMediaService.On("SetUserPhoto", ctx, 7, photo).Return(core.Media{}, errors.New("storage is down")).Once()
+
+	// failed photo upload doesn't fail signup as the user is already created
+	err := authService.SignupBasic(ctx, core.User{Username: username, PasswordHash: password}, &photo)
+	assert.NoError(t, err)
+}
+
 func TestRefresh(t *testing.T) {
 	t.Parallel()
 	mockUserStore := mocks.NewMockUserStore(t)
@@ -325,7 +348,7 @@ func TestRefresh(t *testing.T) {
 	var hashedRefreshToken1Bytes = sha256.Sum256([]byte(refreshToken1))
 	var hashedRefreshToken1 = hex.EncodeToString(hashedRefreshToken1Bytes[:])
 
-	authService := New(mockUserStore, mockRefreshSessionStore, core.AuthServiceConfig{
+	authService := New(mockUserStore, mockRefreshSessionStore, nil, core.AuthServiceConfig{
 		JWTSecret:            secret,
 		AccessTokenLifetime:  accessTokenLifetime,
 		RefreshTokenLifetime: refreshTokenLifetime,
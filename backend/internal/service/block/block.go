@@ -0,0 +1,47 @@
+package block
+
+import (
+	"context"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+type service struct {
+	blockStore core.BlockStore
+	userStore  core.UserStore
+}
+
+// New initializes a new instance of service
+func New(blockStore core.BlockStore, userStore core.UserStore) core.BlockService {
+	return &service{
+		blockStore: blockStore,
+		userStore:  userStore,
+	}
+}
+
+// BlockUser blocks or mutes another existing user
+func (s *service) BlockUser(ctx context.Context, userID, blockedUserID int, blockType core.BlockType) error {
+	if userID == blockedUserID {
+		return core.ErrBlockYourself
+	}
+
+	if _, err := s.userStore.GetUser(ctx, blockedUserID); err != nil {
+		return err
+	}
+
+	return s.blockStore.BlockUser(ctx, core.UserBlock{
+		UserID:        userID,
+		BlockedUserID: blockedUserID,
+		Type:          blockType,
+	})
+}
+
+// UnblockUser removes the block or mute, the user who isn't blocked is left as is
+func (s *service) UnblockUser(ctx context.Context, userID, blockedUserID int, blockType core.BlockType) error {
+	return s.blockStore.UnblockUser(ctx, userID, blockedUserID, blockType)
+}
+
+// GetBlockedUsers retrieves users blocked or muted by the user
+func (s *service) GetBlockedUsers(ctx context.Context, params core.GetBlockedUsersParams) ([]core.UserBlock, int, error) {
+	return s.blockStore.GetBlockedUsers(ctx, params)
+}
@@ -0,0 +1,69 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		userID        int
+		blockedUserID int
+		getUserErr    error
+		invokeGetUser bool
+		invokeBlock   bool
+		wantErr       error
+	}{
+		{
+			name:          "success",
+			userID:        1,
+			blockedUserID: 2,
+			invokeGetUser: true,
+			invokeBlock:   true,
+		},
+		{
+			name:          "block yourself",
+			userID:        1,
+			blockedUserID: 1,
+			wantErr:       core.ErrBlockYourself,
+		},
+		{
+			name:          "no such user",
+			userID:        1,
+			blockedUserID: 2,
+			getUserErr:    core.ErrNoSuchUser,
+			invokeGetUser: true,
+			wantErr:       core.ErrNoSuchUser,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			blockStore := mocks.NewMockBlockStore(t)
+			userStore := mocks.NewMockUserStore(t)
+
+			if tt.invokeGetUser {
+				userStore.EXPECT().GetUser(ctx, tt.blockedUserID).Return(core.User{ID: tt.blockedUserID}, tt.getUserErr).Once()
+			}
+			if tt.invokeBlock {
+				blockStore.EXPECT().
+					BlockUser(ctx, core.UserBlock{UserID: tt.userID, BlockedUserID: tt.blockedUserID, Type: core.BlockTypeMute}).
+					Return(nil).Once()
+			}
+
+			err := New(blockStore, userStore).BlockUser(ctx, tt.userID, tt.blockedUserID, core.BlockTypeMute)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
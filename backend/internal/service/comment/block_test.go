@@ -0,0 +1,101 @@
+package commentservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateComment_BlockedByAuthorOfPost(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	postStore := mocks.NewMockPostStore(t)
+	postStore.EXPECT().GetPostByID(ctx, 1).Return(core.Post{ID: 1, AuthorID: 2}, nil).Once()
+
+	blockStore := mocks.NewMockBlockStore(t)
+	blockStore.EXPECT().GetBlockingUserIDs(ctx, 1, []int{2}).Return([]int{2}, nil).Once()
+
+	commentService := New(nil, postStore, nil, nil, nil, nil, nil, nil, blockStore, core.CommentServiceConfig{})
+
+	_, err := commentService.CreateComment(ctx, core.Comment{PostID: 1, AuthorID: 1, Content: "hi"})
+
+	assert.ErrorIs(t, err, core.ErrBlockedByUser)
+}
+
+func TestCreateComment_MentionOfBlockingUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	postStore := mocks.NewMockPostStore(t)
+	postStore.EXPECT().GetPostByID(ctx, 1).Return(core.Post{ID: 1, AuthorID: 1}, nil).Once()
+
+	commentStore := mocks.NewMockCommentStore(t)
+	commentStore.EXPECT().
+		CreateComment(ctx, mock.Anything).
+		Return(core.Comment{ID: 10, PostID: 1, AuthorID: 1, Status: core.Published, Content: "@alice @carol"}, nil).Once()
+
+	// alice blocked the author, so only carol is notified
+	blockStore := mocks.NewMockBlockStore(t)
+	blockStore.EXPECT().GetBlockingUserIDs(ctx, 1, []int{2, 4}).Return([]int{2}, nil).Once()
+
+	notificationStore := mocks.NewMockNotificationStore(t)
+	notificationStore.EXPECT().
+		CreateNotifications(ctx, []core.Notification{
+			core.CommentNotification(core.NotificationCommentMention, core.Comment{ID: 10}, 4),
+		}).
+		Return(nil).Once()
+
+	commentService := New(
+		commentStore,
+		postStore,
+		newCleanContentFilter(t),
+		newRevisionStore(t),
+		nil,
+		newUserStore(t),
+		newMentionStore(t),
+		notificationStore,
+		blockStore,
+		core.CommentServiceConfig{},
+	)
+
+	_, err := commentService.CreateComment(ctx, core.Comment{PostID: 1, AuthorID: 1, Content: "@alice @carol"})
+
+	assert.NoError(t, err)
+}
+
+func TestGetReplies_HiddenAuthors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	replies := []core.Comment{
+		{ID: 2, AuthorID: 3, Status: core.Published, Content: "blocked"},
+		{ID: 3, AuthorID: 4, Status: core.Published, Content: "visible"},
+	}
+
+	postStore := mocks.NewMockPostStore(t)
+	postStore.EXPECT().GetPostByID(ctx, 1).Return(core.Post{ID: 1}, nil).Once()
+
+	commentStore := mocks.NewMockCommentStore(t)
+	commentStore.EXPECT().GetCommentByID(ctx, 1).Return(core.Comment{ID: 1, PostID: 1, Status: core.Published}, nil).Once()
+	commentStore.EXPECT().GetReplies(ctx, mock.Anything).Return(replies, len(replies), nil).Once()
+
+	blockStore := mocks.NewMockBlockStore(t)
+	blockStore.EXPECT().GetHiddenUserIDs(ctx, 5).Return([]int{3}, nil).Once()
+
+	commentService := New(commentStore, postStore, nil, nil, newLikeStore(t), nil, newMentionStore(t), nil, blockStore, core.CommentServiceConfig{})
+
+	got, _, err := commentService.GetReplies(ctx, core.GetRepliesParams{PostID: 1, ParentID: 1, UserID: 5})
+
+	assert.NoError(t, err)
+	if assert.Len(t, got, 2) {
+		assert.Empty(t, got[0].Content)
+		assert.Equal(t, core.CommentPlaceholderHidden, got[0].Placeholder)
+		assert.Equal(t, "visible", got[1].Content)
+		assert.Empty(t, got[1].Placeholder)
+	}
+}
@@ -2,22 +2,48 @@ package commentservice
 
 import (
 	"context"
+	"time"
 
 	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
 )
 
 type service struct {
-	commentStore core.CommentStore
-	postStore    core.PostStore
+	commentStore      core.CommentStore
+	postStore         core.PostStore
+	contentFilter     core.ContentFilter
+	revisionStore     core.RevisionStore
+	likeStore         core.LikeStore
+	userStore         core.UserStore
+	mentionStore      core.MentionStore
+	notificationStore core.NotificationStore
+	blockStore        core.BlockStore
+	config            core.CommentServiceConfig
 }
 
 func New(
 	commentStore core.CommentStore,
 	postStore core.PostStore,
+	contentFilter core.ContentFilter,
+	revisionStore core.RevisionStore,
+	likeStore core.LikeStore,
+	userStore core.UserStore,
+	mentionStore core.MentionStore,
+	notificationStore core.NotificationStore,
+	blockStore core.BlockStore,
+	config core.CommentServiceConfig,
 ) core.CommentService {
 	return &service{
-		commentStore: commentStore,
-		postStore:    postStore,
+		commentStore:      commentStore,
+		postStore:         postStore,
+		contentFilter:     contentFilter,
+		revisionStore:     revisionStore,
+		likeStore:         likeStore,
+		userStore:         userStore,
+		mentionStore:      mentionStore,
+		notificationStore: notificationStore,
+		blockStore:        blockStore,
+		config:            config,
 	}
 }
 
@@ -26,14 +52,35 @@ func (s *service) GetAllComments(ctx context.Context, params core.GetAllComments
 		return nil, 0, err
 	}
 
-	return s.commentStore.GetAllComments(ctx, params)
+	comments, total, err := s.commentStore.GetAllComments(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := s.fillDetails(ctx, comments, params.UserID); err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
 }
 
 func (s *service) CreateComment(ctx context.Context, comment core.Comment) (data core.Comment, err error) {
-	if _, err := s.postStore.GetPostByID(ctx, comment.PostID); err != nil {
+	post, err := s.postStore.GetPostByID(ctx, comment.PostID)
+	if err != nil {
 		return comment, err
 	}
 
+	// the author of the post who blocked the user doesn't get comments from them
+	if post.AuthorID != comment.AuthorID {
+		blocking, err := s.blockStore.GetBlockingUserIDs(ctx, comment.AuthorID, []int{post.AuthorID})
+		if err != nil {
+			return comment, err
+		}
+		if len(blocking) > 0 {
+			return comment, core.ErrBlockedByUser
+		}
+	}
+
 	if comment.ParentID != nil {
 		dbComment, err := s.commentStore.GetCommentByID(ctx, *comment.ParentID)
 		if err != nil {
@@ -68,7 +115,17 @@ func (s *service) CreateComment(ctx context.Context, comment core.Comment) (data
 		}
 	}
 
-	return s.commentStore.CreateComment(ctx, comment)
+	s.filterComment(ctx, &comment, true)
+
+	created, err := s.commentStore.CreateComment(ctx, comment)
+	if err != nil {
+		return created, err
+	}
+
+	s.saveRevision(ctx, created)
+	s.saveMentions(ctx, &created, false)
+
+	return created, nil
 }
 
 func (s *service) UpdateComment(ctx context.Context, comment core.Comment) (data core.Comment, err error) {
@@ -83,9 +140,26 @@ func (s *service) UpdateComment(ctx context.Context, comment core.Comment) (data
 		return comment, core.ErrCommentPostIDMismatch
 	} else if dbComment.Status == core.Deleted {
 		return comment, core.ErrCommentIsDeleted
+	} else if s.config.EditWindow > 0 && time.Since(dbComment.CreatedAt) > s.config.EditWindow {
+		return comment, core.ErrCommentEditWindowExpired
 	}
 
-	return s.commentStore.UpdateComment(ctx, comment)
+	if comment.Content != dbComment.Content {
+		editedAt := time.Now().UTC()
+		comment.EditedAt = &editedAt
+	}
+
+	s.filterComment(ctx, &comment, false)
+
+	updated, err := s.commentStore.UpdateComment(ctx, comment)
+	if err != nil {
+		return updated, err
+	}
+
+	s.saveRevision(ctx, updated)
+	s.saveMentions(ctx, &updated, dbComment.Status == core.Published)
+
+	return updated, nil
 }
 
 func (s *service) DeleteComment(ctx context.Context, comment core.Comment) error {
@@ -104,3 +178,35 @@ func (s *service) DeleteComment(ctx context.Context, comment core.Comment) error
 
 	return s.commentStore.DeleteComment(ctx, comment)
 }
+
+// filterComment runs comment through the content filter and sends suspicious comment to moderation instead of publishing it.
+// Errors of the filter are logged and don't prevent publication.
+func (s *service) filterComment(ctx context.Context, comment *core.Comment, isNew bool) {
+	verdict, err := s.contentFilter.Check(ctx, core.FilteredContent{
+		AuthorID:    comment.AuthorID,
+		ContentType: core.ReportableTypeComment,
+		Text:        comment.Content,
+		New:         isNew,
+	})
+	if err != nil {
+		logger.Log().Error(ctx, "Failed to check comment content: "+err.Error())
+		return
+	}
+
+	if verdict.Suspicious {
+		comment.Status = core.OnModeration
+		comment.ModerationReason = &verdict.Reason
+	}
+}
+
+// saveRevision copies the saved comment to its history. The comment is already saved at this point,
+// so errors are logged and don't fail the request.
+func (s *service) saveRevision(ctx context.Context, comment core.Comment) {
+	_, err := s.revisionStore.CreateCommentRevision(ctx, core.CommentRevision{
+		CommentID: comment.ID,
+		Content:   comment.Content,
+	})
+	if err != nil {
+		logger.Log().Error(ctx, "Failed to save revision of comment: "+err.Error())
+	}
+}
@@ -12,6 +12,60 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// newCleanContentFilter returns content filter that lets any content through.
+func newCleanContentFilter(t *testing.T) *mocks.MockContentFilter {
+	contentFilter := mocks.NewMockContentFilter(t)
+	contentFilter.EXPECT().
+		Check(mock.Anything, mock.Anything).
+		Return(core.FilterVerdict{}, nil).Maybe()
+
+	return contentFilter
+}
+
+// newRevisionStore returns revision store that accepts any revision.
+func newRevisionStore(t *testing.T) *mocks.MockRevisionStore {
+	revisionStore := mocks.NewMockRevisionStore(t)
+	revisionStore.EXPECT().
+		CreateCommentRevision(mock.Anything, mock.Anything).
+		Return(core.CommentRevision{}, nil).Maybe()
+
+	return revisionStore
+}
+
+func newLikeStore(t *testing.T) *mocks.MockLikeStore {
+	likeStore := mocks.NewMockLikeStore(t)
+	likeStore.EXPECT().
+		GetCommentsLikes(mock.Anything, mock.Anything, mock.Anything).
+		Return(map[int]core.Likes{}, nil).Maybe()
+
+	return likeStore
+}
+
+// newBlockStore returns block store of users who block nobody.
+func newBlockStore(t *testing.T) *mocks.MockBlockStore {
+	blockStore := mocks.NewMockBlockStore(t)
+	blockStore.EXPECT().
+		GetBlockingUserIDs(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).Maybe()
+	blockStore.EXPECT().
+		GetHiddenUserIDs(mock.Anything, mock.Anything).
+		Return(nil, nil).Maybe()
+
+	return blockStore
+}
+
+func newMentionStore(t *testing.T) *mocks.MockMentionStore {
+	mentionStore := mocks.NewMockMentionStore(t)
+	mentionStore.EXPECT().
+		GetCommentsMentions(mock.Anything, mock.Anything).
+		Return(map[int][]core.CommentMention{}, nil).Maybe()
+	mentionStore.EXPECT().
+		SaveCommentMentions(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Maybe()
+
+	return mentionStore
+}
+
 func generateTestComments() []core.Comment {
 	// Example users
 	user1 := core.User{ID: 1, Username: "User1"}
@@ -86,9 +140,6 @@ func generateTestPost() core.Post {
 	now := time.Now()
 	twoDaysAgo := now.Add(-48 * time.Hour)
 
-	// Example photo as a byte slice (you would replace this with actual photo data in a real scenario)
-	photo := []byte{137, 80, 78, 71, 13, 10, 26, 10, 0, 0, 0, 13, 73, 72, 68, 82} // Example PNG file header
-
 	// Generate a test post
 	post := core.Post{
 		ID:        1,
@@ -99,7 +150,6 @@ func generateTestPost() core.Post {
 		Status:    core.Published,
 		CreatedAt: twoDaysAgo,
 		UpdatedAt: now,
-		Photo:     photo,
 	}
 
 	return post
@@ -139,6 +189,14 @@ func TestGetAllComments(t *testing.T) {
 	commentService := New(
 		commentStore,
 		postStore,
+		newCleanContentFilter(t),
+		newRevisionStore(t),
+		newLikeStore(t),
+		nil,
+		newMentionStore(t),
+		nil,
+		newBlockStore(t),
+		core.CommentServiceConfig{},
 	)
 
 	tests := []struct {
@@ -238,6 +296,14 @@ func TestCreateComment(t *testing.T) {
 	commentService := New(
 		commentStore,
 		postStore,
+		newCleanContentFilter(t),
+		newRevisionStore(t),
+		newLikeStore(t),
+		nil,
+		newMentionStore(t),
+		nil,
+		newBlockStore(t),
+		core.CommentServiceConfig{},
 	)
 
 	tests := []struct {
@@ -519,6 +585,14 @@ func TestUpdateComment(t *testing.T) {
 	commentService := New(
 		commentStore,
 		postStore,
+		newCleanContentFilter(t),
+		newRevisionStore(t),
+		newLikeStore(t),
+		nil,
+		newMentionStore(t),
+		nil,
+		newBlockStore(t),
+		core.CommentServiceConfig{},
 	)
 
 	tests := []struct {
@@ -640,6 +714,14 @@ func TestDeleteComment(t *testing.T) {
 	commentService := New(
 		commentStore,
 		postStore,
+		newCleanContentFilter(t),
+		newRevisionStore(t),
+		newLikeStore(t),
+		nil,
+		newMentionStore(t),
+		nil,
+		newBlockStore(t),
+		core.CommentServiceConfig{},
 	)
 
 	tests := []struct {
@@ -720,3 +802,39 @@ func TestDeleteComment(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateComment_SuspiciousContentSentToModeration(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	commentStore := mocks.NewMockCommentStore(t)
+	postStore := mocks.NewMockPostStore(t)
+	contentFilter := mocks.NewMockContentFilter(t)
+
+	comment := core.Comment{
+		Content:  "best casino here",
+		AuthorID: 1,
+		PostID:   1,
+	}
+	reason := `contains stop word "casino"`
+
+	postStore.EXPECT().GetPostByID(mock.Anything, comment.PostID).Return(generateTestPost(), nil).Once()
+	contentFilter.EXPECT().
+		Check(mock.Anything, core.FilteredContent{
+			AuthorID:    comment.AuthorID,
+			ContentType: core.ReportableTypeComment,
+			Text:        comment.Content,
+			New:         true,
+		}).
+		Return(core.FilterVerdict{Suspicious: true, Reason: reason}, nil).Once()
+	commentStore.EXPECT().
+		CreateComment(mock.Anything, mock.MatchedBy(func(c core.Comment) bool {
+			return c.Status == core.OnModeration && c.ModerationReason != nil && *c.ModerationReason == reason
+		})).
+		Return(comment, nil).Once()
+
+	commentService := New(commentStore, postStore, contentFilter, newRevisionStore(t), newLikeStore(t), nil, newMentionStore(t), nil, newBlockStore(t), core.CommentServiceConfig{})
+
+	_, err := commentService.CreateComment(ctx, comment)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,138 @@
+package commentservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateComment_EditWindow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		editWindow   time.Duration
+		createdAt    time.Time
+		content      string
+		wantErr      error
+		wantEditedAt bool
+	}{
+		{
+			name:         "within edit window",
+			editWindow:   time.Hour,
+			createdAt:    time.Now().UTC().Add(-30 * time.Minute),
+			content:      "new content",
+			wantEditedAt: true,
+		},
+		{
+			name:       "edit window expired",
+			editWindow: time.Hour,
+			createdAt:  time.Now().UTC().Add(-2 * time.Hour),
+			content:    "new content",
+			wantErr:    core.ErrCommentEditWindowExpired,
+		},
+		{
+			name:         "edit window disabled",
+			createdAt:    time.Now().UTC().Add(-365 * 24 * time.Hour),
+			content:      "new content",
+			wantEditedAt: true,
+		},
+		{
+			name:       "content isn't changed",
+			editWindow: time.Hour,
+			createdAt:  time.Now().UTC(),
+			content:    "old content",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			commentStore := mocks.NewMockCommentStore(t)
+			commentStore.EXPECT().
+				GetCommentByID(ctx, 1).
+				Return(core.Comment{ID: 1, PostID: 1, AuthorID: 1, Status: core.Published, Content: "old content", CreatedAt: tt.createdAt}, nil).
+				Once()
+
+			if tt.wantErr == nil {
+				commentStore.EXPECT().
+					UpdateComment(ctx, mock.MatchedBy(func(comment core.Comment) bool {
+						return (comment.EditedAt != nil) == tt.wantEditedAt
+					})).
+					RunAndReturn(func(_ context.Context, comment core.Comment) (core.Comment, error) {
+						return comment, nil
+					}).Once()
+			}
+
+			commentService := New(
+				commentStore,
+				nil,
+				newCleanContentFilter(t),
+				newRevisionStore(t),
+				nil,
+				nil,
+				newMentionStore(t),
+				nil,
+				newBlockStore(t),
+				core.CommentServiceConfig{EditWindow: tt.editWindow},
+			)
+
+			comment, err := commentService.UpdateComment(ctx, core.Comment{ID: 1, PostID: 1, AuthorID: 1, Content: tt.content})
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantEditedAt, comment.EditedAt != nil)
+			}
+		})
+	}
+}
+
+func TestGetReplies_Placeholders(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const userID = 5
+	reason := "spam"
+	editedAt := time.Now().UTC()
+	replies := []core.Comment{
+		{ID: 2, AuthorID: 1, Status: core.Published, Content: "visible", EditedAt: &editedAt},
+		{ID: 3, AuthorID: 1, Status: core.Deleted, Content: "deleted", EditedAt: &editedAt},
+		{ID: 4, AuthorID: 1, Status: core.OnModeration, Content: "on moderation", ModerationReason: &reason},
+		{ID: 5, AuthorID: userID, Status: core.OnModeration, Content: "own comment on moderation"},
+	}
+
+	postStore := mocks.NewMockPostStore(t)
+	postStore.EXPECT().GetPostByID(ctx, 1).Return(core.Post{ID: 1}, nil).Once()
+
+	commentStore := mocks.NewMockCommentStore(t)
+	commentStore.EXPECT().GetCommentByID(ctx, 1).Return(core.Comment{ID: 1, PostID: 1, Status: core.Published}, nil).Once()
+	commentStore.EXPECT().GetReplies(ctx, mock.Anything).Return(replies, len(replies), nil).Once()
+
+	commentService := New(commentStore, postStore, nil, nil, newLikeStore(t), nil, newMentionStore(t), nil, newBlockStore(t), core.CommentServiceConfig{})
+
+	got, _, err := commentService.GetReplies(ctx, core.GetRepliesParams{PostID: 1, ParentID: 1, UserID: userID})
+
+	assert.NoError(t, err)
+	if assert.Len(t, got, 4) {
+		assert.Equal(t, "visible", got[0].Content)
+		assert.Empty(t, got[0].Placeholder)
+
+		assert.Empty(t, got[1].Content)
+		assert.Nil(t, got[1].EditedAt)
+		assert.Equal(t, core.CommentPlaceholderDeleted, got[1].Placeholder)
+
+		assert.Empty(t, got[2].Content)
+		assert.Nil(t, got[2].ModerationReason)
+		assert.Equal(t, core.CommentPlaceholderOnModeration, got[2].Placeholder)
+
+		assert.Equal(t, "own comment on moderation", got[3].Content)
+		assert.Empty(t, got[3].Placeholder)
+	}
+}
@@ -0,0 +1,21 @@
+package commentservice
+
+import (
+	"context"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+// GetUserComments returns page of the comment history of the author, comments are hidden from the user who blocked or muted the author
+func (s *service) GetUserComments(ctx context.Context, params core.GetUserCommentsParams) ([]core.Comment, int, error) {
+	comments, total, err := s.commentStore.GetUserComments(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := s.fillDetails(ctx, comments, params.UserID); err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
@@ -0,0 +1,90 @@
+package commentservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserComments(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	limit, offset := 10, 0
+	errStore := errors.New("store error")
+
+	tests := []struct {
+		name          string
+		userID        int
+		storeErr      error
+		hiddenUserIDs []int
+		wantErr       error
+		wantContent   string
+		wantLikes     core.Likes
+	}{
+		{
+			name:        "success",
+			userID:      5,
+			wantContent: "hello",
+			wantLikes:   core.Likes{Count: 2, LikedByMe: true},
+		},
+		{
+			name:        "anonymous user",
+			wantContent: "hello",
+			wantLikes:   core.Likes{Count: 2},
+		},
+		{
+			name:          "author hidden by the user",
+			userID:        5,
+			hiddenUserIDs: []int{2},
+			wantLikes:     core.Likes{Count: 2, LikedByMe: true},
+		},
+		{
+			name:     "store error",
+			userID:   5,
+			storeErr: errStore,
+			wantErr:  errStore,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			commentStore := mocks.NewMockCommentStore(t)
+			likeStore := mocks.NewMockLikeStore(t)
+			blockStore := mocks.NewMockBlockStore(t)
+
+			params := core.GetUserCommentsParams{AuthorID: 2, Limit: &limit, Offset: &offset, UserID: tt.userID}
+			comments := []core.Comment{{ID: 7, PostID: 1, AuthorID: 2, Content: "hello", Status: core.Published}}
+
+			commentStore.EXPECT().GetUserComments(ctx, params).Return(comments, 1, tt.storeErr).Once()
+			if tt.storeErr == nil {
+				likeStore.EXPECT().GetCommentsLikes(ctx, []int{7}, tt.userID).
+					Return(map[int]core.Likes{7: tt.wantLikes}, nil).Once()
+			}
+			if tt.storeErr == nil && tt.userID != 0 {
+				blockStore.EXPECT().GetHiddenUserIDs(ctx, tt.userID).Return(tt.hiddenUserIDs, nil).Once()
+			}
+
+			commentService := New(commentStore, nil, nil, nil, likeStore, nil, newMentionStore(t), nil, blockStore, core.CommentServiceConfig{})
+
+			data, total, err := commentService.GetUserComments(ctx, params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, total)
+			assert.Len(t, data, 1)
+			assert.Equal(t, tt.wantContent, data[0].Content)
+			assert.Equal(t, tt.wantLikes, data[0].Likes)
+			assert.Equal(t, tt.hiddenUserIDs != nil, data[0].Placeholder == core.CommentPlaceholderHidden)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package commentservice
+
+import (
+	"context"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+// LikeComment likes the published comment of the published post, liking it again changes nothing
+func (s *service) LikeComment(ctx context.Context, postID, commentID, userID int) (core.Likes, error) {
+	if _, err := s.postStore.GetPostByID(ctx, postID); err != nil {
+		return core.Likes{}, err
+	}
+
+	comment, err := s.getPostComment(ctx, postID, commentID)
+	if err != nil {
+		return core.Likes{}, err
+	}
+
+	switch comment.Status {
+	case core.Published:
+	case core.Deleted:
+		return core.Likes{}, core.ErrCommentIsDeleted
+	default:
+		return core.Likes{}, core.ErrNoSuchComment
+	}
+
+	if err := s.likeStore.LikeComment(ctx, core.CommentLike{CommentID: commentID, UserID: userID}); err != nil {
+		return core.Likes{}, err
+	}
+
+	return s.getLikes(ctx, commentID, userID)
+}
+
+// UnlikeComment removes the like of the user, so likes of deleted comments may be taken back too
+func (s *service) UnlikeComment(ctx context.Context, postID, commentID, userID int) (core.Likes, error) {
+	if _, err := s.getPostComment(ctx, postID, commentID); err != nil {
+		return core.Likes{}, err
+	}
+
+	if err := s.likeStore.UnlikeComment(ctx, commentID, userID); err != nil {
+		return core.Likes{}, err
+	}
+
+	return s.getLikes(ctx, commentID, userID)
+}
+
+// getPostComment retrieves the comment and checks that it belongs to the post
+func (s *service) getPostComment(ctx context.Context, postID, commentID int) (core.Comment, error) {
+	comment, err := s.commentStore.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return core.Comment{}, err
+	}
+
+	if comment.PostID != postID {
+		return core.Comment{}, core.ErrCommentPostIDMismatch
+	}
+
+	return comment, nil
+}
+
+func (s *service) getLikes(ctx context.Context, commentID, userID int) (core.Likes, error) {
+	likes, err := s.likeStore.GetCommentsLikes(ctx, []int{commentID}, userID)
+	if err != nil {
+		return core.Likes{}, err
+	}
+
+	return likes[commentID], nil
+}
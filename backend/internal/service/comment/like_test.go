@@ -0,0 +1,131 @@
+package commentservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLikeComment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		comment    core.Comment
+		commentErr error
+		postErr    error
+		postID     int
+		wantLikes  core.Likes
+		wantErr    error
+		invokeLike bool
+	}{
+		{
+			name:       "success",
+			comment:    core.Comment{ID: 1, PostID: 1, Status: core.Published},
+			postID:     1,
+			wantLikes:  core.Likes{Count: 3, LikedByMe: true},
+			invokeLike: true,
+		},
+		{
+			name:    "post not published",
+			postErr: core.ErrPostNotFound,
+			postID:  1,
+			wantErr: core.ErrPostNotFound,
+		},
+		{
+			name:       "comment not found",
+			commentErr: core.ErrNoSuchComment,
+			postID:     1,
+			wantErr:    core.ErrNoSuchComment,
+		},
+		{
+			name:    "comment of another post",
+			comment: core.Comment{ID: 1, PostID: 2, Status: core.Published},
+			postID:  1,
+			wantErr: core.ErrCommentPostIDMismatch,
+		},
+		{
+			name:    "deleted comment",
+			comment: core.Comment{ID: 1, PostID: 1, Status: core.Deleted},
+			postID:  1,
+			wantErr: core.ErrCommentIsDeleted,
+		},
+		{
+			name:    "comment on moderation",
+			comment: core.Comment{ID: 1, PostID: 1, Status: core.OnModeration},
+			postID:  1,
+			wantErr: core.ErrNoSuchComment,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			commentStore := mocks.NewMockCommentStore(t)
+			postStore := mocks.NewMockPostStore(t)
+			likeStore := mocks.NewMockLikeStore(t)
+
+			postStore.EXPECT().GetPostByID(ctx, tt.postID).Return(core.Post{ID: tt.postID}, tt.postErr).Once()
+			if tt.postErr == nil {
+				commentStore.EXPECT().GetCommentByID(ctx, 1).Return(tt.comment, tt.commentErr).Once()
+			}
+			if tt.invokeLike {
+				likeStore.EXPECT().LikeComment(ctx, core.CommentLike{CommentID: 1, UserID: 5}).Return(nil).Once()
+				likeStore.EXPECT().GetCommentsLikes(ctx, []int{1}, 5).Return(map[int]core.Likes{1: tt.wantLikes}, nil).Once()
+			}
+
+			commentService := New(commentStore, postStore, nil, nil, likeStore, nil, newMentionStore(t), nil, newBlockStore(t), core.CommentServiceConfig{})
+
+			likes, err := commentService.LikeComment(ctx, tt.postID, 1, 5)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantLikes, likes)
+		})
+	}
+}
+
+func TestUnlikeComment_DeletedComment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	commentStore := mocks.NewMockCommentStore(t)
+	likeStore := mocks.NewMockLikeStore(t)
+
+	commentStore.EXPECT().GetCommentByID(ctx, 1).Return(core.Comment{ID: 1, PostID: 1, Status: core.Deleted}, nil).Once()
+	likeStore.EXPECT().UnlikeComment(ctx, 1, 5).Return(nil).Once()
+	likeStore.EXPECT().GetCommentsLikes(ctx, []int{1}, 5).Return(map[int]core.Likes{}, nil).Once()
+
+	commentService := New(commentStore, nil, nil, nil, likeStore, nil, newMentionStore(t), nil, newBlockStore(t), core.CommentServiceConfig{})
+
+	likes, err := commentService.UnlikeComment(ctx, 1, 1, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, core.Likes{}, likes)
+}
+
+func TestGetAllComments_Likes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	commentStore := mocks.NewMockCommentStore(t)
+	postStore := mocks.NewMockPostStore(t)
+	likeStore := mocks.NewMockLikeStore(t)
+
+	params := core.GetAllCommentsParams{PostID: 1, UserID: 5}
+	comments := []core.Comment{{ID: 1, PostID: 1}, {ID: 2, PostID: 1}}
+
+	postStore.EXPECT().GetPostByID(ctx, 1).Return(core.Post{ID: 1}, nil).Once()
+	commentStore.EXPECT().GetAllComments(ctx, params).Return(comments, 2, nil).Once()
+	likeStore.EXPECT().GetCommentsLikes(ctx, []int{1, 2}, 5).Return(map[int]core.Likes{2: {Count: 1, LikedByMe: true}}, nil).Once()
+
+	commentService := New(commentStore, postStore, nil, nil, likeStore, nil, newMentionStore(t), nil, newBlockStore(t), core.CommentServiceConfig{})
+
+	got, total, err := commentService.GetAllComments(ctx, params)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, core.Likes{}, got[0].Likes)
+	assert.Equal(t, core.Likes{Count: 1, LikedByMe: true}, got[1].Likes)
+}
@@ -0,0 +1,146 @@
+package commentservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// saveMentions resolves "@username" in content of the saved comment to users, saves mentions and notifies
+// users mentioned in the comment for the first time unless they blocked the author. Mentions saved before count as notified
+// only if the comment was published when they were saved, otherwise nobody saw them. The comment is already saved at this point,
+// so errors are logged and don't fail the request.
+func (s *service) saveMentions(ctx context.Context, comment *core.Comment, notifiedBefore bool) {
+	var previous []core.CommentMention
+	if notifiedBefore {
+		mentions, err := s.mentionStore.GetCommentsMentions(ctx, []int{comment.ID})
+		if err != nil {
+			logger.Log().Error(ctx, "Failed to get mentions of comment: "+err.Error())
+			return
+		}
+		previous = mentions[comment.ID]
+	}
+
+	mentions := s.resolveMentions(ctx, comment.Content)
+	if err := s.mentionStore.SaveCommentMentions(ctx, comment.ID, mentions); err != nil {
+		logger.Log().Error(ctx, "Failed to save mentions of comment: "+err.Error())
+		return
+	}
+	comment.Mentions = mentions
+
+	// comment on moderation isn't visible yet, mentioned users are called to it when moderator approves it
+	if comment.Status != core.Published {
+		return
+	}
+
+	s.notifyMentions(ctx, *comment, previous)
+}
+
+// NotifyApprovedComment notifies users mentioned in the comment approved by moderator. Only comments sent to moderation
+// by the content filter are notified, they weren't visible when their mentions were saved.
+// The comment is already approved at this point, so errors are logged only.
+func (s *service) NotifyApprovedComment(ctx context.Context, comment core.Comment) {
+	if comment.ModerationReason == nil {
+		return
+	}
+
+	mentions, err := s.mentionStore.GetCommentsMentions(ctx, []int{comment.ID})
+	if err != nil {
+		logger.Log().Error(ctx, "Failed to get mentions of comment: "+err.Error())
+		return
+	}
+
+	comment.Status = core.Published
+	comment.ModerationReason = nil
+	comment.Mentions = mentions[comment.ID]
+
+	s.notifyMentions(ctx, comment, nil)
+}
+
+// notifyMentions notifies users mentioned in the published comment except the author, users notified by previous mentions
+// and users who blocked the author.
+func (s *service) notifyMentions(ctx context.Context, comment core.Comment, previous []core.CommentMention) {
+	notified := map[int]bool{comment.AuthorID: true}
+	for _, mention := range previous {
+		notified[mention.UserID] = true
+	}
+
+	var userIDs []int
+	for _, mention := range comment.Mentions {
+		if notified[mention.UserID] {
+			continue
+		}
+		notified[mention.UserID] = true
+		userIDs = append(userIDs, mention.UserID)
+	}
+
+	if len(userIDs) == 0 {
+		return
+	}
+
+	// users who blocked the author aren't called by their mentions
+	blocking, err := s.blockStore.GetBlockingUserIDs(ctx, comment.AuthorID, userIDs)
+	if err != nil {
+		logger.Log().Error(ctx, "Failed to get users blocking author of comment: "+err.Error())
+		return
+	}
+	blocked := make(map[int]bool, len(blocking))
+	for _, userID := range blocking {
+		blocked[userID] = true
+	}
+
+	var notifications []core.Notification
+	for _, userID := range userIDs {
+		if !blocked[userID] {
+			notifications = append(notifications, core.CommentNotification(core.NotificationCommentMention, comment, userID))
+		}
+	}
+
+	if len(notifications) == 0 {
+		return
+	}
+
+	if err := s.notificationStore.CreateNotifications(ctx, notifications); err != nil {
+		logger.Log().Error(ctx, "Failed to notify mentioned users: "+err.Error())
+	}
+}
+
+// resolveMentions finds users mentioned in the content. Unknown, banned and deleted users
+// are left as plain text, as well as mentions of users beyond core.MaxCommentMentions.
+func (s *service) resolveMentions(ctx context.Context, content string) []core.CommentMention {
+	users := make(map[string]*core.User)
+	var mentions []core.CommentMention
+
+	for _, mention := range core.ParseMentions(content) {
+		user, resolved := users[mention.Username]
+		if !resolved {
+			if len(users) == core.MaxCommentMentions {
+				break
+			}
+
+			dbUser, err := s.userStore.GetUserByUsername(ctx, mention.Username)
+			switch {
+			case err == nil && dbUser.Status != core.UserBanned && dbUser.Status != core.UserDelete:
+				user = &dbUser
+			case err != nil && !errors.Is(err, core.ErrNoSuchUser):
+				logger.Log().Error(ctx, "Failed to resolve mention: "+err.Error())
+			}
+			users[mention.Username] = user
+		}
+
+		if user == nil {
+			continue
+		}
+
+		mentions = append(mentions, core.CommentMention{
+			UserID:   user.ID,
+			Username: user.Username,
+			Offset:   mention.Offset,
+			Length:   mention.Length,
+		})
+	}
+
+	return mentions
+}
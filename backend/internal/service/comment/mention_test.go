@@ -0,0 +1,246 @@
+package commentservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newUserStore returns user store knowing alice (2), carol (4), banned bob (3) and the author (1)
+func newUserStore(t *testing.T) *mocks.MockUserStore {
+	users := map[string]core.User{
+		"author": {ID: 1, Username: "author", Status: core.UserActive},
+		"alice":  {ID: 2, Username: "alice", Status: core.UserActive},
+		"bob":    {ID: 3, Username: "bob", Status: core.UserBanned},
+		"carol":  {ID: 4, Username: "carol", Status: core.UserActive},
+	}
+
+	userStore := mocks.NewMockUserStore(t)
+	userStore.EXPECT().
+		GetUserByUsername(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, username string) (core.User, error) {
+			user, ok := users[username]
+			if !ok {
+				return core.User{}, core.ErrNoSuchUser
+			}
+			return user, nil
+		}).Maybe()
+
+	return userStore
+}
+
+func TestCreateCommentMentions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		content           string
+		suspicious        bool
+		wantMentions      []core.CommentMention
+		wantNotifications []int
+	}{
+		{
+			name:    "mentions of known users",
+			content: "Hi @alice and @bob, write to me@example.com, @alice, @ghost and @carol.",
+			wantMentions: []core.CommentMention{
+				{UserID: 2, Username: "alice", Offset: 3, Length: 6},
+				{UserID: 2, Username: "alice", Offset: 45, Length: 6},
+				{UserID: 4, Username: "carol", Offset: 64, Length: 6},
+			},
+			wantNotifications: []int{2, 4},
+		},
+		{
+			name:    "offset in characters",
+			content: "Привет, @alice!",
+			wantMentions: []core.CommentMention{
+				{UserID: 2, Username: "alice", Offset: 8, Length: 6},
+			},
+			wantNotifications: []int{2},
+		},
+		{
+			name:    "author mentions themselves",
+			content: "@author",
+			wantMentions: []core.CommentMention{
+				{UserID: 1, Username: "author", Offset: 0, Length: 7},
+			},
+		},
+		{
+			name:       "comment on moderation",
+			content:    "@alice",
+			suspicious: true,
+			wantMentions: []core.CommentMention{
+				{UserID: 2, Username: "alice", Offset: 0, Length: 6},
+			},
+		},
+		{
+			name:    "no mentions",
+			content: "@alice_ and alice@ and a@alice",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			postStore := mocks.NewMockPostStore(t)
+			commentStore := mocks.NewMockCommentStore(t)
+			contentFilter := mocks.NewMockContentFilter(t)
+			mentionStore := mocks.NewMockMentionStore(t)
+			notificationStore := mocks.NewMockNotificationStore(t)
+
+			postStore.EXPECT().GetPostByID(ctx, 1).Return(core.Post{ID: 1}, nil).Once()
+			contentFilter.EXPECT().Check(ctx, mock.Anything).Return(core.FilterVerdict{Suspicious: tt.suspicious}, nil).Once()
+			commentStore.EXPECT().
+				CreateComment(ctx, mock.Anything).
+				RunAndReturn(func(_ context.Context, comment core.Comment) (core.Comment, error) {
+					comment.ID = 10
+					if comment.Status == "" {
+						comment.Status = core.Published
+					}
+					return comment, nil
+				}).Once()
+			mentionStore.EXPECT().SaveCommentMentions(ctx, 10, tt.wantMentions).Return(nil).Once()
+
+			if len(tt.wantNotifications) > 0 {
+				notifications := make([]core.Notification, len(tt.wantNotifications))
+				for i, userID := range tt.wantNotifications {
+					notifications[i] = core.CommentNotification(core.NotificationCommentMention, core.Comment{ID: 10}, userID)
+				}
+				notificationStore.EXPECT().CreateNotifications(ctx, notifications).Return(nil).Once()
+			}
+
+			commentService := New(commentStore, postStore, contentFilter, newRevisionStore(t), nil, newUserStore(t), mentionStore, notificationStore, newBlockStore(t), core.CommentServiceConfig{})
+
+			comment, err := commentService.CreateComment(ctx, core.Comment{PostID: 1, AuthorID: 1, Content: tt.content})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantMentions, comment.Mentions)
+		})
+	}
+}
+
+func TestUpdateCommentMentions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	commentStore := mocks.NewMockCommentStore(t)
+	mentionStore := mocks.NewMockMentionStore(t)
+	notificationStore := mocks.NewMockNotificationStore(t)
+
+	dbComment := core.Comment{ID: 10, PostID: 1, AuthorID: 1, Status: core.Published, Content: "@alice"}
+	commentStore.EXPECT().GetCommentByID(ctx, 10).Return(dbComment, nil).Once()
+	commentStore.EXPECT().
+		UpdateComment(ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, comment core.Comment) (core.Comment, error) {
+			comment.Status = core.Published
+			return comment, nil
+		}).Once()
+
+	mentionStore.EXPECT().
+		GetCommentsMentions(ctx, []int{10}).
+		Return(map[int][]core.CommentMention{10: {{CommentID: 10, UserID: 2, Username: "alice", Offset: 0, Length: 6}}}, nil).
+		Once()
+	wantMentions := []core.CommentMention{
+		{UserID: 2, Username: "alice", Offset: 0, Length: 6},
+		{UserID: 4, Username: "carol", Offset: 11, Length: 6},
+	}
+	mentionStore.EXPECT().SaveCommentMentions(ctx, 10, wantMentions).Return(nil).Once()
+
+	// alice was mentioned before the edit, so only carol is notified
+	notificationStore.EXPECT().
+		CreateNotifications(ctx, []core.Notification{
+			core.CommentNotification(core.NotificationCommentMention, core.Comment{ID: 10}, 4),
+		}).
+		Return(nil).Once()
+
+	commentService := New(commentStore, nil, newCleanContentFilter(t), newRevisionStore(t), nil, newUserStore(t), mentionStore, notificationStore, newBlockStore(t), core.CommentServiceConfig{})
+
+	comment, err := commentService.UpdateComment(ctx, core.Comment{ID: 10, PostID: 1, AuthorID: 1, Content: "@alice and @carol"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, wantMentions, comment.Mentions)
+}
+
+func TestUpdateCommentMentions_CommentOnModeration(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	commentStore := mocks.NewMockCommentStore(t)
+	mentionStore := mocks.NewMockMentionStore(t)
+	notificationStore := mocks.NewMockNotificationStore(t)
+
+	// alice was mentioned while the comment was on moderation, so she wasn't notified
+	dbComment := core.Comment{ID: 10, PostID: 1, AuthorID: 1, Status: core.OnModeration, Content: "@alice"}
+	commentStore.EXPECT().GetCommentByID(ctx, 10).Return(dbComment, nil).Once()
+	commentStore.EXPECT().
+		UpdateComment(ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, comment core.Comment) (core.Comment, error) {
+			comment.Status = core.Published
+			return comment, nil
+		}).Once()
+
+	wantMentions := []core.CommentMention{{UserID: 2, Username: "alice", Offset: 0, Length: 6}}
+	mentionStore.EXPECT().SaveCommentMentions(ctx, 10, wantMentions).Return(nil).Once()
+	notificationStore.EXPECT().
+		CreateNotifications(ctx, []core.Notification{
+			core.CommentNotification(core.NotificationCommentMention, core.Comment{ID: 10}, 2),
+		}).
+		Return(nil).Once()
+
+	commentService := New(commentStore, nil, newCleanContentFilter(t), newRevisionStore(t), nil, newUserStore(t), mentionStore, notificationStore, newBlockStore(t), core.CommentServiceConfig{})
+
+	_, err := commentService.UpdateComment(ctx, core.Comment{ID: 10, PostID: 1, AuthorID: 1, Content: "@alice"})
+
+	assert.NoError(t, err)
+	mentionStore.AssertNotCalled(t, "GetCommentsMentions", ctx, []int{10})
+}
+
+func TestNotifyApprovedComment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	postStore := mocks.NewMockPostStore(t)
+	commentStore := mocks.NewMockCommentStore(t)
+	contentFilter := mocks.NewMockContentFilter(t)
+	mentionStore := mocks.NewMockMentionStore(t)
+	notificationStore := mocks.NewMockNotificationStore(t)
+
+	// the content filter sends the comment to moderation, nobody is notified yet
+	postStore.EXPECT().GetPostByID(ctx, 1).Return(core.Post{ID: 1}, nil).Once()
+	contentFilter.EXPECT().Check(ctx, mock.Anything).Return(core.FilterVerdict{Suspicious: true, Reason: "link"}, nil).Once()
+	commentStore.EXPECT().
+		CreateComment(ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, comment core.Comment) (core.Comment, error) {
+			comment.ID = 10
+			return comment, nil
+		}).Once()
+	mentions := []core.CommentMention{
+		{UserID: 2, Username: "alice", Offset: 0, Length: 6},
+		{UserID: 1, Username: "author", Offset: 7, Length: 7},
+	}
+	mentionStore.EXPECT().SaveCommentMentions(ctx, 10, mentions).Return(nil).Once()
+
+	commentService := New(commentStore, postStore, contentFilter, newRevisionStore(t), nil, newUserStore(t), mentionStore, notificationStore, newBlockStore(t), core.CommentServiceConfig{})
+
+	comment, err := commentService.CreateComment(ctx, core.Comment{PostID: 1, AuthorID: 1, Content: "@alice @author"})
+	assert.NoError(t, err)
+	assert.Equal(t, core.OnModeration, comment.Status)
+
+	// moderator approves the comment, mentioned users are notified except the author
+	mentionStore.EXPECT().GetCommentsMentions(ctx, []int{10}).Return(map[int][]core.CommentMention{10: mentions}, nil).Once()
+	notificationStore.EXPECT().
+		CreateNotifications(ctx, []core.Notification{
+			core.CommentNotification(core.NotificationCommentMention, core.Comment{ID: 10}, 2),
+		}).
+		Return(nil).Once()
+
+	commentService.NotifyApprovedComment(ctx, comment)
+
+	// comments sent to moderation by reports were visible when their mentions were saved
+	commentService.NotifyApprovedComment(ctx, core.Comment{ID: 11, AuthorID: 1, Status: core.OnModeration})
+}
@@ -0,0 +1,143 @@
+package commentservice
+
+import (
+	"context"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+// GetCommentThreads returns page of top-level comments of the post, every one with the first replies and amount of all replies
+func (s *service) GetCommentThreads(ctx context.Context, params core.GetAllCommentsParams) ([]core.CommentThread, int, error) {
+	if _, err := s.postStore.GetPostByID(ctx, params.PostID); err != nil {
+		return nil, 0, err
+	}
+
+	roots, total, err := s.commentStore.GetRootComments(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rootIDs := make([]int, len(roots))
+	for i, root := range roots {
+		rootIDs[i] = root.ID
+	}
+
+	replies, err := s.commentStore.GetFirstReplies(ctx, rootIDs, params.Replies)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	counts, err := s.commentStore.CountReplies(ctx, rootIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := s.fillDetails(ctx, roots, params.UserID); err != nil {
+		return nil, 0, err
+	}
+	if err := s.fillDetails(ctx, replies, params.UserID); err != nil {
+		return nil, 0, err
+	}
+
+	threadReplies := make(map[int][]core.Comment, len(roots))
+	for _, reply := range replies {
+		threadReplies[*reply.ParentID] = append(threadReplies[*reply.ParentID], reply)
+	}
+
+	threads := make([]core.CommentThread, len(roots))
+	for i, root := range roots {
+		threads[i] = core.CommentThread{
+			Comment:      root,
+			Replies:      threadReplies[root.ID],
+			RepliesCount: counts[root.ID],
+		}
+	}
+
+	return threads, total, nil
+}
+
+// GetReplies returns page of replies of the top-level comment of the post
+func (s *service) GetReplies(ctx context.Context, params core.GetRepliesParams) ([]core.Comment, int, error) {
+	if _, err := s.postStore.GetPostByID(ctx, params.PostID); err != nil {
+		return nil, 0, err
+	}
+
+	parent, err := s.getPostComment(ctx, params.PostID, params.ParentID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// replies of replies are kept in the thread of the top-level comment and point to the reply by reply_id
+	if parent.ParentID != nil {
+		return nil, 0, core.ErrInvalidCommentParentID
+	}
+
+	replies, total, err := s.commentStore.GetReplies(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := s.fillDetails(ctx, replies, params.UserID); err != nil {
+		return nil, 0, err
+	}
+
+	return replies, total, nil
+}
+
+// fillDetails sets likes and mentions of the comments fetched at once and hides content the user may not see
+func (s *service) fillDetails(ctx context.Context, comments []core.Comment, userID int) error {
+	commentIDs := make([]int, len(comments))
+	for i, comment := range comments {
+		commentIDs[i] = comment.ID
+	}
+
+	likes, err := s.likeStore.GetCommentsLikes(ctx, commentIDs, userID)
+	if err != nil {
+		return err
+	}
+
+	mentions, err := s.mentionStore.GetCommentsMentions(ctx, commentIDs)
+	if err != nil {
+		return err
+	}
+
+	hidden := make(map[int]bool)
+	if userID != 0 {
+		hiddenUserIDs, err := s.blockStore.GetHiddenUserIDs(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, hiddenUserID := range hiddenUserIDs {
+			hidden[hiddenUserID] = true
+		}
+	}
+
+	for i, comment := range comments {
+		comments[i].Likes = likes[comment.ID]
+		comments[i].Mentions = mentions[comment.ID]
+		hideContent(&comments[i], userID, hidden[comment.AuthorID])
+	}
+
+	return nil
+}
+
+// hideContent replaces content of the deleted comment, the comment on moderation and the comment of the user
+// blocked or muted by the current user with the placeholder, so replies to it keep their place in the thread.
+// The author still sees own comment on moderation.
+func hideContent(comment *core.Comment, userID int, authorHidden bool) {
+	switch {
+	case comment.Status == core.Deleted:
+		comment.Placeholder = core.CommentPlaceholderDeleted
+	case comment.Status == core.OnModeration && comment.AuthorID != userID:
+		comment.Placeholder = core.CommentPlaceholderOnModeration
+	case authorHidden:
+		comment.Placeholder = core.CommentPlaceholderHidden
+	default:
+		return
+	}
+
+	comment.Content = ""
+	comment.ModerationReason = nil
+	comment.Mentions = nil
+	comment.EditedAt = nil
+}
@@ -0,0 +1,113 @@
+package commentservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCommentThreads(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	commentStore := mocks.NewMockCommentStore(t)
+	postStore := mocks.NewMockPostStore(t)
+	likeStore := mocks.NewMockLikeStore(t)
+
+	first, second := 1, 2
+	params := core.GetAllCommentsParams{PostID: 1, Replies: 2, UserID: 5}
+	roots := []core.Comment{{ID: 1, PostID: 1}, {ID: 2, PostID: 1}, {ID: 3, PostID: 1}}
+	replies := []core.Comment{
+		{ID: 4, PostID: 1, ParentID: &first},
+		{ID: 5, PostID: 1, ParentID: &first, ReplyID: &[]int{4}[0]},
+		{ID: 6, PostID: 1, ParentID: &second},
+	}
+
+	postStore.EXPECT().GetPostByID(ctx, 1).Return(core.Post{ID: 1}, nil).Once()
+	commentStore.EXPECT().GetRootComments(ctx, params).Return(roots, 10, nil).Once()
+	commentStore.EXPECT().GetFirstReplies(ctx, []int{1, 2, 3}, 2).Return(replies, nil).Once()
+	commentStore.EXPECT().CountReplies(ctx, []int{1, 2, 3}).Return(map[int]int{1: 7, 2: 1}, nil).Once()
+	likeStore.EXPECT().GetCommentsLikes(ctx, mock.Anything, 5).Return(map[int]core.Likes{5: {Count: 1, LikedByMe: true}}, nil).Twice()
+
+	commentService := New(commentStore, postStore, nil, nil, likeStore, nil, newMentionStore(t), nil, newBlockStore(t), core.CommentServiceConfig{})
+
+	threads, total, err := commentService.GetCommentThreads(ctx, params)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, total)
+	assert.Len(t, threads, 3)
+
+	assert.Equal(t, 1, threads[0].Comment.ID)
+	assert.Equal(t, 7, threads[0].RepliesCount)
+	assert.Equal(t, []int{4, 5}, []int{threads[0].Replies[0].ID, threads[0].Replies[1].ID})
+	assert.Equal(t, core.Likes{Count: 1, LikedByMe: true}, threads[0].Replies[1].Likes)
+
+	assert.Equal(t, 1, threads[1].RepliesCount)
+	assert.Len(t, threads[1].Replies, 1)
+
+	assert.Zero(t, threads[2].RepliesCount)
+	assert.Empty(t, threads[2].Replies)
+}
+
+func TestGetReplies(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	parentID := 1
+
+	tests := []struct {
+		name          string
+		parent        core.Comment
+		parentErr     error
+		wantErr       error
+		invokeReplies bool
+	}{
+		{
+			name:          "success",
+			parent:        core.Comment{ID: 2, PostID: 1},
+			invokeReplies: true,
+		},
+		{
+			name:      "comment not found",
+			parentErr: core.ErrNoSuchComment,
+			wantErr:   core.ErrNoSuchComment,
+		},
+		{
+			name:    "comment of another post",
+			parent:  core.Comment{ID: 2, PostID: 3},
+			wantErr: core.ErrCommentPostIDMismatch,
+		},
+		{
+			name:    "replies of reply",
+			parent:  core.Comment{ID: 2, PostID: 1, ParentID: &parentID},
+			wantErr: core.ErrInvalidCommentParentID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			commentStore := mocks.NewMockCommentStore(t)
+			postStore := mocks.NewMockPostStore(t)
+			likeStore := mocks.NewMockLikeStore(t)
+
+			params := core.GetRepliesParams{PostID: 1, ParentID: 2}
+
+			postStore.EXPECT().GetPostByID(ctx, 1).Return(core.Post{ID: 1}, nil).Once()
+			commentStore.EXPECT().GetCommentByID(ctx, 2).Return(tt.parent, tt.parentErr).Once()
+			if tt.invokeReplies {
+				commentStore.EXPECT().GetReplies(ctx, params).Return([]core.Comment{{ID: 3}}, 1, nil).Once()
+				likeStore.EXPECT().GetCommentsLikes(ctx, []int{3}, 0).Return(map[int]core.Likes{}, nil).Once()
+			}
+
+			commentService := New(commentStore, postStore, nil, nil, likeStore, nil, newMentionStore(t), nil, newBlockStore(t), core.CommentServiceConfig{})
+
+			_, _, err := commentService.GetReplies(ctx, params)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package contentfilter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+type service struct {
+	postStore    core.PostStore
+	commentStore core.CommentStore
+	config       core.ContentFilterConfig
+	stopWords    map[string]string // stem of the stop word -> stop word
+}
+
+// New creates content filter, stop words are normalized once on creation.
+func New(postStore core.PostStore, commentStore core.CommentStore, config core.ContentFilterConfig) core.ContentFilter {
+	stopWords := make(map[string]string, len(config.StopWords))
+	for _, word := range config.StopWords {
+		word = strings.TrimSpace(word)
+		if word == "" {
+			continue
+		}
+		stopWords[stem(normalize(word))] = word
+	}
+
+	return &service{
+		postStore:    postStore,
+		commentStore: commentStore,
+		config:       config,
+		stopWords:    stopWords,
+	}
+}
+
+var (
+	linkRegexp  = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+|\b[a-z0-9][a-z0-9-]*\.(?:ru|su|com|net|org|info|biz|xyz|top|io|me|cc)\b`)
+	phoneRegexp = regexp.MustCompile(`\+?\d[\d\s\-()]{8,}\d`)
+)
+
+// Check - runs content through stop words, spam heuristics and author activity rate.
+// The first failed check defines the reason of the verdict.
+func (s *service) Check(ctx context.Context, content core.FilteredContent) (core.FilterVerdict, error) {
+	if word, ok := s.findStopWord(content.Text); ok {
+		return suspicious(fmt.Sprintf("contains stop word %q", word)), nil
+	}
+
+	if s.config.MaxLinks > 0 {
+		if links := len(linkRegexp.FindAllString(content.Text, -1)); links > s.config.MaxLinks {
+			return suspicious(fmt.Sprintf("contains too many links: %d", links)), nil
+		}
+	}
+
+	if s.config.MaxPhones > 0 {
+		if phones := countPhones(content.Text); phones > s.config.MaxPhones {
+			return suspicious(fmt.Sprintf("contains too many phone numbers: %d", phones)), nil
+		}
+	}
+
+	if content.New {
+		return s.checkRate(ctx, content)
+	}
+
+	return core.FilterVerdict{}, nil
+}
+
+// checkRate - marks content as suspicious if author creates too much content within the configured window.
+func (s *service) checkRate(ctx context.Context, content core.FilteredContent) (core.FilterVerdict, error) {
+	if s.config.RateWindow <= 0 {
+		return core.FilterVerdict{}, nil
+	}
+
+	since := time.Now().UTC().Add(-s.config.RateWindow)
+
+	var (
+		count int
+		limit int
+		err   error
+	)
+
+	switch content.ContentType {
+	case core.ReportableTypePost:
+		limit = s.config.PostRateLimit
+		if limit > 0 {
+			count, err = s.postStore.CountUserPostsSince(ctx, content.AuthorID, since)
+		}
+	case core.ReportableTypeComment:
+		limit = s.config.CommentRateLimit
+		if limit > 0 {
+			count, err = s.commentStore.CountUserCommentsSince(ctx, content.AuthorID, since)
+		}
+	default:
+		return core.FilterVerdict{}, core.ErrInvalidReportableType
+	}
+
+	if err != nil {
+		return core.FilterVerdict{}, err
+	}
+
+	if limit > 0 && count >= limit {
+		return suspicious(fmt.Sprintf("author created %d %ss in the last %s", count, content.ContentType, s.config.RateWindow)), nil
+	}
+
+	return core.FilterVerdict{}, nil
+}
+
+// findStopWord - returns stop word found in the text regardless of its word form.
+func (s *service) findStopWord(text string) (string, bool) {
+	if len(s.stopWords) == 0 {
+		return "", false
+	}
+
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for _, word := range words {
+		if stopWord, ok := s.stopWords[stem(normalize(word))]; ok {
+			return stopWord, true
+		}
+	}
+
+	return "", false
+}
+
+func suspicious(reason string) core.FilterVerdict {
+	return core.FilterVerdict{Suspicious: true, Reason: reason}
+}
+
+// countPhones - counts sequences of digits that look like phone numbers.
+func countPhones(text string) int {
+	var phones int
+
+	for _, match := range phoneRegexp.FindAllString(text, -1) {
+		var digits int
+		for _, r := range match {
+			if unicode.IsDigit(r) {
+				digits++
+			}
+		}
+
+		if digits >= 10 && digits <= 15 {
+			phones++
+		}
+	}
+
+	return phones
+}
+
+// latinLookalikes - latin letters that are used instead of cyrillic ones to bypass filters.
+var latinLookalikes = map[rune]rune{
+	'a': 'а', 'b': 'в', 'c': 'с', 'e': 'е', 'h': 'н', 'k': 'к', 'm': 'м',
+	'o': 'о', 'p': 'р', 't': 'т', 'x': 'х', 'y': 'у',
+}
+
+// normalize - lowercases the word, replaces "ё" with "е" and latin lookalikes in cyrillic words.
+func normalize(word string) string {
+	word = strings.ToLower(word)
+	word = strings.ReplaceAll(word, "ё", "е")
+
+	if !strings.ContainsFunc(word, func(r rune) bool { return unicode.Is(unicode.Cyrillic, r) }) {
+		return word
+	}
+
+	return strings.Map(func(r rune) rune {
+		if cyrillic, ok := latinLookalikes[r]; ok {
+			return cyrillic
+		}
+		return r
+	}, word)
+}
+
+// suffixes - russian and english inflectional endings, longer endings go first.
+var suffixes = []string{
+	"ями", "ами", "ого", "ему", "ому", "ыми", "ими", "ing",
+	"ов", "ев", "ей", "ий", "ый", "ой", "ая", "яя", "ое", "ее", "ые", "ие", "ым", "им", "ом", "ем", "ах", "ях", "ую", "юю", "es", "ed", "ly",
+	"а", "я", "о", "е", "ы", "и", "у", "ю", "ь", "й", "s",
+}
+
+// minStemLength - stem is never shorter than this amount of letters, so short words are compared as is.
+const minStemLength = 3
+
+// stem - strips inflectional ending from the normalized word, so different forms of the word match each other.
+func stem(word string) string {
+	length := len([]rune(word))
+
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) && length-len([]rune(suffix)) >= minStemLength {
+			return strings.TrimSuffix(word, suffix)
+		}
+	}
+
+	return word
+}
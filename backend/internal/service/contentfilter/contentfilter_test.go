@@ -0,0 +1,82 @@
+package contentfilter_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/kotopesp/sos-kotopes/internal/service/contentfilter"
+)
+
+func TestCheck(t *testing.T) {
+	config := core.ContentFilterConfig{
+		StopWords: []string{"казино", "ставка", "casino"},
+		MaxLinks:  1,
+		MaxPhones: 1,
+	}
+	filter := contentfilter.New(nil, nil, config)
+
+	tests := []struct {
+		name       string
+		text       string
+		suspicious bool
+	}{
+		{name: "clean", text: "Нашли рыжего кота возле метро, звоните +7 (999) 123-45-67", suspicious: false},
+		{name: "stop word", text: "Лучшее казино города", suspicious: true},
+		{name: "stop word in another form", text: "Принимаем ставки на котиков", suspicious: true},
+		{name: "stop word with latin lookalikes", text: "Играй в кaзинo", suspicious: true},
+		{name: "english stop word form", text: "Online CASINOS", suspicious: true},
+		{name: "word with the same prefix", text: "Ставил миску у подъезда", suspicious: false},
+		{name: "one link", text: "Подробности на https://example.org/cat", suspicious: false},
+		{name: "too many links", text: "see cats.ru and www.cats.com", suspicious: true},
+		{name: "too many phones", text: "+7 999 123 45 67 или 8-800-555-35-35", suspicious: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := filter.Check(context.TODO(), core.FilteredContent{
+				ContentType: core.ReportableTypePost,
+				Text:        tt.text,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.suspicious, verdict.Suspicious, verdict.Reason)
+			if tt.suspicious {
+				assert.NotEmpty(t, verdict.Reason)
+			}
+		})
+	}
+}
+
+func TestCheck_Rate(t *testing.T) {
+	ctx := context.TODO()
+	postStore := mocks.NewMockPostStore(t)
+	commentStore := mocks.NewMockCommentStore(t)
+
+	config := core.ContentFilterConfig{
+		RateWindow:       time.Hour,
+		PostRateLimit:    3,
+		CommentRateLimit: 10,
+	}
+	filter := contentfilter.New(postStore, commentStore, config)
+
+	postStore.EXPECT().CountUserPostsSince(ctx, 1, mock.Anything).Return(3, nil).Once()
+	commentStore.EXPECT().CountUserCommentsSince(ctx, 1, mock.Anything).Return(2, nil).Once()
+
+	verdict, err := filter.Check(ctx, core.FilteredContent{AuthorID: 1, ContentType: core.ReportableTypePost, Text: "cat", New: true})
+	assert.NoError(t, err)
+	assert.True(t, verdict.Suspicious)
+
+	verdict, err = filter.Check(ctx, core.FilteredContent{AuthorID: 1, ContentType: core.ReportableTypeComment, Text: "cat", New: true})
+	assert.NoError(t, err)
+	assert.False(t, verdict.Suspicious)
+
+	// rate is not checked when content is updated
+	verdict, err = filter.Check(ctx, core.FilteredContent{AuthorID: 1, ContentType: core.ReportableTypePost, Text: "cat"})
+	assert.NoError(t, err)
+	assert.False(t, verdict.Suspicious)
+}
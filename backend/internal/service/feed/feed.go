@@ -0,0 +1,99 @@
+package feed
+
+import (
+	"context"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+type service struct {
+	feedStore   core.FeedStore
+	blockStore  core.BlockStore
+	postService core.PostService
+	config      core.FeedServiceConfig
+}
+
+// New initializes a new instance of service
+func New(feedStore core.FeedStore, blockStore core.BlockStore, postService core.PostService, config core.FeedServiceConfig) core.FeedService {
+	return &service{
+		feedStore:   feedStore,
+		blockStore:  blockStore,
+		postService: postService,
+		config:      config,
+	}
+}
+
+// GetFeed returns the page of the feed of the user: posts nearby, matching preferences of the user, of their favourite users
+// and posts they interacted with, ranked by score. Every page is scored at the moment the first page was built,
+// so posts don't move between pages as they get older.
+func (s *service) GetFeed(ctx context.Context, params core.FeedParams) ([]core.FeedPost, int, error) {
+	now := time.Now().UTC()
+	if cursor := params.Cursor; cursor != nil {
+		if cursor.Sort != core.FeedSort || cursor.Time == nil || cursor.Number == nil {
+			return nil, 0, core.ErrInvalidCursor
+		}
+		now = *cursor.Time
+	}
+
+	preferences, err := s.feedStore.GetFeedPreferences(ctx, params.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hiddenUserIDs, err := s.blockStore.GetHiddenUserIDs(ctx, params.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := core.FeedQuery{
+		UserID:           params.UserID,
+		Limit:            params.Limit,
+		Cursor:           params.Cursor,
+		Preferences:      preferences,
+		Latitude:         params.Latitude,
+		Longitude:        params.Longitude,
+		Radius:           s.config.Radius,
+		Weights:          s.config.Weights,
+		HalfLife:         s.config.HalfLife,
+		Now:              now,
+		ExcludeAuthorIDs: hiddenUserIDs,
+	}
+	if query.Latitude == nil || query.Longitude == nil {
+		query.Latitude, query.Longitude = preferences.Latitude, preferences.Longitude
+	}
+	if preferences.Radius != nil {
+		query.Radius = *preferences.Radius
+	}
+
+	feedPosts, total, err := s.feedStore.GetFeed(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	posts := make([]core.Post, len(feedPosts))
+	for i := range feedPosts {
+		posts[i] = feedPosts[i].PostDetails.Post
+	}
+
+	postsDetails, err := s.postService.BuildPostDetailsList(ctx, posts, total, params.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := range feedPosts {
+		feedPosts[i].PostDetails = postsDetails[i]
+	}
+
+	return feedPosts, total, nil
+}
+
+// GetFeedPreferences returns preferences of the user, empty ones if the user didn't save them
+func (s *service) GetFeedPreferences(ctx context.Context, userID int) (core.FeedPreferences, error) {
+	return s.feedStore.GetFeedPreferences(ctx, userID)
+}
+
+// UpdateFeedPreferences replaces preferences of the user
+func (s *service) UpdateFeedPreferences(ctx context.Context, preferences core.FeedPreferences) (core.FeedPreferences, error) {
+	return s.feedStore.SaveFeedPreferences(ctx, preferences)
+}
@@ -0,0 +1,144 @@
+package feed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var config = core.FeedServiceConfig{
+	Radius:   10,
+	Weights:  core.FeedWeights{Nearby: 3, Preferences: 2, FavouriteUsers: 4, Interactions: 1},
+	HalfLife: 72 * time.Hour,
+}
+
+func TestGetFeed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	homeLat, homeLon, homeRadius := 55.75, 37.62, 5.0
+	currentLat, currentLon := 59.93, 30.33
+	limit := 10
+
+	tests := []struct {
+		name        string
+		params      core.FeedParams
+		preferences core.FeedPreferences
+		check       func(t *testing.T, query core.FeedQuery)
+	}{
+		{
+			name:   "without preferences",
+			params: core.FeedParams{UserID: 1, Limit: &limit},
+			check: func(t *testing.T, query core.FeedQuery) {
+				assert.Nil(t, query.Latitude)
+				assert.Nil(t, query.Longitude)
+				assert.Equal(t, config.Radius, query.Radius)
+			},
+		},
+		{
+			name:   "saved location and radius",
+			params: core.FeedParams{UserID: 1, Limit: &limit},
+			preferences: core.FeedPreferences{
+				UserID: 1, AnimalTypes: []string{"cat"}, Latitude: &homeLat, Longitude: &homeLon, Radius: &homeRadius,
+			},
+			check: func(t *testing.T, query core.FeedQuery) {
+				assert.Equal(t, homeLat, *query.Latitude)
+				assert.Equal(t, homeLon, *query.Longitude)
+				assert.Equal(t, homeRadius, query.Radius)
+				assert.Equal(t, []string{"cat"}, []string(query.Preferences.AnimalTypes))
+			},
+		},
+		{
+			name:        "current location over saved one",
+			params:      core.FeedParams{UserID: 1, Limit: &limit, Latitude: &currentLat, Longitude: &currentLon},
+			preferences: core.FeedPreferences{UserID: 1, Latitude: &homeLat, Longitude: &homeLon},
+			check: func(t *testing.T, query core.FeedQuery) {
+				assert.Equal(t, currentLat, *query.Latitude)
+				assert.Equal(t, currentLon, *query.Longitude)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			feedStore := mocks.NewMockFeedStore(t)
+			blockStore := mocks.NewMockBlockStore(t)
+			postService := mocks.NewMockPostService(t)
+
+			post := core.Post{ID: 5, AuthorID: 2}
+			feedStore.EXPECT().GetFeedPreferences(ctx, 1).Return(tt.preferences, nil).Once()
+			blockStore.EXPECT().GetHiddenUserIDs(ctx, 1).Return([]int{3}, nil).Once()
+			feedStore.EXPECT().GetFeed(ctx, mock.MatchedBy(func(query core.FeedQuery) bool {
+				return query.UserID == 1 && *query.Limit == limit && query.Cursor == nil &&
+					assert.ObjectsAreEqual([]int{3}, query.ExcludeAuthorIDs) &&
+					query.Weights == config.Weights && query.HalfLife == config.HalfLife && !query.Now.IsZero()
+			})).
+				Run(func(_ context.Context, query core.FeedQuery) { tt.check(t, query) }).
+				Return([]core.FeedPost{{
+					PostDetails: core.PostDetails{Post: post},
+					Score:       4,
+					Reasons:     []core.FeedReason{core.FeedReasonFavouriteUser},
+				}}, 1, nil).Once()
+			postService.EXPECT().BuildPostDetailsList(ctx, []core.Post{post}, 1, 1).
+				Return([]core.PostDetails{{Post: post, Username: "alice"}}, nil).Once()
+
+			posts, total, err := New(feedStore, blockStore, postService, config).GetFeed(ctx, tt.params)
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, total)
+			require.Len(t, posts, 1)
+			assert.Equal(t, "alice", posts[0].PostDetails.Username)
+			assert.Equal(t, 4.0, posts[0].Score)
+			assert.Equal(t, []core.FeedReason{core.FeedReasonFavouriteUser}, posts[0].Reasons)
+		})
+	}
+}
+
+func TestGetFeed_Cursor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	scoredAt := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	score := 2.5
+	limit := 10
+
+	t.Run("next page is scored at the moment of the first one", func(t *testing.T) {
+		t.Parallel()
+
+		feedStore := mocks.NewMockFeedStore(t)
+		blockStore := mocks.NewMockBlockStore(t)
+		postService := mocks.NewMockPostService(t)
+
+		cursor := core.FeedPost{PostDetails: core.PostDetails{Post: core.Post{ID: 7}}, Score: score, ScoredAt: scoredAt}.Cursor()
+
+		feedStore.EXPECT().GetFeedPreferences(ctx, 1).Return(core.FeedPreferences{UserID: 1}, nil).Once()
+		blockStore.EXPECT().GetHiddenUserIDs(ctx, 1).Return(nil, nil).Once()
+		feedStore.EXPECT().GetFeed(ctx, mock.MatchedBy(func(query core.FeedQuery) bool {
+			return query.Now.Equal(scoredAt) && *query.Cursor.Number == score && query.Cursor.ID == 7
+		})).Return(nil, 0, nil).Once()
+		postService.EXPECT().BuildPostDetailsList(ctx, []core.Post{}, 0, 1).Return([]core.PostDetails{}, nil).Once()
+
+		_, _, err := New(feedStore, blockStore, postService, config).
+			GetFeed(ctx, core.FeedParams{UserID: 1, Limit: &limit, Cursor: &cursor})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("cursor of other sort", func(t *testing.T) {
+		t.Parallel()
+
+		cursor := core.PostSortNewest.Cursor(core.Post{ID: 7, BumpedAt: scoredAt})
+
+		_, _, err := New(nil, nil, nil, config).GetFeed(ctx, core.FeedParams{UserID: 1, Limit: &limit, Cursor: &cursor})
+
+		assert.ErrorIs(t, err, core.ErrInvalidCursor)
+	})
+}
@@ -0,0 +1,544 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/imaging"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// extensions - file extensions used in storage keys, so files served by static servers get proper MIME type.
+var extensions = map[string]string{
+	imaging.JPEG: ".jpg",
+	imaging.PNG:  ".png",
+	imaging.WebP: ".webp",
+}
+
+// imagingErrors - errors of image processing mapped to errors returned to clients.
+var imagingErrors = map[error]error{
+	imaging.ErrUnsupportedFormat: core.ErrPhotoFormat,
+	imaging.ErrInvalidImage:      core.ErrInvalidPhoto,
+	imaging.ErrTooManyPixels:     core.ErrPhotoDimensions,
+}
+
+// processed - photo ready for upload.
+type processed struct {
+	hash       *int64
+	renditions []rendition
+}
+
+// rendition - encoded photo or its variant waiting for upload.
+type rendition struct {
+	name        core.MediaVariantName // empty for the photo itself
+	contentType string
+	width       int
+	height      int
+	data        []byte
+}
+
+type service struct {
+	mediaStore core.MediaStore
+	blobStore  core.BlobStore
+	config     core.MediaServiceConfig
+}
+
+func New(mediaStore core.MediaStore, blobStore core.BlobStore, config core.MediaServiceConfig) core.MediaService {
+	return &service{
+		mediaStore: mediaStore,
+		blobStore:  blobStore,
+		config:     config,
+	}
+}
+
+// SetPostPhotos - replaces photos of the post, order of the photos is kept.
+func (s *service) SetPostPhotos(ctx context.Context, postID int, photos []core.MediaUpload) ([]core.Media, error) {
+	if s.config.MaxPostPhotos > 0 && len(photos) > s.config.MaxPostPhotos {
+		return nil, core.ErrTooManyPhotos
+	}
+
+	return s.replace(ctx, core.MediaOwnerPost, postID, photos)
+}
+
+func (s *service) GetPostPhotos(ctx context.Context, postID int) ([]core.Media, error) {
+	media, err := s.mediaStore.GetMediaByOwner(ctx, core.MediaOwnerPost, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withURLs(media), nil
+}
+
+// GetPostsPhotos - returns photos of several posts at once grouped by post ID.
+func (s *service) GetPostsPhotos(ctx context.Context, postIDs []int) (map[int][]core.Media, error) {
+	return s.getByOwners(ctx, core.MediaOwnerPost, postIDs)
+}
+
+// SetAnimalEventPhotos - replaces photos of the event of the animal, the same limit as for posts applies.
+func (s *service) SetAnimalEventPhotos(ctx context.Context, eventID int, photos []core.MediaUpload) ([]core.Media, error) {
+	if s.config.MaxPostPhotos > 0 && len(photos) > s.config.MaxPostPhotos {
+		return nil, core.ErrTooManyPhotos
+	}
+
+	return s.replace(ctx, core.MediaOwnerAnimalEvent, eventID, photos)
+}
+
+// GetAnimalEventsPhotos - returns photos of several events of animals at once grouped by event ID.
+func (s *service) GetAnimalEventsPhotos(ctx context.Context, eventIDs []int) (map[int][]core.Media, error) {
+	return s.getByOwners(ctx, core.MediaOwnerAnimalEvent, eventIDs)
+}
+
+// SetUserPhoto - replaces avatar of the user.
+func (s *service) SetUserPhoto(ctx context.Context, userID int, photo core.MediaUpload) (core.Media, error) {
+	media, err := s.replace(ctx, core.MediaOwnerUser, userID, []core.MediaUpload{photo})
+	if err != nil {
+		return core.Media{}, err
+	}
+
+	return media[0], nil
+}
+
+// GetUserPhoto - returns avatar of the user, nil is returned for users without avatar.
+func (s *service) GetUserPhoto(ctx context.Context, userID int) (*core.Media, error) {
+	media, err := s.mediaStore.GetMediaByOwner(ctx, core.MediaOwnerUser, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(media) == 0 {
+		return nil, nil
+	}
+
+	photo := s.withURLs(media)[0]
+
+	return &photo, nil
+}
+
+// MoveLegacyBlobs - uploads photos moved out of BYTEA columns by migration to BlobStore.
+// Photos are processed in batches until none is left, so the method is safe to run on every start.
+func (s *service) MoveLegacyBlobs(ctx context.Context) error {
+	for {
+		blobs, err := s.mediaStore.GetLegacyBlobs(ctx, core.LegacyBlobsBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(blobs) == 0 {
+			return nil
+		}
+
+		for _, blob := range blobs {
+			if err := s.moveLegacyBlob(ctx, blob); err != nil {
+				return err
+			}
+		}
+
+		logger.Log().Info(ctx, fmt.Sprintf("moved %d legacy photos to blob store", len(blobs)))
+	}
+}
+
+// GetMediaFile - returns file of the photo in the requested size and format,
+// ErrMediaNotFound is returned if the viewer can't see the entity the photo belongs to.
+func (s *service) GetMediaFile(ctx context.Context, id int, params core.MediaFileParams) (core.MediaFile, error) {
+	media, err := s.mediaStore.GetMediaByID(ctx, id)
+	if err != nil {
+		return core.MediaFile{}, err
+	}
+
+	private, err := s.checkOwner(ctx, media.OwnerType, media.OwnerID, params.ViewerID)
+	if err != nil {
+		return core.MediaFile{}, err
+	}
+
+	file := selectFile(media, params)
+	file.Private = private
+
+	return file, nil
+}
+
+// GetPostPhotoFile - returns file of the photo of the post at the given position, 0 is the cover of the post.
+// Photos of drafts are returned to their authors only, photos of hidden posts are not returned.
+func (s *service) GetPostPhotoFile(ctx context.Context, postID, position int, params core.MediaFileParams) (core.MediaFile, error) {
+	private, err := s.checkOwner(ctx, core.MediaOwnerPost, postID, params.ViewerID)
+	if err != nil {
+		return core.MediaFile{}, err
+	}
+
+	media, err := s.mediaStore.GetMediaByOwner(ctx, core.MediaOwnerPost, postID)
+	if err != nil {
+		return core.MediaFile{}, err
+	}
+
+	if position < 0 || position >= len(media) {
+		return core.MediaFile{}, core.ErrMediaNotFound
+	}
+
+	file := selectFile(media[position], params)
+	file.Private = private
+
+	return file, nil
+}
+
+// checkOwner returns ErrMediaNotFound if the viewer can't see media of the entity,
+// private is true when the viewer is the only one who sees them, e.g. for photos of the draft.
+// Photos of deleted posts and posts on moderation are hidden like the posts themselves, avatars of deleted users are hidden too.
+func (s *service) checkOwner(ctx context.Context, ownerType core.MediaOwnerType, ownerID, viewerID int) (private bool, err error) {
+	owner, err := s.mediaStore.GetMediaOwner(ctx, ownerType, ownerID)
+	if err != nil {
+		return false, err
+	}
+
+	switch ownerType {
+	case core.MediaOwnerPost:
+		switch {
+		case owner.Status == string(core.Published):
+			return false, nil
+		case owner.Status == string(core.Draft) && owner.AuthorID == viewerID:
+			return true, nil
+		}
+		return false, core.ErrMediaNotFound
+	case core.MediaOwnerUser:
+		if owner.Status == core.UserDelete {
+			return false, core.ErrMediaNotFound
+		}
+	}
+
+	return false, nil
+}
+
+// GetUserPhotoFile - returns file of the avatar of the user, avatars of deleted users are not returned.
+func (s *service) GetUserPhotoFile(ctx context.Context, userID int, params core.MediaFileParams) (core.MediaFile, error) {
+	if _, err := s.checkOwner(ctx, core.MediaOwnerUser, userID, params.ViewerID); err != nil {
+		return core.MediaFile{}, err
+	}
+
+	media, err := s.mediaStore.GetMediaByOwner(ctx, core.MediaOwnerUser, userID)
+	if err != nil {
+		return core.MediaFile{}, err
+	}
+
+	if len(media) == 0 {
+		return core.MediaFile{}, core.ErrMediaNotFound
+	}
+
+	return selectFile(media[0], params), nil
+}
+
+// ReadMediaFile - returns content of the file.
+func (s *service) ReadMediaFile(ctx context.Context, file core.MediaFile) ([]byte, error) {
+	data, err := s.blobStore.Get(ctx, file.StorageKey)
+	if errors.Is(err, core.ErrBlobNotFound) {
+		logger.Log().Error(ctx, "Blob of media is missing: "+file.StorageKey)
+		return nil, core.ErrMediaNotFound
+	}
+
+	return data, err
+}
+
+// selectFile picks the variant of the photo matching requested size and format.
+// The photo itself is returned when the variant is missing, e.g. for legacy files which are not photos.
+func selectFile(media core.Media, params core.MediaFileParams) core.MediaFile {
+	file := core.MediaFile{StorageKey: media.StorageKey, ContentType: media.ContentType, ModifiedAt: media.CreatedAt}
+	if params.Variant == "" {
+		return file
+	}
+
+	contentType := media.ContentType
+	if params.WebP {
+		contentType = imaging.WebP
+	}
+
+	for _, variant := range media.Variants {
+		if variant.Name == params.Variant && variant.ContentType == contentType {
+			file.StorageKey = variant.StorageKey
+			file.ContentType = variant.ContentType
+			return file
+		}
+	}
+
+	return file
+}
+
+// HashPhoto - verifies the photo and computes its perceptual hash, the photo is not stored.
+func (s *service) HashPhoto(photo core.MediaUpload) (int64, error) {
+	img, err := s.decode(photo.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(imaging.DHash(img)), nil
+}
+
+// FindSimilarPhotos - returns owners of photos similar to the photo with the given hash, the most similar first.
+func (s *service) FindSimilarPhotos(
+	ctx context.Context,
+	ownerType core.MediaOwnerType,
+	hash int64,
+	maxDistance, limit int,
+) ([]core.MediaMatch, error) {
+	return s.mediaStore.FindSimilarMedia(ctx, ownerType, hash, maxDistance, limit)
+}
+
+// HashMissingPhotos - computes perceptual hash of photos uploaded before hashes were introduced.
+// Files which can't be decoded are skipped and logged, so the method is safe to run on every start.
+func (s *service) HashMissingPhotos(ctx context.Context) error {
+	afterID, hashed := 0, 0
+	for {
+		media, err := s.mediaStore.GetMediaWithoutHash(ctx, afterID, core.LegacyBlobsBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(media) == 0 {
+			if hashed > 0 {
+				logger.Log().Info(ctx, fmt.Sprintf("computed hashes of %d photos", hashed))
+			}
+			return nil
+		}
+
+		for _, m := range media {
+			afterID = m.ID
+
+			data, err := s.blobStore.Get(ctx, m.StorageKey)
+			if errors.Is(err, core.ErrBlobNotFound) {
+				logger.Log().Info(ctx, fmt.Sprintf("photo %d is missing in blob store", m.ID))
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			hash, err := s.HashPhoto(core.MediaUpload{Data: data})
+			if err != nil {
+				logger.Log().Info(ctx, fmt.Sprintf("hash of photo %d is not computed: %s", m.ID, err.Error()))
+				continue
+			}
+
+			if err := s.mediaStore.SetMediaHash(ctx, m.ID, hash); err != nil {
+				return err
+			}
+			hashed++
+		}
+	}
+}
+
+// moveLegacyBlob - processes legacy photo the same way as uploaded ones, so metadata is stripped and variants are created.
+// Content which is not a supported image is uploaded as is, there is no metadata to strip in it.
+func (s *service) moveLegacyBlob(ctx context.Context, blob core.LegacyBlob) error {
+	media := core.Media{ID: blob.MediaID, StorageKey: blob.StorageKey}
+
+	photo, err := s.process(blob.Data)
+	if err != nil {
+		logger.Log().Info(ctx, fmt.Sprintf("legacy photo %d is not processed: %s", blob.MediaID, err.Error()))
+		photo = processed{renditions: []rendition{{contentType: http.DetectContentType(blob.Data), data: blob.Data}}}
+	}
+
+	media, err = s.upload(ctx, media, photo)
+	if err != nil {
+		return err
+	}
+
+	if err := s.mediaStore.CompleteLegacyBlob(ctx, media); err != nil {
+		s.deleteBlobs(ctx, []core.Media{media})
+		return err
+	}
+
+	return nil
+}
+
+// replace - uploads files to BlobStore, replaces metadata of the owner and removes content of replaced files.
+// Uploaded files are removed if metadata can't be saved.
+func (s *service) replace(
+	ctx context.Context,
+	ownerType core.MediaOwnerType,
+	ownerID int,
+	uploads []core.MediaUpload,
+) ([]core.Media, error) {
+	photos := make([]processed, 0, len(uploads))
+	for _, upload := range uploads {
+		photo, err := s.process(upload.Data)
+		if err != nil {
+			return nil, err
+		}
+		photos = append(photos, photo)
+	}
+
+	media := make([]core.Media, 0, len(uploads))
+	for _, photo := range photos {
+		m, err := s.upload(ctx, core.Media{StorageKey: fmt.Sprintf("%ss/%d/%s", ownerType, ownerID, uuid.NewString())}, photo)
+		if err != nil {
+			s.deleteBlobs(ctx, media)
+			return nil, err
+		}
+
+		media = append(media, m)
+	}
+
+	replaced, err := s.mediaStore.ReplaceMedia(ctx, ownerType, ownerID, media)
+	if err != nil {
+		s.deleteBlobs(ctx, media)
+		return nil, err
+	}
+
+	s.deleteBlobs(ctx, replaced)
+
+	return s.withURLs(media), nil
+}
+
+// process verifies the photo and encodes it again, so metadata like EXIF with GPS coordinates is dropped.
+// Photo with transparency is kept in PNG, others are converted to JPEG. Every variant is encoded in the same format and WebP.
+func (s *service) process(data []byte) (processed, error) {
+	img, err := s.decode(data)
+	if err != nil {
+		return processed{}, err
+	}
+
+	contentType := imaging.JPEG
+	if !img.Opaque() {
+		contentType = imaging.PNG
+	}
+
+	encoded, err := imaging.Encode(img, contentType, s.config.Quality)
+	if err != nil {
+		return processed{}, err
+	}
+	renditions := []rendition{{contentType: contentType, width: img.Rect.Dx(), height: img.Rect.Dy(), data: encoded}}
+
+	for _, variant := range []struct {
+		name core.MediaVariantName
+		size int
+	}{
+		{name: core.MediaVariantMedium, size: s.config.MediumSize},
+		{name: core.MediaVariantThumbnail, size: s.config.ThumbnailSize},
+	} {
+		resized := imaging.Fit(img, variant.size)
+
+		for _, variantType := range []string{contentType, imaging.WebP} {
+			encoded, err := imaging.Encode(resized, variantType, s.config.Quality)
+			if err != nil {
+				return processed{}, err
+			}
+
+			renditions = append(renditions, rendition{
+				name:        variant.name,
+				contentType: variantType,
+				width:       resized.Rect.Dx(),
+				height:      resized.Rect.Dy(),
+				data:        encoded,
+			})
+		}
+	}
+
+	hash := int64(imaging.DHash(img))
+
+	return processed{hash: &hash, renditions: renditions}, nil
+}
+
+// decode verifies the photo against configured limits and decodes it.
+func (s *service) decode(data []byte) (*image.RGBA, error) {
+	if s.config.MaxPhotoSize > 0 && int64(len(data)) > s.config.MaxPhotoSize {
+		return nil, core.ErrPhotoTooLarge
+	}
+
+	img, err := imaging.Decode(data, s.config.MaxPhotoPixels)
+	if err != nil {
+		if mapped, ok := imagingErrors[err]; ok {
+			return nil, mapped
+		}
+		return nil, err
+	}
+
+	return img, nil
+}
+
+// upload puts the photo and its variants to BlobStore. Keys of the files are built from the key of the photo without extension.
+// Already uploaded files are removed if one of uploads fails.
+func (s *service) upload(ctx context.Context, media core.Media, photo processed) (core.Media, error) {
+	base := strings.TrimSuffix(media.StorageKey, path.Ext(media.StorageKey))
+	media.Variants = nil
+	media.Hash = photo.hash
+
+	for _, r := range photo.renditions {
+		if r.name == "" {
+			media.StorageKey = base + extensions[r.contentType]
+			media.ContentType = r.contentType
+			media.Size = int64(len(r.data))
+			media.Width, media.Height = r.width, r.height
+			if err := s.blobStore.Put(ctx, media.StorageKey, r.contentType, r.data); err != nil {
+				s.deleteBlobs(ctx, []core.Media{{Variants: media.Variants}})
+				return core.Media{}, err
+			}
+			continue
+		}
+
+		variant := core.MediaVariant{
+			Name:        r.name,
+			StorageKey:  base + "_" + string(r.name) + extensions[r.contentType],
+			ContentType: r.contentType,
+			Size:        int64(len(r.data)),
+			Width:       r.width,
+			Height:      r.height,
+		}
+		if err := s.blobStore.Put(ctx, variant.StorageKey, r.contentType, r.data); err != nil {
+			s.deleteBlobs(ctx, []core.Media{media})
+			return core.Media{}, err
+		}
+		media.Variants = append(media.Variants, variant)
+	}
+
+	return media, nil
+}
+
+// getByOwners - returns photos of several owners of the same type grouped by owner ID.
+func (s *service) getByOwners(ctx context.Context, ownerType core.MediaOwnerType, ownerIDs []int) (map[int][]core.Media, error) {
+	media, err := s.mediaStore.GetMediaByOwners(ctx, ownerType, ownerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	photos := make(map[int][]core.Media, len(ownerIDs))
+	for _, m := range s.withURLs(media) {
+		photos[m.OwnerID] = append(photos[m.OwnerID], m)
+	}
+
+	return photos, nil
+}
+
+// deleteBlobs - removes content of the files and their variants,
+// errors are logged only as metadata of the files is already gone.
+func (s *service) deleteBlobs(ctx context.Context, media []core.Media) {
+	for _, m := range media {
+		keys := make([]string, 0, len(m.Variants)+1)
+		if m.StorageKey != "" {
+			keys = append(keys, m.StorageKey)
+		}
+		for _, v := range m.Variants {
+			keys = append(keys, v.StorageKey)
+		}
+
+		for _, key := range keys {
+			if err := s.blobStore.Delete(ctx, key); err != nil && !errors.Is(err, core.ErrBlobNotFound) {
+				logger.Log().Error(ctx, "Failed to delete blob "+key+": "+err.Error())
+			}
+		}
+	}
+}
+
+func (s *service) withURLs(media []core.Media) []core.Media {
+	for i := range media {
+		media[i].URL = s.blobStore.URL(media[i].StorageKey)
+		for j := range media[i].Variants {
+			media[i].Variants[j].URL = s.blobStore.URL(media[i].Variants[j].StorageKey)
+		}
+	}
+
+	return media
+}
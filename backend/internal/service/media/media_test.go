@@ -0,0 +1,386 @@
+package media_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"math/bits"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/kotopesp/sos-kotopes/internal/service/media"
+)
+
+var (
+	jpegData = encodeImage(false)
+	pngData  = encodeImage(true)
+)
+
+// encodeImage returns 32x24 image in JPEG, or semi-transparent image in PNG.
+func encodeImage(transparent bool) []byte {
+	alpha := uint8(255)
+	if transparent {
+		alpha = 100
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, 32, 24))
+	for y := 0; y < 24; y++ {
+		for x := 0; x < 32; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x * 8), G: uint8(y * 10), B: 100, A: alpha})
+		}
+	}
+
+	var buf bytes.Buffer
+	encode := func() error { return jpeg.Encode(&buf, img, nil) }
+	if transparent {
+		encode = func() error { return png.Encode(&buf, img) }
+	}
+	if err := encode(); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func newService(t *testing.T, maxPostPhotos int) (core.MediaService, *mocks.MockMediaStore, *mocks.MockBlobStore) {
+	mediaStore := mocks.NewMockMediaStore(t)
+	blobStore := mocks.NewMockBlobStore(t)
+
+	blobStore.EXPECT().URL(mock.Anything).RunAndReturn(func(key string) string {
+		return "/media/" + key
+	}).Maybe()
+
+	return media.New(mediaStore, blobStore, core.MediaServiceConfig{
+		MaxPostPhotos:  maxPostPhotos,
+		MaxPhotoSize:   64 * 1024,
+		MaxPhotoPixels: 10_000,
+		MediumSize:     16,
+		ThumbnailSize:  8,
+		Quality:        80,
+	}), mediaStore, blobStore
+}
+
+func TestSetPostPhotos_Success(t *testing.T) {
+	ctx := context.Background()
+	svc, mediaStore, blobStore := newService(t, 10)
+
+	var keys []string
+	blobStore.EXPECT().Put(ctx, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, key, contentType string, data []byte) error {
+			assert.True(t, strings.HasPrefix(key, "posts/5/"))
+			assert.NotEqual(t, jpegData, data, "photo must be encoded again")
+			keys = append(keys, key+" "+contentType)
+			return nil
+		},
+	).Times(10)
+
+	mediaStore.EXPECT().ReplaceMedia(ctx, core.MediaOwnerPost, 5, mock.MatchedBy(func(m []core.Media) bool {
+		return len(m) == 2 && m[0].ContentType == "image/jpeg" && m[1].ContentType == "image/png" && m[0].Hash != nil
+	})).Return([]core.Media{{StorageKey: "posts/5/old.jpg", Variants: []core.MediaVariant{{StorageKey: "posts/5/old_thumbnail.webp"}}}}, nil).Once()
+	blobStore.EXPECT().Delete(ctx, "posts/5/old.jpg").Return(nil).Once()
+	blobStore.EXPECT().Delete(ctx, "posts/5/old_thumbnail.webp").Return(nil).Once()
+
+	photos, err := svc.SetPostPhotos(ctx, 5, []core.MediaUpload{{Data: jpegData}, {Data: pngData}})
+	require.NoError(t, err)
+	require.Len(t, photos, 2)
+	assert.Equal(t, "/media/"+photos[0].StorageKey, photos[0].URL)
+	assert.True(t, strings.HasSuffix(photos[1].StorageKey, ".png"), "transparent photo is kept in PNG")
+	assert.Equal(t, 32, photos[0].Width)
+	assert.Equal(t, 24, photos[0].Height)
+
+	require.Len(t, photos[0].Variants, 4)
+	base := strings.TrimSuffix(photos[0].StorageKey, ".jpg")
+	assert.Equal(t, core.MediaVariant{
+		Name:        core.MediaVariantMedium,
+		StorageKey:  base + "_medium.webp",
+		ContentType: "image/webp",
+		Size:        photos[0].Variants[1].Size,
+		Width:       16,
+		Height:      12,
+		URL:         "/media/" + base + "_medium.webp",
+	}, photos[0].Variants[1])
+	assert.Equal(t, base+"_thumbnail.jpg", photos[0].Variants[2].StorageKey)
+	assert.Equal(t, 8, photos[0].Variants[2].Width)
+	assert.Contains(t, keys, base+"_thumbnail.webp image/webp")
+}
+
+func TestSetPostPhotos_InvalidPhoto(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{name: "truncated", data: jpegData[:len(jpegData)/2], err: core.ErrInvalidPhoto},
+		{name: "unsupported format", data: []byte("%PDF-1.4"), err: core.ErrPhotoFormat},
+		{name: "too large", data: make([]byte, 64*1024+1), err: core.ErrPhotoTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := newService(t, 10)
+
+			// nothing is uploaded when one of the photos is invalid
+			_, err := svc.SetPostPhotos(context.Background(), 5, []core.MediaUpload{{Data: jpegData}, {Data: tt.data}})
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestSetPostPhotos_TooManyPhotos(t *testing.T) {
+	svc, _, _ := newService(t, 1)
+
+	_, err := svc.SetPostPhotos(context.Background(), 5, []core.MediaUpload{{Data: jpegData}, {Data: pngData}})
+	assert.ErrorIs(t, err, core.ErrTooManyPhotos)
+}
+
+func TestSetPostPhotos_StoreError_RemovesUploadedBlobs(t *testing.T) {
+	ctx := context.Background()
+	svc, mediaStore, blobStore := newService(t, 10)
+
+	uploaded := map[string]bool{}
+	blobStore.EXPECT().Put(ctx, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, key, _ string, _ []byte) error {
+			uploaded[key] = true
+			return nil
+		},
+	).Times(5)
+	mediaStore.EXPECT().ReplaceMedia(ctx, core.MediaOwnerPost, 5, mock.Anything).Return(nil, errors.New("db error")).Once()
+	blobStore.EXPECT().Delete(ctx, mock.Anything).RunAndReturn(func(_ context.Context, key string) error {
+		assert.True(t, uploaded[key])
+		delete(uploaded, key)
+		return nil
+	}).Times(5)
+
+	_, err := svc.SetPostPhotos(ctx, 5, []core.MediaUpload{{Data: jpegData}})
+	assert.EqualError(t, err, "db error")
+	assert.Empty(t, uploaded)
+}
+
+func TestGetPostsPhotos(t *testing.T) {
+	ctx := context.Background()
+	svc, mediaStore, _ := newService(t, 10)
+
+	mediaStore.EXPECT().GetMediaByOwners(ctx, core.MediaOwnerPost, []int{1, 2, 3}).Return([]core.Media{
+		{ID: 10, OwnerID: 1, Position: 0, StorageKey: "posts/1/a.jpg"},
+		{ID: 11, OwnerID: 1, Position: 1, StorageKey: "posts/1/b.jpg"},
+		{ID: 12, OwnerID: 3, Position: 0, StorageKey: "posts/3/c.jpg", Variants: []core.MediaVariant{{StorageKey: "posts/3/c_thumbnail.jpg"}}},
+	}, nil).Once()
+
+	photos, err := svc.GetPostsPhotos(ctx, []int{1, 2, 3})
+	require.NoError(t, err)
+	assert.Len(t, photos[1], 2)
+	assert.Empty(t, photos[2])
+	assert.Equal(t, "/media/posts/3/c.jpg", photos[3][0].URL)
+	assert.Equal(t, "/media/posts/3/c_thumbnail.jpg", photos[3][0].Variants[0].URL)
+}
+
+func TestGetUserPhoto_NoPhoto(t *testing.T) {
+	ctx := context.Background()
+	svc, mediaStore, _ := newService(t, 10)
+
+	mediaStore.EXPECT().GetMediaByOwner(ctx, core.MediaOwnerUser, 1).Return(nil, nil).Once()
+
+	photo, err := svc.GetUserPhoto(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, photo)
+}
+
+func TestMoveLegacyBlobs(t *testing.T) {
+	ctx := context.Background()
+	svc, mediaStore, blobStore := newService(t, 10)
+
+	mediaStore.EXPECT().GetLegacyBlobs(ctx, core.LegacyBlobsBatchSize).Return([]core.LegacyBlob{
+		{MediaID: 1, StorageKey: "posts/1/legacy", Data: jpegData},
+		{MediaID: 2, StorageKey: "posts/2/legacy", Data: []byte("not a photo")},
+	}, nil).Once()
+	blobStore.EXPECT().Put(ctx, "posts/1/legacy.jpg", "image/jpeg", mock.Anything).Return(nil).Once()
+	blobStore.EXPECT().Put(ctx, mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "posts/1/legacy_")
+	}), mock.Anything, mock.Anything).Return(nil).Times(4)
+	mediaStore.EXPECT().CompleteLegacyBlob(ctx, mock.MatchedBy(func(m core.Media) bool {
+		return m.ID == 1 && m.StorageKey == "posts/1/legacy.jpg" && m.Width == 32 && len(m.Variants) == 4
+	})).Return(nil).Once()
+
+	// content which is not a photo is moved as is
+	blobStore.EXPECT().Put(ctx, "posts/2/legacy", "text/plain; charset=utf-8", []byte("not a photo")).Return(nil).Once()
+	mediaStore.EXPECT().CompleteLegacyBlob(ctx, mock.MatchedBy(func(m core.Media) bool {
+		return m.ID == 2 && m.StorageKey == "posts/2/legacy" && len(m.Variants) == 0
+	})).Return(nil).Once()
+
+	mediaStore.EXPECT().GetLegacyBlobs(ctx, core.LegacyBlobsBatchSize).Return(nil, nil).Once()
+
+	assert.NoError(t, svc.MoveLegacyBlobs(ctx))
+}
+
+func TestMoveLegacyBlobs_UploadError(t *testing.T) {
+	ctx := context.Background()
+	svc, mediaStore, blobStore := newService(t, 10)
+
+	mediaStore.EXPECT().GetLegacyBlobs(ctx, core.LegacyBlobsBatchSize).Return([]core.LegacyBlob{
+		{MediaID: 1, StorageKey: "posts/1/legacy", Data: jpegData},
+	}, nil).Once()
+	blobStore.EXPECT().Put(ctx, "posts/1/legacy.jpg", "image/jpeg", mock.Anything).Return(errors.New("storage is down")).Once()
+
+	assert.EqualError(t, svc.MoveLegacyBlobs(ctx), "storage is down")
+}
+
+func TestHashPhoto(t *testing.T) {
+	svc, _, _ := newService(t, 10)
+
+	jpegHash, err := svc.HashPhoto(core.MediaUpload{Data: jpegData})
+	require.NoError(t, err)
+	pngHash, err := svc.HashPhoto(core.MediaUpload{Data: pngData})
+	require.NoError(t, err)
+	// the same picture in other format and with transparency differs only slightly
+	assert.LessOrEqual(t, bits.OnesCount64(uint64(jpegHash^pngHash)), core.DuplicatePhotoDistance)
+
+	_, err = svc.HashPhoto(core.MediaUpload{Data: []byte("%PDF-1.4")})
+	assert.ErrorIs(t, err, core.ErrPhotoFormat)
+}
+
+func TestHashMissingPhotos(t *testing.T) {
+	ctx := context.Background()
+	svc, mediaStore, blobStore := newService(t, 10)
+
+	mediaStore.EXPECT().GetMediaWithoutHash(ctx, 0, core.LegacyBlobsBatchSize).Return([]core.Media{
+		{ID: 1, StorageKey: "posts/1/a.jpg"},
+		{ID: 2, StorageKey: "posts/2/missing.jpg"},
+		{ID: 3, StorageKey: "posts/3/legacy"},
+	}, nil).Once()
+	blobStore.EXPECT().Get(ctx, "posts/1/a.jpg").Return(jpegData, nil).Once()
+	blobStore.EXPECT().Get(ctx, "posts/2/missing.jpg").Return(nil, core.ErrBlobNotFound).Once()
+	blobStore.EXPECT().Get(ctx, "posts/3/legacy").Return([]byte("not a photo"), nil).Once()
+	mediaStore.EXPECT().SetMediaHash(ctx, 1, mock.AnythingOfType("int64")).Return(nil).Once()
+	// files which can't be hashed are skipped, so they are not requested again
+	mediaStore.EXPECT().GetMediaWithoutHash(ctx, 3, core.LegacyBlobsBatchSize).Return(nil, nil).Once()
+
+	assert.NoError(t, svc.HashMissingPhotos(ctx))
+}
+
+func TestGetMediaFile(t *testing.T) {
+	ctx := context.Background()
+	svc, mediaStore, _ := newService(t, 10)
+
+	photo := core.Media{ID: 1, OwnerType: core.MediaOwnerPost, OwnerID: 1, StorageKey: "posts/1/a.jpg", ContentType: "image/jpeg", Variants: []core.MediaVariant{
+		{Name: core.MediaVariantMedium, StorageKey: "posts/1/a_medium.jpg", ContentType: "image/jpeg"},
+		{Name: core.MediaVariantMedium, StorageKey: "posts/1/a_medium.webp", ContentType: "image/webp"},
+	}}
+	mediaStore.EXPECT().GetMediaByID(ctx, 1).Return(photo, nil).Times(3)
+	mediaStore.EXPECT().GetMediaOwner(ctx, core.MediaOwnerPost, 1).
+		Return(core.MediaOwner{Status: string(core.Published), AuthorID: 2}, nil).Times(3)
+
+	file, err := svc.GetMediaFile(ctx, 1, core.MediaFileParams{Variant: core.MediaVariantMedium, WebP: true})
+	require.NoError(t, err)
+	assert.Equal(t, "posts/1/a_medium.webp", file.StorageKey)
+	assert.Equal(t, "image/webp", file.ContentType)
+
+	file, err = svc.GetMediaFile(ctx, 1, core.MediaFileParams{Variant: core.MediaVariantMedium})
+	require.NoError(t, err)
+	assert.Equal(t, "posts/1/a_medium.jpg", file.StorageKey)
+
+	// missing variant falls back to the photo itself
+	file, err = svc.GetMediaFile(ctx, 1, core.MediaFileParams{Variant: core.MediaVariantThumbnail})
+	require.NoError(t, err)
+	assert.Equal(t, "posts/1/a.jpg", file.StorageKey)
+}
+
+func TestGetPostPhotoFile_NotFound(t *testing.T) {
+	ctx := context.Background()
+	svc, mediaStore, _ := newService(t, 10)
+
+	mediaStore.EXPECT().GetMediaOwner(ctx, core.MediaOwnerPost, 1).
+		Return(core.MediaOwner{Status: string(core.Published), AuthorID: 2}, nil).Once()
+	mediaStore.EXPECT().GetMediaByOwner(ctx, core.MediaOwnerPost, 1).Return([]core.Media{{StorageKey: "posts/1/a.jpg"}}, nil).Once()
+
+	_, err := svc.GetPostPhotoFile(ctx, 1, 1, core.MediaFileParams{})
+	assert.ErrorIs(t, err, core.ErrMediaNotFound)
+}
+
+func TestGetPostPhotoFile_Visibility(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		status      core.ContentStatus
+		viewerID    int
+		wantErr     error
+		wantPrivate bool
+	}{
+		{name: "published post", status: core.Published},
+		{name: "draft to its author", status: core.Draft, viewerID: 2, wantPrivate: true},
+		{name: "draft to other user", status: core.Draft, viewerID: 3, wantErr: core.ErrMediaNotFound},
+		{name: "draft to anonymous user", status: core.Draft, wantErr: core.ErrMediaNotFound},
+		{name: "deleted post", status: core.Deleted, viewerID: 2, wantErr: core.ErrMediaNotFound},
+		{name: "post on moderation", status: core.OnModeration, viewerID: 2, wantErr: core.ErrMediaNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, mediaStore, _ := newService(t, 10)
+
+			mediaStore.EXPECT().GetMediaOwner(ctx, core.MediaOwnerPost, 1).
+				Return(core.MediaOwner{Status: string(tt.status), AuthorID: 2}, nil).Twice()
+			mediaStore.EXPECT().GetMediaByOwner(ctx, core.MediaOwnerPost, 1).
+				Return([]core.Media{{ID: 5, OwnerType: core.MediaOwnerPost, OwnerID: 1, StorageKey: "posts/1/a.jpg"}}, nil).Maybe()
+			mediaStore.EXPECT().GetMediaByID(ctx, 5).
+				Return(core.Media{ID: 5, OwnerType: core.MediaOwnerPost, OwnerID: 1, StorageKey: "posts/1/a.jpg"}, nil).Once()
+
+			file, err := svc.GetPostPhotoFile(ctx, 1, 0, core.MediaFileParams{ViewerID: tt.viewerID})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantPrivate, file.Private)
+
+			// the same check applies to the photo requested by its ID
+			file, err = svc.GetMediaFile(ctx, 5, core.MediaFileParams{ViewerID: tt.viewerID})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantPrivate, file.Private)
+		})
+	}
+}
+
+func TestGetUserPhotoFile_Visibility(t *testing.T) {
+	ctx := context.Background()
+	svc, mediaStore, _ := newService(t, 10)
+
+	mediaStore.EXPECT().GetMediaOwner(ctx, core.MediaOwnerUser, 1).
+		Return(core.MediaOwner{Status: core.UserActive, AuthorID: 1}, nil).Once()
+	mediaStore.EXPECT().GetMediaByOwner(ctx, core.MediaOwnerUser, 1).
+		Return([]core.Media{{StorageKey: "users/1/a.jpg"}}, nil).Once()
+
+	file, err := svc.GetUserPhotoFile(ctx, 1, core.MediaFileParams{})
+	require.NoError(t, err)
+	assert.Equal(t, "users/1/a.jpg", file.StorageKey)
+
+	mediaStore.EXPECT().GetMediaOwner(ctx, core.MediaOwnerUser, 2).
+		Return(core.MediaOwner{Status: core.UserDelete, AuthorID: 2}, nil).Once()
+
+	_, err = svc.GetUserPhotoFile(ctx, 2, core.MediaFileParams{})
+	assert.ErrorIs(t, err, core.ErrMediaNotFound)
+}
+
+// photos of events of animals are visible to everyone while the event exists
+func TestGetMediaFile_AnimalEvent(t *testing.T) {
+	ctx := context.Background()
+	svc, mediaStore, _ := newService(t, 10)
+
+	mediaStore.EXPECT().GetMediaByID(ctx, 3).
+		Return(core.Media{ID: 3, OwnerType: core.MediaOwnerAnimalEvent, OwnerID: 4, StorageKey: "animal_events/4/a.jpg"}, nil).Twice()
+	mediaStore.EXPECT().GetMediaOwner(ctx, core.MediaOwnerAnimalEvent, 4).Return(core.MediaOwner{AuthorID: 1}, nil).Once()
+
+	file, err := svc.GetMediaFile(ctx, 3, core.MediaFileParams{})
+	require.NoError(t, err)
+	assert.False(t, file.Private)
+
+	mediaStore.EXPECT().GetMediaOwner(ctx, core.MediaOwnerAnimalEvent, 4).Return(core.MediaOwner{}, core.ErrMediaNotFound).Once()
+
+	_, err = svc.GetMediaFile(ctx, 3, core.MediaFileParams{})
+	assert.ErrorIs(t, err, core.ErrMediaNotFound)
+}
@@ -2,6 +2,7 @@ package moderator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/kotopesp/sos-kotopes/internal/core"
@@ -14,10 +15,34 @@ type service struct {
 	reportStore    core.ReportStore
 	commentStore   core.CommentStore
 	userStore      core.UserStore
+	reviewStore    core.ReviewStore
+	messageStore   core.MessageStore
+	revisionStore  core.RevisionStore
+	commentService core.CommentService
 }
 
-func New(moderatorStore core.ModeratorStore, postStore core.PostStore, reportStore core.ReportStore, userStore core.UserStore, commentStore core.CommentStore) core.ModeratorService {
-	return &service{moderatorStore: moderatorStore, postStore: postStore, reportStore: reportStore, userStore: userStore, commentStore: commentStore}
+func New(
+	moderatorStore core.ModeratorStore,
+	postStore core.PostStore,
+	reportStore core.ReportStore,
+	userStore core.UserStore,
+	commentStore core.CommentStore,
+	reviewStore core.ReviewStore,
+	messageStore core.MessageStore,
+	revisionStore core.RevisionStore,
+	commentService core.CommentService,
+) core.ModeratorService {
+	return &service{
+		moderatorStore: moderatorStore,
+		postStore:      postStore,
+		reportStore:    reportStore,
+		userStore:      userStore,
+		commentStore:   commentStore,
+		reviewStore:    reviewStore,
+		messageStore:   messageStore,
+		revisionStore:  revisionStore,
+		commentService: commentService,
+	}
 }
 
 // GetModerator - returns moderator struct by its id.
@@ -49,9 +74,31 @@ func (s *service) GetPostsForModeration(ctx context.Context, filter core.Filter)
 			continue
 		}
 
+		reports, err := s.reportStore.GetReports(ctx, post.ID, core.ReportableTypePost)
+		if err != nil {
+			logger.Log().Error(ctx, fmt.Sprintf("Error getting reports for, %d: ", post.ID)+err.Error())
+
+			continue
+		}
+
+		revisions, err := s.revisionStore.GetPostRevisions(ctx, post.ID)
+		if err != nil {
+			logger.Log().Error(ctx, fmt.Sprintf("Error getting revisions for, %d: ", post.ID)+err.Error())
+
+			continue
+		}
+
 		postWithReasons := core.PostForModeration{
-			Post:    post,
-			Reasons: reasons,
+			Post:      post,
+			Reasons:   reasons,
+			Reports:   reports,
+			Revisions: make(map[int]core.PostRevision, len(reports)),
+		}
+
+		for _, report := range reports {
+			if revision, ok := core.PostRevisionAt(revisions, report.CreatedAt); ok {
+				postWithReasons.Revisions[report.ID] = revision
+			}
 		}
 
 		moderationPosts = append(moderationPosts, postWithReasons)
@@ -60,15 +107,27 @@ func (s *service) GetPostsForModeration(ctx context.Context, filter core.Filter)
 	return moderationPosts, nil
 }
 
-// DeletePost - method that allows moderator to delete posts.
-func (s *service) DeletePost(ctx context.Context, id int) (err error) {
-	err = s.postStore.DeletePost(ctx, id)
+// DeletePost - method that allows moderator to delete posts, reason of the decision is saved for the author.
+func (s *service) DeletePost(ctx context.Context, decision core.ModerationDecision) (err error) {
+	post, err := s.postStore.GetPostByIDAnyStatus(ctx, decision.TargetID)
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return err
 	}
 
-	return nil
+	err = s.postStore.DeletePost(ctx, post.ID)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	err = s.reportStore.ResolveReports(ctx, post.ID, core.ReportableTypePost, true)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return s.recordDecision(ctx, decision, post.AuthorID, core.ReportableTypePost, core.ActionDeleted)
 }
 
 func (s *service) ApprovePost(ctx context.Context, postID int) (err error) {
@@ -78,6 +137,12 @@ func (s *service) ApprovePost(ctx context.Context, postID int) (err error) {
 		return err
 	}
 
+	err = s.reportStore.ResolveReports(ctx, postID, core.ReportableTypePost, false)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
 	err = s.reportStore.DeleteAllReports(ctx, postID, core.ReportableTypePost)
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
@@ -100,17 +165,37 @@ func (s *service) GetCommentsForModeration(ctx context.Context, filter core.Filt
 			return nil, err
 		}
 
-		result = append(result, core.CommentForModeration{
-			Comment: comment,
-			Reasons: reasons,
-		})
+		reports, err := s.reportStore.GetReports(ctx, comment.ID, core.ReportableTypeComment)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions, err := s.revisionStore.GetCommentRevisions(ctx, comment.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		commentWithReasons := core.CommentForModeration{
+			Comment:   comment,
+			Reasons:   reasons,
+			Reports:   reports,
+			Revisions: make(map[int]core.CommentRevision, len(reports)),
+		}
+
+		for _, report := range reports {
+			if revision, ok := core.CommentRevisionAt(revisions, report.CreatedAt); ok {
+				commentWithReasons.Revisions[report.ID] = revision
+			}
+		}
+
+		result = append(result, commentWithReasons)
 	}
 
 	return result, nil
 }
 
-func (s *service) DeleteComment(ctx context.Context, commentID int) error {
-	comment, err := s.commentStore.GetCommentByID(ctx, commentID)
+func (s *service) DeleteComment(ctx context.Context, decision core.ModerationDecision) error {
+	comment, err := s.commentStore.GetCommentByID(ctx, decision.TargetID)
 	if err != nil {
 		logger.Log().Error(ctx, "Failed to get comment for deletion: "+err.Error())
 		return core.ErrNoSuchComment
@@ -121,11 +206,16 @@ func (s *service) DeleteComment(ctx context.Context, commentID int) error {
 		return err
 	}
 
-	return nil
+	if err := s.reportStore.ResolveReports(ctx, comment.ID, core.ReportableTypeComment, true); err != nil {
+		logger.Log().Error(ctx, "Failed to resolve reports for comment: "+err.Error())
+		return err
+	}
+
+	return s.recordDecision(ctx, decision, comment.AuthorID, core.ReportableTypeComment, core.ActionDeleted)
 }
 
 func (s *service) ApproveComment(ctx context.Context, commentID int) error {
-	_, err := s.commentStore.GetCommentByID(ctx, commentID)
+	comment, err := s.commentStore.GetCommentByID(ctx, commentID)
 	if err != nil {
 		return core.ErrNoSuchComment
 	}
@@ -134,6 +224,15 @@ func (s *service) ApproveComment(ctx context.Context, commentID int) error {
 		return err
 	}
 
+	// users mentioned in the comment hidden by the content filter see it for the first time
+	s.commentService.NotifyApprovedComment(ctx, comment)
+
+	if err := s.reportStore.ResolveReports(ctx, commentID, core.ReportableTypeComment, false); err != nil {
+		logger.Log().Error(ctx, "Failed to resolve reports for comment: "+err.Error())
+
+		return err
+	}
+
 	if err := s.reportStore.DeleteAllReports(ctx, commentID, core.ReportableTypeComment); err != nil {
 		logger.Log().Error(ctx, "Failed to delete reports for comment: "+err.Error())
 
@@ -159,5 +258,244 @@ func (s *service) BanUser(ctx context.Context, banRecord core.BannedUserRecord)
 		return err
 	}
 
+	if err = s.closeReports(ctx, banRecord.UserID, core.ReportableTypeUser); err != nil {
+		return err
+	}
+
+	decision := core.ModerationDecision{
+		ModeratorID: banRecord.ModeratorID,
+		TargetID:    banRecord.UserID,
+		Reason:      banRecord.Reason,
+		Comment:     banRecord.Comment,
+	}
+
+	return s.recordDecision(ctx, decision, banRecord.UserID, core.ReportableTypeUser, core.ActionBanned)
+}
+
+// GetReportedTargets - returns users, reviews or messages waiting for moderation with their reports.
+func (s *service) GetReportedTargets(ctx context.Context, reportableType string, filter core.Filter) ([]core.ReportedTarget, error) {
+	if !isQueuedType(reportableType) {
+		return nil, core.ErrInvalidReportableType
+	}
+
+	targets, err := s.reportStore.GetTargetsForModeration(ctx, reportableType, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []core.ReportedTarget
+	for _, target := range targets {
+		reportedTarget, err := s.reportedTarget(ctx, target)
+		if err != nil {
+			logger.Log().Error(ctx, fmt.Sprintf("Error getting reported %s, %d: ", target.ReportableType, target.ReportableID)+err.Error())
+
+			continue
+		}
+
+		result = append(result, reportedTarget)
+	}
+
+	return result, nil
+}
+
+// reportedTarget - collects content summary and reports of the target from moderation queue.
+func (s *service) reportedTarget(ctx context.Context, target core.ModerationTarget) (core.ReportedTarget, error) {
+	reportedTarget := core.ReportedTarget{
+		ReportableID:   target.ReportableID,
+		ReportableType: target.ReportableType,
+	}
+
+	switch target.ReportableType {
+	case core.ReportableTypeUser:
+		user, err := s.userStore.GetUserByID(ctx, target.ReportableID)
+		if err != nil {
+			return core.ReportedTarget{}, err
+		}
+		reportedTarget.AuthorID = user.ID
+		reportedTarget.Content = user.Description
+
+	case core.ReportableTypeVetReview, core.ReportableTypeKeeperReview:
+		reviewType, _ := core.ReviewTypeOf(target.ReportableType)
+		review, err := s.reviewStore.GetReviewByID(ctx, reviewType, target.ReportableID)
+		if err != nil {
+			return core.ReportedTarget{}, err
+		}
+		reportedTarget.AuthorID = review.AuthorID
+		reportedTarget.Content = review.Content
+
+	case core.ReportableTypeMessage:
+		message, err := s.messageStore.GetMessageByID(ctx, target.ReportableID)
+		if err != nil {
+			return core.ReportedTarget{}, err
+		}
+		reportedTarget.AuthorID = message.UserID
+		reportedTarget.Content = &message.Content
+	}
+
+	reports, err := s.reportStore.GetReports(ctx, target.ReportableID, target.ReportableType)
+	if err != nil {
+		return core.ReportedTarget{}, err
+	}
+	reportedTarget.Reports = reports
+
+	return reportedTarget, nil
+}
+
+// DismissReports - leaves reported user, review or message as is and rejects reports made on it.
+func (s *service) DismissReports(ctx context.Context, reportableID int, reportableType string) error {
+	if !isQueuedType(reportableType) {
+		return core.ErrInvalidReportableType
+	}
+
+	if err := s.reportStore.RemoveFromModeration(ctx, reportableID, reportableType); err != nil {
+		return err
+	}
+
+	if err := s.reportStore.ResolveReports(ctx, reportableID, reportableType, false); err != nil {
+		logger.Log().Error(ctx, "Failed to resolve reports: "+err.Error())
+		return err
+	}
+
+	if err := s.reportStore.DeleteAllReports(ctx, reportableID, reportableType); err != nil {
+		logger.Log().Error(ctx, "Failed to delete reports: "+err.Error())
+		return err
+	}
+
 	return nil
 }
+
+// DeleteReportedTarget - deletes reported review or message from moderation queue and upholds reports made on it.
+// Reported users are handled with BanUser.
+func (s *service) DeleteReportedTarget(ctx context.Context, decision core.ModerationDecision) error {
+	if decision.TargetType == core.ReportableTypeUser || !isQueuedType(decision.TargetType) {
+		return core.ErrInvalidReportableType
+	}
+
+	onModeration, err := s.reportStore.IsOnModeration(ctx, decision.TargetID, decision.TargetType)
+	if err != nil {
+		return err
+	}
+	if !onModeration {
+		return core.ErrNotOnModeration
+	}
+
+	var authorID int
+
+	switch decision.TargetType {
+	case core.ReportableTypeVetReview, core.ReportableTypeKeeperReview:
+		reviewType, _ := core.ReviewTypeOf(decision.TargetType)
+		review, err := s.reviewStore.GetReviewByID(ctx, reviewType, decision.TargetID)
+		if err != nil {
+			return err
+		}
+		if err := s.reviewStore.DeleteReview(ctx, reviewType, review.ID); err != nil {
+			return err
+		}
+		authorID = review.AuthorID
+	case core.ReportableTypeMessage:
+		message, err := s.messageStore.GetMessageByID(ctx, decision.TargetID)
+		if err != nil {
+			return err
+		}
+		if err := s.messageStore.DeleteMessage(ctx, message.ID); err != nil {
+			return err
+		}
+		authorID = message.UserID
+	default:
+		return core.ErrInvalidReportableType
+	}
+
+	if err := s.closeReports(ctx, decision.TargetID, decision.TargetType); err != nil {
+		return err
+	}
+
+	return s.recordDecision(ctx, decision, authorID, decision.TargetType, core.ActionDeleted)
+}
+
+// GetUserModerationHistory - returns decisions of moderators against the user and their content.
+func (s *service) GetUserModerationHistory(ctx context.Context, userID int) ([]core.ModerationDecision, error) {
+	return s.moderatorStore.GetUserDecisions(ctx, userID)
+}
+
+// GetPostModeration - explains author why their post is not published.
+func (s *service) GetPostModeration(ctx context.Context, userID, postID int) (core.PostModeration, error) {
+	post, err := s.postStore.GetPostByIDAnyStatus(ctx, postID)
+	if err != nil {
+		return core.PostModeration{}, err
+	}
+
+	if post.AuthorID != userID {
+		return core.PostModeration{}, core.ErrPostAuthorIDMismatch
+	}
+
+	postModeration := core.PostModeration{
+		Status:       post.Status,
+		FilterReason: post.ModerationReason,
+	}
+
+	decision, err := s.moderatorStore.GetTargetDecision(ctx, core.ReportableTypePost, post.ID)
+	switch {
+	case err == nil:
+		postModeration.Decision = &decision
+	case !errors.Is(err, core.ErrNoModerationDecision):
+		return core.PostModeration{}, err
+	}
+
+	return postModeration, nil
+}
+
+// SearchComments - finds comments of any status by words of their content, so moderators can find abusive ones.
+func (s *service) SearchComments(ctx context.Context, params core.SearchCommentsParams) (comments []core.Comment, total int, err error) {
+	comments, total, err = s.commentStore.SearchComments(ctx, params)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// recordDecision - saves decision of the moderator, so affected user can see why their content was removed.
+func (s *service) recordDecision(
+	ctx context.Context,
+	decision core.ModerationDecision,
+	userID int,
+	targetType string,
+	action core.ModerationAction,
+) error {
+	decision.UserID = userID
+	decision.TargetType = targetType
+	decision.Action = action
+
+	if err := s.moderatorStore.CreateDecision(ctx, decision); err != nil {
+		logger.Log().Error(ctx, "Failed to save moderation decision: "+err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// closeReports - upholds reports of the removed entity and takes it out of moderation queue.
+func (s *service) closeReports(ctx context.Context, reportableID int, reportableType string) error {
+	if err := s.reportStore.ResolveReports(ctx, reportableID, reportableType, true); err != nil {
+		logger.Log().Error(ctx, "Failed to resolve reports: "+err.Error())
+		return err
+	}
+
+	if err := s.reportStore.RemoveFromModeration(ctx, reportableID, reportableType); err != nil && !errors.Is(err, core.ErrNotOnModeration) {
+		return err
+	}
+
+	return nil
+}
+
+// isQueuedType - checks whether entities of the type don't have their own moderation status and are stored in moderation queue.
+func isQueuedType(reportableType string) bool {
+	switch reportableType {
+	case core.ReportableTypeUser, core.ReportableTypeVetReview, core.ReportableTypeKeeperReview, core.ReportableTypeMessage:
+		return true
+	default:
+		return false
+	}
+}
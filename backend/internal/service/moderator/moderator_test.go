@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
 
@@ -11,12 +12,13 @@ import (
 	"github.com/kotopesp/sos-kotopes/internal/service/moderator"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestGetModerator_Success(t *testing.T) {
 	ctx := context.TODO()
 	mockMod := new(mocks.MockModeratorStore)
-	svc := moderator.New(mockMod, nil, nil, nil, nil)
+	svc := moderator.New(mockMod, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	expected := core.Moderator{UserID: 1}
 	mockMod.On("GetModeratorByID", ctx, 1).Return(expected, nil)
@@ -30,7 +32,7 @@ func TestGetModerator_Success(t *testing.T) {
 func TestGetModerator_Failure(t *testing.T) {
 	ctx := context.TODO()
 	mockMod := new(mocks.MockModeratorStore)
-	svc := moderator.New(mockMod, nil, nil, nil, nil)
+	svc := moderator.New(mockMod, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	mockMod.On("GetModeratorByID", ctx, 2).Return(core.Moderator{}, core.ErrNoSuchModerator)
 
@@ -44,14 +46,19 @@ func TestGetPostsForModeration_Success(t *testing.T) {
 	ctx := context.TODO()
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
+	mockRevisions := new(mocks.MockRevisionStore)
 
 	filter := core.FilterDESC
 	posts := []core.Post{{ID: 1}, {ID: 2}}
 	mockPosts.On("GetPostsForModeration", ctx, filter).Return(posts, nil)
 	mockReports.On("GetReportReasons", ctx, 1, core.ReportableTypePost).Return([]string{"spam"}, nil)
 	mockReports.On("GetReportReasons", ctx, 2, core.ReportableTypePost).Return([]string{"offensive"}, nil)
+	mockReports.On("GetReports", ctx, 1, core.ReportableTypePost).Return([]core.Report{{Reason: core.Spam}}, nil)
+	mockReports.On("GetReports", ctx, 2, core.ReportableTypePost).Return([]core.Report{{Reason: "offensive"}}, nil)
+	mockRevisions.On("GetPostRevisions", ctx, 1).Return([]core.PostRevision{}, nil)
+	mockRevisions.On("GetPostRevisions", ctx, 2).Return([]core.PostRevision{}, nil)
 
-	svc := moderator.New(nil, mockPosts, mockReports, nil, nil)
+	svc := moderator.New(nil, mockPosts, mockReports, nil, nil, nil, nil, mockRevisions, nil)
 
 	result, err := svc.GetPostsForModeration(ctx, filter)
 	assert.NoError(t, err)
@@ -63,18 +70,53 @@ func TestGetPostsForModeration_Success(t *testing.T) {
 	mockReports.AssertExpectations(t)
 }
 
+func TestGetPostsForModeration_RevisionsAtReports(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockReports := new(mocks.MockReportStore)
+	mockRevisions := new(mocks.MockRevisionStore)
+
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	revisions := []core.PostRevision{
+		{PostID: 1, Revision: 1, Title: "Found a cat", CreatedAt: created},
+		{PostID: 1, Revision: 2, Title: "Nothing to see", CreatedAt: created.Add(2 * time.Hour)},
+	}
+	reports := []core.Report{
+		{ID: 10, Reason: core.Spam, CreatedAt: created.Add(time.Hour)},
+		{ID: 11, Reason: core.Spam, CreatedAt: created.Add(3 * time.Hour)},
+		{ID: 12, Reason: core.Spam, CreatedAt: created.Add(-time.Hour)}, // filed before history was kept
+	}
+
+	mockPosts.On("GetPostsForModeration", ctx, core.FilterDESC).Return([]core.Post{{ID: 1}}, nil)
+	mockReports.On("GetReportReasons", ctx, 1, core.ReportableTypePost).Return([]string{"spam"}, nil)
+	mockReports.On("GetReports", ctx, 1, core.ReportableTypePost).Return(reports, nil)
+	mockRevisions.On("GetPostRevisions", ctx, 1).Return(revisions, nil)
+
+	svc := moderator.New(nil, mockPosts, mockReports, nil, nil, nil, nil, mockRevisions, nil)
+
+	result, err := svc.GetPostsForModeration(ctx, core.FilterDESC)
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, 1, result[0].Revisions[10].Revision)
+	assert.Equal(t, 2, result[0].Revisions[11].Revision)
+	assert.NotContains(t, result[0].Revisions, 12)
+}
+
 func TestGetPostsForModeration_ReportFail_Continues(t *testing.T) {
 	ctx := context.TODO()
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
+	mockRevisions := new(mocks.MockRevisionStore)
 
 	filter := core.FilterASC
 	posts := []core.Post{{ID: 1}, {ID: 2}}
 	mockPosts.On("GetPostsForModeration", ctx, filter).Return(posts, nil)
 	mockReports.On("GetReportReasons", ctx, 1, core.ReportableTypePost).Return(nil, core.ErrGettingReportReasons)
 	mockReports.On("GetReportReasons", ctx, 2, core.ReportableTypePost).Return([]string{"spam"}, nil)
+	mockReports.On("GetReports", ctx, 2, core.ReportableTypePost).Return([]core.Report{{Reason: core.Spam}}, nil)
+	mockRevisions.On("GetPostRevisions", ctx, 2).Return([]core.PostRevision{}, nil)
 
-	svc := moderator.New(nil, mockPosts, mockReports, nil, nil)
+	svc := moderator.New(nil, mockPosts, mockReports, nil, nil, nil, nil, mockRevisions, nil)
 
 	result, err := svc.GetPostsForModeration(ctx, filter)
 	assert.NoError(t, err)
@@ -94,7 +136,7 @@ func TestGetPostsForModeration_NoPostsForModeration(t *testing.T) {
 	filter := core.Filter("asc")
 	mockPosts.On("GetPostsForModeration", ctx, filter).Return(nil, core.ErrNoPostsWaitingForModeration)
 
-	svc := moderator.New(nil, mockPosts, mockReports, nil, nil)
+	svc := moderator.New(nil, mockPosts, mockReports, nil, nil, nil, nil, nil, nil)
 
 	listOfPosts, err := svc.GetPostsForModeration(ctx, filter)
 	assert.Error(t, err)
@@ -106,36 +148,54 @@ func TestGetPostsForModeration_NoPostsForModeration(t *testing.T) {
 func TestDeletePost_Success(t *testing.T) {
 	ctx := context.TODO()
 	mockPosts := new(mocks.MockPostStore)
+	mockReports := new(mocks.MockReportStore)
+	mockModStore := new(mocks.MockModeratorStore)
+	mockPosts.On("GetPostByIDAnyStatus", ctx, 10).Return(core.Post{ID: 10, AuthorID: 3}, nil)
 	mockPosts.On("DeletePost", ctx, 10).Return(nil)
+	mockReports.On("ResolveReports", ctx, 10, core.ReportableTypePost, true).Return(nil)
+	mockModStore.On("CreateDecision", ctx, core.ModerationDecision{
+		UserID:      3,
+		ModeratorID: 2,
+		TargetType:  core.ReportableTypePost,
+		TargetID:    10,
+		Action:      core.ActionDeleted,
+		Reason:      core.Spam,
+	}).Return(nil)
 
-	svc := moderator.New(nil, mockPosts, nil, nil, nil)
-	err := svc.DeletePost(ctx, 10)
+	svc := moderator.New(mockModStore, mockPosts, mockReports, nil, nil, nil, nil, nil, nil)
+	err := svc.DeletePost(ctx, core.ModerationDecision{ModeratorID: 2, TargetID: 10, Reason: core.Spam})
 
 	assert.NoError(t, err)
 	mockPosts.AssertExpectations(t)
+	mockReports.AssertExpectations(t)
+	mockModStore.AssertExpectations(t)
 }
 
 func TestDeletePost_Failure(t *testing.T) {
 	ctx := context.TODO()
 	mockPosts := new(mocks.MockPostStore)
+	mockReports := new(mocks.MockReportStore)
+	mockPosts.On("GetPostByIDAnyStatus", ctx, 99).Return(core.Post{ID: 99}, nil)
 	mockPosts.On("DeletePost", ctx, 99).Return(core.ErrPostNotFound)
 
-	svc := moderator.New(nil, mockPosts, nil, nil, nil)
-	err := svc.DeletePost(ctx, 99)
+	svc := moderator.New(nil, mockPosts, mockReports, nil, nil, nil, nil, nil, nil)
+	err := svc.DeletePost(ctx, core.ModerationDecision{TargetID: 99, Reason: core.Spam})
 
 	assert.Error(t, err)
 	assert.Equal(t, err, core.ErrPostNotFound)
 	mockPosts.AssertExpectations(t)
+	mockReports.AssertNotCalled(t, "ResolveReports", ctx, 99, core.ReportableTypePost, true)
 }
 
 func TestApprovePost_Success(t *testing.T) {
 	ctx := context.TODO()
 	mockReports := new(mocks.MockReportStore)
 	mockPosts := new(mocks.MockPostStore)
+	mockReports.On("ResolveReports", ctx, 5, core.ReportableTypePost, false).Return(nil)
 	mockReports.On("DeleteAllReports", ctx, 5, core.ReportableTypePost).Return(nil)
 	mockPosts.On("ApprovePostFromModeration", ctx, 5).Return(nil)
 
-	svc := moderator.New(nil, mockPosts, mockReports, nil, nil)
+	svc := moderator.New(nil, mockPosts, mockReports, nil, nil, nil, nil, nil, nil)
 	err := svc.ApprovePost(ctx, 5)
 
 	assert.NoError(t, err)
@@ -146,10 +206,11 @@ func TestApprovePost_Failure(t *testing.T) {
 	ctx := context.TODO()
 	mockReports := new(mocks.MockReportStore)
 	mockPosts := new(mocks.MockPostStore)
+	mockReports.On("ResolveReports", ctx, 5, core.ReportableTypePost, false).Return(nil)
 	mockReports.On("DeleteAllReports", ctx, 5, core.ReportableTypePost).Return(errors.New("fail"))
 	mockPosts.On("ApprovePostFromModeration", ctx, 5).Return(nil)
 
-	svc := moderator.New(nil, mockPosts, mockReports, nil, nil)
+	svc := moderator.New(nil, mockPosts, mockReports, nil, nil, nil, nil, nil, nil)
 	err := svc.ApprovePost(ctx, 5)
 
 	assert.Error(t, err)
@@ -163,7 +224,7 @@ func TestApprovePost_Failure_ApprovePostFromModeration(t *testing.T) {
 
 	mockPosts.On("ApprovePostFromModeration", ctx, 5).Return(errors.New("approve failed"))
 
-	svc := moderator.New(nil, mockPosts, mockReports, nil, nil)
+	svc := moderator.New(nil, mockPosts, mockReports, nil, nil, nil, nil, nil, nil)
 	err := svc.ApprovePost(ctx, 5)
 
 	assert.Error(t, err)
@@ -176,8 +237,9 @@ func TestBanUser_Success(t *testing.T) {
 	ctx := context.TODO()
 	mockUserStore := new(mocks.MockUserStore)
 	mockModStore := new(mocks.MockModeratorStore)
+	mockReportStore := new(mocks.MockReportStore)
 
-	svc := moderator.New(mockModStore, nil, nil, mockUserStore, nil)
+	svc := moderator.New(mockModStore, nil, mockReportStore, mockUserStore, nil, nil, nil, nil, nil)
 
 	banRecord := core.BannedUserRecord{
 		UserID:      1,
@@ -192,11 +254,15 @@ func TestBanUser_Success(t *testing.T) {
 
 	mockUserStore.On("GetUserByID", ctx, 1).Return(activeUser, nil)
 	mockUserStore.On("BanUserWithRecord", ctx, banRecord).Return(nil)
+	mockReportStore.On("ResolveReports", ctx, 1, core.ReportableTypeUser, true).Return(nil)
+	mockReportStore.On("RemoveFromModeration", ctx, 1, core.ReportableTypeUser).Return(core.ErrNotOnModeration)
+	mockModStore.On("CreateDecision", ctx, mock.AnythingOfType("core.ModerationDecision")).Return(nil)
 
 	err := svc.BanUser(ctx, banRecord)
 
 	assert.NoError(t, err)
 	mockUserStore.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
 }
 
 func TestBanUser_UserNotFound(t *testing.T) {
@@ -204,7 +270,7 @@ func TestBanUser_UserNotFound(t *testing.T) {
 	mockUserStore := new(mocks.MockUserStore)
 	mockModStore := new(mocks.MockModeratorStore)
 
-	svc := moderator.New(mockModStore, nil, nil, mockUserStore, nil)
+	svc := moderator.New(mockModStore, nil, nil, mockUserStore, nil, nil, nil, nil, nil)
 
 	banRecord := core.BannedUserRecord{UserID: 999}
 
@@ -223,7 +289,7 @@ func TestBanUser_UserAlreadyBanned(t *testing.T) {
 	mockUserStore := new(mocks.MockUserStore)
 	mockModStore := new(mocks.MockModeratorStore)
 
-	svc := moderator.New(mockModStore, nil, nil, mockUserStore, nil)
+	svc := moderator.New(mockModStore, nil, nil, mockUserStore, nil, nil, nil, nil, nil)
 
 	banRecord := core.BannedUserRecord{UserID: 1}
 
@@ -247,7 +313,7 @@ func TestBanUser_StoreErrorOnGetUser(t *testing.T) {
 	mockUserStore := new(mocks.MockUserStore)
 	mockModStore := new(mocks.MockModeratorStore)
 
-	svc := moderator.New(mockModStore, nil, nil, mockUserStore, nil)
+	svc := moderator.New(mockModStore, nil, nil, mockUserStore, nil, nil, nil, nil, nil)
 
 	banRecord := core.BannedUserRecord{UserID: 1}
 
@@ -267,7 +333,7 @@ func TestBanUser_StoreErrorOnBan(t *testing.T) {
 	mockUserStore := new(mocks.MockUserStore)
 	mockModStore := new(mocks.MockModeratorStore)
 
-	svc := moderator.New(mockModStore, nil, nil, mockUserStore, nil)
+	svc := moderator.New(mockModStore, nil, nil, mockUserStore, nil, nil, nil, nil, nil)
 
 	banRecord := core.BannedUserRecord{UserID: 1}
 
@@ -290,8 +356,9 @@ func TestBanUser_WithNilReportID(t *testing.T) {
 	ctx := context.TODO()
 	mockUserStore := new(mocks.MockUserStore)
 	mockModStore := new(mocks.MockModeratorStore)
+	mockReportStore := new(mocks.MockReportStore)
 
-	svc := moderator.New(mockModStore, nil, nil, mockUserStore, nil)
+	svc := moderator.New(mockModStore, nil, mockReportStore, mockUserStore, nil, nil, nil, nil, nil)
 
 	banRecord := core.BannedUserRecord{
 		UserID:      1,
@@ -306,6 +373,9 @@ func TestBanUser_WithNilReportID(t *testing.T) {
 
 	mockUserStore.On("GetUserByID", ctx, 1).Return(activeUser, nil)
 	mockUserStore.On("BanUserWithRecord", ctx, banRecord).Return(nil)
+	mockReportStore.On("ResolveReports", ctx, 1, core.ReportableTypeUser, true).Return(nil)
+	mockReportStore.On("RemoveFromModeration", ctx, 1, core.ReportableTypeUser).Return(nil)
+	mockModStore.On("CreateDecision", ctx, mock.AnythingOfType("core.ModerationDecision")).Return(nil)
 
 	err := svc.BanUser(ctx, banRecord)
 
@@ -317,8 +387,9 @@ func TestBanUser_ConcurrentCalls(t *testing.T) {
 	ctx := context.TODO()
 	mockUserStore := new(mocks.MockUserStore)
 	mockModStore := new(mocks.MockModeratorStore)
+	mockReportStore := new(mocks.MockReportStore)
 
-	svc := moderator.New(mockModStore, nil, nil, mockUserStore, nil)
+	svc := moderator.New(mockModStore, nil, mockReportStore, mockUserStore, nil, nil, nil, nil, nil)
 
 	banRecord := core.BannedUserRecord{UserID: 1}
 
@@ -334,6 +405,9 @@ func TestBanUser_ConcurrentCalls(t *testing.T) {
 
 	mockUserStore.On("GetUserByID", ctx, 1).Return(activeUser, nil).Once()
 	mockUserStore.On("BanUserWithRecord", ctx, banRecord).Return(nil).Once()
+	mockReportStore.On("ResolveReports", ctx, 1, core.ReportableTypeUser, true).Return(nil)
+	mockReportStore.On("RemoveFromModeration", ctx, 1, core.ReportableTypeUser).Return(nil)
+	mockModStore.On("CreateDecision", ctx, mock.AnythingOfType("core.ModerationDecision")).Return(nil)
 
 	mockUserStore.On("GetUserByID", ctx, 1).Return(bannedUser, nil).Once()
 
@@ -351,6 +425,7 @@ func TestGetCommentsForModeration_Success(t *testing.T) {
 	ctx := context.TODO()
 	mockCommentStore := new(mocks.MockCommentStore)
 	mockReportStore := new(mocks.MockReportStore)
+	mockRevisions := new(mocks.MockRevisionStore)
 
 	filter := core.FilterDESC
 	comments := []core.Comment{
@@ -361,8 +436,12 @@ func TestGetCommentsForModeration_Success(t *testing.T) {
 	mockCommentStore.On("GetCommentsForModeration", ctx, filter).Return(comments, nil)
 	mockReportStore.On("GetReportReasons", ctx, 1, core.ReportableTypeComment).Return([]string{"spam"}, nil)
 	mockReportStore.On("GetReportReasons", ctx, 2, core.ReportableTypeComment).Return([]string{"offensive"}, nil)
+	mockReportStore.On("GetReports", ctx, 1, core.ReportableTypeComment).Return([]core.Report{{Reason: core.Spam}}, nil)
+	mockReportStore.On("GetReports", ctx, 2, core.ReportableTypeComment).Return([]core.Report{{Reason: "offensive"}}, nil)
+	mockRevisions.On("GetCommentRevisions", ctx, 1).Return([]core.CommentRevision{}, nil)
+	mockRevisions.On("GetCommentRevisions", ctx, 2).Return([]core.CommentRevision{}, nil)
 
-	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore, nil, nil, mockRevisions, nil)
 
 	result, err := svc.GetCommentsForModeration(ctx, filter)
 	assert.NoError(t, err)
@@ -384,7 +463,7 @@ func TestGetCommentsForModeration_NoComments(t *testing.T) {
 	filter := core.FilterASC
 	mockCommentStore.On("GetCommentsForModeration", ctx, filter).Return([]core.Comment{}, nil)
 
-	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore, nil, nil, nil, nil)
 
 	result, err := svc.GetCommentsForModeration(ctx, filter)
 	assert.NoError(t, err)
@@ -402,7 +481,7 @@ func TestGetCommentsForModeration_StoreError(t *testing.T) {
 	filter := core.FilterDESC
 	mockCommentStore.On("GetCommentsForModeration", ctx, filter).Return(nil, errors.New("database error"))
 
-	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore, nil, nil, nil, nil)
 
 	result, err := svc.GetCommentsForModeration(ctx, filter)
 	assert.Error(t, err)
@@ -427,7 +506,7 @@ func TestGetCommentsForModeration_ReportError_FailsImmediately(t *testing.T) {
 	mockCommentStore.On("GetCommentsForModeration", ctx, filter).Return(comments, nil)
 	mockReportStore.On("GetReportReasons", ctx, 1, core.ReportableTypeComment).Return(nil, errors.New("report error"))
 
-	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore, nil, nil, nil, nil)
 
 	result, err := svc.GetCommentsForModeration(ctx, filter)
 	assert.Error(t, err)
@@ -442,19 +521,32 @@ func TestGetCommentsForModeration_ReportError_FailsImmediately(t *testing.T) {
 func TestDeleteComment_Success(t *testing.T) {
 	ctx := context.TODO()
 	mockCommentStore := new(mocks.MockCommentStore)
+	mockReportStore := new(mocks.MockReportStore)
 
 	commentID := 1
-	comment := core.Comment{ID: commentID, Content: "test comment"}
+	comment := core.Comment{ID: commentID, AuthorID: 4, Content: "test comment"}
 
 	mockCommentStore.On("GetCommentByID", ctx, commentID).Return(comment, nil)
 	mockCommentStore.On("DeleteComment", ctx, comment).Return(nil)
+	mockReportStore.On("ResolveReports", ctx, commentID, core.ReportableTypeComment, true).Return(nil)
 
-	svc := moderator.New(nil, nil, nil, nil, mockCommentStore)
+	mockModStore := new(mocks.MockModeratorStore)
+	mockModStore.On("CreateDecision", ctx, core.ModerationDecision{
+		UserID:     comment.AuthorID,
+		TargetType: core.ReportableTypeComment,
+		TargetID:   commentID,
+		Action:     core.ActionDeleted,
+		Reason:     core.ViolentSpeech,
+	}).Return(nil)
+
+	svc := moderator.New(mockModStore, nil, mockReportStore, nil, mockCommentStore, nil, nil, nil, nil)
 
-	err := svc.DeleteComment(ctx, commentID)
+	err := svc.DeleteComment(ctx, core.ModerationDecision{TargetID: commentID, Reason: core.ViolentSpeech})
 	assert.NoError(t, err)
 
 	mockCommentStore.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
+	mockModStore.AssertExpectations(t)
 }
 
 func TestDeleteComment_CommentNotFound(t *testing.T) {
@@ -464,9 +556,9 @@ func TestDeleteComment_CommentNotFound(t *testing.T) {
 	commentID := 999
 	mockCommentStore.On("GetCommentByID", ctx, commentID).Return(core.Comment{}, core.ErrNoSuchComment)
 
-	svc := moderator.New(nil, nil, nil, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, nil, nil, mockCommentStore, nil, nil, nil, nil)
 
-	err := svc.DeleteComment(ctx, commentID)
+	err := svc.DeleteComment(ctx, core.ModerationDecision{TargetID: commentID})
 	assert.Error(t, err)
 	assert.Equal(t, core.ErrNoSuchComment, err)
 
@@ -484,9 +576,9 @@ func TestDeleteComment_DeleteError(t *testing.T) {
 	mockCommentStore.On("GetCommentByID", ctx, commentID).Return(comment, nil)
 	mockCommentStore.On("DeleteComment", ctx, comment).Return(errors.New("delete error"))
 
-	svc := moderator.New(nil, nil, nil, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, nil, nil, mockCommentStore, nil, nil, nil, nil)
 
-	err := svc.DeleteComment(ctx, commentID)
+	err := svc.DeleteComment(ctx, core.ModerationDecision{TargetID: commentID})
 	assert.Error(t, err)
 	assert.EqualError(t, err, "delete error")
 
@@ -503,15 +595,20 @@ func TestApproveComment_Success(t *testing.T) {
 
 	mockCommentStore.On("GetCommentByID", ctx, commentID).Return(comment, nil)
 	mockCommentStore.On("ApproveCommentFromModeration", ctx, commentID).Return(nil)
+	mockReportStore.On("ResolveReports", ctx, commentID, core.ReportableTypeComment, false).Return(nil)
 	mockReportStore.On("DeleteAllReports", ctx, commentID, core.ReportableTypeComment).Return(nil)
+	// mentioned users are called to the comment once it is visible
+	mockCommentService := new(mocks.MockCommentService)
+	mockCommentService.On("NotifyApprovedComment", ctx, comment).Return().Once()
 
-	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore, nil, nil, nil, mockCommentService)
 
 	err := svc.ApproveComment(ctx, commentID)
 	assert.NoError(t, err)
 
 	mockCommentStore.AssertExpectations(t)
 	mockReportStore.AssertExpectations(t)
+	mockCommentService.AssertExpectations(t)
 }
 
 func TestApproveComment_CommentNotFound(t *testing.T) {
@@ -522,7 +619,7 @@ func TestApproveComment_CommentNotFound(t *testing.T) {
 	commentID := 999
 	mockCommentStore.On("GetCommentByID", ctx, commentID).Return(core.Comment{}, core.ErrNoSuchComment)
 
-	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore, nil, nil, nil, nil)
 
 	err := svc.ApproveComment(ctx, commentID)
 	assert.Error(t, err)
@@ -544,7 +641,7 @@ func TestApproveComment_ApproveError(t *testing.T) {
 	mockCommentStore.On("GetCommentByID", ctx, commentID).Return(comment, nil)
 	mockCommentStore.On("ApproveCommentFromModeration", ctx, commentID).Return(errors.New("approve error"))
 
-	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore, nil, nil, nil, nil)
 
 	err := svc.ApproveComment(ctx, commentID)
 	assert.Error(t, err)
@@ -564,9 +661,12 @@ func TestApproveComment_DeleteReportsError_ButApprovalSucceeds(t *testing.T) {
 
 	mockCommentStore.On("GetCommentByID", ctx, commentID).Return(comment, nil)
 	mockCommentStore.On("ApproveCommentFromModeration", ctx, commentID).Return(nil)
+	mockReportStore.On("ResolveReports", ctx, commentID, core.ReportableTypeComment, false).Return(nil)
 	mockReportStore.On("DeleteAllReports", ctx, commentID, core.ReportableTypeComment).Return(errors.New("delete reports error"))
+	mockCommentService := new(mocks.MockCommentService)
+	mockCommentService.On("NotifyApprovedComment", ctx, comment).Return()
 
-	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore, nil, nil, nil, mockCommentService)
 
 	err := svc.ApproveComment(ctx, commentID)
 	assert.Error(t, err)
@@ -584,7 +684,7 @@ func TestApproveComment_GetCommentByIDError(t *testing.T) {
 	commentID := 1
 	mockCommentStore.On("GetCommentByID", ctx, commentID).Return(core.Comment{}, core.ErrNoSuchComment)
 
-	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore, nil, nil, nil, nil)
 
 	err := svc.ApproveComment(ctx, commentID)
 	assert.Error(t, err)
@@ -603,7 +703,7 @@ func TestApproveComment_GetCommentByIDReturnsErrNoSuchComment(t *testing.T) {
 	commentID := 999
 	mockCommentStore.On("GetCommentByID", ctx, commentID).Return(core.Comment{}, core.ErrNoSuchComment)
 
-	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore)
+	svc := moderator.New(nil, nil, mockReportStore, nil, mockCommentStore, nil, nil, nil, nil)
 
 	err := svc.ApproveComment(ctx, commentID)
 	assert.Error(t, err)
@@ -613,3 +713,211 @@ func TestApproveComment_GetCommentByIDReturnsErrNoSuchComment(t *testing.T) {
 	mockCommentStore.AssertNotCalled(t, "ApproveCommentFromModeration")
 	mockReportStore.AssertNotCalled(t, "DeleteAllReports")
 }
+
+func TestGetReportedTargets_Success(t *testing.T) {
+	ctx := context.TODO()
+	mockReportStore := new(mocks.MockReportStore)
+	mockMessageStore := new(mocks.MockMessageStore)
+
+	description := "scam link"
+	targets := []core.ModerationTarget{{ReportableID: 3, ReportableType: core.ReportableTypeMessage}}
+	reports := []core.Report{{Reason: core.Other, Description: &description}}
+
+	mockReportStore.On("GetTargetsForModeration", ctx, core.ReportableTypeMessage, core.FilterASC).Return(targets, nil)
+	mockMessageStore.On("GetMessageByID", ctx, 3).Return(core.Message{ID: 3, UserID: 8, Content: "hello"}, nil)
+	mockReportStore.On("GetReports", ctx, 3, core.ReportableTypeMessage).Return(reports, nil)
+
+	svc := moderator.New(nil, nil, mockReportStore, nil, nil, nil, mockMessageStore, nil, nil)
+
+	result, err := svc.GetReportedTargets(ctx, core.ReportableTypeMessage, core.FilterASC)
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, 8, result[0].AuthorID)
+	assert.Equal(t, "hello", *result[0].Content)
+	assert.Equal(t, reports, result[0].Reports)
+
+	mockReportStore.AssertExpectations(t)
+	mockMessageStore.AssertExpectations(t)
+}
+
+func TestGetReportedTargets_InvalidType(t *testing.T) {
+	ctx := context.TODO()
+
+	svc := moderator.New(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	result, err := svc.GetReportedTargets(ctx, core.ReportableTypePost, core.FilterASC)
+	assert.ErrorIs(t, err, core.ErrInvalidReportableType)
+	assert.Nil(t, result)
+}
+
+func TestDismissReports_Success(t *testing.T) {
+	ctx := context.TODO()
+	mockReportStore := new(mocks.MockReportStore)
+
+	mockReportStore.On("RemoveFromModeration", ctx, 4, core.ReportableTypeVetReview).Return(nil)
+	mockReportStore.On("ResolveReports", ctx, 4, core.ReportableTypeVetReview, false).Return(nil)
+	mockReportStore.On("DeleteAllReports", ctx, 4, core.ReportableTypeVetReview).Return(nil)
+
+	svc := moderator.New(nil, nil, mockReportStore, nil, nil, nil, nil, nil, nil)
+
+	err := svc.DismissReports(ctx, 4, core.ReportableTypeVetReview)
+	assert.NoError(t, err)
+
+	mockReportStore.AssertExpectations(t)
+}
+
+func TestDismissReports_NotOnModeration(t *testing.T) {
+	ctx := context.TODO()
+	mockReportStore := new(mocks.MockReportStore)
+
+	mockReportStore.On("RemoveFromModeration", ctx, 4, core.ReportableTypeUser).Return(core.ErrNotOnModeration)
+
+	svc := moderator.New(nil, nil, mockReportStore, nil, nil, nil, nil, nil, nil)
+
+	err := svc.DismissReports(ctx, 4, core.ReportableTypeUser)
+	assert.ErrorIs(t, err, core.ErrNotOnModeration)
+
+	mockReportStore.AssertNotCalled(t, "ResolveReports")
+}
+
+func TestDeleteReportedTarget_Review(t *testing.T) {
+	ctx := context.TODO()
+	mockReportStore := new(mocks.MockReportStore)
+	mockReviewStore := new(mocks.MockReviewStore)
+
+	mockModStore := new(mocks.MockModeratorStore)
+
+	mockReportStore.On("IsOnModeration", ctx, 5, core.ReportableTypeKeeperReview).Return(true, nil)
+	mockReviewStore.On("GetReviewByID", ctx, core.KeeperReview, 5).Return(core.Review{ID: 5, AuthorID: 8}, nil)
+	mockReviewStore.On("DeleteReview", ctx, core.KeeperReview, 5).Return(nil)
+	mockReportStore.On("ResolveReports", ctx, 5, core.ReportableTypeKeeperReview, true).Return(nil)
+	mockReportStore.On("RemoveFromModeration", ctx, 5, core.ReportableTypeKeeperReview).Return(nil)
+	mockModStore.On("CreateDecision", ctx, core.ModerationDecision{
+		UserID:     8,
+		TargetType: core.ReportableTypeKeeperReview,
+		TargetID:   5,
+		Action:     core.ActionDeleted,
+		Reason:     core.Other,
+	}).Return(nil)
+
+	svc := moderator.New(mockModStore, nil, mockReportStore, nil, nil, mockReviewStore, nil, nil, nil)
+
+	err := svc.DeleteReportedTarget(ctx, core.ModerationDecision{
+		TargetType: core.ReportableTypeKeeperReview,
+		TargetID:   5,
+		Reason:     core.Other,
+	})
+	assert.NoError(t, err)
+
+	mockReviewStore.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
+	mockModStore.AssertExpectations(t)
+}
+
+func TestDeleteReportedTarget_NotOnModeration(t *testing.T) {
+	ctx := context.TODO()
+	mockReportStore := new(mocks.MockReportStore)
+	mockMessageStore := new(mocks.MockMessageStore)
+	mockModStore := new(mocks.MockModeratorStore)
+
+	mockReportStore.On("IsOnModeration", ctx, 5, core.ReportableTypeMessage).Return(false, nil)
+
+	svc := moderator.New(mockModStore, nil, mockReportStore, nil, nil, nil, mockMessageStore, nil, nil)
+
+	err := svc.DeleteReportedTarget(ctx, core.ModerationDecision{TargetType: core.ReportableTypeMessage, TargetID: 5, Reason: core.Spam})
+	assert.ErrorIs(t, err, core.ErrNotOnModeration)
+
+	mockMessageStore.AssertNotCalled(t, "DeleteMessage", ctx, 5)
+	mockModStore.AssertNotCalled(t, "CreateDecision", ctx, mock.Anything)
+}
+
+func TestDeleteReportedTarget_UserNotAllowed(t *testing.T) {
+	ctx := context.TODO()
+
+	svc := moderator.New(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	err := svc.DeleteReportedTarget(ctx, core.ModerationDecision{TargetType: core.ReportableTypeUser, TargetID: 5})
+	assert.ErrorIs(t, err, core.ErrInvalidReportableType)
+}
+
+func TestGetPostModeration_WithDecision(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockModStore := new(mocks.MockModeratorStore)
+
+	reason := "stop words"
+	decision := core.ModerationDecision{ID: 1, UserID: 3, TargetType: core.ReportableTypePost, TargetID: 10, Action: core.ActionDeleted, Reason: core.Spam}
+
+	mockPosts.On("GetPostByIDAnyStatus", ctx, 10).Return(core.Post{ID: 10, AuthorID: 3, Status: core.Deleted, ModerationReason: &reason}, nil)
+	mockModStore.On("GetTargetDecision", ctx, core.ReportableTypePost, 10).Return(decision, nil)
+
+	svc := moderator.New(mockModStore, mockPosts, nil, nil, nil, nil, nil, nil, nil)
+
+	postModeration, err := svc.GetPostModeration(ctx, 3, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, core.Deleted, postModeration.Status)
+	assert.Equal(t, &reason, postModeration.FilterReason)
+	assert.Equal(t, &decision, postModeration.Decision)
+}
+
+func TestGetPostModeration_NoDecision(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockModStore := new(mocks.MockModeratorStore)
+
+	mockPosts.On("GetPostByIDAnyStatus", ctx, 10).Return(core.Post{ID: 10, AuthorID: 3, Status: core.OnModeration}, nil)
+	mockModStore.On("GetTargetDecision", ctx, core.ReportableTypePost, 10).Return(core.ModerationDecision{}, core.ErrNoModerationDecision)
+
+	svc := moderator.New(mockModStore, mockPosts, nil, nil, nil, nil, nil, nil, nil)
+
+	postModeration, err := svc.GetPostModeration(ctx, 3, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, core.OnModeration, postModeration.Status)
+	assert.Nil(t, postModeration.Decision)
+}
+
+func TestGetPostModeration_NotAuthor(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+
+	mockPosts.On("GetPostByIDAnyStatus", ctx, 10).Return(core.Post{ID: 10, AuthorID: 3}, nil)
+
+	svc := moderator.New(nil, mockPosts, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := svc.GetPostModeration(ctx, 4, 10)
+	assert.ErrorIs(t, err, core.ErrPostAuthorIDMismatch)
+}
+
+func TestSearchComments_Success(t *testing.T) {
+	ctx := context.TODO()
+	mockComments := new(mocks.MockCommentStore)
+
+	status := core.OnModeration
+	params := core.SearchCommentsParams{Query: "кот", Status: &status, Limit: 10}
+	highlight := "<mark>кот</mark>"
+	comments := []core.Comment{{ID: 1, Content: "кот", Status: core.OnModeration, Highlight: &highlight}}
+
+	mockComments.On("SearchComments", ctx, params).Return(comments, 1, nil)
+
+	svc := moderator.New(nil, nil, nil, nil, mockComments, nil, nil, nil, nil)
+
+	result, total, err := svc.SearchComments(ctx, params)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, comments, result)
+}
+
+func TestSearchComments_StoreError(t *testing.T) {
+	ctx := context.TODO()
+	mockComments := new(mocks.MockCommentStore)
+
+	params := core.SearchCommentsParams{Query: "кот", Limit: 10}
+	mockComments.On("SearchComments", ctx, params).Return(nil, 0, errors.New("db error"))
+
+	svc := moderator.New(nil, nil, nil, nil, mockComments, nil, nil, nil, nil)
+
+	result, total, err := svc.SearchComments(ctx, params)
+	assert.Error(t, err)
+	assert.Zero(t, total)
+	assert.Nil(t, result)
+}
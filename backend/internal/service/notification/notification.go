@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+type service struct {
+	notificationStore core.NotificationStore
+}
+
+// New initializes a new instance of service
+func New(notificationStore core.NotificationStore) core.NotificationService {
+	return &service{
+		notificationStore: notificationStore,
+	}
+}
+
+// GetNotifications retrieves notifications of the user
+func (s *service) GetNotifications(ctx context.Context, params core.GetNotificationsParams) ([]core.Notification, int, int, error) {
+	return s.notificationStore.GetNotifications(ctx, params)
+}
+
+// MarkNotificationsRead marks notifications of the user as read, all notifications are marked when ids are empty
+func (s *service) MarkNotificationsRead(ctx context.Context, userID int, ids []int) error {
+	return s.notificationStore.MarkNotificationsRead(ctx, userID, ids)
+}
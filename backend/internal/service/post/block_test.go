@@ -0,0 +1,52 @@
+package post_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/kotopesp/sos-kotopes/internal/service/post"
+)
+
+func TestGetAllPosts_HidesBlockedAuthors(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockMedia := new(mocks.MockMediaService)
+	mockBlocks := new(mocks.MockBlockStore)
+
+	mockBlocks.On("GetHiddenUserIDs", ctx, 5).Return([]int{2, 3}, nil).Once()
+	mockPosts.On("GetAllPosts", ctx, mock.MatchedBy(func(params core.GetAllPostsParams) bool {
+		return assert.ObjectsAreEqual([]int{2, 3}, params.ExcludeAuthorIDs)
+	})).Return([]core.Post{}, 0, nil).Once()
+	mockMedia.On("GetPostsPhotos", ctx, []int{}).Return(map[int][]core.Media{}, nil)
+
+	svc := post.New(mockPosts, nil, nil, nil, nil, mockMedia, nil, nil, newLikeStore(), mockBlocks, config)
+
+	_, _, err := svc.GetAllPosts(ctx, core.GetAllPostsParams{ViewerID: 5})
+	assert.NoError(t, err)
+
+	mockPosts.AssertExpectations(t)
+	mockBlocks.AssertExpectations(t)
+}
+
+func TestGetAllPosts_AnonymousUser(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockMedia := new(mocks.MockMediaService)
+	mockBlocks := new(mocks.MockBlockStore)
+
+	mockPosts.On("GetAllPosts", ctx, core.GetAllPostsParams{}).Return([]core.Post{}, 0, nil).Once()
+	mockMedia.On("GetPostsPhotos", ctx, []int{}).Return(map[int][]core.Media{}, nil)
+
+	svc := post.New(mockPosts, nil, nil, nil, nil, mockMedia, nil, nil, newLikeStore(), mockBlocks, config)
+
+	_, _, err := svc.GetAllPosts(ctx, core.GetAllPostsParams{})
+	assert.NoError(t, err)
+
+	mockPosts.AssertExpectations(t)
+	mockBlocks.AssertNotCalled(t, "GetHiddenUserIDs", mock.Anything, mock.Anything)
+}
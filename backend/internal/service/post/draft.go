@@ -0,0 +1,147 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// GetDrafts retrieves drafts of the author
+func (s *service) GetDrafts(ctx context.Context, authorID int, params core.GetAllPostsParams) ([]core.PostDetails, int, error) {
+	posts, total, err := s.postStore.GetDrafts(ctx, authorID, params)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	postsDetails, err := s.BuildPostDetailsList(ctx, posts, total, authorID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return postsDetails, total, nil
+}
+
+// PublishPost publishes the complete draft now or schedules its publication, the schedule may be changed until the draft is published
+func (s *service) PublishPost(ctx context.Context, publish core.PublishPost) (core.PostDetails, error) {
+	post, err := s.getEditablePost(ctx, publish.ID, publish.AuthorID)
+	if err != nil {
+		return core.PostDetails{}, err
+	}
+
+	if post.AuthorID != publish.AuthorID {
+		return core.PostDetails{}, core.ErrPostAuthorIDMismatch
+	} else if post.Status != core.Draft {
+		return core.PostDetails{}, core.ErrPostNotDraft
+	}
+
+	details, err := s.BuildPostDetails(ctx, post, publish.AuthorID)
+	if err != nil {
+		return core.PostDetails{}, err
+	}
+
+	if missing := details.MissingForPublication(); len(missing) > 0 {
+		return core.PostDetails{}, fmt.Errorf("%w: %s", core.ErrDraftIncomplete, strings.Join(missing, ", "))
+	}
+
+	if publish.PublishAt != nil && publish.PublishAt.After(time.Now()) {
+		publishAt := publish.PublishAt.UTC()
+		details.Post, err = s.postStore.SchedulePost(ctx, post.ID, &publishAt)
+		if err != nil {
+			return core.PostDetails{}, err
+		}
+
+		return details, nil
+	}
+
+	return s.publish(ctx, details)
+}
+
+// PublishScheduledPosts publishes drafts whose time has come. Drafts made incomplete after scheduling aren't published,
+// their schedule is cancelled and authors are notified. Failure of one draft is logged and doesn't stop the rest,
+// the failed draft is tried again on the next run.
+func (s *service) PublishScheduledPosts(ctx context.Context) error {
+	posts, err := s.postStore.GetScheduledPosts(ctx, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	var published, incomplete []core.Post
+	for _, post := range posts {
+		details, err := s.BuildPostDetails(ctx, post, post.AuthorID)
+		if err != nil {
+			logger.Log().Error(ctx, fmt.Sprintf("Failed to publish scheduled post %d: %s", post.ID, err.Error()))
+			continue
+		}
+
+		if len(details.MissingForPublication()) > 0 {
+			if _, err := s.postStore.SchedulePost(ctx, post.ID, nil); err != nil && !errors.Is(err, core.ErrPostNotDraft) {
+				logger.Log().Error(ctx, fmt.Sprintf("Failed to cancel schedule of post %d: %s", post.ID, err.Error()))
+				continue
+			}
+			incomplete = append(incomplete, post)
+			continue
+		}
+
+		details, err = s.publish(ctx, details)
+		switch {
+		case errors.Is(err, core.ErrPostNotDraft): // the author published the draft meanwhile
+			continue
+		case err != nil:
+			logger.Log().Error(ctx, fmt.Sprintf("Failed to publish scheduled post %d: %s", post.ID, err.Error()))
+			continue
+		}
+		published = append(published, details.Post)
+	}
+
+	if err := s.notifyAuthors(ctx, core.NotificationPostPublished, published); err != nil {
+		return err
+	}
+
+	return s.notifyAuthors(ctx, core.NotificationPostNotPublished, incomplete)
+}
+
+// publish runs the draft through the content filter and publishes it, suspicious draft is sent to moderation instead
+func (s *service) publish(ctx context.Context, details core.PostDetails) (core.PostDetails, error) {
+	post := details.Post
+	post.Status = core.Published
+	s.filterPost(ctx, &post, true)
+
+	published, err := s.postStore.PublishPost(ctx, post)
+	if err != nil {
+		return core.PostDetails{}, err
+	}
+
+	details.Post = published
+	details.DuplicatePostIDs = s.findDuplicates(ctx, published.ID, details.Photos)
+
+	// the history starts from the published version, states of the draft stay private
+	s.saveRevision(ctx, details, published.AuthorID)
+
+	return details, nil
+}
+
+// getEditablePost retrieves the post the author may change: published post or draft.
+// Drafts of other users are not found, so they don't reveal their existence.
+func (s *service) getEditablePost(ctx context.Context, id, authorID int) (core.Post, error) {
+	post, err := s.postStore.GetPostByIDAnyStatus(ctx, id)
+	if err != nil {
+		return core.Post{}, err
+	}
+
+	switch post.Status {
+	case core.Published:
+		return post, nil
+	case core.Draft:
+		if post.AuthorID == authorID {
+			return post, nil
+		}
+	}
+
+	return core.Post{}, core.ErrPostNotFound
+}
@@ -0,0 +1,227 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/kotopesp/sos-kotopes/internal/service/post"
+)
+
+var completeAnimal = core.Animal{ID: 1, AnimalType: "cat", Color: "black", Gender: "female", Status: "lost"}
+
+func TestPublishPost(t *testing.T) {
+	ctx := context.TODO()
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+	draft := core.Post{ID: 1, AuthorID: 1, AnimalID: 1, Title: "Lost cat", Content: "Black cat", Status: core.Draft}
+
+	tests := []struct {
+		name      string
+		post      core.Post
+		animal    core.Animal
+		photos    []core.Media
+		authorID  int
+		publishAt *time.Time
+		wantErr   error
+		scheduled bool
+		published bool
+	}{
+		{
+			name:      "publish now",
+			post:      draft,
+			animal:    completeAnimal,
+			photos:    []core.Media{{ID: 1}},
+			authorID:  1,
+			published: true,
+		},
+		{
+			name:      "past time publishes now",
+			post:      draft,
+			animal:    completeAnimal,
+			photos:    []core.Media{{ID: 1}},
+			authorID:  1,
+			publishAt: &past,
+			published: true,
+		},
+		{
+			name:      "schedule",
+			post:      draft,
+			animal:    completeAnimal,
+			photos:    []core.Media{{ID: 1}},
+			authorID:  1,
+			publishAt: &future,
+			scheduled: true,
+		},
+		{
+			name:     "incomplete draft",
+			post:     draft,
+			animal:   core.Animal{ID: 1, AnimalType: "cat"},
+			authorID: 1,
+			wantErr:  core.ErrDraftIncomplete,
+		},
+		{
+			name:     "draft of another user",
+			post:     draft,
+			authorID: 2,
+			wantErr:  core.ErrPostNotFound,
+		},
+		{
+			name:     "published post",
+			post:     core.Post{ID: 1, AuthorID: 1, AnimalID: 1, Status: core.Published},
+			authorID: 1,
+			wantErr:  core.ErrPostNotDraft,
+		},
+		{
+			name:     "published post of another user",
+			post:     core.Post{ID: 1, AuthorID: 1, AnimalID: 1, Status: core.Published},
+			authorID: 2,
+			wantErr:  core.ErrPostAuthorIDMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPosts := new(mocks.MockPostStore)
+			mockAnimals := new(mocks.MockAnimalStore)
+			mockUsers := new(mocks.MockUserStore)
+			mockMedia := new(mocks.MockMediaService)
+			mockFilter := new(mocks.MockContentFilter)
+			mockRevisions := new(mocks.MockRevisionStore)
+
+			mockPosts.On("GetPostByIDAnyStatus", ctx, 1).Return(tt.post, nil)
+			mockAnimals.On("GetAnimalByID", ctx, 1).Return(tt.animal, nil).Maybe()
+			mockUsers.On("GetUserByID", ctx, 1).Return(core.User{ID: 1}, nil).Maybe()
+			mockMedia.On("GetPostPhotos", ctx, 1).Return(tt.photos, nil).Maybe()
+			mockFilter.On("Check", ctx, mock.Anything).Return(core.FilterVerdict{}, nil).Maybe()
+			mockPosts.On("GetPostsByIDs", ctx, mock.Anything).Return(nil, nil).Maybe()
+			mockPosts.On("SchedulePost", ctx, 1, mock.Anything).Return(func(_ context.Context, _ int, publishAt *time.Time) (core.Post, error) {
+				scheduled := tt.post
+				scheduled.PublishAt = publishAt
+				return scheduled, nil
+			}).Maybe()
+			mockPosts.On("PublishPost", ctx, mock.MatchedBy(func(p core.Post) bool {
+				return p.Status == core.Published
+			})).Return(func(_ context.Context, p core.Post) (core.Post, error) {
+				return p, nil
+			}).Maybe()
+			mockRevisions.On("CreatePostRevision", ctx, mock.Anything).Return(core.PostRevision{}, nil).Maybe()
+
+			svc := post.New(mockPosts, nil, mockAnimals, mockUsers, mockFilter, mockMedia, nil, mockRevisions, newLikeStore(), nil, config)
+
+			details, err := svc.PublishPost(ctx, core.PublishPost{ID: 1, AuthorID: tt.authorID, PublishAt: tt.publishAt})
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			if tt.scheduled {
+				mockPosts.AssertCalled(t, "SchedulePost", ctx, 1, mock.Anything)
+				assert.Equal(t, core.Draft, details.Post.Status)
+				assert.NotNil(t, details.Post.PublishAt)
+			} else {
+				mockPosts.AssertNotCalled(t, "SchedulePost", ctx, 1, mock.Anything)
+			}
+
+			// the history starts from the published version
+			if tt.published {
+				assert.Equal(t, core.Published, details.Post.Status)
+				mockRevisions.AssertCalled(t, "CreatePostRevision", ctx, mock.MatchedBy(func(r core.PostRevision) bool {
+					return r.PostID == 1 && r.EditorID == 1
+				}))
+			} else {
+				mockPosts.AssertNotCalled(t, "PublishPost", ctx, mock.Anything)
+				mockRevisions.AssertNotCalled(t, "CreatePostRevision", ctx, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestPublishScheduledPosts(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockAnimals := new(mocks.MockAnimalStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockMedia := new(mocks.MockMediaService)
+	mockFilter := new(mocks.MockContentFilter)
+	mockNotifications := new(mocks.MockNotificationStore)
+	mockRevisions := new(mocks.MockRevisionStore)
+
+	complete := core.Post{ID: 1, AuthorID: 1, AnimalID: 1, Title: "Lost cat", Content: "Black cat", Status: core.Draft}
+	incomplete := core.Post{ID: 2, AuthorID: 1, AnimalID: 2, Title: "Lost dog", Status: core.Draft}
+	publishedMeanwhile := core.Post{ID: 3, AuthorID: 1, AnimalID: 1, Title: "Found cat", Content: "Black cat", Status: core.Draft}
+
+	mockPosts.On("GetScheduledPosts", ctx, mock.Anything).Return([]core.Post{complete, incomplete, publishedMeanwhile}, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(completeAnimal, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 2).Return(core.Animal{ID: 2, AnimalType: "dog"}, nil)
+	mockUsers.On("GetUserByID", ctx, 1).Return(core.User{ID: 1}, nil)
+	mockMedia.On("GetPostPhotos", ctx, mock.Anything).Return([]core.Media{{ID: 1}}, nil)
+	mockFilter.On("Check", ctx, mock.Anything).Return(core.FilterVerdict{}, nil)
+	mockPosts.On("GetPostsByIDs", ctx, mock.Anything).Return(nil, nil)
+	mockPosts.On("PublishPost", ctx, mock.MatchedBy(func(p core.Post) bool { return p.ID == 1 })).
+		Return(func(_ context.Context, p core.Post) (core.Post, error) { return p, nil }).Once()
+	mockPosts.On("PublishPost", ctx, mock.MatchedBy(func(p core.Post) bool { return p.ID == 3 })).
+		Return(core.Post{}, core.ErrPostNotDraft).Once()
+	mockPosts.On("SchedulePost", ctx, 2, (*time.Time)(nil)).Return(incomplete, nil).Once()
+	mockNotifications.On("CreateNotifications", ctx, mock.MatchedBy(func(n []core.Notification) bool {
+		return len(n) == 1 && n[0].Type == core.NotificationPostPublished
+	})).Return(nil).Once()
+	mockNotifications.On("CreateNotifications", ctx, mock.MatchedBy(func(n []core.Notification) bool {
+		return len(n) == 1 && n[0].Type == core.NotificationPostNotPublished
+	})).Return(nil).Once()
+
+	mockRevisions.On("CreatePostRevision", ctx, mock.MatchedBy(func(r core.PostRevision) bool { return r.PostID == 1 })).
+		Return(core.PostRevision{}, nil).Once()
+
+	svc := post.New(mockPosts, nil, mockAnimals, mockUsers, mockFilter, mockMedia, mockNotifications, mockRevisions, newLikeStore(), nil, config)
+
+	err := svc.PublishScheduledPosts(ctx)
+	assert.NoError(t, err)
+
+	mockPosts.AssertExpectations(t)
+	mockNotifications.AssertExpectations(t)
+	mockRevisions.AssertExpectations(t)
+}
+
+func TestPublishScheduledPosts_FailedDraft(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockAnimals := new(mocks.MockAnimalStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockMedia := new(mocks.MockMediaService)
+	mockFilter := new(mocks.MockContentFilter)
+	mockNotifications := new(mocks.MockNotificationStore)
+	mockRevisions := new(mocks.MockRevisionStore)
+
+	brokenAnimal := core.Post{ID: 1, AuthorID: 1, AnimalID: 2, Title: "Lost dog", Content: "Brown dog", Status: core.Draft}
+	published := core.Post{ID: 2, AuthorID: 1, AnimalID: 1, Title: "Lost cat", Content: "Black cat", Status: core.Draft}
+	failed := core.Post{ID: 3, AuthorID: 1, AnimalID: 1, Title: "Found cat", Content: "Black cat", Status: core.Draft}
+
+	mockPosts.On("GetScheduledPosts", ctx, mock.Anything).Return([]core.Post{brokenAnimal, published, failed}, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(completeAnimal, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 2).Return(core.Animal{}, errors.New("connection reset"))
+	mockUsers.On("GetUserByID", ctx, 1).Return(core.User{ID: 1}, nil)
+	mockMedia.On("GetPostPhotos", ctx, mock.Anything).Return([]core.Media{{ID: 1}}, nil)
+	mockFilter.On("Check", ctx, mock.Anything).Return(core.FilterVerdict{}, nil)
+	mockPosts.On("GetPostsByIDs", ctx, mock.Anything).Return(nil, nil)
+	mockPosts.On("PublishPost", ctx, mock.MatchedBy(func(p core.Post) bool { return p.ID == 2 })).
+		Return(func(_ context.Context, p core.Post) (core.Post, error) { return p, nil }).Once()
+	mockPosts.On("PublishPost", ctx, mock.MatchedBy(func(p core.Post) bool { return p.ID == 3 })).
+		Return(core.Post{}, errors.New("connection reset")).Once()
+	mockRevisions.On("CreatePostRevision", ctx, mock.Anything).Return(core.PostRevision{}, nil)
+	mockNotifications.On("CreateNotifications", ctx, mock.MatchedBy(func(n []core.Notification) bool {
+		return len(n) == 1 && n[0].Type == core.NotificationPostPublished
+	})).Return(nil).Once()
+	mockNotifications.On("CreateNotifications", ctx, []core.Notification{}).Return(nil).Once() // no incomplete drafts
+
+	svc := post.New(mockPosts, nil, mockAnimals, mockUsers, mockFilter, mockMedia, mockNotifications, mockRevisions, newLikeStore(), nil, config)
+
+	err := svc.PublishScheduledPosts(ctx)
+	assert.NoError(t, err)
+
+	mockPosts.AssertExpectations(t)
+	mockNotifications.AssertExpectations(t)
+}
@@ -7,19 +7,37 @@ import (
 	"github.com/kotopesp/sos-kotopes/pkg/logger"
 )
 
-// ToCorePostDetails creates a core.PostDetails object from a core.Post, core.Animal, and username string.
-func ToCorePostDetails(post core.Post, animal core.Animal, userName string) core.PostDetails {
+// ToCorePostDetails creates a core.PostDetails object from a core.Post, core.Animal, and the author of the post.
+func ToCorePostDetails(post core.Post, animal core.Animal, author core.User) core.PostDetails {
 	return core.PostDetails{
-		Post:     post,
-		Animal:   animal,
-		Username: userName,
+		Post:           post,
+		Animal:         animal,
+		Username:       author.Username,
+		AuthorContacts: author.Contacts,
 	}
 }
 
 // BuildPostDetailsList constructs a list of core.PostDetails from a list of core.Post objects.
-// It fetches the associated animal and user information for each post.
-func (s *service) BuildPostDetailsList(ctx context.Context, posts []core.Post, total int) ([]core.PostDetails, error) {
-	postDetails := make([]core.PostDetails, total)
+// It fetches the associated animal and user information for each post, likes are counted for the viewer.
+func (s *service) BuildPostDetailsList(ctx context.Context, posts []core.Post, total, viewerID int) ([]core.PostDetails, error) {
+	postDetails := make([]core.PostDetails, len(posts)) // total counts posts of all pages
+
+	postIDs := make([]int, len(posts))
+	for i, post := range posts {
+		postIDs[i] = post.ID
+	}
+
+	photos, err := s.mediaService.GetPostsPhotos(ctx, postIDs) // Fetch photos of all posts at once
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	likes, err := s.likeStore.GetPostsLikes(ctx, postIDs, viewerID) // Fetch likes of all posts at once
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
 
 	// Iterate through each post to build the post details
 	for i, post := range posts {
@@ -34,15 +52,17 @@ func (s *service) BuildPostDetailsList(ctx context.Context, posts []core.Post, t
 			return nil, err
 		}
 
-		postDetails[i] = ToCorePostDetails(post, animal, user.Username)
+		postDetails[i] = ToCorePostDetails(post, animal, user)
+		postDetails[i].Photos = photos[post.ID]
+		postDetails[i].Likes = likes[post.ID]
 	}
 
 	return postDetails, nil
 }
 
 // BuildPostDetails constructs a core.PostDetails object from a core.Post object.
-// It fetches the associated animal and user information for the post.
-func (s *service) BuildPostDetails(ctx context.Context, post core.Post) (core.PostDetails, error) {
+// It fetches the associated animal and user information, photos and likes of the post counted for the viewer.
+func (s *service) BuildPostDetails(ctx context.Context, post core.Post, viewerID int) (core.PostDetails, error) {
 	animal, err := s.animalStore.GetAnimalByID(ctx, post.AnimalID) // Fetch the animal details
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
@@ -55,11 +75,93 @@ func (s *service) BuildPostDetails(ctx context.Context, post core.Post) (core.Po
 		return core.PostDetails{}, err
 	}
 
-	postDetails := ToCorePostDetails(post, animal, user.Username)
+	photos, err := s.mediaService.GetPostPhotos(ctx, post.ID) // Fetch photos of the post
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.PostDetails{}, err
+	}
+
+	likes, err := s.likeStore.GetPostsLikes(ctx, []int{post.ID}, viewerID) // Fetch likes of the post
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.PostDetails{}, err
+	}
+
+	postDetails := ToCorePostDetails(post, animal, user)
+	postDetails.Photos = photos
+	postDetails.Likes = likes[post.ID]
 
 	return postDetails, nil
 }
 
+// findDuplicates returns published posts having the same photos as the given post.
+// Duplicates are reported to the author only, so errors are logged and don't prevent saving of the post.
+func (s *service) findDuplicates(ctx context.Context, postID int, photos []core.Media) []int {
+	var postIDs []int
+	seen := map[int]bool{postID: true}
+
+	for _, photo := range photos {
+		if photo.Hash == nil {
+			continue
+		}
+
+		matches, err := s.mediaService.FindSimilarPhotos(
+			ctx, core.MediaOwnerPost, *photo.Hash, core.DuplicatePhotoDistance, core.DefaultSimilarPostsLimit,
+		)
+		if err != nil {
+			logger.Log().Error(ctx, err.Error())
+			return nil
+		}
+
+		for _, match := range matches {
+			if !seen[match.OwnerID] {
+				seen[match.OwnerID] = true
+				postIDs = append(postIDs, match.OwnerID)
+			}
+		}
+	}
+
+	posts, err := s.postStore.GetPostsByIDs(ctx, postIDs)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil
+	}
+
+	published := make(map[int]bool, len(posts))
+	for _, post := range posts {
+		published[post.ID] = true
+	}
+
+	duplicates := make([]int, 0, len(posts))
+	for _, id := range postIDs {
+		if published[id] {
+			duplicates = append(duplicates, id)
+		}
+	}
+
+	return duplicates
+}
+
+// filterPost runs post through the content filter and sends suspicious post to moderation instead of publishing it.
+// Errors of the filter are logged and don't prevent publication.
+func (s *service) filterPost(ctx context.Context, post *core.Post, isNew bool) {
+	verdict, err := s.contentFilter.Check(ctx, core.FilteredContent{
+		AuthorID:    post.AuthorID,
+		ContentType: core.ReportableTypePost,
+		Text:        post.Title + "\n" + post.Content,
+		New:         isNew,
+	})
+	if err != nil {
+		logger.Log().Error(ctx, "Failed to check post content: "+err.Error())
+		return
+	}
+
+	if verdict.Suspicious {
+		post.Status = core.OnModeration
+		post.ModerationReason = &verdict.Reason
+	}
+}
+
 // FuncUpdateRequestBodyPost updates the post details based on UpdateRequestBodyPost
 func FuncUpdateRequestBodyPost(postDetails core.PostDetails, updatePost core.UpdateRequestBodyPost) core.PostDetails {
 	if updatePost.Title != nil {
@@ -70,10 +172,6 @@ func FuncUpdateRequestBodyPost(postDetails core.PostDetails, updatePost core.Upd
 		postDetails.Post.Content = *updatePost.Content
 	}
 
-	if updatePost.Photo != nil {
-		postDetails.Post.Photo = *updatePost.Photo
-	}
-
 	if updatePost.AnimalType != nil {
 		postDetails.Animal.AnimalType = *updatePost.AnimalType
 	}
@@ -98,5 +196,58 @@ func FuncUpdateRequestBodyPost(postDetails core.PostDetails, updatePost core.Upd
 		postDetails.Animal.Status = *updatePost.Status
 	}
 
+	if updatePost.Name != nil {
+		postDetails.Animal.Name = *updatePost.Name
+	}
+
+	if updatePost.Breed != nil {
+		postDetails.Animal.Breed = *updatePost.Breed
+	}
+
+	if updatePost.Size != nil {
+		postDetails.Animal.Size = updatePost.Size
+	}
+
+	if updatePost.Colors != nil {
+		postDetails.Animal.Colors = updatePost.Colors
+	}
+
+	if updatePost.CoatPattern != nil {
+		postDetails.Animal.CoatPattern = updatePost.CoatPattern
+	}
+
+	if updatePost.DistinguishingMarks != nil {
+		postDetails.Animal.DistinguishingMarks = *updatePost.DistinguishingMarks
+	}
+
+	if updatePost.MicrochipNumber != nil {
+		postDetails.Animal.MicrochipNumber = updatePost.MicrochipNumber
+	}
+
+	if updatePost.TattooID != nil {
+		postDetails.Animal.TattooID = updatePost.TattooID
+	}
+
+	if updatePost.IsSterilized != nil {
+		postDetails.Animal.IsSterilized = updatePost.IsSterilized
+	}
+
+	if updatePost.IsVaccinated != nil {
+		postDetails.Animal.IsVaccinated = updatePost.IsVaccinated
+	}
+
+	if updatePost.HasCollar != nil {
+		postDetails.Animal.HasCollar = updatePost.HasCollar
+	}
+
+	if updatePost.CollarDescription != nil {
+		postDetails.Animal.CollarDescription = *updatePost.CollarDescription
+	}
+
+	if updatePost.Latitude != nil && updatePost.Longitude != nil {
+		postDetails.Post.Latitude = updatePost.Latitude
+		postDetails.Post.Longitude = updatePost.Longitude
+	}
+
 	return postDetails
 }
@@ -0,0 +1,148 @@
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// statisticsPeriod - period of recently resolved posts in statistics.
+const statisticsPeriod = 30 * 24 * time.Hour
+
+// ResolvePost closes the case of the post, e.g. the lost animal returned home, the resolution must match status of the animal.
+// Archived posts may be resolved as well, resolved posts stay visible by ID and in listings of resolved posts.
+func (s *service) ResolvePost(ctx context.Context, resolve core.ResolvePost) (core.PostDetails, error) {
+	post, err := s.getAuthorPost(ctx, resolve.ID, resolve.AuthorID)
+	if err != nil {
+		return core.PostDetails{}, err
+	}
+
+	if post.State == core.PostResolved {
+		return core.PostDetails{}, core.ErrPostNotActive
+	}
+
+	animal, err := s.animalStore.GetAnimalByID(ctx, post.AnimalID)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.PostDetails{}, err
+	}
+
+	if !resolve.Resolution.CanResolve(animal.Status) {
+		return core.PostDetails{}, core.ErrInvalidPostResolution
+	}
+
+	now := time.Now().UTC()
+	post.State = core.PostResolved
+	post.Resolution = &resolve.Resolution
+	post.SuccessStory = resolve.SuccessStory
+	post.ResolvedAt = &now
+
+	return s.savePost(ctx, post)
+}
+
+// RenewPost returns the archived post to active ones or keeps the active post from archiving after the author was reminded about it
+func (s *service) RenewPost(ctx context.Context, post core.Post) (core.PostDetails, error) {
+	dbPost, err := s.getAuthorPost(ctx, post.ID, post.AuthorID)
+	if err != nil {
+		return core.PostDetails{}, err
+	}
+
+	switch {
+	case dbPost.State == core.PostResolved:
+		return core.PostDetails{}, core.ErrPostNotActive
+	case dbPost.State == core.PostActive && (dbPost.ExpiryRemindedAt == nil || dbPost.ExpiryRemindedAt.Before(dbPost.BumpedAt)):
+		return core.PostDetails{}, core.ErrPostNotExpiring
+	}
+
+	dbPost.State = core.PostActive
+	dbPost.ArchivedAt = nil
+	dbPost.ExpiryRemindedAt = nil
+	dbPost.BumpedAt = time.Now().UTC()
+
+	return s.savePost(ctx, dbPost)
+}
+
+// BumpPost moves the active post to the top of the newest posts, the post may be bumped once per the bump interval
+func (s *service) BumpPost(ctx context.Context, post core.Post) (core.PostDetails, error) {
+	dbPost, err := s.getAuthorPost(ctx, post.ID, post.AuthorID)
+	if err != nil {
+		return core.PostDetails{}, err
+	}
+
+	if dbPost.State != core.PostActive {
+		return core.PostDetails{}, core.ErrPostNotActive
+	}
+
+	bumped, err := s.postStore.BumpPost(ctx, dbPost.ID, time.Now().UTC().Add(-s.config.BumpInterval))
+	if err != nil {
+		return core.PostDetails{}, err
+	}
+
+	return s.BuildPostDetails(ctx, bumped, post.AuthorID)
+}
+
+// ExpirePosts reminds authors about posts which are about to be archived and archives expired posts.
+// The post expires when it isn't bumped or renewed for the lifetime, expiry is disabled when the lifetime isn't set.
+func (s *service) ExpirePosts(ctx context.Context) error {
+	if s.config.Lifetime <= 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+
+	reminded, err := s.postStore.RemindExpiringPosts(ctx, now.Add(-(s.config.Lifetime - s.config.ExpiryReminder)))
+	if err != nil {
+		return err
+	}
+	if err := s.notifyAuthors(ctx, core.NotificationPostExpiring, reminded); err != nil {
+		return err
+	}
+
+	archived, err := s.postStore.ArchiveExpiredPosts(ctx, now.Add(-s.config.Lifetime), now.Add(-s.config.ExpiryReminder))
+	if err != nil {
+		return err
+	}
+
+	return s.notifyAuthors(ctx, core.NotificationPostArchived, archived)
+}
+
+// GetPostStatistics counts active and resolved posts
+func (s *service) GetPostStatistics(ctx context.Context) (core.PostStatistics, error) {
+	return s.postStore.GetPostStatistics(ctx, time.Now().UTC().Add(-statisticsPeriod))
+}
+
+// getAuthorPost retrieves the published post of the author
+func (s *service) getAuthorPost(ctx context.Context, id, authorID int) (core.Post, error) {
+	post, err := s.postStore.GetPostByID(ctx, id)
+	if err != nil {
+		return core.Post{}, err
+	}
+
+	if post.AuthorID != authorID {
+		return core.Post{}, core.ErrPostAuthorIDMismatch
+	}
+
+	return post, nil
+}
+
+// savePost updates the post and retrieves its details
+func (s *service) savePost(ctx context.Context, post core.Post) (core.PostDetails, error) {
+	updated, err := s.postStore.UpdatePost(ctx, post)
+	if err != nil {
+		return core.PostDetails{}, err
+	}
+
+	return s.BuildPostDetails(ctx, updated, post.AuthorID)
+}
+
+// notifyAuthors notifies authors of the posts
+func (s *service) notifyAuthors(ctx context.Context, notificationType string, posts []core.Post) error {
+	notifications := make([]core.Notification, len(posts))
+	for i, post := range posts {
+		notifications[i] = core.PostNotification(notificationType, post)
+	}
+
+	return s.notificationStore.CreateNotifications(ctx, notifications)
+}
@@ -0,0 +1,214 @@
+package post_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/kotopesp/sos-kotopes/internal/service/post"
+)
+
+var config = core.PostServiceConfig{
+	Lifetime:       30 * 24 * time.Hour,
+	ExpiryReminder: 3 * 24 * time.Hour,
+	BumpInterval:   24 * time.Hour,
+}
+
+func newService(posts *mocks.MockPostStore, animals *mocks.MockAnimalStore, users *mocks.MockUserStore, media *mocks.MockMediaService, notifications *mocks.MockNotificationStore) core.PostService {
+	return post.New(posts, nil, animals, users, nil, media, notifications, nil, newLikeStore(), nil, config)
+}
+
+// newLikeStore returns store of posts without likes
+func newLikeStore() *mocks.MockLikeStore {
+	likes := new(mocks.MockLikeStore)
+	likes.On("GetPostsLikes", mock.Anything, mock.Anything, mock.Anything).Return(map[int]core.Likes{}, nil).Maybe()
+	return likes
+}
+
+func TestResolvePost(t *testing.T) {
+	ctx := context.TODO()
+
+	tests := []struct {
+		name         string
+		post         core.Post
+		animalStatus string
+		resolution   core.PostResolution
+		wantErr      error
+	}{
+		{
+			name:         "lost animal reunited",
+			post:         core.Post{ID: 1, AuthorID: 1, AnimalID: 1, State: core.PostActive},
+			animalStatus: "lost",
+			resolution:   core.PostReunited,
+		},
+		{
+			name:         "archived post resolved",
+			post:         core.Post{ID: 1, AuthorID: 1, AnimalID: 1, State: core.PostArchived},
+			animalStatus: core.AnimalStatusNeedHome,
+			resolution:   core.PostAdopted,
+		},
+		{
+			name:         "lost animal can't be adopted",
+			post:         core.Post{ID: 1, AuthorID: 1, AnimalID: 1, State: core.PostActive},
+			animalStatus: "lost",
+			resolution:   core.PostAdopted,
+			wantErr:      core.ErrInvalidPostResolution,
+		},
+		{
+			name:         "already resolved",
+			post:         core.Post{ID: 1, AuthorID: 1, AnimalID: 1, State: core.PostResolved},
+			animalStatus: "lost",
+			resolution:   core.PostReunited,
+			wantErr:      core.ErrPostNotActive,
+		},
+		{
+			name:         "not the author",
+			post:         core.Post{ID: 1, AuthorID: 2, AnimalID: 1, State: core.PostActive},
+			animalStatus: "lost",
+			resolution:   core.PostReunited,
+			wantErr:      core.ErrPostAuthorIDMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPosts := new(mocks.MockPostStore)
+			mockAnimals := new(mocks.MockAnimalStore)
+			mockUsers := new(mocks.MockUserStore)
+			mockMedia := new(mocks.MockMediaService)
+
+			story := "Murka is home"
+			mockPosts.On("GetPostByID", ctx, 1).Return(tt.post, nil)
+			mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1, Status: tt.animalStatus}, nil)
+			mockPosts.On("UpdatePost", ctx, mock.MatchedBy(func(p core.Post) bool {
+				return p.State == core.PostResolved && *p.Resolution == tt.resolution && *p.SuccessStory == story && p.ResolvedAt != nil
+			})).Return(func(_ context.Context, p core.Post) (core.Post, error) {
+				return p, nil
+			}).Maybe()
+			mockUsers.On("GetUserByID", ctx, 1).Return(core.User{ID: 1}, nil).Maybe()
+			mockMedia.On("GetPostPhotos", ctx, 1).Return(nil, nil).Maybe()
+
+			svc := newService(mockPosts, mockAnimals, mockUsers, mockMedia, nil)
+
+			got, err := svc.ResolvePost(ctx, core.ResolvePost{ID: 1, AuthorID: 1, Resolution: tt.resolution, SuccessStory: &story})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockPosts.AssertNotCalled(t, "UpdatePost", ctx, mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, core.PostResolved, got.Post.State)
+		})
+	}
+}
+
+func TestRenewPost(t *testing.T) {
+	ctx := context.TODO()
+	bumpedAt := time.Now().UTC().Add(-28 * 24 * time.Hour)
+	remindedAt := bumpedAt.Add(27 * 24 * time.Hour)
+	archivedAt := bumpedAt.Add(30 * 24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		post    core.Post
+		wantErr error
+	}{
+		{
+			name: "archived",
+			post: core.Post{ID: 1, AuthorID: 1, State: core.PostArchived, BumpedAt: bumpedAt, ExpiryRemindedAt: &remindedAt, ArchivedAt: &archivedAt},
+		},
+		{
+			name: "reminded",
+			post: core.Post{ID: 1, AuthorID: 1, State: core.PostActive, BumpedAt: bumpedAt, ExpiryRemindedAt: &remindedAt},
+		},
+		{
+			name:    "not reminded",
+			post:    core.Post{ID: 1, AuthorID: 1, State: core.PostActive, BumpedAt: bumpedAt},
+			wantErr: core.ErrPostNotExpiring,
+		},
+		{
+			name:    "resolved",
+			post:    core.Post{ID: 1, AuthorID: 1, State: core.PostResolved, BumpedAt: bumpedAt},
+			wantErr: core.ErrPostNotActive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPosts := new(mocks.MockPostStore)
+			mockAnimals := new(mocks.MockAnimalStore)
+			mockUsers := new(mocks.MockUserStore)
+			mockMedia := new(mocks.MockMediaService)
+
+			mockPosts.On("GetPostByID", ctx, 1).Return(tt.post, nil)
+			mockPosts.On("UpdatePost", ctx, mock.MatchedBy(func(p core.Post) bool {
+				return p.State == core.PostActive && p.ArchivedAt == nil && p.ExpiryRemindedAt == nil && p.BumpedAt.After(bumpedAt)
+			})).Return(func(_ context.Context, p core.Post) (core.Post, error) {
+				return p, nil
+			}).Maybe()
+			mockAnimals.On("GetAnimalByID", ctx, mock.Anything).Return(core.Animal{}, nil).Maybe()
+			mockUsers.On("GetUserByID", ctx, 1).Return(core.User{ID: 1}, nil).Maybe()
+			mockMedia.On("GetPostPhotos", ctx, 1).Return(nil, nil).Maybe()
+
+			svc := newService(mockPosts, mockAnimals, mockUsers, mockMedia, nil)
+
+			got, err := svc.RenewPost(ctx, core.Post{ID: 1, AuthorID: 1})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockPosts.AssertNotCalled(t, "UpdatePost", ctx, mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, core.PostActive, got.Post.State)
+		})
+	}
+}
+
+func TestBumpPost_BumpedRecently(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+
+	mockPosts.On("GetPostByID", ctx, 1).Return(core.Post{ID: 1, AuthorID: 1, State: core.PostActive}, nil)
+	mockPosts.On("BumpPost", ctx, 1, mock.MatchedBy(func(bumpedBefore time.Time) bool {
+		return time.Since(bumpedBefore) >= config.BumpInterval
+	})).Return(core.Post{}, core.ErrPostBumpedRecently)
+
+	svc := newService(mockPosts, nil, nil, nil, nil)
+
+	_, err := svc.BumpPost(ctx, core.Post{ID: 1, AuthorID: 1})
+	assert.ErrorIs(t, err, core.ErrPostBumpedRecently)
+
+	mockPosts.AssertExpectations(t)
+}
+
+func TestExpirePosts(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockNotifications := new(mocks.MockNotificationStore)
+
+	now := time.Now().UTC()
+	mockPosts.On("RemindExpiringPosts", ctx, mock.MatchedBy(func(bumpedBefore time.Time) bool {
+		return bumpedBefore.Before(now.Add(-27*24*time.Hour).Add(time.Minute)) && bumpedBefore.After(now.Add(-27*24*time.Hour).Add(-time.Minute))
+	})).Return([]core.Post{{ID: 1, AuthorID: 5}}, nil)
+	mockPosts.On("ArchiveExpiredPosts", ctx, mock.Anything, mock.Anything).Return([]core.Post{{ID: 2, AuthorID: 6}}, nil)
+	mockNotifications.On("CreateNotifications", ctx, mock.MatchedBy(func(n []core.Notification) bool {
+		return len(n) == 1 && n[0].UserID == 5 && n[0].Type == core.NotificationPostExpiring && *n[0].EntityID == 1
+	})).Return(nil).Once()
+	mockNotifications.On("CreateNotifications", ctx, mock.MatchedBy(func(n []core.Notification) bool {
+		return len(n) == 1 && n[0].UserID == 6 && n[0].Type == core.NotificationPostArchived && *n[0].EntityID == 2
+	})).Return(nil).Once()
+
+	svc := newService(mockPosts, nil, nil, nil, mockNotifications)
+
+	assert.NoError(t, svc.ExpirePosts(ctx))
+
+	mockPosts.AssertExpectations(t)
+	mockNotifications.AssertExpectations(t)
+}
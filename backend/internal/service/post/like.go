@@ -0,0 +1,42 @@
+package post
+
+import (
+	"context"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+// LikePost likes the published post, liking it again changes nothing
+func (s *service) LikePost(ctx context.Context, postID, userID int) (core.Likes, error) {
+	if _, err := s.postStore.GetPostByID(ctx, postID); err != nil {
+		return core.Likes{}, err
+	}
+
+	if err := s.likeStore.LikePost(ctx, core.PostLike{PostID: postID, UserID: userID}); err != nil {
+		return core.Likes{}, err
+	}
+
+	return s.getLikes(ctx, postID, userID)
+}
+
+// UnlikePost removes the like of the user from the published post
+func (s *service) UnlikePost(ctx context.Context, postID, userID int) (core.Likes, error) {
+	if _, err := s.postStore.GetPostByID(ctx, postID); err != nil {
+		return core.Likes{}, err
+	}
+
+	if err := s.likeStore.UnlikePost(ctx, postID, userID); err != nil {
+		return core.Likes{}, err
+	}
+
+	return s.getLikes(ctx, postID, userID)
+}
+
+func (s *service) getLikes(ctx context.Context, postID, userID int) (core.Likes, error) {
+	likes, err := s.likeStore.GetPostsLikes(ctx, []int{postID}, userID)
+	if err != nil {
+		return core.Likes{}, err
+	}
+
+	return likes[postID], nil
+}
@@ -0,0 +1,94 @@
+package post_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/kotopesp/sos-kotopes/internal/service/post"
+)
+
+func TestLikePost(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockLikes := new(mocks.MockLikeStore)
+
+	mockPosts.On("GetPostByID", ctx, 1).Return(core.Post{ID: 1}, nil)
+	mockLikes.On("LikePost", ctx, core.PostLike{PostID: 1, UserID: 5}).Return(nil).Once()
+	mockLikes.On("GetPostsLikes", ctx, []int{1}, 5).Return(map[int]core.Likes{1: {Count: 2, LikedByMe: true}}, nil).Once()
+
+	svc := post.New(mockPosts, nil, nil, nil, nil, nil, nil, nil, mockLikes, nil, config)
+
+	likes, err := svc.LikePost(ctx, 1, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, core.Likes{Count: 2, LikedByMe: true}, likes)
+
+	mockLikes.AssertExpectations(t)
+}
+
+func TestLikePost_PostNotFound(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockLikes := new(mocks.MockLikeStore)
+
+	mockPosts.On("GetPostByID", ctx, 1).Return(core.Post{}, core.ErrPostNotFound)
+
+	svc := post.New(mockPosts, nil, nil, nil, nil, nil, nil, nil, mockLikes, nil, config)
+
+	_, err := svc.LikePost(ctx, 1, 5)
+	assert.ErrorIs(t, err, core.ErrPostNotFound)
+
+	mockLikes.AssertNotCalled(t, "LikePost", ctx, core.PostLike{PostID: 1, UserID: 5})
+}
+
+func TestGetAllPosts_LikedByViewer(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockAnimals := new(mocks.MockAnimalStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockMedia := new(mocks.MockMediaService)
+	mockLikes := new(mocks.MockLikeStore)
+	mockBlocks := new(mocks.MockBlockStore)
+
+	mockBlocks.On("GetHiddenUserIDs", ctx, 5).Return(nil, nil).Once()
+	mockPosts.On("GetAllPosts", ctx, core.GetAllPostsParams{ViewerID: 5}).
+		Return([]core.Post{{ID: 1, AnimalID: 1, AuthorID: 2}}, 1, nil).Once()
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1}, nil)
+	mockUsers.On("GetUserByID", ctx, 2).Return(core.User{ID: 2}, nil)
+	mockMedia.On("GetPostsPhotos", ctx, []int{1}).Return(map[int][]core.Media{}, nil)
+	mockLikes.On("GetPostsLikes", ctx, []int{1}, 5).Return(map[int]core.Likes{1: {Count: 2, LikedByMe: true}}, nil).Once()
+
+	svc := post.New(mockPosts, nil, mockAnimals, mockUsers, nil, mockMedia, nil, nil, mockLikes, mockBlocks, config)
+
+	posts, _, err := svc.GetAllPosts(ctx, core.GetAllPostsParams{ViewerID: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, core.Likes{Count: 2, LikedByMe: true}, posts[0].Likes)
+
+	mockLikes.AssertExpectations(t)
+}
+
+func TestGetPostByID_LikedByViewer(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockAnimals := new(mocks.MockAnimalStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockMedia := new(mocks.MockMediaService)
+	mockLikes := new(mocks.MockLikeStore)
+
+	mockPosts.On("GetPostByID", ctx, 1).Return(core.Post{ID: 1, AnimalID: 1, AuthorID: 2}, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1}, nil)
+	mockUsers.On("GetUserByID", ctx, 2).Return(core.User{ID: 2}, nil)
+	mockMedia.On("GetPostPhotos", ctx, 1).Return([]core.Media{}, nil)
+	mockLikes.On("GetPostsLikes", ctx, []int{1}, 5).Return(map[int]core.Likes{1: {Count: 1, LikedByMe: true}}, nil).Once()
+
+	svc := post.New(mockPosts, nil, mockAnimals, mockUsers, nil, mockMedia, nil, nil, mockLikes, nil, config)
+
+	details, err := svc.GetPostByID(ctx, 1, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, core.Likes{Count: 1, LikedByMe: true}, details.Likes)
+
+	mockLikes.AssertExpectations(t)
+}
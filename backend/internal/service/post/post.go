@@ -3,9 +3,7 @@ package post
 import (
 	"context"
 	"fmt"
-	"mime/multipart"
 
-	"github.com/kotopesp/sos-kotopes/internal/controller/http"
 	"github.com/kotopesp/sos-kotopes/internal/core"
 	"github.com/kotopesp/sos-kotopes/pkg/logger"
 )
@@ -15,20 +13,53 @@ type service struct {
 	postFavouriteStore core.PostFavouriteStore
 	animalStore        core.AnimalStore
 	userStore          core.UserStore
+	contentFilter      core.ContentFilter
+	mediaService       core.MediaService
+	notificationStore  core.NotificationStore
+	revisionStore      core.RevisionStore
+	likeStore          core.LikeStore
+	blockStore         core.BlockStore
+	config             core.PostServiceConfig
 }
 
 // New initializes a new instance of service
-func New(postStore core.PostStore, postFavouriteStore core.PostFavouriteStore, animalStore core.AnimalStore, userStore core.UserStore) core.PostService {
+func New(
+	postStore core.PostStore,
+	postFavouriteStore core.PostFavouriteStore,
+	animalStore core.AnimalStore,
+	userStore core.UserStore,
+	contentFilter core.ContentFilter,
+	mediaService core.MediaService,
+	notificationStore core.NotificationStore,
+	revisionStore core.RevisionStore,
+	likeStore core.LikeStore,
+	blockStore core.BlockStore,
+	config core.PostServiceConfig,
+) core.PostService {
 	return &service{
 		postStore:          postStore,
 		postFavouriteStore: postFavouriteStore,
 		animalStore:        animalStore,
 		userStore:          userStore,
+		contentFilter:      contentFilter,
+		mediaService:       mediaService,
+		notificationStore:  notificationStore,
+		revisionStore:      revisionStore,
+		likeStore:          likeStore,
+		blockStore:         blockStore,
+		config:             config,
 	}
 }
 
 // GetAllPosts retrieves all posts with the given parameters
 func (s *service) GetAllPosts(ctx context.Context, params core.GetAllPostsParams) ([]core.PostDetails, int, error) {
+	if params.ViewerID != 0 {
+		hiddenUserIDs, err := s.blockStore.GetHiddenUserIDs(ctx, params.ViewerID)
+		if err != nil {
+			return nil, 0, err
+		}
+		params.ExcludeAuthorIDs = append(params.ExcludeAuthorIDs, hiddenUserIDs...)
+	}
 
 	posts, total, err := s.postStore.GetAllPosts(ctx, params)
 	if err != nil {
@@ -36,7 +67,7 @@ func (s *service) GetAllPosts(ctx context.Context, params core.GetAllPostsParams
 		return nil, 0, err
 	}
 
-	postDetails, err := s.BuildPostDetailsList(ctx, posts, total)
+	postDetails, err := s.BuildPostDetailsList(ctx, posts, total, params.ViewerID)
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return nil, 0, err
@@ -45,26 +76,26 @@ func (s *service) GetAllPosts(ctx context.Context, params core.GetAllPostsParams
 	return postDetails, total, nil
 }
 
-// GetUserPosts retrieves all posts with the given user ID
-func (s *service) GetUserPosts(ctx context.Context, id int) (postsDetails []core.PostDetails, count int, err error) {
-	posts, total, err := s.postStore.GetUserPosts(ctx, id)
+// GetUserPosts retrieves posts of the user with the given ID
+func (s *service) GetUserPosts(ctx context.Context, id int, params core.GetAllPostsParams) (postsDetails []core.PostDetails, count int, err error) {
+	posts, total, err := s.postStore.GetUserPosts(ctx, id, params)
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return nil, 0, err
 	}
-	postsDetails, err = s.BuildPostDetailsList(ctx, posts, total)
+	postsDetails, err = s.BuildPostDetailsList(ctx, posts, total, params.ViewerID)
 	return postsDetails, total, err
 }
 
-// GetPostByID retrieves a post by its ID
-func (s *service) GetPostByID(ctx context.Context, id int) (core.PostDetails, error) {
+// GetPostByID retrieves a post by its ID with likes counted for the viewer
+func (s *service) GetPostByID(ctx context.Context, id, viewerID int) (core.PostDetails, error) {
 	post, err := s.postStore.GetPostByID(ctx, id)
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return core.PostDetails{}, err
 	}
 
-	postDetails, err := s.BuildPostDetails(ctx, post)
+	postDetails, err := s.BuildPostDetails(ctx, post, viewerID)
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return core.PostDetails{}, err
@@ -73,45 +104,96 @@ func (s *service) GetPostByID(ctx context.Context, id int) (core.PostDetails, er
 	return postDetails, nil
 }
 
-// CreatePost creates a new post with the provided details and photo
-func (s *service) CreatePost(ctx context.Context, postDetails core.PostDetails, fileHeader *multipart.FileHeader) (core.PostDetails, error) {
-	photoBytes, err := http.GetPhotoBytes(fileHeader)
-	if err != nil {
-		logger.Log().Error(ctx, err.Error())
-		return core.PostDetails{}, err
+// CreatePost creates a new post with the provided details and photos.
+// Drafts may have no photos, they are checked by the content filter when they are published.
+func (s *service) CreatePost(ctx context.Context, postDetails core.PostDetails, photos []core.MediaUpload) (core.PostDetails, error) {
+	isDraft := postDetails.Post.Status == core.Draft
+	if len(photos) == 0 && !isDraft {
+		return core.PostDetails{}, core.ErrNoPhotos
 	}
 
-	postDetails.Post.Photo = *photoBytes
-
-	animal, err := s.animalStore.CreateAnimal(ctx, postDetails.Animal)
+	animal, err := s.postAnimal(ctx, postDetails)
 	if err != nil {
-		logger.Log().Error(ctx, err.Error())
 		return core.PostDetails{}, err
 	}
 
 	postDetails.Post.AnimalID = animal.ID
 
+	if !isDraft {
+		s.filterPost(ctx, &postDetails.Post, true)
+	}
+
 	post, err := s.postStore.CreatePost(ctx, postDetails.Post)
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return core.PostDetails{}, err
 	}
 
+	var media []core.Media
+	if len(photos) > 0 {
+		media, err = s.mediaService.SetPostPhotos(ctx, post.ID, photos)
+		if err != nil {
+			logger.Log().Error(ctx, err.Error())
+			// post without photos is useless, so it is removed instead of being left half created
+			if deleteErr := s.postStore.DeletePost(ctx, post.ID); deleteErr != nil {
+				logger.Log().Error(ctx, deleteErr.Error())
+			}
+			return core.PostDetails{}, err
+		}
+	}
+
 	user, err := s.userStore.GetUserByID(ctx, post.AuthorID)
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return core.PostDetails{}, err
 	}
 
-	createPostDetails := ToCorePostDetails(post, animal, user.Username)
+	createPostDetails := ToCorePostDetails(post, animal, user)
+	createPostDetails.Photos = media
+	createPostDetails.DuplicatePostIDs = s.findDuplicates(ctx, post.ID, media)
+
+	s.saveRevision(ctx, createPostDetails, post.AuthorID)
 
 	return createPostDetails, err
 }
 
+// postAnimal returns the animal of the new post. The post may continue the story of the registered animal,
+// e.g. the adopted animal needs a new home again, then the author must manage the animal. Otherwise, the animal is registered.
+func (s *service) postAnimal(ctx context.Context, postDetails core.PostDetails) (core.Animal, error) {
+	if postDetails.Post.AnimalID == 0 {
+		postDetails.Animal.NormalizeIdentifiers()
+
+		animal, err := s.animalStore.CreateAnimal(ctx, postDetails.Animal)
+		if err != nil {
+			logger.Log().Error(ctx, err.Error())
+			return core.Animal{}, err
+		}
+
+		return animal, nil
+	}
+
+	animal, err := s.animalStore.GetAnimalByID(ctx, postDetails.Post.AnimalID)
+	if err != nil {
+		return core.Animal{}, err
+	}
+
+	if !animal.ManagedBy(postDetails.Post.AuthorID) {
+		return core.Animal{}, core.ErrAnimalAccessDenied
+	}
+
+	return animal, nil
+}
+
 // UpdatePost updates an existing post with the provided details
 func (s *service) UpdatePost(ctx context.Context, postUpdateRequest core.UpdateRequestBodyPost) (core.PostDetails, error) {
 	logger.Log().Debug(ctx, fmt.Sprintf("%v", *postUpdateRequest.ID))
-	dbPost, err := s.GetPostByID(ctx, *postUpdateRequest.ID)
+	editablePost, err := s.getEditablePost(ctx, *postUpdateRequest.ID, *postUpdateRequest.AuthorID)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.PostDetails{}, err
+	}
+
+	dbPost, err := s.BuildPostDetails(ctx, editablePost, *postUpdateRequest.AuthorID)
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return core.PostDetails{}, err
@@ -124,6 +206,11 @@ func (s *service) UpdatePost(ctx context.Context, postUpdateRequest core.UpdateR
 	}
 
 	dbPost = FuncUpdateRequestBodyPost(dbPost, postUpdateRequest)
+	dbPost.Animal.NormalizeIdentifiers()
+
+	if (postUpdateRequest.Title != nil || postUpdateRequest.Content != nil) && dbPost.Post.Status != core.Draft {
+		s.filterPost(ctx, &dbPost.Post, false)
+	}
 
 	post, err := s.postStore.UpdatePost(ctx, dbPost.Post)
 	if err != nil {
@@ -131,23 +218,95 @@ func (s *service) UpdatePost(ctx context.Context, postUpdateRequest core.UpdateR
 		return core.PostDetails{}, err
 	}
 
-	animal, err := s.animalStore.UpdateAnimal(ctx, dbPost.Animal)
-	if err != nil {
+	if _, err := s.animalStore.UpdateAnimal(ctx, dbPost.Animal); err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return core.PostDetails{}, err
 	}
 
-	var updatePostDetails core.PostDetails
+	var duplicatePostIDs []int
+	if postUpdateRequest.Photos != nil {
+		photos, err := s.mediaService.SetPostPhotos(ctx, post.ID, postUpdateRequest.Photos)
+		if err != nil {
+			logger.Log().Error(ctx, err.Error())
+			return core.PostDetails{}, err
+		}
+		duplicatePostIDs = s.findDuplicates(ctx, post.ID, photos)
+	}
+
+	// the response has the author and likes like other posts of the author
+	updatePostDetails, err := s.BuildPostDetails(ctx, post, *postUpdateRequest.AuthorID)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.PostDetails{}, err
+	}
+	updatePostDetails.DuplicatePostIDs = duplicatePostIDs
 
-	updatePostDetails.Post = post
-	updatePostDetails.Animal = animal
+	s.saveRevision(ctx, updatePostDetails, *postUpdateRequest.AuthorID)
 
 	return updatePostDetails, nil
 }
 
+// SearchPostsByPhoto finds published posts with photos similar to the given one, the most similar posts go first
+func (s *service) SearchPostsByPhoto(
+	ctx context.Context,
+	photo core.MediaUpload,
+	params core.SearchPostsByPhotoParams,
+) ([]core.SimilarPost, error) {
+	hash, err := s.mediaService.HashPhoto(photo)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDistance, limit := core.DefaultSimilarPhotoDistance, core.DefaultSimilarPostsLimit
+	if params.MaxDistance != nil {
+		maxDistance = *params.MaxDistance
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	matches, err := s.mediaService.FindSimilarPhotos(ctx, core.MediaOwnerPost, hash, maxDistance, limit)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	postIDs := make([]int, len(matches))
+	for i, match := range matches {
+		postIDs[i] = match.OwnerID
+	}
+
+	posts, err := s.postStore.GetPostsByIDs(ctx, postIDs)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	postsDetails, err := s.BuildPostDetailsList(ctx, posts, len(posts), params.ViewerID)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	detailsByID := make(map[int]core.PostDetails, len(postsDetails))
+	for _, details := range postsDetails {
+		detailsByID[details.Post.ID] = details
+	}
+
+	// posts which are not published are skipped, the order of matches is kept
+	similarPosts := make([]core.SimilarPost, 0, len(postsDetails))
+	for _, match := range matches {
+		if details, ok := detailsByID[match.OwnerID]; ok {
+			similarPosts = append(similarPosts, core.SimilarPost{PostDetails: details, Distance: match.Distance})
+		}
+	}
+
+	return similarPosts, nil
+}
+
 // DeletePost deletes a post by its ID
 func (s *service) DeletePost(ctx context.Context, post core.Post) error {
-	dbPost, err := s.postStore.GetPostByID(ctx, post.ID)
+	dbPost, err := s.getEditablePost(ctx, post.ID, post.AuthorID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,49 @@
+package post_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/kotopesp/sos-kotopes/internal/service/post"
+)
+
+func TestUpdatePost_AuthorDetailsAndLikes(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockAnimals := new(mocks.MockAnimalStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockMedia := new(mocks.MockMediaService)
+	mockRevisions := new(mocks.MockRevisionStore)
+	mockLikes := new(mocks.MockLikeStore)
+
+	telegram := "murka_finder"
+	author := core.User{ID: 1, Username: "finder", Contacts: core.Contacts{Telegram: &telegram}}
+
+	mockPosts.On("GetPostByIDAnyStatus", ctx, 1).Return(core.Post{ID: 1, AuthorID: 1, AnimalID: 1, Status: core.Published}, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1}, nil)
+	mockUsers.On("GetUserByID", ctx, 1).Return(author, nil)
+	mockMedia.On("GetPostPhotos", ctx, 1).Return(nil, nil)
+	mockPosts.On("UpdatePost", ctx, mock.Anything).Return(func(_ context.Context, p core.Post) (core.Post, error) {
+		return p, nil
+	})
+	mockAnimals.On("UpdateAnimal", ctx, mock.Anything).Return(func(_ context.Context, a core.Animal) (core.Animal, error) {
+		return a, nil
+	})
+	mockRevisions.On("CreatePostRevision", ctx, mock.Anything).Return(core.PostRevision{}, nil)
+	mockLikes.On("GetPostsLikes", ctx, []int{1}, 1).Return(map[int]core.Likes{1: {Count: 3, LikedByMe: true}}, nil)
+
+	svc := post.New(mockPosts, nil, mockAnimals, mockUsers, nil, mockMedia, nil, mockRevisions, mockLikes, nil, config)
+
+	id, authorID, name := 1, 1, "Lucky"
+	details, err := svc.UpdatePost(ctx, core.UpdateRequestBodyPost{ID: &id, AuthorID: &authorID, Name: &name})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "finder", details.Username)
+	assert.Equal(t, author.Contacts, details.AuthorContacts)
+	assert.Equal(t, core.Likes{Count: 3, LikedByMe: true}, details.Likes)
+}
@@ -7,18 +7,14 @@ import (
 	"github.com/kotopesp/sos-kotopes/pkg/logger"
 )
 
-func (s *service) GetFavouritePosts(ctx context.Context, userID int) ([]core.PostDetails, int, error) {
-	// TODO: add GetAllPostsParams
-	limit := 0
-	offset := 0
-
-	posts, total, err := s.postFavouriteStore.GetFavouritePosts(ctx, userID, limit, offset)
+func (s *service) GetFavouritePosts(ctx context.Context, userID int, params core.GetAllPostsParams) ([]core.PostDetails, int, error) {
+	posts, total, err := s.postFavouriteStore.GetFavouritePosts(ctx, userID, params)
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return nil, 0, err
 	}
 
-	postDetails, err := s.BuildPostDetailsList(ctx, posts, total)
+	postDetails, err := s.BuildPostDetailsList(ctx, posts, total, userID)
 	if err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return nil, 0, err
@@ -0,0 +1,40 @@
+package post
+
+import (
+	"context"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// GetPostRevisions returns revisions of the post from the first one, drafts have no revisions.
+// Revisions keep content removed by the author and versions stopped by the content filter,
+// so only the author and moderators see them. Posts on moderation and deleted posts keep their history for moderators.
+func (s *service) GetPostRevisions(ctx context.Context, params core.GetPostRevisionsParams) ([]core.PostRevision, error) {
+	post, err := s.postStore.GetPostByIDAnyStatus(ctx, params.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	if post.Status == core.Draft {
+		return nil, core.ErrPostNotFound
+	}
+
+	if post.AuthorID != params.UserID && !params.IsModerator {
+		return nil, core.ErrPostAuthorIDMismatch
+	}
+
+	return s.revisionStore.GetPostRevisions(ctx, params.PostID)
+}
+
+// saveRevision copies the saved post to its history. The post is already saved at this point,
+// so errors are logged and don't fail the request. Drafts have no history, their first revision is saved on publication.
+func (s *service) saveRevision(ctx context.Context, details core.PostDetails, editorID int) {
+	if details.Post.Status == core.Draft {
+		return
+	}
+
+	if _, err := s.revisionStore.CreatePostRevision(ctx, core.NewPostRevision(details, editorID)); err != nil {
+		logger.Log().Error(ctx, "Failed to save revision of post: "+err.Error())
+	}
+}
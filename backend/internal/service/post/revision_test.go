@@ -0,0 +1,185 @@
+package post_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/kotopesp/sos-kotopes/internal/service/post"
+)
+
+func TestUpdatePost_SavesRevision(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockAnimals := new(mocks.MockAnimalStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockMedia := new(mocks.MockMediaService)
+	mockRevisions := new(mocks.MockRevisionStore)
+
+	chip := "643094100000001"
+	dbPost := core.Post{ID: 1, AuthorID: 1, AnimalID: 1, Title: "Found a cat", Status: core.Published}
+	animal := core.Animal{ID: 1, Name: "Murka", MicrochipNumber: &chip}
+	photos := []core.Media{{ID: 5}, {ID: 3}}
+
+	mockPosts.On("GetPostByIDAnyStatus", ctx, 1).Return(dbPost, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(func(context.Context, int) (core.Animal, error) {
+		return animal, nil
+	})
+	mockUsers.On("GetUserByID", ctx, 1).Return(core.User{ID: 1}, nil)
+	mockMedia.On("GetPostPhotos", ctx, 1).Return(photos, nil)
+	mockPosts.On("UpdatePost", ctx, mock.Anything).Return(func(_ context.Context, p core.Post) (core.Post, error) {
+		return p, nil
+	})
+	mockAnimals.On("UpdateAnimal", ctx, mock.Anything).Return(func(_ context.Context, a core.Animal) (core.Animal, error) {
+		animal = a
+		return a, nil
+	})
+	mockRevisions.On("CreatePostRevision", ctx, mock.MatchedBy(func(r core.PostRevision) bool {
+		return r.PostID == 1 && r.EditorID == 1 && r.Animal.Name == "Lucky" &&
+			assert.ObjectsAreEqual(pq.Int64Array{5, 3}, r.PhotoIDs)
+	})).Return(core.PostRevision{}, nil).Once()
+
+	svc := post.New(mockPosts, nil, mockAnimals, mockUsers, nil, mockMedia, nil, mockRevisions, newLikeStore(), nil, config)
+
+	id, authorID, name := 1, 1, "Lucky"
+	_, err := svc.UpdatePost(ctx, core.UpdateRequestBodyPost{ID: &id, AuthorID: &authorID, Name: &name})
+	assert.NoError(t, err)
+
+	mockRevisions.AssertExpectations(t)
+}
+
+func TestUpdatePost_DraftHasNoRevisions(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockAnimals := new(mocks.MockAnimalStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockMedia := new(mocks.MockMediaService)
+	mockRevisions := new(mocks.MockRevisionStore)
+
+	draft := core.Post{ID: 1, AuthorID: 1, AnimalID: 1, Title: "Found a cat", Status: core.Draft}
+
+	mockPosts.On("GetPostByIDAnyStatus", ctx, 1).Return(draft, nil)
+	mockAnimals.On("GetAnimalByID", ctx, 1).Return(core.Animal{ID: 1}, nil)
+	mockUsers.On("GetUserByID", ctx, 1).Return(core.User{ID: 1}, nil)
+	mockMedia.On("GetPostPhotos", ctx, 1).Return(nil, nil)
+	mockPosts.On("UpdatePost", ctx, mock.Anything).Return(func(_ context.Context, p core.Post) (core.Post, error) {
+		return p, nil
+	})
+	mockAnimals.On("UpdateAnimal", ctx, mock.Anything).Return(func(_ context.Context, a core.Animal) (core.Animal, error) {
+		return a, nil
+	})
+
+	svc := post.New(mockPosts, nil, mockAnimals, mockUsers, nil, mockMedia, nil, mockRevisions, newLikeStore(), nil, config)
+
+	id, authorID, content := 1, 1, "My address is 1 Main street"
+	_, err := svc.UpdatePost(ctx, core.UpdateRequestBodyPost{ID: &id, AuthorID: &authorID, Content: &content})
+	assert.NoError(t, err)
+
+	mockRevisions.AssertNotCalled(t, "CreatePostRevision", ctx, mock.Anything)
+}
+
+func TestGetPostRevisions_PostNotFound(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockRevisions := new(mocks.MockRevisionStore)
+
+	mockPosts.On("GetPostByIDAnyStatus", ctx, 1).Return(core.Post{}, core.ErrPostNotFound)
+
+	svc := post.New(mockPosts, nil, nil, nil, nil, nil, nil, mockRevisions, newLikeStore(), nil, config)
+
+	_, err := svc.GetPostRevisions(ctx, core.GetPostRevisionsParams{PostID: 1, UserID: 1})
+	assert.ErrorIs(t, err, core.ErrPostNotFound)
+
+	mockRevisions.AssertNotCalled(t, "GetPostRevisions", ctx, 1)
+}
+
+func TestGetPostRevisions_Access(t *testing.T) {
+	ctx := context.TODO()
+	revisions := []core.PostRevision{{PostID: 1, Revision: 1}}
+
+	tests := []struct {
+		name    string
+		status  core.ContentStatus
+		params  core.GetPostRevisionsParams
+		wantErr error
+	}{
+		{
+			name:   "author",
+			status: core.Published,
+			params: core.GetPostRevisionsParams{PostID: 1, UserID: 1},
+		},
+		{
+			name:   "moderator",
+			status: core.Published,
+			params: core.GetPostRevisionsParams{PostID: 1, UserID: 2, IsModerator: true},
+		},
+		{
+			name:    "other user",
+			status:  core.Published,
+			params:  core.GetPostRevisionsParams{PostID: 1, UserID: 2},
+			wantErr: core.ErrPostAuthorIDMismatch,
+		},
+		{
+			name:   "moderator, post on moderation",
+			status: core.OnModeration,
+			params: core.GetPostRevisionsParams{PostID: 1, UserID: 2, IsModerator: true},
+		},
+		{
+			name:   "moderator, deleted post",
+			status: core.Deleted,
+			params: core.GetPostRevisionsParams{PostID: 1, UserID: 2, IsModerator: true},
+		},
+		{
+			name:   "author, post on moderation",
+			status: core.OnModeration,
+			params: core.GetPostRevisionsParams{PostID: 1, UserID: 1},
+		},
+		{
+			name:    "other user, post on moderation",
+			status:  core.OnModeration,
+			params:  core.GetPostRevisionsParams{PostID: 1, UserID: 2},
+			wantErr: core.ErrPostAuthorIDMismatch,
+		},
+		{
+			name:    "moderator, draft",
+			status:  core.Draft,
+			params:  core.GetPostRevisionsParams{PostID: 1, UserID: 2, IsModerator: true},
+			wantErr: core.ErrPostNotFound,
+		},
+		{
+			name:    "author, draft",
+			status:  core.Draft,
+			params:  core.GetPostRevisionsParams{PostID: 1, UserID: 1},
+			wantErr: core.ErrPostNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPosts := new(mocks.MockPostStore)
+			mockRevisions := new(mocks.MockRevisionStore)
+
+			mockPosts.On("GetPostByIDAnyStatus", ctx, 1).Return(core.Post{ID: 1, AuthorID: 1, Status: tt.status}, nil)
+			if tt.wantErr == nil {
+				mockRevisions.On("GetPostRevisions", ctx, 1).Return(revisions, nil).Once()
+			}
+
+			svc := post.New(mockPosts, nil, nil, nil, nil, nil, nil, mockRevisions, newLikeStore(), nil, config)
+
+			got, err := svc.GetPostRevisions(ctx, tt.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRevisions.AssertNotCalled(t, "GetPostRevisions", ctx, 1)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, revisions, got)
+		})
+	}
+}
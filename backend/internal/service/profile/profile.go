@@ -0,0 +1,132 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+)
+
+type service struct {
+	profileStore   core.ProfileStore
+	userService    core.UserService
+	roleService    core.RoleService
+	commentService core.CommentService
+}
+
+// New initializes a new instance of service
+func New(
+	profileStore core.ProfileStore,
+	userService core.UserService,
+	roleService core.RoleService,
+	commentService core.CommentService,
+) core.ProfileService {
+	return &service{
+		profileStore:   profileStore,
+		userService:    userService,
+		roleService:    roleService,
+		commentService: commentService,
+	}
+}
+
+// GetProfile returns the user with roles, badges, statistics and last seen moment,
+// statistics and last seen moment are left out if the user hides them from the viewer
+func (s *service) GetProfile(ctx context.Context, userID, viewerID int) (core.UserProfile, error) {
+	user, err := s.userService.GetUser(ctx, userID)
+	if err != nil {
+		return core.UserProfile{}, err
+	}
+
+	roles, err := s.roleService.GetUserRoles(ctx, userID)
+	if err != nil {
+		return core.UserProfile{}, err
+	}
+
+	badges, err := s.profileStore.GetUserBadges(ctx, userID)
+	if err != nil {
+		return core.UserProfile{}, err
+	}
+
+	settings, err := s.profileStore.GetPrivacySettings(ctx, userID)
+	if err != nil {
+		return core.UserProfile{}, err
+	}
+
+	profile := core.UserProfile{
+		User:   user,
+		Roles:  roles,
+		Badges: badges,
+	}
+
+	if settings.Statistics.VisibleTo(userID, viewerID) {
+		statistics, err := s.profileStore.GetUserStatistics(ctx, userID)
+		if err != nil {
+			return core.UserProfile{}, err
+		}
+		profile.Statistics = &statistics
+	}
+
+	if settings.LastSeen.VisibleTo(userID, viewerID) {
+		profile.LastSeenAt = user.LastSeenAt
+	}
+
+	return profile, nil
+}
+
+// GetUserComments returns page of the comment history of the user if the user shows it to the viewer
+func (s *service) GetUserComments(ctx context.Context, params core.GetUserCommentsParams) ([]core.Comment, int, error) {
+	if _, err := s.userService.GetUser(ctx, params.AuthorID); err != nil {
+		return nil, 0, err
+	}
+
+	settings, err := s.profileStore.GetPrivacySettings(ctx, params.AuthorID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !settings.Comments.VisibleTo(params.AuthorID, params.UserID) {
+		return nil, 0, core.ErrUserCommentsHidden
+	}
+
+	return s.commentService.GetUserComments(ctx, params)
+}
+
+// GetPrivacySettings returns privacy settings of the user, default ones if the user didn't change them
+func (s *service) GetPrivacySettings(ctx context.Context, userID int) (core.PrivacySettings, error) {
+	return s.profileStore.GetPrivacySettings(ctx, userID)
+}
+
+// UpdatePrivacySettings replaces privacy settings of the user
+func (s *service) UpdatePrivacySettings(ctx context.Context, settings core.PrivacySettings) (core.PrivacySettings, error) {
+	return s.profileStore.SavePrivacySettings(ctx, settings)
+}
+
+// GetContactsAccess returns privacy settings of the owners and which of them have a chat with the viewer
+func (s *service) GetContactsAccess(ctx context.Context, viewerID int, ownerIDs []int) (core.ContactsAccess, error) {
+	access := core.ContactsAccess{ViewerID: viewerID}
+	if len(ownerIDs) == 0 {
+		return access, nil
+	}
+
+	settings, err := s.profileStore.GetUsersPrivacySettings(ctx, ownerIDs)
+	if err != nil {
+		return core.ContactsAccess{}, err
+	}
+	access.Settings = settings
+
+	// anonymous users have no chats
+	if viewerID == 0 {
+		return access, nil
+	}
+
+	partnerIDs, err := s.profileStore.GetChatPartnerIDs(ctx, viewerID, ownerIDs)
+	if err != nil {
+		return core.ContactsAccess{}, err
+	}
+
+	access.ChatPartners = make(map[int]bool, len(partnerIDs))
+	for _, id := range partnerIDs {
+		access.ChatPartners[id] = true
+	}
+
+	return access, nil
+}
@@ -0,0 +1,315 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProfile(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lastSeenAt := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	user := core.User{ID: 2, Username: "alice", LastSeenAt: &lastSeenAt}
+	roles := []core.RoleDetails{{Name: core.Vet, UserID: 2}}
+	badges := []core.Badge{core.BadgeModerator}
+	statistics := core.UserStatistics{Posts: map[core.PostState]int{core.PostActive: 1}, Comments: 4}
+	errStore := errors.New("store error")
+
+	tests := []struct {
+		name           string
+		viewerID       int
+		settings       core.PrivacySettings
+		userErr        error
+		statisticsErr  error
+		wantErr        error
+		wantStatistics bool
+		wantLastSeen   bool
+	}{
+		{
+			name:           "anonymous user sees public parts",
+			settings:       core.DefaultPrivacySettings(2),
+			wantStatistics: true,
+		},
+		{
+			name:           "logged-in user sees parts shown to users",
+			viewerID:       5,
+			settings:       core.DefaultPrivacySettings(2),
+			wantStatistics: true,
+			wantLastSeen:   true,
+		},
+		{
+			name:     "hidden from everyone",
+			viewerID: 5,
+			settings: core.PrivacySettings{UserID: 2, Statistics: core.VisibilityNobody, LastSeen: core.VisibilityNobody},
+		},
+		{
+			name:           "owner sees everything",
+			viewerID:       2,
+			settings:       core.PrivacySettings{UserID: 2, Statistics: core.VisibilityNobody, LastSeen: core.VisibilityNobody},
+			wantStatistics: true,
+			wantLastSeen:   true,
+		},
+		{
+			name:    "user not found",
+			userErr: core.ErrNoSuchUser,
+			wantErr: core.ErrNoSuchUser,
+		},
+		{
+			name:          "statistics error",
+			settings:      core.DefaultPrivacySettings(2),
+			statisticsErr: errStore,
+			wantErr:       errStore,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			profileStore := mocks.NewMockProfileStore(t)
+			userService := mocks.NewMockUserService(t)
+			roleService := mocks.NewMockRoleService(t)
+
+			userService.EXPECT().GetUser(ctx, 2).Return(user, tt.userErr).Once()
+			if tt.userErr == nil {
+				roleService.EXPECT().GetUserRoles(ctx, 2).Return(roles, nil).Once()
+				profileStore.EXPECT().GetUserBadges(ctx, 2).Return(badges, nil).Once()
+				profileStore.EXPECT().GetPrivacySettings(ctx, 2).Return(tt.settings, nil).Once()
+			}
+			if tt.wantStatistics || tt.statisticsErr != nil {
+				profileStore.EXPECT().GetUserStatistics(ctx, 2).Return(statistics, tt.statisticsErr).Once()
+			}
+
+			profileService := New(profileStore, userService, roleService, nil)
+
+			profile, err := profileService.GetProfile(ctx, 2, tt.viewerID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, user, profile.User)
+			assert.Equal(t, roles, profile.Roles)
+			assert.Equal(t, badges, profile.Badges)
+
+			if tt.wantStatistics {
+				assert.Equal(t, &statistics, profile.Statistics)
+			} else {
+				assert.Nil(t, profile.Statistics)
+			}
+
+			if tt.wantLastSeen {
+				assert.Equal(t, &lastSeenAt, profile.LastSeenAt)
+			} else {
+				assert.Nil(t, profile.LastSeenAt)
+			}
+		})
+	}
+}
+
+func TestGetUserComments(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	limit, offset := 10, 0
+	comments := []core.Comment{{ID: 7, AuthorID: 2}}
+
+	tests := []struct {
+		name         string
+		viewerID     int
+		visibility   core.Visibility
+		userErr      error
+		wantErr      error
+		wantComments bool
+	}{
+		{
+			name:         "public comments",
+			visibility:   core.VisibilityPublic,
+			wantComments: true,
+		},
+		{
+			name:       "comments shown to users are hidden from anonymous user",
+			visibility: core.VisibilityUsers,
+			wantErr:    core.ErrUserCommentsHidden,
+		},
+		{
+			name:         "comments shown to users",
+			viewerID:     5,
+			visibility:   core.VisibilityUsers,
+			wantComments: true,
+		},
+		{
+			name:       "hidden comments",
+			viewerID:   5,
+			visibility: core.VisibilityNobody,
+			wantErr:    core.ErrUserCommentsHidden,
+		},
+		{
+			name:         "owner sees hidden comments",
+			viewerID:     2,
+			visibility:   core.VisibilityNobody,
+			wantComments: true,
+		},
+		{
+			name:    "user not found",
+			userErr: core.ErrNoSuchUser,
+			wantErr: core.ErrNoSuchUser,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			profileStore := mocks.NewMockProfileStore(t)
+			userService := mocks.NewMockUserService(t)
+			commentService := mocks.NewMockCommentService(t)
+
+			params := core.GetUserCommentsParams{AuthorID: 2, Limit: &limit, Offset: &offset, UserID: tt.viewerID}
+
+			userService.EXPECT().GetUser(ctx, 2).Return(core.User{ID: 2}, tt.userErr).Once()
+			if tt.userErr == nil {
+				profileStore.EXPECT().GetPrivacySettings(ctx, 2).
+					Return(core.PrivacySettings{UserID: 2, Comments: tt.visibility}, nil).Once()
+			}
+			if tt.wantComments {
+				commentService.EXPECT().GetUserComments(ctx, params).Return(comments, 1, nil).Once()
+			}
+
+			profileService := New(profileStore, userService, nil, commentService)
+
+			data, total, err := profileService.GetUserComments(ctx, params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, total)
+			assert.Equal(t, comments, data)
+		})
+	}
+}
+
+func TestUpdatePrivacySettings(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	profileStore := mocks.NewMockProfileStore(t)
+	settings := core.PrivacySettings{UserID: 2, Statistics: core.VisibilityUsers, LastSeen: core.VisibilityNobody, Comments: core.VisibilityPublic}
+	profileStore.EXPECT().SavePrivacySettings(ctx, settings).Return(settings, nil).Once()
+
+	profileService := New(profileStore, nil, nil, nil)
+
+	updated, err := profileService.UpdatePrivacySettings(ctx, settings)
+	assert.NoError(t, err)
+	assert.Equal(t, settings, updated)
+}
+
+func TestGetContactsAccess(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	settings := map[int]core.PrivacySettings{2: core.DefaultPrivacySettings(2), 3: core.DefaultPrivacySettings(3)}
+
+	tests := []struct {
+		name     string
+		viewerID int
+		ownerIDs []int
+		want     core.ContactsAccess
+	}{
+		{
+			name:     "anonymous user has no chats",
+			ownerIDs: []int{2, 3},
+			want:     core.ContactsAccess{Settings: settings},
+		},
+		{
+			name:     "logged-in user",
+			viewerID: 5,
+			ownerIDs: []int{2, 3},
+			want:     core.ContactsAccess{ViewerID: 5, Settings: settings, ChatPartners: map[int]bool{3: true}},
+		},
+		{
+			name:     "no owners",
+			viewerID: 5,
+			want:     core.ContactsAccess{ViewerID: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			profileStore := mocks.NewMockProfileStore(t)
+			if len(tt.ownerIDs) != 0 {
+				profileStore.EXPECT().GetUsersPrivacySettings(ctx, tt.ownerIDs).Return(settings, nil).Once()
+			}
+			if len(tt.ownerIDs) != 0 && tt.viewerID != 0 {
+				profileStore.EXPECT().GetChatPartnerIDs(ctx, tt.viewerID, tt.ownerIDs).Return([]int{3}, nil).Once()
+			}
+
+			profileService := New(profileStore, nil, nil, nil)
+
+			access, err := profileService.GetContactsAccess(ctx, tt.viewerID, tt.ownerIDs)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, access)
+		})
+	}
+}
+
+func TestVisibleContacts(t *testing.T) {
+	t.Parallel()
+
+	phone, telegram, vkLink := "+79991234567", "jack_vorobey", "https://vk.com/jack_vorobey"
+	contacts := core.Contacts{Phone: &phone, Telegram: &telegram, VKLink: &vkLink}
+	settings := map[int]core.PrivacySettings{
+		2: {UserID: 2, Phone: core.VisibilityChats, Telegram: core.VisibilityUsers, VKLink: core.VisibilityPublic},
+	}
+
+	tests := []struct {
+		name   string
+		access core.ContactsAccess
+		want   core.Contacts
+	}{
+		{
+			name:   "anonymous user",
+			access: core.ContactsAccess{Settings: settings},
+			want:   core.Contacts{VKLink: &vkLink},
+		},
+		{
+			name:   "logged-in user",
+			access: core.ContactsAccess{ViewerID: 5, Settings: settings},
+			want:   core.Contacts{Telegram: &telegram, VKLink: &vkLink},
+		},
+		{
+			name:   "chat partner",
+			access: core.ContactsAccess{ViewerID: 5, Settings: settings, ChatPartners: map[int]bool{2: true}},
+			want:   contacts,
+		},
+		{
+			name:   "owner",
+			access: core.ContactsAccess{ViewerID: 2},
+			want:   contacts,
+		},
+		{
+			name:   "unknown settings",
+			access: core.ContactsAccess{ViewerID: 5},
+			want:   core.Contacts{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.access.VisibleContacts(2, contacts))
+		})
+	}
+}
@@ -3,18 +3,42 @@ package report
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/kotopesp/sos-kotopes/internal/core"
 )
 
 type service struct {
-	reportStore  core.ReportStore
-	postStore    core.PostStore
-	commentStore core.CommentStore
+	reportStore    core.ReportStore
+	postStore      core.PostStore
+	commentStore   core.CommentStore
+	userStore      core.UserStore
+	moderatorStore core.ModeratorStore
+	reviewStore    core.ReviewStore
+	messageStore   core.MessageStore
+	config         core.ReportServiceConfig
 }
 
-func NewReportService(reportStore core.ReportStore, postStore core.PostStore, commentStore core.CommentStore) core.ReportService {
-	return &service{reportStore: reportStore, postStore: postStore, commentStore: commentStore}
+func NewReportService(
+	reportStore core.ReportStore,
+	postStore core.PostStore,
+	commentStore core.CommentStore,
+	userStore core.UserStore,
+	moderatorStore core.ModeratorStore,
+	reviewStore core.ReviewStore,
+	messageStore core.MessageStore,
+	config core.ReportServiceConfig,
+) core.ReportService {
+	return &service{
+		reportStore:    reportStore,
+		postStore:      postStore,
+		commentStore:   commentStore,
+		userStore:      userStore,
+		moderatorStore: moderatorStore,
+		reviewStore:    reviewStore,
+		messageStore:   messageStore,
+		config:         config,
+	}
 }
 
 func (s *service) CreateReport(ctx context.Context, report core.Report) error {
@@ -26,15 +50,26 @@ func (s *service) CreateReport(ctx context.Context, report core.Report) error {
 		return err
 	}
 
-	if err := s.checkModerationThreshold(ctx, report); err != nil {
+	weight, trusted, err := s.reporterWeight(ctx, report.UserID)
+	if err != nil {
 		return err
 	}
 
-	if err := s.createReportRecord(ctx, report); err != nil {
+	report.Weight = weight
+
+	if err := s.reportStore.CreateReport(ctx, report); err != nil {
+		if errors.Is(err, core.ErrDuplicateReport) {
+			return nil
+		}
+
 		return err
 	}
 
-	return nil
+	if trusted {
+		return s.sendToModeration(ctx, report)
+	}
+
+	return s.checkModerationThreshold(ctx, report)
 }
 
 func (s *service) validateTarget(ctx context.Context, report core.Report) error {
@@ -56,6 +91,44 @@ func (s *service) validateTarget(ctx context.Context, report core.Report) error
 		if comment.Status == core.OnModeration {
 			return core.ErrContentAlreadyOnModeration
 		}
+
+	case core.ReportableTypeUser:
+		user, err := s.userStore.GetUserByID(ctx, report.ReportableID)
+		if err != nil || user.Status == core.UserDelete {
+			return core.ErrTargetNotFound
+		}
+		if user.Status == core.UserBanned {
+			return core.ErrContentAlreadyOnModeration
+		}
+
+		return s.checkModerationQueue(ctx, report)
+
+	case core.ReportableTypeVetReview, core.ReportableTypeKeeperReview:
+		reviewType, _ := core.ReviewTypeOf(report.ReportableType)
+		review, err := s.reviewStore.GetReviewByID(ctx, reviewType, report.ReportableID)
+		if err != nil || review.IsDeleted {
+			return core.ErrTargetNotFound
+		}
+
+		return s.checkModerationQueue(ctx, report)
+
+	case core.ReportableTypeMessage:
+		message, err := s.messageStore.GetMessageByID(ctx, report.ReportableID)
+		if err != nil || message.IsDeleted {
+			return core.ErrTargetNotFound
+		}
+
+		// Chats are private, so only their members are able to see and report messages.
+		isMember, err := s.messageStore.IsChatMember(ctx, message.ChatID, report.UserID)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return core.ErrNotChatMember
+		}
+
+		return s.checkModerationQueue(ctx, report)
+
 	default:
 		return core.ErrInvalidReportableType
 	}
@@ -63,22 +136,84 @@ func (s *service) validateTarget(ctx context.Context, report core.Report) error
 	return nil
 }
 
-func (s *service) createReportRecord(ctx context.Context, report core.Report) error {
-	err := s.reportStore.CreateReport(ctx, report)
-	if errors.Is(err, core.ErrDuplicateReport) {
-		return nil
+// checkModerationQueue - returns core.ErrContentAlreadyOnModeration if entity without its own status is already queued.
+func (s *service) checkModerationQueue(ctx context.Context, report core.Report) error {
+	onModeration, err := s.reportStore.IsOnModeration(ctx, report.ReportableID, report.ReportableType)
+	if err != nil {
+		return err
+	}
+	if onModeration {
+		return core.ErrContentAlreadyOnModeration
+	}
+
+	return nil
+}
+
+// reporterWeight - calculates weight of the report based on reporter account age and history of their reports.
+// Reports of moderators and trusted reporters move content to moderation immediately.
+func (s *service) reporterWeight(ctx context.Context, userID int) (weight float64, trusted bool, err error) {
+	_, err = s.moderatorStore.GetModeratorByID(ctx, userID)
+	if err == nil {
+		return s.config.MaxReportWeight, true, nil
+	}
+	if !errors.Is(err, core.ErrNoSuchModerator) {
+		return 0, false, err
+	}
+
+	user, err := s.userStore.GetUserByID(ctx, userID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	reputation, err := s.reportStore.GetReporterReputation(ctx, userID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	weight, trusted = ReportWeight(s.config, user.CreatedAt, reputation, time.Now().UTC())
+
+	return weight, trusted, nil
+}
+
+// ReportWeight - returns weight of a single report and whether its author is trusted.
+// Accuracy of the reporter is estimated with Laplace smoothing, so reporter without history gets weight 1.
+func ReportWeight(config core.ReportServiceConfig, registeredAt time.Time, reputation core.ReporterReputation, now time.Time) (weight float64, trusted bool) {
+	resolved := reputation.UpheldReports + reputation.RejectedReports
+	accuracy := float64(reputation.UpheldReports+1) / float64(resolved+2)
+
+	weight = 2 * accuracy
+	if now.Sub(registeredAt) < config.NewAccountAge {
+		weight *= config.NewAccountWeight
+	}
+
+	if weight < config.MinReportWeight {
+		weight = config.MinReportWeight
+	}
+	if config.MaxReportWeight > 0 && weight > config.MaxReportWeight {
+		weight = config.MaxReportWeight
 	}
 
-	return err
+	trusted = config.TrustedMinUpheld > 0 &&
+		reputation.UpheldReports >= config.TrustedMinUpheld &&
+		float64(reputation.UpheldReports)/float64(resolved) >= config.TrustedMinAccuracy
+
+	return weight, trusted
 }
 
+// checkModerationThreshold - sends content to moderation when weighted reports reach the threshold.
+// Every reason has its own threshold, so score is a sum of weights normalized by thresholds of their reasons.
 func (s *service) checkModerationThreshold(ctx context.Context, report core.Report) error {
-	reportCount, err := s.reportStore.GetReportsCount(ctx, report.ReportableID, report.ReportableType)
+	weights, err := s.reportStore.GetReportWeights(ctx, report.ReportableID, report.ReportableType)
 	if err != nil {
 		return err
 	}
 
-	if reportCount >= core.ReportAmountThreshold {
+	var score float64
+	for reason, weight := range weights {
+		score += weight / s.config.Threshold(report.ReportableType, reason)
+	}
+
+	if score >= 1 {
 		return s.sendToModeration(ctx, report)
 	}
 
@@ -91,6 +226,8 @@ func (s *service) sendToModeration(ctx context.Context, report core.Report) erro
 		return s.postStore.SendToModeration(ctx, report.ReportableID)
 	case core.ReportableTypeComment:
 		return s.commentStore.SendToModeration(ctx, report.ReportableID)
+	case core.ReportableTypeUser, core.ReportableTypeVetReview, core.ReportableTypeKeeperReview, core.ReportableTypeMessage:
+		return s.reportStore.SendToModeration(ctx, report.ReportableID, report.ReportableType)
 	default:
 		return core.ErrInvalidReportableType
 	}
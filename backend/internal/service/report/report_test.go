@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -13,16 +14,29 @@ import (
 	"github.com/kotopesp/sos-kotopes/internal/service/report"
 )
 
+func expectRegularReporter(
+	ctx context.Context,
+	mockUsers *mocks.MockUserStore,
+	mockModerators *mocks.MockModeratorStore,
+	mockReports *mocks.MockReportStore,
+) {
+	mockModerators.On("GetModeratorByID", ctx, 0).Return(core.Moderator{}, core.ErrNoSuchModerator)
+	mockUsers.On("GetUserByID", ctx, 0).Return(core.User{CreatedAt: time.Now().AddDate(-1, 0, 0)}, nil)
+	mockReports.On("GetReporterReputation", ctx, 0).Return(core.ReporterReputation{}, nil)
+}
+
 func TestCreateReport_PostAlreadyOnModeration(t *testing.T) {
 	ctx := context.TODO()
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
 	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
 
 	post := core.Post{ID: 1, Status: core.OnModeration}
 	mockPosts.On("GetPostByID", ctx, 1).Return(post, nil)
 
-	svc := report.NewReportService(mockReports, mockPosts, mockComments)
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
 
 	err := svc.CreateReport(ctx, core.Report{
 		ReportableID:   1,
@@ -31,7 +45,7 @@ func TestCreateReport_PostAlreadyOnModeration(t *testing.T) {
 	assert.NoError(t, err)
 
 	mockPosts.AssertExpectations(t)
-	mockReports.AssertNotCalled(t, "GetReportsCount", ctx, mock.Anything, mock.Anything)
+	mockReports.AssertNotCalled(t, "GetReportWeights", ctx, mock.Anything, mock.Anything)
 	mockReports.AssertNotCalled(t, "CreateReport", ctx, mock.Anything)
 	mockComments.AssertNotCalled(t, "GetCommentByID", ctx, mock.Anything)
 }
@@ -41,11 +55,13 @@ func TestCreateReport_CommentAlreadyOnModeration(t *testing.T) {
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
 	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
 
 	comment := core.Comment{ID: 1, Status: core.OnModeration}
 	mockComments.On("GetCommentByID", ctx, 1).Return(comment, nil)
 
-	svc := report.NewReportService(mockReports, mockPosts, mockComments)
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
 
 	err := svc.CreateReport(ctx, core.Report{
 		ReportableID:   1,
@@ -63,14 +79,18 @@ func TestCreateReport_PostSuccess(t *testing.T) {
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
 	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
 
 	post := core.Post{ID: 2, Status: core.Published}
 	mockPosts.On("GetPostByID", ctx, 2).Return(post, nil)
+	expectRegularReporter(ctx, mockUsers, mockModerators, mockReports)
 	mockReports.On("CreateReport", ctx, mock.Anything).Return(nil)
-	mockReports.On("GetReportsCount", ctx, 2, core.ReportableTypePost).Return(core.ReportAmountThreshold, nil)
+	mockReports.On("GetReportWeights", ctx, 2, core.ReportableTypePost).
+		Return(map[core.ReportReason]float64{core.Spam: core.ReportAmountThreshold}, nil)
 	mockPosts.On("SendToModeration", ctx, 2).Return(nil)
 
-	svc := report.NewReportService(mockReports, mockPosts, mockComments)
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
 
 	err := svc.CreateReport(ctx, core.Report{
 		ReportableID:   2,
@@ -88,14 +108,18 @@ func TestCreateReport_CommentSuccess(t *testing.T) {
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
 	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
 
 	comment := core.Comment{ID: 3, Status: core.Published}
 	mockComments.On("GetCommentByID", ctx, 3).Return(comment, nil)
+	expectRegularReporter(ctx, mockUsers, mockModerators, mockReports)
 	mockReports.On("CreateReport", ctx, mock.Anything).Return(nil)
-	mockReports.On("GetReportsCount", ctx, 3, core.ReportableTypeComment).Return(core.ReportAmountThreshold, nil)
+	mockReports.On("GetReportWeights", ctx, 3, core.ReportableTypeComment).
+		Return(map[core.ReportReason]float64{core.Spam: core.ReportAmountThreshold}, nil)
 	mockComments.On("SendToModeration", ctx, 3).Return(nil)
 
-	svc := report.NewReportService(mockReports, mockPosts, mockComments)
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
 
 	err := svc.CreateReport(ctx, core.Report{
 		ReportableID:   3,
@@ -113,10 +137,10 @@ func TestCreateReport_InvalidReportableType(t *testing.T) {
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
 	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
 
-	svc := report.NewReportService(mockReports, mockPosts, mockComments)
-
-	mockComments.On("GetReportsCount")
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
 
 	err := svc.CreateReport(ctx, core.Report{
 		ReportableID:   1,
@@ -135,10 +159,12 @@ func TestCreateReport_PostNotFound(t *testing.T) {
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
 	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
 
 	mockPosts.On("GetPostByID", ctx, 4).Return(core.Post{}, errors.New("not found"))
 
-	svc := report.NewReportService(mockReports, mockPosts, mockComments)
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
 
 	err := svc.CreateReport(ctx, core.Report{
 		ReportableID:   4,
@@ -156,10 +182,12 @@ func TestCreateReport_CommentNotFound(t *testing.T) {
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
 	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
 
 	mockComments.On("GetCommentByID", ctx, 5).Return(core.Comment{}, errors.New("not found"))
 
-	svc := report.NewReportService(mockReports, mockPosts, mockComments)
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
 
 	err := svc.CreateReport(ctx, core.Report{
 		ReportableID:   5,
@@ -177,13 +205,15 @@ func TestCreatePostReport_DuplicateReport(t *testing.T) {
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
 	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
 
 	post := core.Post{ID: 6, Status: core.Published}
 	mockPosts.On("GetPostByID", ctx, 6).Return(post, nil)
+	expectRegularReporter(ctx, mockUsers, mockModerators, mockReports)
 	mockReports.On("CreateReport", ctx, mock.Anything).Return(core.ErrDuplicateReport)
-	mockReports.On("GetReportsCount", ctx, 6, core.ReportableTypePost).Return(1, nil)
 
-	svc := report.NewReportService(mockReports, mockPosts, mockComments)
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
 
 	err := svc.CreateReport(ctx, core.Report{
 		ReportableID:   6,
@@ -200,13 +230,15 @@ func TestCreateCommentReport_DuplicateReport(t *testing.T) {
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
 	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
 
 	comment := core.Comment{ID: 6, Status: core.Published}
 	mockComments.On("GetCommentByID", ctx, 6).Return(comment, nil)
+	expectRegularReporter(ctx, mockUsers, mockModerators, mockReports)
 	mockReports.On("CreateReport", ctx, mock.Anything).Return(core.ErrDuplicateReport)
-	mockReports.On("GetReportsCount", ctx, 6, core.ReportableTypeComment).Return(1, nil)
 
-	svc := report.NewReportService(mockReports, mockPosts, mockComments)
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
 
 	err := svc.CreateReport(ctx, core.Report{
 		ReportableID:   6,
@@ -223,13 +255,17 @@ func TestCreateReport_BelowThreshold(t *testing.T) {
 	mockPosts := new(mocks.MockPostStore)
 	mockReports := new(mocks.MockReportStore)
 	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
 
 	post := core.Post{ID: 7, Status: core.Published}
 	mockPosts.On("GetPostByID", ctx, 7).Return(post, nil)
+	expectRegularReporter(ctx, mockUsers, mockModerators, mockReports)
 	mockReports.On("CreateReport", ctx, mock.Anything).Return(nil)
-	mockReports.On("GetReportsCount", ctx, 7, core.ReportableTypePost).Return(5, nil)
+	mockReports.On("GetReportWeights", ctx, 7, core.ReportableTypePost).
+		Return(map[core.ReportReason]float64{core.Spam: 5}, nil)
 
-	svc := report.NewReportService(mockReports, mockPosts, mockComments)
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
 
 	err := svc.CreateReport(ctx, core.Report{
 		ReportableID:   7,
@@ -241,3 +277,234 @@ func TestCreateReport_BelowThreshold(t *testing.T) {
 	mockReports.AssertExpectations(t)
 	mockPosts.AssertNotCalled(t, "SendToModeration", ctx, mock.Anything)
 }
+
+func TestCreateReport_ReasonThreshold(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockReports := new(mocks.MockReportStore)
+	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
+
+	post := core.Post{ID: 8, Status: core.Published}
+	mockPosts.On("GetPostByID", ctx, 8).Return(post, nil)
+	expectRegularReporter(ctx, mockUsers, mockModerators, mockReports)
+	mockReports.On("CreateReport", ctx, mock.Anything).Return(nil)
+	mockReports.On("GetReportWeights", ctx, 8, core.ReportableTypePost).
+		Return(map[core.ReportReason]float64{core.ViolentContent: 2, core.Spam: 9}, nil)
+	mockPosts.On("SendToModeration", ctx, 8).Return(nil)
+
+	config := core.ReportServiceConfig{
+		DefaultThreshold: 15,
+		Thresholds: map[string]map[core.ReportReason]float64{
+			core.ReportableTypePost: {core.ViolentContent: 5},
+		},
+	}
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, config)
+
+	err := svc.CreateReport(ctx, core.Report{
+		ReportableID:   8,
+		ReportableType: core.ReportableTypePost,
+		Reason:         core.ViolentContent,
+	})
+	assert.NoError(t, err)
+
+	mockPosts.AssertExpectations(t)
+	mockReports.AssertExpectations(t)
+}
+
+func TestCreateReport_ModeratorSendsToModerationImmediately(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockReports := new(mocks.MockReportStore)
+	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
+
+	comment := core.Comment{ID: 9, Status: core.Published}
+	mockComments.On("GetCommentByID", ctx, 9).Return(comment, nil)
+	mockModerators.On("GetModeratorByID", ctx, 4).Return(core.Moderator{UserID: 4}, nil)
+	mockReports.On("CreateReport", ctx, mock.Anything).Return(nil)
+	mockComments.On("SendToModeration", ctx, 9).Return(nil)
+
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
+
+	err := svc.CreateReport(ctx, core.Report{
+		UserID:         4,
+		ReportableID:   9,
+		ReportableType: core.ReportableTypeComment,
+	})
+	assert.NoError(t, err)
+
+	mockComments.AssertExpectations(t)
+	mockReports.AssertExpectations(t)
+	mockReports.AssertNotCalled(t, "GetReportWeights", ctx, mock.Anything, mock.Anything)
+	mockUsers.AssertNotCalled(t, "GetUserByID", ctx, mock.Anything)
+}
+
+func TestCreateReport_TrustedReporterSendsToModerationImmediately(t *testing.T) {
+	ctx := context.TODO()
+	mockPosts := new(mocks.MockPostStore)
+	mockReports := new(mocks.MockReportStore)
+	mockComments := new(mocks.MockCommentStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
+
+	post := core.Post{ID: 10, Status: core.Published}
+	mockPosts.On("GetPostByID", ctx, 10).Return(post, nil)
+	mockModerators.On("GetModeratorByID", ctx, 5).Return(core.Moderator{}, core.ErrNoSuchModerator)
+	mockUsers.On("GetUserByID", ctx, 5).Return(core.User{ID: 5, CreatedAt: time.Now().AddDate(-1, 0, 0)}, nil)
+	mockReports.On("GetReporterReputation", ctx, 5).
+		Return(core.ReporterReputation{UserID: 5, UpheldReports: 20, RejectedReports: 1}, nil)
+	mockReports.On("CreateReport", ctx, mock.MatchedBy(func(r core.Report) bool {
+		return r.Weight > 1
+	})).Return(nil)
+	mockPosts.On("SendToModeration", ctx, 10).Return(nil)
+
+	config := core.ReportServiceConfig{TrustedMinUpheld: 10, TrustedMinAccuracy: 0.9, MaxReportWeight: 3}
+	svc := report.NewReportService(mockReports, mockPosts, mockComments, mockUsers, mockModerators, nil, nil, config)
+
+	err := svc.CreateReport(ctx, core.Report{
+		UserID:         5,
+		ReportableID:   10,
+		ReportableType: core.ReportableTypePost,
+	})
+	assert.NoError(t, err)
+
+	mockPosts.AssertExpectations(t)
+	mockReports.AssertExpectations(t)
+	mockReports.AssertNotCalled(t, "GetReportWeights", ctx, mock.Anything, mock.Anything)
+}
+
+func TestReportWeight(t *testing.T) {
+	now := time.Now()
+	config := core.ReportServiceConfig{
+		NewAccountAge:      7 * 24 * time.Hour,
+		NewAccountWeight:   0.5,
+		MinReportWeight:    0.25,
+		MaxReportWeight:    3,
+		TrustedMinUpheld:   10,
+		TrustedMinAccuracy: 0.9,
+	}
+
+	tests := []struct {
+		name         string
+		registeredAt time.Time
+		reputation   core.ReporterReputation
+		wantWeight   float64
+		wantTrusted  bool
+	}{
+		{
+			name:         "reporter without history",
+			registeredAt: now.AddDate(-1, 0, 0),
+			wantWeight:   1,
+		},
+		{
+			name:         "new account",
+			registeredAt: now.Add(-time.Hour),
+			wantWeight:   0.5,
+		},
+		{
+			name:         "reporter with rejected reports",
+			registeredAt: now.AddDate(-1, 0, 0),
+			reputation:   core.ReporterReputation{RejectedReports: 18},
+			wantWeight:   0.25,
+		},
+		{
+			name:         "trusted reporter",
+			registeredAt: now.AddDate(-1, 0, 0),
+			reputation:   core.ReporterReputation{UpheldReports: 18},
+			wantWeight:   1.9,
+			wantTrusted:  true,
+		},
+		{
+			name:         "accurate but not experienced reporter",
+			registeredAt: now.AddDate(-1, 0, 0),
+			reputation:   core.ReporterReputation{UpheldReports: 2},
+			wantWeight:   1.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weight, trusted := report.ReportWeight(config, tt.registeredAt, tt.reputation, now)
+			assert.InDelta(t, tt.wantWeight, weight, 0.001)
+			assert.Equal(t, tt.wantTrusted, trusted)
+		})
+	}
+}
+
+func TestCreateReport_MessageReporterNotChatMember(t *testing.T) {
+	ctx := context.TODO()
+	mockReports := new(mocks.MockReportStore)
+	mockMessages := new(mocks.MockMessageStore)
+
+	message := core.Message{ID: 3, ChatID: 7, UserID: 2}
+	mockMessages.On("GetMessageByID", ctx, 3).Return(message, nil)
+	mockMessages.On("IsChatMember", ctx, 7, 5).Return(false, nil)
+
+	svc := report.NewReportService(mockReports, nil, nil, nil, nil, nil, mockMessages, core.ReportServiceConfig{})
+
+	err := svc.CreateReport(ctx, core.Report{
+		UserID:         5,
+		ReportableID:   3,
+		ReportableType: core.ReportableTypeMessage,
+		Reason:         core.Spam,
+	})
+	assert.ErrorIs(t, err, core.ErrNotChatMember)
+
+	mockMessages.AssertExpectations(t)
+	mockReports.AssertNotCalled(t, "CreateReport", ctx, mock.Anything)
+}
+
+func TestCreateReport_UserSentToModerationQueue(t *testing.T) {
+	ctx := context.TODO()
+	mockReports := new(mocks.MockReportStore)
+	mockUsers := new(mocks.MockUserStore)
+	mockModerators := new(mocks.MockModeratorStore)
+
+	description := "pretends to be a shelter and asks for money"
+	mockUsers.On("GetUserByID", ctx, 6).Return(core.User{ID: 6, Status: core.UserActive}, nil)
+	mockReports.On("IsOnModeration", ctx, 6, core.ReportableTypeUser).Return(false, nil)
+	expectRegularReporter(ctx, mockUsers, mockModerators, mockReports)
+	mockReports.On("CreateReport", ctx, mock.MatchedBy(func(r core.Report) bool {
+		return r.Reason == core.Other && r.Description != nil && *r.Description == description
+	})).Return(nil)
+	mockReports.On("GetReportWeights", ctx, 6, core.ReportableTypeUser).
+		Return(map[core.ReportReason]float64{core.Other: 15}, nil)
+	mockReports.On("SendToModeration", ctx, 6, core.ReportableTypeUser).Return(nil)
+
+	svc := report.NewReportService(mockReports, nil, nil, mockUsers, mockModerators, nil, nil, core.ReportServiceConfig{})
+
+	err := svc.CreateReport(ctx, core.Report{
+		ReportableID:   6,
+		ReportableType: core.ReportableTypeUser,
+		Reason:         core.Other,
+		Description:    &description,
+	})
+	assert.NoError(t, err)
+
+	mockReports.AssertExpectations(t)
+	mockUsers.AssertExpectations(t)
+}
+
+func TestCreateReport_ReviewAlreadyOnModeration(t *testing.T) {
+	ctx := context.TODO()
+	mockReports := new(mocks.MockReportStore)
+	mockReviews := new(mocks.MockReviewStore)
+
+	mockReviews.On("GetReviewByID", ctx, core.KeeperReview, 4).Return(core.Review{ID: 4}, nil)
+	mockReports.On("IsOnModeration", ctx, 4, core.ReportableTypeKeeperReview).Return(true, nil)
+
+	svc := report.NewReportService(mockReports, nil, nil, nil, nil, mockReviews, nil, core.ReportServiceConfig{})
+
+	err := svc.CreateReport(ctx, core.Report{
+		ReportableID:   4,
+		ReportableType: core.ReportableTypeKeeperReview,
+		Reason:         core.ViolentSpeech,
+	})
+	assert.NoError(t, err)
+
+	mockReviews.AssertExpectations(t)
+	mockReports.AssertNotCalled(t, "CreateReport", ctx, mock.Anything)
+}
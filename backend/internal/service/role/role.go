@@ -39,14 +39,14 @@ func (s *service) GetUserRoles(ctx context.Context, id int) (rolesDetails []core
 
 	user, err := s.userStore.GetUserByID(ctx, id)
 	username := user.Username
-	if seekerRole, exists := roles["seeker"]; exists {
-		rolesDetails = append(rolesDetails, toRoleDetails(&seekerRole, "seeker", username))
+	if seekerRole, exists := roles[core.Seeker]; exists {
+		rolesDetails = append(rolesDetails, toRoleDetails(&seekerRole, core.Seeker, username))
 	}
-	if keeperRole, exists := roles["keeper"]; exists {
-		rolesDetails = append(rolesDetails, toRoleDetails(&keeperRole, "keeper", username))
+	if keeperRole, exists := roles[core.Keeper]; exists {
+		rolesDetails = append(rolesDetails, toRoleDetails(&keeperRole, core.Keeper, username))
 	}
-	if vetRole, exists := roles["seeker"]; exists {
-		rolesDetails = append(rolesDetails, toRoleDetails(&vetRole, "seeker", username))
+	if vetRole, exists := roles[core.Vet]; exists {
+		rolesDetails = append(rolesDetails, toRoleDetails(&vetRole, core.Vet, username))
 	}
 	return rolesDetails, err
 }
@@ -9,19 +9,63 @@ import (
 type service struct {
 	userStore          core.UserStore
 	userFavouriteStore core.UserFavouriteStore
+	mediaService       core.MediaService
 }
 
-func New(store core.UserStore, favouriteStore core.UserFavouriteStore) core.UserService {
+func New(store core.UserStore, favouriteStore core.UserFavouriteStore, mediaService core.MediaService) core.UserService {
 	return &service{
 		userStore:          store,
 		userFavouriteStore: favouriteStore,
+		mediaService:       mediaService,
 	}
 }
 
 func (s *service) GetUser(ctx context.Context, id int) (user core.User, err error) {
-	return s.userStore.GetUser(ctx, id)
+	user, err = s.userStore.GetUser(ctx, id)
+	if err != nil {
+		return core.User{}, err
+	}
+
+	user.Photo, err = s.mediaService.GetUserPhoto(ctx, id)
+	if err != nil {
+		return core.User{}, err
+	}
+
+	return user, nil
 }
 
 func (s *service) UpdateUser(ctx context.Context, id int, update core.UpdateUser) (updatedUser core.User, err error) {
-	return s.userStore.UpdateUser(ctx, id, update)
+	if update.Photo == nil {
+		updatedUser, err = s.userStore.UpdateUser(ctx, id, update)
+		if err != nil {
+			return core.User{}, err
+		}
+
+		updatedUser.Photo, err = s.mediaService.GetUserPhoto(ctx, id)
+		if err != nil {
+			return core.User{}, err
+		}
+
+		return updatedUser, nil
+	}
+
+	// photo is stored separately, so request changing only photo doesn't touch the user record
+	if update.Username != nil || update.Firstname != nil || update.Lastname != nil ||
+		update.Description != nil || update.PasswordHash != nil ||
+		update.Phone != nil || update.Telegram != nil || update.VKLink != nil {
+		updatedUser, err = s.userStore.UpdateUser(ctx, id, update)
+	} else {
+		updatedUser, err = s.userStore.GetUser(ctx, id)
+	}
+	if err != nil {
+		return core.User{}, err
+	}
+
+	photo, err := s.mediaService.SetUserPhoto(ctx, id, *update.Photo)
+	if err != nil {
+		return core.User{}, err
+	}
+	updatedUser.Photo = &photo
+
+	return updatedUser, nil
 }
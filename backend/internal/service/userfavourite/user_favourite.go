@@ -8,21 +8,66 @@ import (
 
 type service struct {
 	userFavouriteStore core.UserFavouriteStore
+	userStore          core.UserStore
+	postService        core.PostService
+	blockStore         core.BlockStore
 }
 
-func New(store core.UserFavouriteStore) core.UserFavouriteService {
+func New(
+	store core.UserFavouriteStore,
+	userStore core.UserStore,
+	postService core.PostService,
+	blockStore core.BlockStore,
+) core.UserFavouriteService {
 	return &service{
 		userFavouriteStore: store,
+		userStore:          userStore,
+		postService:        postService,
+		blockStore:         blockStore,
 	}
 }
 
+// AddUserToFavourite adds the existing person to favourites of the user
 func (s *service) AddUserToFavourite(ctx context.Context, personID, userID int) (err error) {
-	panic("implement me")
+	if personID == userID {
+		return core.ErrFavouriteYourself
+	}
+
+	if _, err := s.userStore.GetUser(ctx, personID); err != nil {
+		return err
+	}
+
+	return s.userFavouriteStore.AddUserToFavourite(ctx, personID, userID)
 }
 
-func (s *service) GetFavouriteUsers(ctx context.Context, userID int, params core.GetFavourites) (favouriteUsers []core.User, err error) {
-	panic("implement me")
+// GetFavouriteUsers retrieves users favourited by the user
+func (s *service) GetFavouriteUsers(ctx context.Context, params core.GetFavouriteUsersParams) ([]core.FavouriteUser, int, error) {
+	return s.userFavouriteStore.GetFavouriteUsers(ctx, params)
 }
+
+// DeleteUserFromFavourite removes the person from favourites of the user
 func (s *service) DeleteUserFromFavourite(ctx context.Context, personID, userID int) (err error) {
-	panic("implement me")
+	return s.userFavouriteStore.DeleteUserFromFavourite(ctx, personID, userID)
+}
+
+// GetFavouriteUsersPosts returns posts of users favourited by the user with their details,
+// posts of favourites blocked or muted by the user afterwards are hidden
+func (s *service) GetFavouriteUsersPosts(ctx context.Context, userID int, params core.GetAllPostsParams) ([]core.PostDetails, int, error) {
+	hiddenUserIDs, err := s.blockStore.GetHiddenUserIDs(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	params.ExcludeAuthorIDs = append(params.ExcludeAuthorIDs, hiddenUserIDs...)
+
+	posts, total, err := s.userFavouriteStore.GetFavouriteUsersPosts(ctx, userID, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	postDetails, err := s.postService.BuildPostDetailsList(ctx, posts, total, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return postDetails, total, nil
 }
@@ -0,0 +1,137 @@
+package userfavourite
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	mocks "github.com/kotopesp/sos-kotopes/internal/core/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddUserToFavourite(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		personID      int
+		userID        int
+		getUserErr    error
+		addErr        error
+		invokeGetUser bool
+		invokeAdd     bool
+		wantErr       error
+	}{
+		{
+			name:          "success",
+			personID:      2,
+			userID:        1,
+			invokeGetUser: true,
+			invokeAdd:     true,
+		},
+		{
+			name:     "favourite yourself",
+			personID: 1,
+			userID:   1,
+			wantErr:  core.ErrFavouriteYourself,
+		},
+		{
+			name:          "no such user",
+			personID:      2,
+			userID:        1,
+			getUserErr:    core.ErrNoSuchUser,
+			invokeGetUser: true,
+			wantErr:       core.ErrNoSuchUser,
+		},
+		{
+			name:          "already in favourites",
+			personID:      2,
+			userID:        1,
+			addErr:        core.ErrUserAlreadyInFavourites,
+			invokeGetUser: true,
+			invokeAdd:     true,
+			wantErr:       core.ErrUserAlreadyInFavourites,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userFavouriteStore := mocks.NewMockUserFavouriteStore(t)
+			userStore := mocks.NewMockUserStore(t)
+
+			if tt.invokeGetUser {
+				userStore.EXPECT().GetUser(ctx, tt.personID).Return(core.User{ID: tt.personID}, tt.getUserErr).Once()
+			}
+			if tt.invokeAdd {
+				userFavouriteStore.EXPECT().AddUserToFavourite(ctx, tt.personID, tt.userID).Return(tt.addErr).Once()
+			}
+
+			err := New(userFavouriteStore, userStore, nil, nil).AddUserToFavourite(ctx, tt.personID, tt.userID)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGetFavouriteUsersPosts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	limit := 10
+	params := core.GetAllPostsParams{Limit: &limit}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		userFavouriteStore := mocks.NewMockUserFavouriteStore(t)
+		postService := mocks.NewMockPostService(t)
+		blockStore := mocks.NewMockBlockStore(t)
+
+		posts := []core.Post{{ID: 1, AuthorID: 2}, {ID: 2, AuthorID: 3}}
+		details := []core.PostDetails{{Post: posts[0]}, {Post: posts[1]}}
+		blockStore.EXPECT().GetHiddenUserIDs(ctx, 1).Return(nil, nil).Once()
+		userFavouriteStore.EXPECT().GetFavouriteUsersPosts(ctx, 1, params).Return(posts, 2, nil).Once()
+		postService.EXPECT().BuildPostDetailsList(ctx, posts, 2, 1).Return(details, nil).Once()
+
+		got, total, err := New(userFavouriteStore, nil, postService, blockStore).GetFavouriteUsersPosts(ctx, 1, params)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, details, got)
+	})
+
+	t.Run("hides blocked and muted users", func(t *testing.T) {
+		t.Parallel()
+
+		userFavouriteStore := mocks.NewMockUserFavouriteStore(t)
+		postService := mocks.NewMockPostService(t)
+		blockStore := mocks.NewMockBlockStore(t)
+
+		blockStore.EXPECT().GetHiddenUserIDs(ctx, 1).Return([]int{2, 3}, nil).Once()
+		userFavouriteStore.EXPECT().GetFavouriteUsersPosts(ctx, 1, core.GetAllPostsParams{Limit: &limit, ExcludeAuthorIDs: []int{2, 3}}).
+			Return([]core.Post{}, 0, nil).Once()
+		postService.EXPECT().BuildPostDetailsList(ctx, []core.Post{}, 0, 1).Return([]core.PostDetails{}, nil).Once()
+
+		_, _, err := New(userFavouriteStore, nil, postService, blockStore).GetFavouriteUsersPosts(ctx, 1, params)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		t.Parallel()
+
+		storeErr := errors.New("store error")
+		userFavouriteStore := mocks.NewMockUserFavouriteStore(t)
+		blockStore := mocks.NewMockBlockStore(t)
+		blockStore.EXPECT().GetHiddenUserIDs(ctx, 1).Return(nil, nil).Once()
+		userFavouriteStore.EXPECT().GetFavouriteUsersPosts(ctx, 1, params).Return(nil, 0, storeErr).Once()
+
+		_, _, err := New(userFavouriteStore, nil, nil, blockStore).GetFavouriteUsersPosts(ctx, 1, params)
+
+		assert.ErrorIs(t, err, storeErr)
+	})
+}
@@ -0,0 +1,170 @@
+package adoption
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	animalstore "github.com/kotopesp/sos-kotopes/internal/store/animal"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+type store struct {
+	*postgres.Postgres
+}
+
+func New(pg *postgres.Postgres) core.AdoptionStore {
+	return &store{pg}
+}
+
+// CreateApplication - inserts the application, the applicant may have only one open application for the animal.
+func (s *store) CreateApplication(ctx context.Context, application core.AdoptionApplication) (core.AdoptionApplication, error) {
+	application.CreatedAt = time.Now().UTC()
+	application.UpdatedAt = application.CreatedAt
+
+	if err := s.DB.WithContext(ctx).Create(&application).Error; err != nil {
+		if strings.Contains(err.Error(), "idx_adoption_applications_open") { // unique index of open applications
+			return core.AdoptionApplication{}, core.ErrAdoptionApplicationExists
+		}
+
+		logger.Log().Error(ctx, err.Error())
+		return core.AdoptionApplication{}, err
+	}
+
+	return application, nil
+}
+
+// GetApplicationByID - retrieves the application by its ID.
+func (s *store) GetApplicationByID(ctx context.Context, id int) (core.AdoptionApplication, error) {
+	var application core.AdoptionApplication
+
+	if err := s.DB.WithContext(ctx).First(&application, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return core.AdoptionApplication{}, core.ErrAdoptionApplicationNotFound
+		}
+
+		logger.Log().Error(ctx, err.Error())
+		return core.AdoptionApplication{}, err
+	}
+
+	return application, nil
+}
+
+// GetApplications - retrieves applications matching the filters from the newest one and their total count.
+func (s *store) GetApplications(ctx context.Context, params core.GetAdoptionApplicationsParams) ([]core.AdoptionApplication, int, error) {
+	var applications []core.AdoptionApplication
+
+	query := s.DB.WithContext(ctx).Model(&core.AdoptionApplication{})
+
+	if params.AnimalID != nil {
+		query = query.Where("animal_id = ?", *params.AnimalID)
+	}
+	if params.ApplicantID != nil {
+		query = query.Where("applicant_id = ?", *params.ApplicantID)
+	}
+	if params.Status != nil {
+		query = query.Where("status = ?", *params.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	query = query.Order("created_at DESC, id DESC")
+
+	if params.Limit != nil {
+		query = query.Limit(*params.Limit)
+	}
+	if params.Offset != nil {
+		query = query.Offset(*params.Offset)
+	}
+
+	if err := query.Find(&applications).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	return applications, int(total), nil
+}
+
+// UpdateApplication - saves status and note of the application.
+func (s *store) UpdateApplication(ctx context.Context, application core.AdoptionApplication) (core.AdoptionApplication, error) {
+	application.UpdatedAt = time.Now().UTC()
+
+	err := s.DB.WithContext(ctx).
+		Model(&application).
+		Select("status", "keeper_note", "updated_at", "closed_at").
+		Updates(&application).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.AdoptionApplication{}, err
+	}
+
+	return application, nil
+}
+
+// ApproveApplication - approves the application if it is still open, rejects other open applications for the animal,
+// marks the animal as adopted and records the adoption in case history of the animal, all at once.
+func (s *store) ApproveApplication(ctx context.Context, application core.AdoptionApplication, approvedBy int) (core.AdoptionApplication, error) {
+	now := time.Now().UTC()
+	application.Status = core.AdoptionApproved
+	application.UpdatedAt = now
+	application.ClosedAt = &now
+
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the application may be withdrawn while the keeper decides
+		result := tx.Model(&application).
+			Where("status IN ?", core.OpenAdoptionStatuses).
+			Select("status", "keeper_note", "updated_at", "closed_at").
+			Updates(&application)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return core.ErrAdoptionStatusTransition
+		}
+
+		err := tx.Model(&core.AdoptionApplication{}).
+			Where("animal_id = ? AND id <> ? AND status IN ?", application.AnimalID, application.ID, core.OpenAdoptionStatuses).
+			Updates(map[string]interface{}{
+				"status":     core.AdoptionRejected,
+				"updated_at": now,
+				"closed_at":  now,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&core.Animal{}).
+			Where("id = ?", application.AnimalID).
+			Updates(map[string]interface{}{
+				"status":     core.AnimalStatusAdopted,
+				"updated_at": now,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		return animalstore.CreateEvent(tx, &core.AnimalEvent{
+			AnimalID:   application.AnimalID,
+			AuthorID:   approvedBy,
+			Type:       "adopted",
+			HappenedAt: now,
+		})
+	})
+	if err != nil {
+		if !errors.Is(err, core.ErrAdoptionStatusTransition) {
+			logger.Log().Error(ctx, err.Error())
+		}
+		return core.AdoptionApplication{}, err
+	}
+
+	return application, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kotopesp/sos-kotopes/internal/core"
 	"github.com/kotopesp/sos-kotopes/pkg/logger"
 	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+	"gorm.io/gorm"
 )
 
 type (
@@ -16,17 +17,42 @@ type (
 	}
 )
 
+// withKeeper - joins keepers of animals, so animals are filtered and selected by the user of the keeper
+// and clients never deal with IDs of keepers.
+func withKeeper(query *gorm.DB) *gorm.DB {
+	return query.Model(&core.Animal{}).Joins("LEFT JOIN keepers ON keepers.id = animals.keeper_id")
+}
+
+// keeperColumns - columns of animals selected with withKeeper.
+const keeperColumns = "animals.*, keepers.user_id AS keeper_user_id"
+
 func New(pg *postgres.Postgres) core.AnimalStore {
 	return &store{pg}
 }
 
+// unsetEnums - enum columns the animal has no value for, e.g. the animal of the draft post. Empty string isn't a value
+// of the enum, so the columns are omitted and stay NULL or keep the previous value.
+func unsetEnums(animal core.Animal) []string {
+	var columns []string
+	for column, value := range map[string]string{
+		"animal_type": animal.AnimalType,
+		"gender":      animal.Gender,
+		"status":      animal.Status,
+	} {
+		if value == "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
 // CreateAnimal inserts a new animal record into the database
 func (s *store) CreateAnimal(ctx context.Context, animal core.Animal) (core.Animal, error) {
 	// Set the creation and update timestamps
 	animal.CreatedAt = time.Now().UTC()
 	animal.UpdatedAt = time.Now().UTC()
 
-	if err := s.DB.WithContext(ctx).Create(&animal).Error; err != nil {
+	if err := s.DB.WithContext(ctx).Omit(unsetEnums(animal)...).Create(&animal).Error; err != nil {
 		logger.Log().Error(ctx, err.Error())
 		return core.Animal{}, err
 	}
@@ -38,7 +64,7 @@ func (s *store) CreateAnimal(ctx context.Context, animal core.Animal) (core.Anim
 func (s *store) GetAnimalByID(ctx context.Context, id int) (core.Animal, error) {
 	var animal core.Animal
 
-	if err := s.DB.WithContext(ctx).Where("id = ?", id).First(&animal).Error; err != nil {
+	if err := withKeeper(s.DB.WithContext(ctx)).Select(keeperColumns).Where("animals.id = ?", id).First(&animal).Error; err != nil {
 		if errors.Is(err, core.ErrRecordNotFound) {
 			logger.Log().Error(ctx, core.ErrRecordNotFound.Error())
 			return core.Animal{}, core.ErrAnimalNotFound
@@ -56,17 +82,91 @@ func (s *store) UpdateAnimal(ctx context.Context, animal core.Animal) (core.Anim
 	// Set the update timestamp
 	animal.UpdatedAt = time.Now().UTC()
 
-	var updateAnimal core.Animal
+	if err := s.DB.WithContext(ctx).Omit(unsetEnums(animal)...).Save(&animal).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.Animal{}, err
+	}
 
-	if err := s.DB.WithContext(ctx).Save(&animal).First(&updateAnimal).Error; err != nil {
-		if errors.Is(err, core.ErrRecordNotFound) {
-			logger.Log().Error(ctx, core.ErrRecordNotFound.Error())
-			return core.Animal{}, core.ErrAnimalNotFound
-		}
+	return s.GetAnimalByID(ctx, animal.ID)
+}
+
+// GetAnimals retrieves animals matching the filters, the latest registered go first
+func (s *store) GetAnimals(ctx context.Context, params core.GetAnimalsParams) (animals []core.Animal, total int, err error) {
+	query := withKeeper(s.DB.WithContext(ctx))
+
+	if params.AuthorID != nil {
+		query = query.Where("animals.author_id = ?", *params.AuthorID)
+	}
+
+	if params.KeeperUserID != nil {
+		query = query.Where("keepers.user_id = ?", *params.KeeperUserID)
+	}
+
+	if params.Stage != nil {
+		query = query.Where("animals.stage = ?", *params.Stage)
+	}
 
+	if params.Status != nil {
+		query = query.Where("animals.status = ?", *params.Status)
+	}
+
+	if params.AnimalType != nil {
+		query = query.Where("animals.animal_type = ?", *params.AnimalType)
+	}
+
+	var total64 int64
+	if err := query.Count(&total64).Error; err != nil {
 		logger.Log().Error(ctx, err.Error())
-		return core.Animal{}, err
+		return nil, 0, err
+	}
+
+	query = query.Select(keeperColumns).Order("animals.created_at DESC, animals.id DESC")
+
+	if params.Limit != nil {
+		query = query.Limit(*params.Limit)
+	}
+
+	if params.Offset != nil {
+		query = query.Offset(*params.Offset)
+	}
+
+	if err := query.Find(&animals).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	return animals, int(total64), nil
+}
+
+// SetAnimalKeeper assigns the animal to the keeper role of the user, nil user leaves the animal without keeper
+func (s *store) SetAnimalKeeper(ctx context.Context, id int, keeperUserID *int) error {
+	var keeperID *int
+	if keeperUserID != nil {
+		var keeper core.Role
+		err := s.DB.WithContext(ctx).Table("keepers").Where("user_id = ? AND NOT is_deleted", *keeperUserID).First(&keeper).Error
+		if err != nil {
+			if errors.Is(err, core.ErrRecordNotFound) {
+				return core.ErrUserIsNotKeeper
+			}
+
+			logger.Log().Error(ctx, err.Error())
+			return err
+		}
+		keeperID = &keeper.ID
+	}
+
+	result := s.DB.WithContext(ctx).Model(&core.Animal{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"keeper_id":  keeperID,
+		"updated_at": time.Now().UTC(),
+	})
+	if result.Error != nil {
+		logger.Log().Error(ctx, result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return core.ErrAnimalNotFound
 	}
 
-	return updateAnimal, nil
+	return nil
 }
@@ -0,0 +1,76 @@
+package animal
+
+import (
+	"context"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// refreshStage - sets stage of the animal to the type of its latest event, events may be recorded in any order.
+const refreshStage = "UPDATE animals SET stage = (" +
+	"SELECT type FROM animal_events WHERE animal_events.animal_id = animals.id ORDER BY happened_at DESC, id DESC LIMIT 1" +
+	") WHERE id = ?"
+
+// CreateEvent adds the event to the case history of the animal and updates stage of the animal within the transaction,
+// used by other stores which record events as part of their changes
+func CreateEvent(tx *gorm.DB, event *core.AnimalEvent) error {
+	event.CreatedAt = time.Now().UTC()
+	if event.HappenedAt.IsZero() {
+		event.HappenedAt = event.CreatedAt
+	}
+
+	if err := tx.Create(event).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(refreshStage, event.AnimalID).Error
+}
+
+// CreateAnimalEvent adds the event to the case history of the animal and updates stage of the animal
+func (s *store) CreateAnimalEvent(ctx context.Context, event core.AnimalEvent) (core.AnimalEvent, error) {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return CreateEvent(tx, &event)
+	})
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.AnimalEvent{}, err
+	}
+
+	return event, nil
+}
+
+// DeleteAnimalEvent removes the event, used when the event can't be completed, e.g. its photos failed to upload
+func (s *store) DeleteAnimalEvent(ctx context.Context, event core.AnimalEvent) error {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&core.AnimalEvent{}, event.ID).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec(refreshStage, event.AnimalID).Error
+	})
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetAnimalEvents retrieves case history of the animal from the earliest event
+func (s *store) GetAnimalEvents(ctx context.Context, animalID int) ([]core.AnimalEvent, error) {
+	var events []core.AnimalEvent
+
+	err := s.DB.WithContext(ctx).
+		Where("animal_id = ?", animalID).
+		Order("happened_at, id").
+		Find(&events).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,125 @@
+package blob_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/internal/store/blob"
+)
+
+func TestFilesystem(t *testing.T) {
+	ctx := context.Background()
+	store := blob.NewFilesystem(t.TempDir(), "/media/")
+
+	require.NoError(t, store.Put(ctx, "posts/1/photo.jpg", "image/jpeg", []byte("photo")))
+
+	data, err := store.Get(ctx, "posts/1/photo.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("photo"), data)
+	assert.Equal(t, "/media/posts/1/photo.jpg", store.URL("posts/1/photo.jpg"))
+
+	require.NoError(t, store.Delete(ctx, "posts/1/photo.jpg"))
+	require.NoError(t, store.Delete(ctx, "posts/1/photo.jpg"))
+
+	_, err = store.Get(ctx, "posts/1/photo.jpg")
+	assert.ErrorIs(t, err, core.ErrBlobNotFound)
+}
+
+func TestFilesystem_InvalidKey(t *testing.T) {
+	ctx := context.Background()
+	store := blob.NewFilesystem(t.TempDir(), "/media")
+
+	for _, key := range []string{"", "../secret", "/etc/passwd", "posts/../../secret"} {
+		assert.ErrorIs(t, store.Put(ctx, key, "image/jpeg", []byte("photo")), core.ErrInvalidMediaKey, key)
+	}
+}
+
+// fakeS3 - minimal stand-in of S3 compatible storage keeping objects in memory.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") ||
+		r.Header.Get("X-Amz-Date") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		sum := sha256.Sum256(body)
+		if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects[r.URL.Path] = body
+	case http.MethodGet:
+		body, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(body)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeS3{objects: make(map[string][]byte)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store := blob.NewS3(blob.S3Config{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		Bucket:    "photos",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+
+	require.NoError(t, store.Put(ctx, "posts/1/photo.jpg", "image/jpeg", []byte("photo")))
+	assert.Contains(t, fake.objects, "/photos/posts/1/photo.jpg")
+
+	data, err := store.Get(ctx, "posts/1/photo.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("photo"), data)
+	assert.Equal(t, server.URL+"/photos/posts/1/photo.jpg", store.URL("posts/1/photo.jpg"))
+
+	require.NoError(t, store.Delete(ctx, "posts/1/photo.jpg"))
+
+	_, err = store.Get(ctx, "posts/1/photo.jpg")
+	assert.ErrorIs(t, err, core.ErrBlobNotFound)
+}
+
+func TestS3_ErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	store := blob.NewS3(blob.S3Config{Endpoint: server.URL, Bucket: "photos", PublicURL: "https://cdn.example.com/"})
+
+	err := store.Put(context.Background(), "posts/1/photo.jpg", "image/jpeg", []byte("photo"))
+	assert.ErrorContains(t, err, "403")
+	assert.Equal(t, "https://cdn.example.com/posts/1/photo.jpg", store.URL("posts/1/photo.jpg"))
+}
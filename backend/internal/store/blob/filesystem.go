@@ -0,0 +1,109 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+type filesystemStore struct {
+	root    string
+	baseURL string
+}
+
+// NewFilesystem returns BlobStore keeping files in the root directory, baseURL is a prefix files are served with.
+func NewFilesystem(root, baseURL string) core.BlobStore {
+	return &filesystemStore{
+		root:    root,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// Put writes file to temporary location first, so readers never see partially written file.
+func (s *filesystemStore) Put(ctx context.Context, key, _ string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *filesystemStore) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, core.ErrBlobNotFound
+		}
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Delete removes the file, missing file is not an error.
+func (s *filesystemStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *filesystemStore) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+// path converts key to the path inside the root directory and rejects keys escaping it.
+func (s *filesystemStore) path(key string) (string, error) {
+	if key == "" || !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", core.ErrInvalidMediaKey
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
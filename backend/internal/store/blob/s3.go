@@ -0,0 +1,215 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+const (
+	s3Service       = "s3"
+	s3Algorithm     = "AWS4-HMAC-SHA256"
+	s3DateFormat    = "20060102"
+	s3TimeFormat    = "20060102T150405Z"
+	s3ClientTimeout = 30 * time.Second
+)
+
+// S3Config - connection settings of S3 compatible storage, e.g. AWS S3, MinIO or Yandex Object Storage.
+type S3Config struct {
+	Endpoint  string // Address of the storage, e.g. http://localhost:9000
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PublicURL string // Prefix files are served with, endpoint with bucket is used when empty
+}
+
+type s3Store struct {
+	config S3Config
+	client *http.Client
+}
+
+// NewS3 returns BlobStore keeping files in S3 compatible storage. Path-style addressing is used,
+// so the store works with self-hosted storages without wildcard DNS.
+func NewS3(config S3Config) core.BlobStore {
+	config.Endpoint = strings.TrimRight(config.Endpoint, "/")
+	if config.PublicURL == "" {
+		config.PublicURL = config.Endpoint + "/" + config.Bucket
+	}
+	config.PublicURL = strings.TrimRight(config.PublicURL, "/")
+
+	return &s3Store{
+		config: config,
+		client: &http.Client{Timeout: s3ClientTimeout},
+	}
+}
+
+func (s *s3Store) Put(ctx context.Context, key, contentType string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, key, contentType, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.responseError(ctx, resp)
+	}
+
+	return nil
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, core.ErrBlobNotFound
+	default:
+		return nil, s.responseError(ctx, resp)
+	}
+}
+
+// Delete removes the object, S3 treats removal of missing object as success.
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, "", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return s.responseError(ctx, resp)
+	}
+
+	return nil
+}
+
+func (s *s3Store) URL(key string) string {
+	return s.config.PublicURL + "/" + key
+}
+
+// do sends request signed with AWS Signature Version 4.
+func (s *s3Store) do(ctx context.Context, method, key, contentType string, body []byte) (*http.Response, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "..") {
+		return nil, core.ErrInvalidMediaKey
+	}
+
+	path := "/" + s.config.Bucket + "/" + escapeKey(key)
+
+	req, err := http.NewRequestWithContext(ctx, method, s.config.Endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	s.sign(req, path, body, time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *s3Store) sign(req *http.Request, path string, body []byte, now time.Time) {
+	payloadHash := sha256Hex(body)
+	amzDate := now.Format(s3TimeFormat)
+	scope := strings.Join([]string{now.Format(s3DateFormat), s.config.Region, s3Service, "aws4_request"}, "/")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": payloadHash,
+		"x-amz-date":           amzDate,
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != "" {
+		headers["content-type"] = contentType
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		"",
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretKey), now.Format(s3DateFormat))
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.config.AccessKey, scope, signedHeaders, signature,
+	))
+}
+
+func (s *s3Store) responseError(ctx context.Context, resp *http.Response) error {
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	err := fmt.Errorf("s3 %s %s: %s: %s", resp.Request.Method, resp.Request.URL.Path, resp.Status, message)
+	logger.Log().Error(ctx, err.Error())
+
+	return err
+}
+
+// escapeKey escapes every segment of the key, slashes separating segments are kept.
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
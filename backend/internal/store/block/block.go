@@ -0,0 +1,111 @@
+package block
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+type store struct {
+	*postgres.Postgres
+}
+
+func New(pg *postgres.Postgres) core.BlockStore {
+	return &store{pg}
+}
+
+// BlockUser saves the block, repeated block of the same type keeps the first one
+func (s *store) BlockUser(ctx context.Context, block core.UserBlock) error {
+	block.CreatedAt = time.Now().UTC()
+
+	if err := s.DB.WithContext(ctx).
+		Omit("BlockedUser").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&block).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UnblockUser removes the block of the type, missing block isn't an error
+func (s *store) UnblockUser(ctx context.Context, userID, blockedUserID int, blockType core.BlockType) error {
+	if err := s.DB.WithContext(ctx).
+		Where("user_id = ? AND blocked_user_id = ? AND type = ?", userID, blockedUserID, blockType).
+		Delete(&core.UserBlock{}).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetBlockedUsers retrieves blocks of the user together with blocked users, the latest blocks go first
+func (s *store) GetBlockedUsers(ctx context.Context, params core.GetBlockedUsersParams) ([]core.UserBlock, int, error) {
+	query := s.DB.WithContext(ctx).Model(&core.UserBlock{}).Where("user_id = ?", params.UserID)
+	if params.Type != nil {
+		query = query.Where("type = ?", *params.Type)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	query = query.Order("created_at DESC, id DESC")
+	if params.Limit != nil {
+		query = query.Limit(*params.Limit)
+	}
+	if params.Offset != nil {
+		query = query.Offset(*params.Offset)
+	}
+
+	var blocks []core.UserBlock
+	if err := query.Preload("BlockedUser").Find(&blocks).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	return blocks, int(total), nil
+}
+
+// GetHiddenUserIDs returns users blocked or muted by the user
+func (s *store) GetHiddenUserIDs(ctx context.Context, userID int) ([]int, error) {
+	var userIDs []int
+
+	if err := s.DB.WithContext(ctx).
+		Model(&core.UserBlock{}).
+		Distinct("blocked_user_id").
+		Where("user_id = ?", userID).
+		Pluck("blocked_user_id", &userIDs).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// GetBlockingUserIDs returns which of userIDs blocked the user, mutes don't restrict the user
+func (s *store) GetBlockingUserIDs(ctx context.Context, userID int, userIDs []int) ([]int, error) {
+	var blockingUserIDs []int
+	if len(userIDs) == 0 {
+		return blockingUserIDs, nil
+	}
+
+	if err := s.DB.WithContext(ctx).
+		Model(&core.UserBlock{}).
+		Where("blocked_user_id = ? AND type = ? AND user_id IN ?", userID, core.BlockTypeBlock, userIDs).
+		Pluck("user_id", &blockingUserIDs).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return blockingUserIDs, nil
+}
@@ -13,6 +13,9 @@ import (
 	"github.com/kotopesp/sos-kotopes/pkg/postgres"
 )
 
+// threadLikes - amount of likes of the top-level comment of the thread the comment belongs to.
+const threadLikes = "(SELECT COUNT(*) FROM comment_likes WHERE comment_likes.comment_id = COALESCE(comments.parent_id, comments.id))"
+
 type store struct {
 	*postgres.Postgres
 }
@@ -24,8 +27,8 @@ func New(pg *postgres.Postgres) core.CommentStore {
 func (s *store) GetCommentByID(ctx context.Context, commentID int) (core.Comment, error) {
 	var comment core.Comment
 	if err := s.DB.WithContext(ctx).
-		First(&comment).
-		Where("id = ?", commentID).Error; err != nil {
+		Where("id = ?", commentID).
+		First(&comment).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return comment, core.ErrNoSuchComment
 		}
@@ -38,19 +41,52 @@ func (s *store) GetCommentByID(ctx context.Context, commentID int) (core.Comment
 func (s *store) GetAllComments(ctx context.Context, params core.GetAllCommentsParams) (data []core.Comment, total int, err error) {
 	var comments []core.Comment
 
+	sort := sortOrDefault(params.Sort)
+	if err := checkCursor(sort, params.Cursor); err != nil {
+		return nil, 0, err
+	}
+
 	query := s.DB.WithContext(ctx).
 		Model(&core.Comment{}).
 		Where("posts_id=?", params.PostID)
 
-	query = query.Order(
-		"COALESCE(parent_id, id), (parent_id IS NULL)::int DESC, id",
-	) // sorting by comment and replies to it
+	var totalInt64 int64
+	if err := query.Count(&totalInt64).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// a reply is always created after its parent, so ordering by ID within the thread puts the parent first
+	switch sort {
+	case core.CommentSortTop:
+		if params.Cursor != nil {
+			query = query.Where(
+				"("+threadLikes+" < @likes OR ("+threadLikes+" = @likes AND "+
+					"(COALESCE(parent_id, id) < @thread OR (COALESCE(parent_id, id) = @thread AND id > @id))))",
+				map[string]interface{}{"likes": *params.Cursor.Number, "thread": *params.Cursor.ThreadID, "id": params.Cursor.ID},
+			)
+		}
+		query = query.Select("comments.*, " + threadLikes + " AS thread_likes").
+			Order("thread_likes DESC, COALESCE(parent_id, id) DESC, id") // equally liked threads from the newest
+	case core.CommentSortNewest:
+		if params.Cursor != nil {
+			query = query.Where(
+				"(COALESCE(parent_id, id) < ? OR (COALESCE(parent_id, id) = ? AND id > ?))",
+				*params.Cursor.ThreadID, *params.Cursor.ThreadID, params.Cursor.ID,
+			)
+		}
+		query = query.Order("COALESCE(parent_id, id) DESC, id") // newest threads first
+	default:
+		if params.Cursor != nil {
+			query = query.Where("(COALESCE(parent_id, id), id) > (?, ?)", *params.Cursor.ThreadID, params.Cursor.ID)
+		}
+		query = query.Order("COALESCE(parent_id, id), id") // sorting by comment and replies to it
+	}
 
 	if params.Limit != nil {
 		query = query.Limit(*params.Limit)
 	}
 
-	if params.Offset != nil {
+	if params.Offset != nil && params.Cursor == nil {
 		query = query.Offset(*params.Offset)
 	}
 
@@ -60,16 +96,28 @@ func (s *store) GetAllComments(ctx context.Context, params core.GetAllCommentsPa
 		return nil, 0, err
 	}
 
-	query = s.DB.WithContext(ctx).
-		Model(&core.Comment{}).
-		Where("posts_id=?", params.PostID)
+	return comments, int(totalInt64), nil
+}
 
-	var totalInt64 int64
-	if err := query.Count(&totalInt64).Error; err != nil {
-		return nil, 0, err
+// sortOrDefault - threads go from the oldest by default.
+func sortOrDefault(sort core.CommentSort) core.CommentSort {
+	if sort == "" {
+		return core.CommentSortOldest
 	}
+	return sort
+}
 
-	return comments, int(totalInt64), nil
+// checkCursor - cursor is valid only for the sort it was issued for and must contain the keys of the sort.
+func checkCursor(sort core.CommentSort, cursor *core.Cursor) error {
+	if cursor == nil {
+		return nil
+	}
+
+	if cursor.Sort != string(sort) || cursor.ThreadID == nil || sort == core.CommentSortTop && cursor.Number == nil {
+		return core.ErrInvalidCursor
+	}
+
+	return nil
 }
 
 func (s *store) CreateComment(ctx context.Context, comment core.Comment) (core.Comment, error) {
@@ -146,8 +194,9 @@ func (s *store) GetCommentsForModeration(ctx context.Context, filter core.Filter
 // ApproveCommentFromModeration - changes comment status from "on_moderation" to "published"
 func (s *store) ApproveCommentFromModeration(ctx context.Context, commentID int) error {
 	updates := map[string]interface{}{
-		"status":     core.Published,
-		"updated_at": time.Now().UTC(),
+		"status":            core.Published,
+		"moderation_reason": nil,
+		"updated_at":        time.Now().UTC(),
 	}
 
 	result := s.DB.WithContext(ctx).
@@ -170,3 +219,18 @@ func (s *store) ApproveCommentFromModeration(ctx context.Context, commentID int)
 
 	return nil
 }
+
+// CountUserCommentsSince - returns amount of comments created by the author after the given moment.
+func (s *store) CountUserCommentsSince(ctx context.Context, authorID int, since time.Time) (int, error) {
+	var count int64
+
+	if err := s.DB.WithContext(ctx).
+		Model(&core.Comment{}).
+		Where("author_id = ? AND created_at > ?", authorID, since).
+		Count(&count).Error; err != nil {
+		logger.Log().Error(ctx, "Failed to count user comments: "+err.Error())
+		return 0, err
+	}
+
+	return int(count), nil
+}
@@ -0,0 +1,168 @@
+package commentstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/internal/store/storetest"
+)
+
+// Every cursor compares keys of the sort down to ID of the comment in the direction of the order,
+// so threads with equal likes and replies of the same thread are neither skipped nor repeated.
+func TestGetAllComments_Cursor(t *testing.T) {
+	t.Parallel()
+
+	parentID := 5
+	// the last comment of the previous page is a reply, the next page continues its thread
+	reply := core.Comment{ID: 7, ParentID: &parentID, ThreadLikes: 2}
+
+	tests := []struct {
+		sort      core.CommentSort
+		wantWhere string
+		wantOrder string
+	}{
+		{
+			sort:      core.CommentSortOldest,
+			wantWhere: "WHERE posts_id=1 AND (COALESCE(parent_id, id), id) > (5, 7)",
+			wantOrder: "ORDER BY COALESCE(parent_id, id), id",
+		},
+		{
+			sort:      core.CommentSortNewest,
+			wantWhere: "WHERE posts_id=1 AND ((COALESCE(parent_id, id) < 5 OR (COALESCE(parent_id, id) = 5 AND id > 7)))",
+			wantOrder: "ORDER BY COALESCE(parent_id, id) DESC, id",
+		},
+		{
+			sort: core.CommentSortTop,
+			wantWhere: "WHERE posts_id=1 AND ((" + threadLikes + " < 2 OR (" + threadLikes + " = 2 AND " +
+				"(COALESCE(parent_id, id) < 5 OR (COALESCE(parent_id, id) = 5 AND id > 7)))))",
+			wantOrder: "ORDER BY thread_likes DESC, COALESCE(parent_id, id) DESC, id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			t.Parallel()
+
+			pg, recorder := storetest.DryRun(t)
+			cursor := tt.sort.Cursor(reply)
+
+			_, _, err := New(pg).GetAllComments(context.Background(), core.GetAllCommentsParams{PostID: 1, Sort: tt.sort, Cursor: &cursor})
+			require.NoError(t, err)
+
+			require.Len(t, recorder.Statements, 2)
+			// total counts all comments, not the rest of them
+			assert.Equal(t, `SELECT count(*) FROM "comments" WHERE posts_id=1`, recorder.Statements[0])
+			assert.Contains(t, recorder.Statements[1], tt.wantWhere+" "+tt.wantOrder)
+		})
+	}
+}
+
+// ID of the top-level comment is ID of its thread, so the same cursors page top-level comments.
+func TestGetRootComments_Cursor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		sort      core.CommentSort
+		wantWhere string
+		wantOrder string
+	}{
+		{
+			sort:      core.CommentSortOldest,
+			wantWhere: "WHERE (posts_id = 1 AND parent_id IS NULL) AND id > 5",
+			wantOrder: "ORDER BY id",
+		},
+		{
+			sort:      core.CommentSortNewest,
+			wantWhere: "WHERE (posts_id = 1 AND parent_id IS NULL) AND id < 5",
+			wantOrder: "ORDER BY id DESC",
+		},
+		{
+			sort:      core.CommentSortTop,
+			wantWhere: "WHERE (posts_id = 1 AND parent_id IS NULL) AND (" + commentLikes + ", id) < (2, 5)",
+			wantOrder: "ORDER BY thread_likes DESC, id DESC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			t.Parallel()
+
+			pg, recorder := storetest.DryRun(t)
+			cursor := tt.sort.Cursor(core.Comment{ID: 5, ThreadLikes: 2})
+
+			_, _, err := New(pg).GetRootComments(context.Background(), core.GetAllCommentsParams{PostID: 1, Sort: tt.sort, Cursor: &cursor})
+			require.NoError(t, err)
+
+			require.Len(t, recorder.Statements, 2)
+			assert.Contains(t, recorder.Statements[1], tt.wantWhere+" "+tt.wantOrder)
+		})
+	}
+}
+
+func TestComments_InvalidCursor(t *testing.T) {
+	t.Parallel()
+
+	threadID, likes := 5, 2.0
+	sorts := []core.CommentSort{core.CommentSortOldest, core.CommentSortNewest, core.CommentSortTop}
+
+	// cursor is accepted only by the sort it was issued for, even if it contains keys of the other sort
+	for _, issuedFor := range sorts {
+		for _, sort := range sorts {
+			if sort == issuedFor {
+				continue
+			}
+
+			pg, recorder := storetest.DryRun(t)
+			store := New(pg)
+			cursor := core.Cursor{Sort: string(issuedFor), ThreadID: &threadID, Number: &likes, ID: 7}
+
+			_, _, err := store.GetAllComments(context.Background(), core.GetAllCommentsParams{PostID: 1, Sort: sort, Cursor: &cursor})
+			assert.ErrorIs(t, err, core.ErrInvalidCursor, "cursor of %s used for %s", issuedFor, sort)
+
+			_, _, err = store.GetRootComments(context.Background(), core.GetAllCommentsParams{PostID: 1, Sort: sort, Cursor: &cursor})
+			assert.ErrorIs(t, err, core.ErrInvalidCursor, "cursor of %s used for %s", issuedFor, sort)
+
+			assert.Empty(t, recorder.Statements)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		sort   core.CommentSort
+		cursor core.Cursor
+	}{
+		{name: "oldest without thread", sort: core.CommentSortOldest, cursor: core.Cursor{Sort: "oldest", ID: 7}},
+		{name: "top without likes", sort: core.CommentSortTop, cursor: core.Cursor{Sort: "top", ThreadID: &threadID, ID: 7}},
+		{name: "default sort is oldest", cursor: core.Cursor{Sort: "newest", ThreadID: &threadID, ID: 7}},
+	}
+
+	for _, tt := range tests {
+		pg, _ := storetest.DryRun(t)
+		cursor := tt.cursor
+
+		_, _, err := New(pg).GetAllComments(context.Background(), core.GetAllCommentsParams{PostID: 1, Sort: tt.sort, Cursor: &cursor})
+		assert.ErrorIs(t, err, core.ErrInvalidCursor, tt.name)
+	}
+}
+
+// replies always go from the oldest, so only cursors of the oldest sort page them
+func TestGetReplies_Cursor(t *testing.T) {
+	t.Parallel()
+
+	threadID := 5
+	pg, recorder := storetest.DryRun(t)
+	store := New(pg)
+
+	cursor := core.CommentSortOldest.Cursor(core.Comment{ID: 7, ParentID: &threadID})
+	_, _, err := store.GetReplies(context.Background(), core.GetRepliesParams{ParentID: 5, Cursor: &cursor})
+	require.NoError(t, err)
+	assert.Equal(t, `SELECT * FROM "comments" WHERE parent_id = 5 AND id > 7 ORDER BY id`, recorder.Last())
+
+	cursor = core.CommentSortNewest.Cursor(core.Comment{ID: 7, ParentID: &threadID})
+	_, _, err = store.GetReplies(context.Background(), core.GetRepliesParams{ParentID: 5, Cursor: &cursor})
+	assert.ErrorIs(t, err, core.ErrInvalidCursor)
+}
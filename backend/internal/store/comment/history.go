@@ -0,0 +1,41 @@
+package commentstore
+
+import (
+	"context"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// GetUserComments retrieves published comments of the author under published posts, the newest first
+func (s *store) GetUserComments(ctx context.Context, params core.GetUserCommentsParams) (data []core.Comment, total int, err error) {
+	query := s.DB.WithContext(ctx).
+		Model(&core.Comment{}).
+		Where("comments.author_id = ? AND comments.status = ?", params.AuthorID, core.Published).
+		Where("EXISTS (SELECT 1 FROM posts WHERE posts.id = comments.posts_id AND posts.status = ?)", core.Published)
+
+	var total64 int64
+	if err := query.Count(&total64).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	if params.Limit != nil {
+		query = query.Limit(*params.Limit)
+	}
+
+	if params.Offset != nil {
+		query = query.Offset(*params.Offset)
+	}
+
+	var comments []core.Comment
+	if err := query.
+		Order("comments.created_at DESC, comments.id DESC").
+		Preload("Author").
+		Find(&comments).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	return comments, int(total64), nil
+}
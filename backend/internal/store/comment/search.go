@@ -0,0 +1,56 @@
+package commentstore
+
+import (
+	"context"
+	"strings"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// searchQuery - query of the moderator parsed in both languages comments are indexed in.
+const searchQuery = "(websearch_to_tsquery('russian', @q) || websearch_to_tsquery('english', @q))"
+
+// searchRank - relevance of the comment, matches found by trigram similarity only get the lowest rank.
+const searchRank = "ts_rank_cd(comments.search_vector, " + searchQuery + ") + 0.1 * word_similarity(@q, comments.content)"
+
+// searchHighlight - fragments of the content with matched words in <mark> tags, HTML of the content is escaped.
+const searchHighlight = "ts_headline('russian', " +
+	"replace(replace(replace(comments.content, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), " +
+	searchQuery + ", 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5')"
+
+// SearchComments - full-text search over comments of all statuses, the most relevant comments go first.
+// Trigram similarity of words finds comments with typos.
+func (s *store) SearchComments(ctx context.Context, params core.SearchCommentsParams) (data []core.Comment, total int, err error) {
+	args := map[string]interface{}{"q": strings.TrimSpace(params.Query)}
+
+	query := s.DB.WithContext(ctx).
+		Model(&core.Comment{}).
+		Where("(comments.search_vector @@ "+searchQuery+" OR @q <% comments.content)", args)
+
+	if params.Status != nil {
+		query = query.Where("comments.status = ?", *params.Status)
+	}
+
+	var totalInt64 int64
+	if err := query.Count(&totalInt64).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	err = query.
+		Select("comments.*, "+searchHighlight+" AS highlight", args).
+		Order(clause.OrderBy{Expression: clause.NamedExpr{SQL: searchRank + " DESC, comments.id DESC", Vars: []interface{}{args}}}).
+		Limit(params.Limit).
+		Offset(params.Offset).
+		Preload("Author").
+		Find(&data).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	return data, int(totalInt64), nil
+}
@@ -0,0 +1,155 @@
+package commentstore
+
+import (
+	"context"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// commentLikes - amount of likes of the comment.
+const commentLikes = "(SELECT COUNT(*) FROM comment_likes WHERE comment_likes.comment_id = comments.id)"
+
+// GetRootComments retrieves top-level comments of the post, the thread of the top-level comment is never cut by a page
+func (s *store) GetRootComments(ctx context.Context, params core.GetAllCommentsParams) (data []core.Comment, total int, err error) {
+	sort := sortOrDefault(params.Sort)
+	if err := checkCursor(sort, params.Cursor); err != nil {
+		return nil, 0, err
+	}
+
+	query := s.DB.WithContext(ctx).
+		Model(&core.Comment{}).
+		Where("posts_id = ? AND parent_id IS NULL", params.PostID)
+
+	var total64 int64
+	if err := query.Count(&total64).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	// ID of the top-level comment is ID of its thread, so cursors are the same as of GetAllComments
+	switch sort {
+	case core.CommentSortTop:
+		if params.Cursor != nil {
+			query = query.Where("("+commentLikes+", id) < (?, ?)", *params.Cursor.Number, params.Cursor.ID)
+		}
+		query = query.Select("comments.*, " + commentLikes + " AS thread_likes").Order("thread_likes DESC, id DESC")
+	case core.CommentSortNewest:
+		if params.Cursor != nil {
+			query = query.Where("id < ?", params.Cursor.ID)
+		}
+		query = query.Order("id DESC")
+	default:
+		if params.Cursor != nil {
+			query = query.Where("id > ?", params.Cursor.ID)
+		}
+		query = query.Order("id")
+	}
+
+	if params.Limit != nil {
+		query = query.Limit(*params.Limit)
+	}
+
+	if params.Offset != nil && params.Cursor == nil {
+		query = query.Offset(*params.Offset)
+	}
+
+	var comments []core.Comment
+	if err := query.Preload("Author").Find(&comments).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	return comments, int(total64), nil
+}
+
+// GetFirstReplies retrieves the oldest replies of every top-level comment at once
+func (s *store) GetFirstReplies(ctx context.Context, parentIDs []int, limit int) ([]core.Comment, error) {
+	if len(parentIDs) == 0 || limit <= 0 {
+		return nil, nil
+	}
+
+	numbered := s.DB.
+		Model(&core.Comment{}).
+		Select("comments.*, ROW_NUMBER() OVER (PARTITION BY parent_id ORDER BY id) AS position").
+		Where("parent_id IN ?", parentIDs)
+
+	var replies []core.Comment
+	if err := s.DB.WithContext(ctx).
+		Table("(?) AS comments", numbered).
+		Where("position <= ?", limit).
+		Order("parent_id, id").
+		Preload("Author").
+		Find(&replies).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return replies, nil
+}
+
+// CountReplies counts replies of the top-level comments at once
+func (s *store) CountReplies(ctx context.Context, parentIDs []int) (map[int]int, error) {
+	counts := make(map[int]int, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		ParentID int
+		Count    int
+	}
+
+	if err := s.DB.WithContext(ctx).
+		Model(&core.Comment{}).
+		Select("parent_id, COUNT(*) AS count").
+		Where("parent_id IN ?", parentIDs).
+		Group("parent_id").
+		Scan(&rows).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.ParentID] = row.Count
+	}
+
+	return counts, nil
+}
+
+// GetReplies retrieves replies of the top-level comment from the oldest
+func (s *store) GetReplies(ctx context.Context, params core.GetRepliesParams) (data []core.Comment, total int, err error) {
+	if params.Cursor != nil && params.Cursor.Sort != string(core.CommentSortOldest) {
+		return nil, 0, core.ErrInvalidCursor
+	}
+
+	query := s.DB.WithContext(ctx).
+		Model(&core.Comment{}).
+		Where("parent_id = ?", params.ParentID)
+
+	var total64 int64
+	if err := query.Count(&total64).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	if params.Cursor != nil {
+		query = query.Where("id > ?", params.Cursor.ID)
+	}
+
+	if params.Limit != nil {
+		query = query.Limit(*params.Limit)
+	}
+
+	if params.Offset != nil && params.Cursor == nil {
+		query = query.Offset(*params.Offset)
+	}
+
+	var replies []core.Comment
+	if err := query.Order("id").Preload("Author").Find(&replies).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	return replies, int(total64), nil
+}
@@ -0,0 +1,225 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	poststore "github.com/kotopesp/sos-kotopes/internal/store/post"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+type store struct {
+	*postgres.Postgres
+}
+
+func New(pg *postgres.Postgres) core.FeedStore {
+	return &store{pg}
+}
+
+// reason - condition the post meets to get into the feed for the reason, weight is added to the score of the post.
+type reason struct {
+	reason    core.FeedReason
+	condition string
+	weight    float64
+}
+
+// feedPost - post with its score and reasons selected by the feed query, columns of reasons are named after them.
+type feedPost struct {
+	core.Post     `gorm:"embedded"`
+	Score         float64 `gorm:"column:feed_score"`
+	Nearby        bool    `gorm:"column:nearby"`
+	Preferences   bool    `gorm:"column:preferences"`
+	FavouriteUser bool    `gorm:"column:favourite_user"`
+	Interaction   bool    `gorm:"column:interaction"`
+}
+
+const (
+	// nearby - the post is within @radius kilometers from the point @lat, @lon.
+	nearby = "(posts.latitude IS NOT NULL AND posts.longitude IS NOT NULL AND " + poststore.Distance + " <= @radius)"
+
+	favouriteUser = "posts.author_id IN (SELECT person_id FROM favourite_persons WHERE favourite_persons.user_id = @user_id)"
+
+	interaction = "(EXISTS (SELECT 1 FROM post_likes WHERE post_likes.post_id = posts.id AND post_likes.user_id = @user_id)" +
+		" OR EXISTS (SELECT 1 FROM comments WHERE comments.posts_id = posts.id AND comments.author_id = @user_id AND comments.status <> @deleted)" +
+		" OR EXISTS (SELECT 1 FROM favourite_posts WHERE favourite_posts.post_id = posts.id AND favourite_posts.user_id = @user_id))"
+
+	// decay - score of the post halves every @half_life seconds after the bump, posts bumped after @now aren't boosted.
+	decay = "power(0.5, greatest(0, extract(epoch FROM CAST(@now AS TIMESTAMP) - posts.bumped_at)) / CAST(@half_life AS DOUBLE PRECISION))"
+)
+
+// GetFeed retrieves published active posts of other users having reasons to be in the feed,
+// every post is selected once and scored by the sum of weights of its reasons decayed by its age.
+func (s *store) GetFeed(ctx context.Context, feedQuery core.FeedQuery) ([]core.FeedPost, int, error) {
+	args := map[string]interface{}{"user_id": feedQuery.UserID, "deleted": core.Deleted}
+	reasons := feedReasons(feedQuery, args)
+	if len(reasons) == 0 {
+		return nil, 0, nil
+	}
+
+	conditions := make([]string, len(reasons))
+	columns := make([]string, len(reasons))
+	terms := make([]string, len(reasons))
+	for i, r := range reasons {
+		column := string(r.reason) // the column tells whether the post has the reason
+		args["weight_"+column] = r.weight
+		conditions[i] = r.condition
+		columns[i] = r.condition + " AS " + column
+		terms[i] = "CASE WHEN " + r.condition + " THEN CAST(@weight_" + column + " AS DOUBLE PRECISION) ELSE 0 END"
+	}
+
+	score := "(" + strings.Join(terms, " + ") + ")"
+	if feedQuery.HalfLife > 0 {
+		args["now"] = feedQuery.Now
+		args["half_life"] = feedQuery.HalfLife.Seconds()
+		score += " * " + decay
+	}
+
+	query := s.DB.WithContext(ctx).Model(&core.Post{}).
+		Joins("JOIN animals ON posts.animal_id = animals.id").
+		Where("posts.status = ? AND posts.state = ? AND posts.author_id <> ?", core.Published, core.PostActive, feedQuery.UserID).
+		Where("("+strings.Join(conditions, " OR ")+")", args)
+
+	if len(feedQuery.ExcludeAuthorIDs) > 0 {
+		query = query.Where("posts.author_id NOT IN ?", feedQuery.ExcludeAuthorIDs)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	if cursor := feedQuery.Cursor; cursor != nil {
+		args["cursor_score"], args["cursor_id"] = *cursor.Number, cursor.ID
+		query = query.Where("("+score+", posts.id) < (@cursor_score, @cursor_id)", args)
+	}
+
+	query = query.
+		Select("posts.*, "+score+" AS feed_score, "+strings.Join(columns, ", "), args).
+		Order("feed_score DESC, posts.id DESC")
+
+	if feedQuery.Limit != nil {
+		query = query.Limit(*feedQuery.Limit)
+	}
+
+	var rows []feedPost
+	if err := query.Find(&rows).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	posts := make([]core.FeedPost, len(rows))
+	for i, row := range rows {
+		posts[i] = core.FeedPost{
+			PostDetails: core.PostDetails{Post: row.Post},
+			Score:       row.Score,
+			Reasons:     row.reasons(),
+			ScoredAt:    feedQuery.Now,
+		}
+	}
+
+	return posts, int(total), nil
+}
+
+// feedReasons returns reasons with positive weights the posts may have, named arguments of their conditions are put to args.
+func feedReasons(feedQuery core.FeedQuery, args map[string]interface{}) []reason {
+	var reasons []reason
+	weights := feedQuery.Weights
+
+	if weights.Nearby > 0 && feedQuery.Latitude != nil && feedQuery.Longitude != nil {
+		args["lat"], args["lon"], args["radius"] = *feedQuery.Latitude, *feedQuery.Longitude, feedQuery.Radius
+		reasons = append(reasons, reason{core.FeedReasonNearby, nearby, weights.Nearby})
+	}
+
+	if preferences := feedQuery.Preferences; weights.Preferences > 0 && preferences.HasAnimalPreferences() {
+		var conditions []string
+		if len(preferences.AnimalTypes) > 0 {
+			args["animal_types"] = []string(preferences.AnimalTypes)
+			conditions = append(conditions, "animals.animal_type IN @animal_types")
+		}
+		if len(preferences.Statuses) > 0 {
+			args["statuses"] = []string(preferences.Statuses)
+			conditions = append(conditions, "animals.status IN @statuses")
+		}
+		if len(preferences.Colors) > 0 {
+			args["colors"] = preferences.Colors
+			conditions = append(conditions, "animals.colors && @colors")
+		}
+		reasons = append(reasons, reason{core.FeedReasonPreferences, "(" + strings.Join(conditions, " AND ") + ")", weights.Preferences})
+	}
+
+	if weights.FavouriteUsers > 0 {
+		reasons = append(reasons, reason{core.FeedReasonFavouriteUser, favouriteUser, weights.FavouriteUsers})
+	}
+
+	if weights.Interactions > 0 {
+		reasons = append(reasons, reason{core.FeedReasonInteraction, interaction, weights.Interactions})
+	}
+
+	return reasons
+}
+
+// reasons lists reasons the post got into the feed for.
+func (p feedPost) reasons() []core.FeedReason {
+	var reasons []core.FeedReason
+	for _, r := range []struct {
+		reason core.FeedReason
+		has    bool
+	}{
+		{core.FeedReasonNearby, p.Nearby},
+		{core.FeedReasonPreferences, p.Preferences},
+		{core.FeedReasonFavouriteUser, p.FavouriteUser},
+		{core.FeedReasonInteraction, p.Interaction},
+	} {
+		if r.has {
+			reasons = append(reasons, r.reason)
+		}
+	}
+	return reasons
+}
+
+// GetFeedPreferences retrieves preferences of the user, empty preferences are returned if the user didn't save them
+func (s *store) GetFeedPreferences(ctx context.Context, userID int) (core.FeedPreferences, error) {
+	var preferences core.FeedPreferences
+	err := s.DB.WithContext(ctx).Where("user_id = ?", userID).First(&preferences).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return core.FeedPreferences{UserID: userID}, nil
+	}
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.FeedPreferences{}, err
+	}
+
+	return preferences, nil
+}
+
+// SaveFeedPreferences creates or replaces preferences of the user
+func (s *store) SaveFeedPreferences(ctx context.Context, preferences core.FeedPreferences) (core.FeedPreferences, error) {
+	if preferences.AnimalTypes == nil {
+		preferences.AnimalTypes = pq.StringArray{}
+	}
+	if preferences.Statuses == nil {
+		preferences.Statuses = pq.StringArray{}
+	}
+	if preferences.Colors == nil {
+		preferences.Colors = pq.StringArray{}
+	}
+	preferences.UpdatedAt = time.Now().UTC()
+
+	if err := s.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "user_id"}}, UpdateAll: true}).
+		Create(&preferences).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.FeedPreferences{}, err
+	}
+
+	return preferences, nil
+}
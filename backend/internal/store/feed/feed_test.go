@@ -0,0 +1,179 @@
+package feed
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/internal/store/storetest"
+)
+
+func TestFeedReasons(t *testing.T) {
+	t.Parallel()
+
+	lat, lon := 55.75, 37.61
+	all := core.FeedWeights{Nearby: 3, Preferences: 2, FavouriteUsers: 1.5, Interactions: 1}
+
+	tests := []struct {
+		name        string
+		query       core.FeedQuery
+		wantReasons []reason
+		wantArgs    map[string]interface{}
+	}{
+		{
+			name:     "no weights",
+			query:    core.FeedQuery{Latitude: &lat, Longitude: &lon, Preferences: core.FeedPreferences{Colors: pq.StringArray{"black"}}},
+			wantArgs: map[string]interface{}{},
+		},
+		{
+			name:  "no location and animal preferences",
+			query: core.FeedQuery{Weights: all, Radius: 5},
+			wantReasons: []reason{
+				{core.FeedReasonFavouriteUser, favouriteUser, 1.5},
+				{core.FeedReasonInteraction, interaction, 1},
+			},
+			wantArgs: map[string]interface{}{},
+		},
+		{
+			name: "every reason",
+			query: core.FeedQuery{
+				Weights:   all,
+				Latitude:  &lat,
+				Longitude: &lon,
+				Radius:    5,
+				Preferences: core.FeedPreferences{
+					AnimalTypes: pq.StringArray{"cat"},
+					Statuses:    pq.StringArray{"lost", "found"},
+					Colors:      pq.StringArray{"black", "white"},
+				},
+			},
+			wantReasons: []reason{
+				{core.FeedReasonNearby, nearby, 3},
+				{
+					core.FeedReasonPreferences,
+					"(animals.animal_type IN @animal_types AND animals.status IN @statuses AND animals.colors && @colors)",
+					2,
+				},
+				{core.FeedReasonFavouriteUser, favouriteUser, 1.5},
+				{core.FeedReasonInteraction, interaction, 1},
+			},
+			wantArgs: map[string]interface{}{
+				"lat":          lat,
+				"lon":          lon,
+				"radius":       5.0,
+				"animal_types": []string{"cat"},
+				"statuses":     []string{"lost", "found"},
+				"colors":       pq.StringArray{"black", "white"},
+			},
+		},
+		{
+			name: "only colors are preferred",
+			query: core.FeedQuery{
+				Weights:     core.FeedWeights{Preferences: 2},
+				Preferences: core.FeedPreferences{Colors: pq.StringArray{"ginger"}},
+			},
+			wantReasons: []reason{{core.FeedReasonPreferences, "(animals.colors && @colors)", 2}},
+			wantArgs:    map[string]interface{}{"colors": pq.StringArray{"ginger"}},
+		},
+		{
+			name: "zero weight leaves the reason out",
+			query: core.FeedQuery{
+				Weights:     core.FeedWeights{Nearby: 0, Preferences: 0, Interactions: 1},
+				Latitude:    &lat,
+				Longitude:   &lon,
+				Preferences: core.FeedPreferences{AnimalTypes: pq.StringArray{"dog"}},
+			},
+			wantReasons: []reason{{core.FeedReasonInteraction, interaction, 1}},
+			wantArgs:    map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := map[string]interface{}{}
+			assert.Equal(t, tt.wantReasons, feedReasons(tt.query, args))
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestGetFeed_ScoreAndCursor(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	score := 1.5
+	query := core.FeedQuery{
+		UserID:  1,
+		Weights: core.FeedWeights{FavouriteUsers: 2, Interactions: 1},
+		Now:     now,
+		Cursor:  &core.Cursor{Sort: core.FeedSort, Time: &now, Number: &score, ID: 9},
+	}
+	favourite := strings.ReplaceAll(favouriteUser, "@user_id", "1")
+	interacted := strings.NewReplacer("@user_id", "1", "@deleted", "'deleted'").Replace(interaction)
+	sum := "(CASE WHEN " + favourite + " THEN CAST(2 AS DOUBLE PRECISION) ELSE 0 END + " +
+		"CASE WHEN " + interacted + " THEN CAST(1 AS DOUBLE PRECISION) ELSE 0 END)"
+
+	tests := []struct {
+		name      string
+		halfLife  time.Duration
+		wantScore string
+	}{
+		{
+			name:      "without decay",
+			wantScore: sum,
+		},
+		{
+			name:     "decayed by age",
+			halfLife: time.Hour,
+			wantScore: sum + " * power(0.5, greatest(0, extract(epoch FROM CAST('2024-05-01 10:00:00' AS TIMESTAMP) - posts.bumped_at))" +
+				" / CAST(3600 AS DOUBLE PRECISION))",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pg, recorder := storetest.DryRun(t)
+			query := query
+			query.HalfLife = tt.halfLife
+
+			_, _, err := New(pg).GetFeed(context.Background(), query)
+			require.NoError(t, err)
+			require.Len(t, recorder.Statements, 2)
+			count, list := recorder.Statements[0], recorder.Statements[1]
+
+			// a post gets into the feed for any of its reasons, and tells which of them it has
+			where := "WHERE (posts.status = 'published' AND posts.state = 'active' AND posts.author_id <> 1) AND ((" +
+				favourite + " OR " + interacted + "))"
+			assert.Contains(t, count, where)
+			assert.Contains(t, list, "SELECT posts.*, "+tt.wantScore+" AS feed_score, "+
+				favourite+" AS favourite_user, "+interacted+" AS interaction FROM")
+
+			// posts with the same score as the last post of the page are ordered by ID
+			cursor := "AND ((" + tt.wantScore + ", posts.id) < (1.5, 9)) ORDER BY feed_score DESC, posts.id DESC"
+			assert.Contains(t, list, where+" "+cursor)
+			assert.NotContains(t, count, "(1.5, 9)")
+		})
+	}
+}
+
+func TestGetFeed_NoReasons(t *testing.T) {
+	t.Parallel()
+
+	pg, recorder := storetest.DryRun(t)
+
+	posts, total, err := New(pg).GetFeed(context.Background(), core.FeedQuery{UserID: 1})
+	require.NoError(t, err)
+	assert.Empty(t, posts)
+	assert.Zero(t, total)
+	assert.Empty(t, recorder.Statements)
+}
@@ -0,0 +1,109 @@
+package like
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+type store struct {
+	*postgres.Postgres
+}
+
+func New(pg *postgres.Postgres) core.LikeStore {
+	return &store{pg}
+}
+
+// LikeComment saves the like, the comment already liked by the user keeps the first like
+func (s *store) LikeComment(ctx context.Context, like core.CommentLike) error {
+	like.CreatedAt = time.Now().UTC()
+
+	if err := s.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&like).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UnlikeComment removes the like of the user
+func (s *store) UnlikeComment(ctx context.Context, commentID, userID int) error {
+	if err := s.DB.WithContext(ctx).
+		Where("comment_id = ? AND user_id = ?", commentID, userID).
+		Delete(&core.CommentLike{}).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetCommentsLikes counts likes of the comments at once, comments without likes are missing from the result
+func (s *store) GetCommentsLikes(ctx context.Context, commentIDs []int, userID int) (map[int]core.Likes, error) {
+	return s.getLikes(ctx, s.DB.Model(&core.CommentLike{}), "comment_id", commentIDs, userID)
+}
+
+// LikePost saves the like, the post already liked by the user keeps the first like
+func (s *store) LikePost(ctx context.Context, like core.PostLike) error {
+	like.CreatedAt = time.Now().UTC()
+
+	if err := s.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&like).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UnlikePost removes the like of the user
+func (s *store) UnlikePost(ctx context.Context, postID, userID int) error {
+	if err := s.DB.WithContext(ctx).
+		Where("post_id = ? AND user_id = ?", postID, userID).
+		Delete(&core.PostLike{}).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetPostsLikes counts likes of the posts at once, posts without likes are missing from the result
+func (s *store) GetPostsLikes(ctx context.Context, postIDs []int, userID int) (map[int]core.Likes, error) {
+	return s.getLikes(ctx, s.DB.Model(&core.PostLike{}), "post_id", postIDs, userID)
+}
+
+// getLikes groups likes of the table by the column of the liked entity
+func (s *store) getLikes(ctx context.Context, query *gorm.DB, column string, ids []int, userID int) (map[int]core.Likes, error) {
+	likes := make(map[int]core.Likes, len(ids))
+	if len(ids) == 0 {
+		return likes, nil
+	}
+
+	var rows []struct {
+		ID        int
+		Count     int
+		LikedByMe bool
+	}
+
+	err := query.WithContext(ctx).
+		Select(column+" AS id, COUNT(*) AS count, BOOL_OR(user_id = ?) AS liked_by_me", userID).
+		Where(column+" IN ?", ids).
+		Group(column).
+		Scan(&rows).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	for _, row := range rows {
+		likes[row.ID] = core.Likes{Count: row.Count, LikedByMe: row.LikedByMe}
+	}
+
+	return likes, nil
+}
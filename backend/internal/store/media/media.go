@@ -0,0 +1,290 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+type store struct {
+	*postgres.Postgres
+}
+
+func New(pg *postgres.Postgres) core.MediaStore {
+	return &store{pg}
+}
+
+func orderVariants(db *gorm.DB) *gorm.DB {
+	return db.Order("id ASC")
+}
+
+// GetMediaByOwner - returns media of the entity ordered by position.
+func (s *store) GetMediaByOwner(ctx context.Context, ownerType core.MediaOwnerType, ownerID int) (media []core.Media, err error) {
+	err = s.DB.WithContext(ctx).
+		Preload("Variants", orderVariants).
+		Where("owner_type = ? AND owner_id = ?", ownerType, ownerID).
+		Order("position ASC").
+		Find(&media).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// GetMediaByID - returns media with its variants.
+func (s *store) GetMediaByID(ctx context.Context, id int) (media core.Media, err error) {
+	err = s.DB.WithContext(ctx).Preload("Variants", orderVariants).Where("id = ?", id).First(&media).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return core.Media{}, core.ErrMediaNotFound
+		}
+		logger.Log().Error(ctx, err.Error())
+		return core.Media{}, err
+	}
+
+	return media, nil
+}
+
+// GetMediaOwner - returns status and author of the entity media belong to, ErrMediaNotFound is returned if it doesn't exist.
+func (s *store) GetMediaOwner(ctx context.Context, ownerType core.MediaOwnerType, ownerID int) (owner core.MediaOwner, err error) {
+	query := s.DB.WithContext(ctx)
+	switch ownerType {
+	case core.MediaOwnerPost:
+		query = query.Table("posts").Select("status, author_id")
+	case core.MediaOwnerUser:
+		query = query.Table("users").Select("status, id AS author_id")
+	case core.MediaOwnerAnimalEvent:
+		query = query.Table("animal_events").Select("author_id")
+	default:
+		return core.MediaOwner{}, core.ErrMediaNotFound
+	}
+
+	err = query.Where("id = ?", ownerID).Take(&owner).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return core.MediaOwner{}, core.ErrMediaNotFound
+		}
+		logger.Log().Error(ctx, err.Error())
+		return core.MediaOwner{}, err
+	}
+
+	return owner, nil
+}
+
+// GetMediaByOwners - returns media of several entities of the same type ordered by owner and position.
+func (s *store) GetMediaByOwners(ctx context.Context, ownerType core.MediaOwnerType, ownerIDs []int) (media []core.Media, err error) {
+	if len(ownerIDs) == 0 {
+		return nil, nil
+	}
+
+	err = s.DB.WithContext(ctx).
+		Preload("Variants", orderVariants).
+		Where("owner_type = ? AND owner_id IN ?", ownerType, ownerIDs).
+		Order("owner_id ASC, position ASC").
+		Find(&media).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// ReplaceMedia - replaces media of the entity with the given list, position of each file is its index in the list.
+// Returns replaced media, so their content can be removed from BlobStore.
+func (s *store) ReplaceMedia(
+	ctx context.Context,
+	ownerType core.MediaOwnerType,
+	ownerID int,
+	media []core.Media,
+) (replaced []core.Media, err error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback().Error; rollbackErr != nil {
+				logger.Log().Error(ctx, "Failed to rollback transaction: "+rollbackErr.Error())
+			}
+		}
+	}()
+
+	err = tx.Preload("Variants").Where("owner_type = ? AND owner_id = ?", ownerType, ownerID).Find(&replaced).Error
+	if err != nil {
+		logger.Log().Error(ctx, "Failed to get media: "+err.Error())
+		return nil, err
+	}
+
+	if err = tx.Where("owner_type = ? AND owner_id = ?", ownerType, ownerID).Delete(&core.Media{}).Error; err != nil {
+		logger.Log().Error(ctx, "Failed to delete media: "+err.Error())
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	for i := range media {
+		media[i].OwnerType = ownerType
+		media[i].OwnerID = ownerID
+		media[i].Position = i
+		media[i].CreatedAt = now
+	}
+
+	// variants are created together with the media they belong to
+	if len(media) > 0 {
+		if err = tx.Create(&media).Error; err != nil {
+			logger.Log().Error(ctx, "Failed to create media: "+err.Error())
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return replaced, nil
+}
+
+// GetLegacyBlobs - returns photos moved out of BYTEA columns which are not uploaded to BlobStore yet.
+func (s *store) GetLegacyBlobs(ctx context.Context, limit int) (blobs []core.LegacyBlob, err error) {
+	err = s.DB.WithContext(ctx).
+		Table("media_legacy_blobs").
+		Select("media_legacy_blobs.media_id, media_legacy_blobs.data, media.storage_key").
+		Joins("JOIN media ON media.id = media_legacy_blobs.media_id").
+		Order("media_legacy_blobs.media_id ASC").
+		Limit(limit).
+		Find(&blobs).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return blobs, nil
+}
+
+// CompleteLegacyBlob - saves metadata and variants of the uploaded legacy photo and removes its content from the database.
+func (s *store) CompleteLegacyBlob(ctx context.Context, media core.Media) (err error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback().Error; rollbackErr != nil {
+				logger.Log().Error(ctx, "Failed to rollback transaction: "+rollbackErr.Error())
+			}
+		}
+	}()
+
+	err = tx.Model(&core.Media{}).Where("id = ?", media.ID).Updates(map[string]interface{}{
+		"storage_key":  media.StorageKey,
+		"content_type": media.ContentType,
+		"size":         media.Size,
+		"width":        media.Width,
+		"height":       media.Height,
+		"phash":        media.Hash,
+	}).Error
+	if err != nil {
+		logger.Log().Error(ctx, "Failed to update media: "+err.Error())
+		return err
+	}
+
+	for i := range media.Variants {
+		media.Variants[i].MediaID = media.ID
+	}
+	if len(media.Variants) > 0 {
+		if err = tx.Create(&media.Variants).Error; err != nil {
+			logger.Log().Error(ctx, "Failed to create media variants: "+err.Error())
+			return err
+		}
+	}
+
+	if err = tx.Where("media_id = ?", media.ID).Delete(&core.LegacyBlob{}).Error; err != nil {
+		logger.Log().Error(ctx, "Failed to delete legacy blob: "+err.Error())
+		return err
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// hammingDistance - amount of different bits of phash and the searched hash, Postgres has no popcount for BIGINT
+// before version 14, so the bits are counted in the text form.
+const hammingDistance = "length(replace(((phash # ?)::bit(64))::text, '0', ''))"
+
+// FindSimilarMedia - returns owners of photos with hash not further than maxDistance from the given one, the closest first.
+// Every owner is returned once with the distance of its closest photo. Hashes are compared in a full scan of media of the owner type.
+// Posts which are not published are skipped before the limit is applied, so hidden look-alikes don't take places of visible ones.
+func (s *store) FindSimilarMedia(
+	ctx context.Context,
+	ownerType core.MediaOwnerType,
+	hash int64,
+	maxDistance, limit int,
+) (matches []core.MediaMatch, err error) {
+	distances := s.DB.WithContext(ctx).
+		Model(&core.Media{}).
+		Select("owner_id, "+hammingDistance+" AS distance", hash).
+		Where("owner_type = ? AND phash IS NOT NULL", ownerType)
+	if ownerType == core.MediaOwnerPost {
+		distances = distances.Where(
+			"EXISTS (SELECT 1 FROM posts WHERE posts.id = media.owner_id AND posts.status = ?)", core.Published,
+		)
+	}
+
+	err = s.DB.WithContext(ctx).
+		Table("(?) AS distances", distances).
+		Select("owner_id, MIN(distance) AS distance").
+		Where("distance <= ?", maxDistance).
+		Group("owner_id").
+		Order("distance ASC, owner_id DESC").
+		Limit(limit).
+		Find(&matches).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// GetMediaWithoutHash - returns uploaded media without perceptual hash ordered by ID, media are returned after the given ID.
+// Legacy photos are skipped, their hash is set when they are moved to BlobStore.
+func (s *store) GetMediaWithoutHash(ctx context.Context, afterID, limit int) (media []core.Media, err error) {
+	err = s.DB.WithContext(ctx).
+		Where("phash IS NULL AND id > ?", afterID).
+		Where("NOT EXISTS (SELECT 1 FROM media_legacy_blobs WHERE media_legacy_blobs.media_id = media.id)").
+		Order("id ASC").
+		Limit(limit).
+		Find(&media).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// SetMediaHash - saves perceptual hash of the photo.
+func (s *store) SetMediaHash(ctx context.Context, id int, hash int64) error {
+	err := s.DB.WithContext(ctx).Model(&core.Media{}).Where("id = ?", id).Update("phash", hash).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package mention
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+type store struct {
+	*postgres.Postgres
+}
+
+func New(pg *postgres.Postgres) core.MentionStore {
+	return &store{pg}
+}
+
+// SaveCommentMentions replaces mentions of the comment in a transaction, so the edited comment never has mixed mentions
+func (s *store) SaveCommentMentions(ctx context.Context, commentID int, mentions []core.CommentMention) error {
+	now := time.Now().UTC()
+	for i := range mentions {
+		mentions[i].ID = 0
+		mentions[i].CommentID = commentID
+		mentions[i].CreatedAt = now
+	}
+
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("comment_id = ?", commentID).Delete(&core.CommentMention{}).Error; err != nil {
+			return err
+		}
+
+		if len(mentions) == 0 {
+			return nil
+		}
+
+		return tx.Create(&mentions).Error
+	})
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetCommentsMentions fetches mentions of the comments at once together with current usernames of mentioned users
+func (s *store) GetCommentsMentions(ctx context.Context, commentIDs []int) (map[int][]core.CommentMention, error) {
+	result := make(map[int][]core.CommentMention)
+	if len(commentIDs) == 0 {
+		return result, nil
+	}
+
+	var mentions []core.CommentMention
+	err := s.DB.WithContext(ctx).
+		Model(&core.CommentMention{}).
+		Select("comment_mentions.*, users.username").
+		Joins("JOIN users ON users.id = comment_mentions.user_id").
+		Where("comment_mentions.comment_id IN ?", commentIDs).
+		Order(`comment_mentions.comment_id, comment_mentions."offset"`).
+		Find(&mentions).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	for _, mention := range mentions {
+		result[mention.CommentID] = append(result[mention.CommentID], mention)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+type store struct {
+	*postgres.Postgres
+}
+
+func New(pg *postgres.Postgres) core.MessageStore {
+	return &store{pg}
+}
+
+// GetMessageByID - returns message by its id.
+func (s *store) GetMessageByID(ctx context.Context, id int) (message core.Message, err error) {
+	err = s.DB.WithContext(ctx).
+		Where("id = ?", id).
+		First(&message).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Log().Debug(ctx, err.Error())
+			return core.Message{}, core.ErrNoSuchMessage
+		}
+		logger.Log().Error(ctx, err.Error())
+
+		return core.Message{}, err
+	}
+
+	return message, nil
+}
+
+// IsChatMember - checks whether user is an active member of the chat.
+func (s *store) IsChatMember(ctx context.Context, chatID, userID int) (bool, error) {
+	var count int64
+
+	if err := s.DB.WithContext(ctx).
+		Table("chat_members").
+		Where("chat_id = ? AND user_id = ? AND is_deleted = ?", chatID, userID, false).
+		Count(&count).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// DeleteMessage - marks message as deleted.
+func (s *store) DeleteMessage(ctx context.Context, id int) error {
+	now := time.Now().UTC()
+
+	result := s.DB.WithContext(ctx).
+		Model(&core.Message{}).
+		Where("id = ? AND is_deleted = ?", id, false).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		logger.Log().Error(ctx, result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return core.ErrNoSuchMessage
+	}
+
+	return nil
+}
@@ -52,3 +52,47 @@ func (s *store) CreateModerator(ctx context.Context, moderator core.Moderator) (
 
 	return nil
 }
+
+// CreateDecision saves decision of the moderator.
+func (s *store) CreateDecision(ctx context.Context, decision core.ModerationDecision) (err error) {
+	decision.CreatedAt = time.Now().UTC()
+
+	if err = s.DB.WithContext(ctx).Create(&decision).Error; err != nil {
+		logger.Log().Error(ctx, "Failed to create moderation decision: "+err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetUserDecisions returns decisions of moderators against the user and their content, newest first.
+func (s *store) GetUserDecisions(ctx context.Context, userID int) (decisions []core.ModerationDecision, err error) {
+	err = s.DB.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&decisions).Error
+	if err != nil {
+		logger.Log().Error(ctx, "Failed to get moderation decisions: "+err.Error())
+		return nil, err
+	}
+
+	return decisions, nil
+}
+
+// GetTargetDecision returns the latest decision of moderators on the entity.
+func (s *store) GetTargetDecision(ctx context.Context, targetType string, targetID int) (decision core.ModerationDecision, err error) {
+	err = s.DB.WithContext(ctx).
+		Where("target_type = ? AND target_id = ?", targetType, targetID).
+		Order("created_at DESC").
+		First(&decision).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return core.ModerationDecision{}, core.ErrNoModerationDecision
+		}
+		logger.Log().Error(ctx, "Failed to get moderation decision: "+err.Error())
+
+		return core.ModerationDecision{}, err
+	}
+
+	return decision, nil
+}
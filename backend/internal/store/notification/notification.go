@@ -0,0 +1,96 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+type store struct {
+	*postgres.Postgres
+}
+
+func New(pg *postgres.Postgres) core.NotificationStore {
+	return &store{pg}
+}
+
+// CreateNotifications - inserts notifications at once.
+func (s *store) CreateNotifications(ctx context.Context, notifications []core.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	for i := range notifications {
+		notifications[i].CreatedAt = now
+	}
+
+	if err := s.DB.WithContext(ctx).Create(&notifications).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetNotifications - retrieves notifications of the user from the newest one.
+func (s *store) GetNotifications(ctx context.Context, params core.GetNotificationsParams) ([]core.Notification, int, int, error) {
+	var notifications []core.Notification
+
+	query := s.DB.WithContext(ctx).Model(&core.Notification{}).Where("user_id = ?", params.UserID)
+
+	var counts struct {
+		Total  int
+		Unread int
+	}
+	if err := query.
+		Select("COUNT(*) AS total, COUNT(*) FILTER (WHERE read_at IS NULL) AS unread").
+		Scan(&counts).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, 0, err
+	}
+
+	query = s.DB.WithContext(ctx).Where("user_id = ?", params.UserID)
+	total := counts.Total
+	if params.Unread {
+		query = query.Where("read_at IS NULL")
+		total = counts.Unread
+	}
+
+	query = query.Order("created_at DESC, id DESC")
+
+	if params.Limit != nil {
+		query = query.Limit(*params.Limit)
+	}
+	if params.Offset != nil {
+		query = query.Offset(*params.Offset)
+	}
+
+	if err := query.Find(&notifications).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, 0, err
+	}
+
+	return notifications, total, counts.Unread, nil
+}
+
+// MarkNotificationsRead - sets read time of unread notifications of the user.
+func (s *store) MarkNotificationsRead(ctx context.Context, userID int, ids []int) error {
+	query := s.DB.WithContext(ctx).
+		Model(&core.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID)
+
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+
+	if err := query.Update("read_at", time.Now().UTC()).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
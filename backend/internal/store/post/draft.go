@@ -0,0 +1,88 @@
+package poststore
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// GetDrafts retrieves drafts of the author based on the GetAllPostsParams
+func (s *store) GetDrafts(ctx context.Context, authorID int, params core.GetAllPostsParams) ([]core.Post, int, error) {
+	query := s.DB.WithContext(ctx).Model(&core.Post{}).
+		Where("posts.author_id = ? AND posts.status = ?", authorID, core.Draft)
+
+	return ListPosts(ctx, query, params)
+}
+
+// SchedulePost sets time the draft is published at, nil cancels the schedule
+func (s *store) SchedulePost(ctx context.Context, id int, publishAt *time.Time) (core.Post, error) {
+	var posts []core.Post
+
+	result := s.DB.WithContext(ctx).
+		Model(&posts).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND status = ?", id, core.Draft).
+		Updates(map[string]interface{}{
+			"publish_at": publishAt,
+			"updated_at": time.Now().UTC(),
+		})
+	if result.Error != nil {
+		logger.Log().Error(ctx, result.Error.Error())
+		return core.Post{}, result.Error
+	}
+
+	if len(posts) == 0 {
+		return core.Post{}, core.ErrPostNotDraft
+	}
+
+	return posts[0], nil
+}
+
+// PublishPost changes status of the draft to the status of the post and moves it to the top of the newest posts,
+// the draft is published at most once even if the author and the worker publish it at the same time
+func (s *store) PublishPost(ctx context.Context, post core.Post) (core.Post, error) {
+	var posts []core.Post
+	now := time.Now().UTC()
+
+	result := s.DB.WithContext(ctx).
+		Model(&posts).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND status = ?", post.ID, core.Draft).
+		Updates(map[string]interface{}{
+			"status":            post.Status,
+			"moderation_reason": post.ModerationReason,
+			"publish_at":        nil,
+			"bumped_at":         now,
+			"updated_at":        now,
+		})
+	if result.Error != nil {
+		logger.Log().Error(ctx, result.Error.Error())
+		return core.Post{}, result.Error
+	}
+
+	if len(posts) == 0 {
+		return core.Post{}, core.ErrPostNotDraft
+	}
+
+	return posts[0], nil
+}
+
+// GetScheduledPosts retrieves drafts scheduled to be published before the moment, the earliest go first
+func (s *store) GetScheduledPosts(ctx context.Context, before time.Time) ([]core.Post, error) {
+	var posts []core.Post
+
+	err := s.DB.WithContext(ctx).
+		Where("status = ? AND publish_at <= ?", core.Draft, before).
+		Order("publish_at, id").
+		Find(&posts).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,114 @@
+package poststore
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// BumpPost moves the active post to the top of the newest posts if it wasn't bumped after bumpedBefore,
+// the check and the bump are done at once, so concurrent bumps can't both succeed
+func (s *store) BumpPost(ctx context.Context, id int, bumpedBefore time.Time) (core.Post, error) {
+	var posts []core.Post
+
+	result := s.DB.WithContext(ctx).
+		Model(&posts).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND status = ? AND state = ? AND bumped_at < ?", id, core.Published, core.PostActive, bumpedBefore).
+		Updates(map[string]interface{}{
+			"bumped_at":          time.Now().UTC(),
+			"expiry_reminded_at": nil,
+		})
+	if result.Error != nil {
+		logger.Log().Error(ctx, result.Error.Error())
+		return core.Post{}, result.Error
+	}
+
+	if len(posts) == 0 {
+		return core.Post{}, core.ErrPostBumpedRecently
+	}
+
+	return posts[0], nil
+}
+
+// RemindExpiringPosts marks active posts bumped before bumpedBefore as reminded and returns them,
+// posts are reminded once after every bump
+func (s *store) RemindExpiringPosts(ctx context.Context, bumpedBefore time.Time) ([]core.Post, error) {
+	var posts []core.Post
+
+	err := s.DB.WithContext(ctx).
+		Model(&posts).
+		Clauses(clause.Returning{}).
+		Where("status = ? AND state = ? AND bumped_at < ?", core.Published, core.PostActive, bumpedBefore).
+		Where("(expiry_reminded_at IS NULL OR expiry_reminded_at < bumped_at)").
+		Update("expiry_reminded_at", time.Now().UTC()).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+// ArchiveExpiredPosts archives active posts bumped before bumpedBefore whose authors were reminded before remindedBefore,
+// so the author always has time to renew the post even if the worker didn't run for a long time
+func (s *store) ArchiveExpiredPosts(ctx context.Context, bumpedBefore, remindedBefore time.Time) ([]core.Post, error) {
+	var posts []core.Post
+
+	err := s.DB.WithContext(ctx).
+		Model(&posts).
+		Clauses(clause.Returning{}).
+		Where("status = ? AND state = ? AND bumped_at < ?", core.Published, core.PostActive, bumpedBefore).
+		Where("expiry_reminded_at >= bumped_at AND expiry_reminded_at < ?", remindedBefore).
+		Updates(map[string]interface{}{
+			"state":       core.PostArchived,
+			"archived_at": time.Now().UTC(),
+		}).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+// GetPostStatistics counts published posts by their states and resolutions
+func (s *store) GetPostStatistics(ctx context.Context, resolvedSince time.Time) (core.PostStatistics, error) {
+	var rows []struct {
+		State      core.PostState
+		Resolution *core.PostResolution
+		Total      int
+		Recent     int
+	}
+
+	err := s.DB.WithContext(ctx).
+		Model(&core.Post{}).
+		Select("state, resolution, COUNT(*) AS total, COUNT(*) FILTER (WHERE resolved_at >= ?) AS recent", resolvedSince).
+		Where("status = ? AND state IN ?", core.Published, []core.PostState{core.PostActive, core.PostResolved}).
+		Group("state, resolution").
+		Scan(&rows).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.PostStatistics{}, err
+	}
+
+	statistics := core.PostStatistics{Resolutions: make(map[core.PostResolution]int)}
+	for _, row := range rows {
+		if row.State == core.PostActive {
+			statistics.ActivePosts += row.Total
+			continue
+		}
+
+		statistics.ResolvedPosts += row.Total
+		statistics.ResolvedLastMonth += row.Recent
+		if row.Resolution != nil {
+			statistics.Resolutions[*row.Resolution] += row.Total
+		}
+	}
+
+	return statistics, nil
+}
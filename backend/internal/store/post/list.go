@@ -0,0 +1,196 @@
+package poststore
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// favouritesCount - amount of users favourited the post.
+const favouritesCount = "(SELECT COUNT(*) FROM favourite_posts WHERE favourite_posts.post_id = posts.id)"
+
+// Distance - great-circle distance in kilometers between the post and the point @lat, @lon.
+const Distance = "6371 * 2 * asin(least(1, sqrt(" +
+	"power(sin(radians(posts.latitude - @lat) / 2), 2) + " +
+	"cos(radians(@lat)) * cos(radians(posts.latitude)) * power(sin(radians(posts.longitude - @lon) / 2), 2))))"
+
+// likeEscaper escapes wildcards of LIKE patterns, so text of the user is matched literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// ListPosts applies filters, sort and pagination of the params to the query of posts and runs it.
+// Total is counted before pagination, so it is the amount of all posts matching the filters.
+// The query may be narrowed by the caller, e.g. to posts of the user, animals of the posts are joined here.
+func ListPosts(ctx context.Context, query *gorm.DB, params core.GetAllPostsParams) (posts []core.Post, total int, err error) {
+	sort := params.SortOrDefault()
+	if err := checkCursor(sort, params.Cursor); err != nil {
+		return nil, 0, err
+	}
+
+	args := map[string]interface{}{}
+
+	query = query.Joins("JOIN animals ON posts.animal_id = animals.id")
+
+	if params.AnimalID != nil {
+		query = query.Where("posts.animal_id = ?", *params.AnimalID)
+	}
+
+	if params.State != nil {
+		query = query.Where("posts.state = ?", *params.State)
+	}
+
+	if len(params.ExcludeAuthorIDs) > 0 {
+		query = query.Where("posts.author_id NOT IN ?", params.ExcludeAuthorIDs)
+	}
+
+	if params.Resolution != nil {
+		query = query.Where("posts.resolution = ?", *params.Resolution)
+	}
+
+	if params.Status != nil {
+		query = query.Where("animals.status = ?", *params.Status)
+	}
+
+	if params.AnimalType != nil {
+		query = query.Where("animals.animal_type = ?", *params.AnimalType)
+	}
+
+	if params.Gender != nil {
+		query = query.Where("animals.gender = ?", *params.Gender)
+	}
+
+	if params.Color != nil {
+		query = query.Where("LOWER(animals.color) = LOWER(?)", *params.Color)
+	}
+
+	if params.Breed != nil {
+		query = query.Where("animals.breed ILIKE ?", "%"+likeEscaper.Replace(*params.Breed)+"%")
+	}
+
+	if params.Size != nil {
+		query = query.Where("animals.size = ?", *params.Size)
+	}
+
+	if len(params.Colors) > 0 {
+		query = query.Where("animals.colors && ?", pq.StringArray(params.Colors))
+	}
+
+	if params.CoatPattern != nil {
+		query = query.Where("animals.coat_pattern = ?", *params.CoatPattern)
+	}
+
+	if params.Sterilized != nil {
+		query = query.Where("animals.is_sterilized = ?", *params.Sterilized)
+	}
+
+	if params.Identifier != nil {
+		id := core.NormalizeIdentifier(*params.Identifier)
+		query = query.Where("(animals.microchip_number = ? OR animals.tattoo_id = ?)", id, id)
+	}
+
+	if params.HasQuery() {
+		args["q"] = strings.TrimSpace(*params.Query)
+		query = search(query, *params.Query)
+	}
+
+	if sort == core.PostSortNearest {
+		if params.Latitude == nil || params.Longitude == nil {
+			return nil, 0, core.ErrLocationRequired
+		}
+		args["lat"], args["lon"] = *params.Latitude, *params.Longitude
+		query = query.Where("posts.latitude IS NOT NULL AND posts.longitude IS NOT NULL")
+	}
+
+	var total64 int64
+	if err := query.Count(&total64).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	query = sortPosts(query, sort, params.Cursor, args)
+
+	if params.Limit != nil {
+		query = query.Limit(*params.Limit)
+	}
+
+	if params.Offset != nil && params.Cursor == nil {
+		query = query.Offset(*params.Offset)
+	}
+
+	if err := query.Find(&posts).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	return posts, int(total64), nil
+}
+
+// sortPosts selects the key of the sort, orders posts by it and skips posts up to the cursor.
+// ID of the post is the last key of every sort, so the order is stable for posts with equal keys.
+func sortPosts(query *gorm.DB, sort core.PostSort, cursor *core.Cursor, args map[string]interface{}) *gorm.DB {
+	switch sort {
+	case core.PostSortOldest:
+		if cursor != nil {
+			query = query.Where("(posts.created_at, posts.id) > (?, ?)", *cursor.Time, cursor.ID)
+		}
+		return query.Select("posts.*").Order("posts.created_at, posts.id")
+	case core.PostSortUpdated:
+		if cursor != nil {
+			query = query.Where("(posts.updated_at, posts.id) < (?, ?)", *cursor.Time, cursor.ID)
+		}
+		return query.Select("posts.*").Order("posts.updated_at DESC, posts.id DESC")
+	case core.PostSortFavourited:
+		if cursor != nil {
+			query = query.Where("("+favouritesCount+", posts.id) < (?, ?)", *cursor.Number, cursor.ID)
+		}
+		return query.Select("posts.*, " + favouritesCount + " AS favourites_count").Order("favourites_count DESC, posts.id DESC")
+	case core.PostSortNearest:
+		if cursor != nil {
+			query = query.Where("("+Distance+", posts.id) > (@distance, @id)", map[string]interface{}{
+				"lat": args["lat"], "lon": args["lon"], "distance": *cursor.Number, "id": cursor.ID,
+			})
+		}
+		return query.Select("posts.*, "+Distance+" AS distance", args).Order("distance, posts.id")
+	case core.PostSortRelevance:
+		return query.
+			Select("posts.*, "+searchHighlight+" AS highlight", args).
+			Order(clause.OrderBy{Expression: clause.NamedExpr{SQL: searchRank + " DESC, posts.id DESC", Vars: []interface{}{args}}})
+	default:
+		if cursor != nil {
+			query = query.Where("(posts.bumped_at, posts.id) < (?, ?)", *cursor.Time, cursor.ID)
+		}
+		return query.Select("posts.*").Order("posts.bumped_at DESC, posts.id DESC")
+	}
+}
+
+// checkCursor - cursor is valid only for the sort it was issued for and must contain the key of the sort.
+func checkCursor(sort core.PostSort, cursor *core.Cursor) error {
+	if cursor == nil {
+		return nil
+	}
+
+	if cursor.Sort != string(sort) {
+		return core.ErrInvalidCursor
+	}
+
+	switch sort {
+	case core.PostSortNewest, core.PostSortOldest, core.PostSortUpdated:
+		if cursor.Time == nil {
+			return core.ErrInvalidCursor
+		}
+	case core.PostSortFavourited, core.PostSortNearest:
+		if cursor.Number == nil {
+			return core.ErrInvalidCursor
+		}
+	default:
+		// relevance is computed for every query anew, so it has no stable key
+		return core.ErrInvalidCursor
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package poststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/internal/store/storetest"
+)
+
+// listPosts runs ListPosts in dry run mode and returns the statement counting posts and the statement selecting them.
+func listPosts(t *testing.T, params core.GetAllPostsParams) (count, list string, err error) {
+	t.Helper()
+
+	pg, recorder := storetest.DryRun(t)
+	_, _, err = ListPosts(context.Background(), pg.DB.Model(&core.Post{}), params)
+	if err != nil {
+		return "", "", err
+	}
+
+	require.Len(t, recorder.Statements, 2)
+	return recorder.Statements[0], recorder.Statements[1], nil
+}
+
+func TestListPosts_AnimalFilters(t *testing.T) {
+	t.Parallel()
+
+	ptr := func(s string) *string { return &s }
+	sterilized := false
+
+	tests := []struct {
+		name      string
+		params    core.GetAllPostsParams
+		wantWhere string
+	}{
+		{
+			name:      "breed matches part of the breed",
+			params:    core.GetAllPostsParams{Breed: ptr("Maine coon")},
+			wantWhere: `WHERE animals.breed ILIKE '%Maine coon%'`,
+		},
+		{
+			name:      "wildcards of breed are matched literally",
+			params:    core.GetAllPostsParams{Breed: ptr(`50%_mix\`)},
+			wantWhere: `WHERE animals.breed ILIKE '%50\%\_mix\\%'`,
+		},
+		{
+			name:      "size",
+			params:    core.GetAllPostsParams{Size: ptr("large")},
+			wantWhere: `WHERE animals.size = 'large'`,
+		},
+		{
+			name:      "any of colors",
+			params:    core.GetAllPostsParams{Colors: []string{"black", "white"}},
+			wantWhere: `WHERE animals.colors && '{"black","white"}'`,
+		},
+		{
+			name:      "coat pattern",
+			params:    core.GetAllPostsParams{CoatPattern: ptr("tabby")},
+			wantWhere: `WHERE animals.coat_pattern = 'tabby'`,
+		},
+		{
+			name:      "not sterilized",
+			params:    core.GetAllPostsParams{Sterilized: &sterilized},
+			wantWhere: `WHERE animals.is_sterilized = false`,
+		},
+		{
+			name:      "identifier is normalized",
+			params:    core.GetAllPostsParams{Identifier: ptr(" 643-094 100/ab.12 ")},
+			wantWhere: `WHERE (animals.microchip_number = '643094100AB12' OR animals.tattoo_id = '643094100AB12')`,
+		},
+		{
+			name: "filters are combined",
+			params: core.GetAllPostsParams{
+				Size:        ptr("small"),
+				Colors:      []string{"ginger"},
+				CoatPattern: ptr("solid"),
+			},
+			wantWhere: `WHERE animals.size = 'small' AND animals.colors && '{"ginger"}' AND animals.coat_pattern = 'solid'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			count, list, err := listPosts(t, tt.params)
+			require.NoError(t, err)
+
+			// total is counted with the same filters as the page
+			assert.Contains(t, count, "JOIN animals ON posts.animal_id = animals.id "+tt.wantWhere)
+			assert.Contains(t, list, "JOIN animals ON posts.animal_id = animals.id "+tt.wantWhere+" ORDER BY")
+		})
+	}
+}
+
+func TestListPosts_EmptyColors(t *testing.T) {
+	t.Parallel()
+
+	count, _, err := listPosts(t, core.GetAllPostsParams{Colors: []string{}})
+	require.NoError(t, err)
+	assert.NotContains(t, count, "colors")
+}
+
+// Every cursor compares the key of the sort together with ID of the post in the direction of the order,
+// so posts with the same key as the last post of the page are neither skipped nor repeated.
+func TestListPosts_Cursor(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	number := 3.0
+	lat, lon := 55.75, 37.61
+
+	tests := []struct {
+		sort      core.PostSort
+		wantWhere string
+		wantOrder string
+	}{
+		{
+			sort:      core.PostSortNewest,
+			wantWhere: "WHERE (posts.bumped_at, posts.id) < ('2024-05-01 10:00:00', 7)",
+			wantOrder: "ORDER BY posts.bumped_at DESC, posts.id DESC",
+		},
+		{
+			sort:      core.PostSortOldest,
+			wantWhere: "WHERE (posts.created_at, posts.id) > ('2024-05-01 10:00:00', 7)",
+			wantOrder: "ORDER BY posts.created_at, posts.id",
+		},
+		{
+			sort:      core.PostSortUpdated,
+			wantWhere: "WHERE (posts.updated_at, posts.id) < ('2024-05-01 10:00:00', 7)",
+			wantOrder: "ORDER BY posts.updated_at DESC, posts.id DESC",
+		},
+		{
+			sort:      core.PostSortFavourited,
+			wantWhere: "WHERE (" + favouritesCount + ", posts.id) < (3, 7)",
+			wantOrder: "ORDER BY favourites_count DESC, posts.id DESC",
+		},
+		{
+			sort:      core.PostSortNearest,
+			wantWhere: "posts.id) > (3, 7)",
+			wantOrder: "ORDER BY distance, posts.id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			t.Parallel()
+
+			// the last post of the previous page shares the key with the posts of the next page
+			cursor := tt.sort.Cursor(core.Post{ID: 7, BumpedAt: at, CreatedAt: at, UpdatedAt: at, FavouritesCount: 3, Distance: &number})
+			count, list, err := listPosts(t, core.GetAllPostsParams{Sort: tt.sort, Cursor: &cursor, Latitude: &lat, Longitude: &lon})
+			require.NoError(t, err)
+
+			assert.Contains(t, list, tt.wantWhere+" "+tt.wantOrder)
+			// total counts all posts, not the rest of them
+			assert.NotContains(t, count, tt.wantWhere)
+		})
+	}
+}
+
+func TestListPosts_InvalidCursor(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	post := core.Post{ID: 7, BumpedAt: at, CreatedAt: at, UpdatedAt: at}
+	query := "cat"
+	sorts := []core.PostSort{
+		core.PostSortNewest, core.PostSortOldest, core.PostSortUpdated, core.PostSortFavourited, core.PostSortNearest,
+	}
+
+	// cursor is accepted only by the sort it was issued for, even if it contains the key of the other sort
+	for _, issuedFor := range sorts {
+		for _, sort := range append(sorts, core.PostSortRelevance) {
+			if sort == issuedFor {
+				continue
+			}
+
+			cursor := issuedFor.Cursor(post)
+			_, _, err := listPosts(t, core.GetAllPostsParams{Sort: sort, Cursor: &cursor, Query: &query})
+			assert.ErrorIs(t, err, core.ErrInvalidCursor, "cursor of %s used for %s", issuedFor, sort)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		sort   core.PostSort
+		cursor core.Cursor
+	}{
+		{name: "newest without time", sort: core.PostSortNewest, cursor: core.Cursor{Sort: "newest", ID: 7}},
+		{name: "favourited without amount", sort: core.PostSortFavourited, cursor: core.Cursor{Sort: "favourited", Time: &at, ID: 7}},
+		{name: "nearest without distance", sort: core.PostSortNearest, cursor: core.Cursor{Sort: "nearest", ID: 7}},
+		{name: "relevance", sort: core.PostSortRelevance, cursor: core.Cursor{Sort: "relevance", ID: 7}},
+	}
+
+	for _, tt := range tests {
+		cursor := tt.cursor
+		_, _, err := listPosts(t, core.GetAllPostsParams{Sort: tt.sort, Cursor: &cursor, Query: &query})
+		assert.ErrorIs(t, err, core.ErrInvalidCursor, tt.name)
+	}
+}
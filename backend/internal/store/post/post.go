@@ -20,76 +20,49 @@ func New(pg *postgres.Postgres) core.PostStore {
 	return &store{pg}
 }
 
-// GetAllPosts retrieves all posts from the database based on the GetAllPostsParams
+// GetAllPosts retrieves published posts from the database based on the GetAllPostsParams, active posts are retrieved by default
 func (s *store) GetAllPosts(ctx context.Context, params core.GetAllPostsParams) ([]core.Post, int, error) {
-	var posts []core.Post
-
 	query := s.DB.WithContext(ctx).Model(&core.Post{}).
-		Joins("JOIN animals ON posts.animal_id = animals.id").
 		Where("posts.status = ?", core.Published)
 
-	// Apply filtering based on the GetAllPostsParams
-	if params.Limit != nil {
-		query = query.Limit(*params.Limit)
-	}
-
-	if params.Offset != nil {
-		query = query.Offset(*params.Offset)
-	}
-
-	if params.Status != nil {
-		query = query.Where("animals.status = ?", *params.Status)
-	}
-
-	if params.AnimalType != nil {
-		query = query.Where("animals.animal_type = ?", *params.AnimalType)
+	if params.State == nil {
+		state := core.PostActive
+		params.State = &state
 	}
 
-	if params.Gender != nil {
-		query = query.Where("animals.gender = ?", *params.Gender)
-	}
+	return ListPosts(ctx, query, params)
+}
 
-	if params.Color != nil {
-		query = query.Where("animals.color = ?", *params.Color)
-	}
+// GetUserPosts retrieves posts of the user with the given ID based on the GetAllPostsParams, drafts are never retrieved
+func (s *store) GetUserPosts(ctx context.Context, id int, params core.GetAllPostsParams) (posts []core.Post, count int, err error) {
+	query := s.DB.WithContext(ctx).Model(&core.Post{}).
+		Where("posts.author_id = ? AND posts.status <> ?", id, core.Draft)
 
-	var total int64
-	if err := query.Count(&total).Error; err != nil {
-		logger.Log().Error(ctx, err.Error())
-		return nil, 0, err
-	}
+	return ListPosts(ctx, query, params)
+}
 
-	if err := query.Select("posts.*").Find(&posts).Error; err != nil {
-		logger.Log().Error(ctx, err.Error())
-		return nil, 0, err
-	}
+// GetPostByID retrieves a post from the database by its ID
+func (s *store) GetPostByID(ctx context.Context, id int) (core.Post, error) {
+	var post core.Post
 
-	return posts, int(total), nil
-}
+	if err := s.DB.WithContext(ctx).Where("id = ? AND status = ?", id, core.Published).First(&post).Error; err != nil {
+		if errors.Is(err, core.ErrRecordNotFound) {
+			logger.Log().Error(ctx, core.ErrRecordNotFound.Error())
+			return core.Post{}, core.ErrPostNotFound
+		}
 
-// GetUserPosts retrieves all posts from the database based on the given user ID
-func (s *store) GetUserPosts(ctx context.Context, id int) (posts []core.Post, count int, err error) {
-	err = s.DB.WithContext(ctx).
-		Where("author_id = ?", id).
-		Order("created_at DESC").
-		Find(&posts).Error
-	if err != nil {
 		logger.Log().Error(ctx, err.Error())
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, 0, core.ErrNoSuchUser
-		}
-		return nil, 0, err
+		return core.Post{}, err
 	}
 
-	count = len(posts)
-	return posts, count, nil
+	return post, nil
 }
 
-// GetPostByID retrieves a post from the database by its ID
-func (s *store) GetPostByID(ctx context.Context, id int) (core.Post, error) {
+// GetPostByIDAnyStatus retrieves a post from the database by its ID regardless of its status
+func (s *store) GetPostByIDAnyStatus(ctx context.Context, id int) (core.Post, error) {
 	var post core.Post
 
-	if err := s.DB.WithContext(ctx).Where("id = ? AND status = ?", id, core.Published).First(&post).Error; err != nil {
+	if err := s.DB.WithContext(ctx).Where("id = ?", id).First(&post).Error; err != nil {
 		if errors.Is(err, core.ErrRecordNotFound) {
 			logger.Log().Error(ctx, core.ErrRecordNotFound.Error())
 			return core.Post{}, core.ErrPostNotFound
@@ -106,7 +79,11 @@ func (s *store) GetPostByID(ctx context.Context, id int) (core.Post, error) {
 func (s *store) CreatePost(ctx context.Context, post core.Post) (core.Post, error) {
 	post.CreatedAt = time.Now().UTC()
 	post.UpdatedAt = time.Now().UTC()
-	post.Status = core.Published
+	post.BumpedAt = post.CreatedAt
+	post.State = core.PostActive
+	if post.Status != core.OnModeration && post.Status != core.Draft {
+		post.Status = core.Published
+	}
 	var createdPost core.Post
 
 	if err := s.DB.WithContext(ctx).Create(&post).First(&createdPost, post.ID).Error; err != nil {
@@ -203,8 +180,9 @@ func (s *store) GetPostsForModeration(ctx context.Context, filter core.Filter) (
 
 func (s *store) ApprovePostFromModeration(ctx context.Context, postID int) (err error) {
 	updates := map[string]interface{}{
-		"status":     core.Published,
-		"updated_at": time.Now().UTC(),
+		"status":            core.Published,
+		"moderation_reason": nil,
+		"updated_at":        time.Now().UTC(),
 	}
 
 	result := s.DB.WithContext(ctx).Model(&core.Post{}).Where("id = ?", postID).Updates(updates)
@@ -224,3 +202,34 @@ func (s *store) ApprovePostFromModeration(ctx context.Context, postID int) (err
 
 	return nil
 }
+
+// CountUserPostsSince - returns amount of posts created by the author after the given moment.
+func (s *store) CountUserPostsSince(ctx context.Context, authorID int, since time.Time) (int, error) {
+	var count int64
+
+	if err := s.DB.WithContext(ctx).
+		Model(&core.Post{}).
+		Where("author_id = ? AND created_at > ?", authorID, since).
+		Count(&count).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// GetPostsByIDs retrieves published posts with the given IDs, posts which are missing or not published are skipped
+func (s *store) GetPostsByIDs(ctx context.Context, ids []int) ([]core.Post, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var posts []core.Post
+
+	if err := s.DB.WithContext(ctx).Where("id IN ? AND status = ?", ids, core.Published).Find(&posts).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,31 @@
+package poststore
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// searchQuery - query of the user parsed in both languages posts are indexed in, words may be combined with OR and "-".
+const searchQuery = "(websearch_to_tsquery('russian', @q) || websearch_to_tsquery('english', @q))"
+
+// searchCondition - full-text match of the post or description of its animal, trigram similarity of words finds typos.
+const searchCondition = "(posts.search_vector @@ " + searchQuery +
+	" OR animals.search_vector @@ " + searchQuery +
+	" OR @q <% posts.title OR @q <% posts.content OR @q <% animals.description)"
+
+// searchRank - relevance of the post, matches found by similarity only get the lowest rank.
+const searchRank = "ts_rank_cd(posts.search_vector, " + searchQuery + ")" +
+	" + 0.5 * ts_rank_cd(animals.search_vector, " + searchQuery + ")" +
+	" + 0.1 * GREATEST(word_similarity(@q, posts.title), word_similarity(@q, coalesce(posts.content, '')))"
+
+// searchHighlight - fragments of the content with matched words in <mark> tags.
+// HTML of the content is escaped first, so the fragments can be shown as is.
+const searchHighlight = "ts_headline('russian', " +
+	"replace(replace(replace(coalesce(posts.content, ''), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), " +
+	searchQuery + ", 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5')"
+
+// search filters posts by the query of the user.
+func search(db *gorm.DB, q string) *gorm.DB {
+	return db.Where(searchCondition, map[string]interface{}{"q": strings.TrimSpace(q)})
+}
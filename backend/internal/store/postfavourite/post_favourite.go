@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/kotopesp/sos-kotopes/internal/core"
+	poststore "github.com/kotopesp/sos-kotopes/internal/store/post"
 	"github.com/kotopesp/sos-kotopes/pkg/logger"
 	"github.com/kotopesp/sos-kotopes/pkg/postgres"
 )
@@ -20,36 +21,12 @@ func New(pg *postgres.Postgres) core.PostFavouriteStore {
 }
 
 // GetFavouritePosts retrieves the favourite posts of a user from the database based on the GetAllPostsParams
-// TODO: add GetAllPostsParams
-func (s *store) GetFavouritePosts(ctx context.Context, userID, limit, offset int) ([]core.Post, int, error) {
-	var posts []core.Post
-
+func (s *store) GetFavouritePosts(ctx context.Context, userID int, params core.GetAllPostsParams) ([]core.Post, int, error) {
 	query := s.DB.WithContext(ctx).Model(&core.Post{}).
 		Joins("JOIN favourite_posts ON posts.id = favourite_posts.post_id").
-		Where("favourite_posts.user_id = ?", userID).
-		Offset(offset).
-		Limit(limit)
-
-	var total int64
-	if err := query.Count(&total).Error; err != nil {
-		if errors.Is(err, core.ErrRecordNotFound) {
-			logger.Log().Error(ctx, core.ErrRecordNotFound.Error())
-			return nil, 0, core.ErrPostNotFound
-		}
-		logger.Log().Error(ctx, err.Error())
-		return nil, 0, err
-	}
-
-	if err := query.Select("posts.*").Find(&posts).Error; err != nil {
-		if errors.Is(err, core.ErrRecordNotFound) {
-			logger.Log().Error(ctx, core.ErrRecordNotFound.Error())
-			return nil, 0, core.ErrPostNotFound
-		}
-		logger.Log().Error(ctx, err.Error())
-		return nil, 0, err
-	}
+		Where("favourite_posts.user_id = ?", userID)
 
-	return posts, int(total), nil
+	return poststore.ListPosts(ctx, query, params)
 }
 
 // AddToFavourites adds a post to the user's favourites in the database
@@ -0,0 +1,195 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+type store struct {
+	*postgres.Postgres
+}
+
+func New(pg *postgres.Postgres) core.ProfileStore {
+	return &store{pg}
+}
+
+// resolvedStages - stages of animals whose cases ended well.
+var resolvedStages = []string{"adopted", "returned_to_owner"}
+
+// GetUserStatistics counts published posts of the user by state, resolved cases, published comments and ratings
+func (s *store) GetUserStatistics(ctx context.Context, userID int) (core.UserStatistics, error) {
+	statistics := core.UserStatistics{
+		Posts: map[core.PostState]int{core.PostActive: 0, core.PostResolved: 0, core.PostArchived: 0},
+	}
+
+	var posts []struct {
+		State core.PostState `gorm:"column:state"`
+		Count int            `gorm:"column:count"`
+	}
+	if err := s.DB.WithContext(ctx).
+		Table("posts").
+		Select("state, COUNT(*) AS count").
+		Where("author_id = ? AND status = ?", userID, core.Published).
+		Group("state").
+		Scan(&posts).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.UserStatistics{}, err
+	}
+	for _, p := range posts {
+		statistics.Posts[p.State] = p.Count
+	}
+
+	var resolvedCases int64
+	if err := s.DB.WithContext(ctx).
+		Table("animals").
+		Where("animals.stage IN ?", resolvedStages).
+		Where("(animals.author_id = ? OR animals.keeper_id IN (SELECT id FROM keepers WHERE keepers.user_id = ?))", userID, userID).
+		Count(&resolvedCases).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.UserStatistics{}, err
+	}
+	statistics.ResolvedCases = int(resolvedCases)
+
+	var comments int64
+	if err := s.DB.WithContext(ctx).
+		Model(&core.Comment{}).
+		Where("author_id = ? AND status = ?", userID, core.Published).
+		Count(&comments).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.UserStatistics{}, err
+	}
+	statistics.Comments = int(comments)
+
+	var err error
+	if statistics.KeeperRating, err = s.getRating(ctx, core.KeeperReview, userID); err != nil {
+		return core.UserStatistics{}, err
+	}
+	if statistics.VetRating, err = s.getRating(ctx, core.VetReview, userID); err != nil {
+		return core.UserStatistics{}, err
+	}
+
+	return statistics, nil
+}
+
+// getRating averages grades of reviews of the type about the user, nil is returned if there are no reviews
+func (s *store) getRating(ctx context.Context, reviewType core.ReviewType, userID int) (*core.Rating, error) {
+	reviews := reviewType.TableName()
+	roles := string(reviewType) + "s" // reviews refer to the role of the user, e.g. keeper_reviews.keeper_id to keepers
+
+	var rating core.Rating
+	if err := s.DB.WithContext(ctx).
+		Table(reviews).
+		Select("COUNT(*) AS count, COALESCE(CAST(AVG("+reviews+".grade) AS DOUBLE PRECISION), 0) AS average").
+		Joins("JOIN "+roles+" ON "+roles+".id = "+reviews+"."+string(reviewType)+"_id").
+		Where(roles+".user_id = ? AND "+reviews+".is_deleted IS NOT TRUE", userID).
+		Scan(&rating).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if rating.Count == 0 {
+		return nil, nil
+	}
+
+	return &rating, nil
+}
+
+// GetUserBadges retrieves what the user is verified as
+func (s *store) GetUserBadges(ctx context.Context, userID int) ([]core.Badge, error) {
+	var flags struct {
+		Moderator  bool `gorm:"column:moderator"`
+		VKVerified bool `gorm:"column:vk_verified"`
+	}
+	if err := s.DB.WithContext(ctx).
+		Raw("SELECT EXISTS (SELECT 1 FROM moderators WHERE user_id = @user_id) AS moderator, "+
+			"EXISTS (SELECT 1 FROM external_users WHERE user_id = @user_id AND auth_provider = 'vk') AS vk_verified",
+			map[string]interface{}{"user_id": userID}).
+		Scan(&flags).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	badges := make([]core.Badge, 0, 2)
+	if flags.Moderator {
+		badges = append(badges, core.BadgeModerator)
+	}
+	if flags.VKVerified {
+		badges = append(badges, core.BadgeVKVerified)
+	}
+
+	return badges, nil
+}
+
+// GetPrivacySettings retrieves privacy settings of the user, default settings are returned if the user didn't save them
+func (s *store) GetPrivacySettings(ctx context.Context, userID int) (core.PrivacySettings, error) {
+	var settings core.PrivacySettings
+	err := s.DB.WithContext(ctx).Where("user_id = ?", userID).First(&settings).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return core.DefaultPrivacySettings(userID), nil
+	}
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.PrivacySettings{}, err
+	}
+
+	return settings, nil
+}
+
+// SavePrivacySettings creates or replaces privacy settings of the user
+func (s *store) SavePrivacySettings(ctx context.Context, settings core.PrivacySettings) (core.PrivacySettings, error) {
+	settings.UpdatedAt = time.Now().UTC()
+
+	if err := s.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "user_id"}}, UpdateAll: true}).
+		Create(&settings).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.PrivacySettings{}, err
+	}
+
+	return settings, nil
+}
+
+// GetUsersPrivacySettings retrieves privacy settings of the users by their IDs, default settings are returned for users who didn't save them
+func (s *store) GetUsersPrivacySettings(ctx context.Context, userIDs []int) (map[int]core.PrivacySettings, error) {
+	var saved []core.PrivacySettings
+	if err := s.DB.WithContext(ctx).Where("user_id IN ?", userIDs).Find(&saved).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	settings := make(map[int]core.PrivacySettings, len(userIDs))
+	for _, id := range userIDs {
+		settings[id] = core.DefaultPrivacySettings(id)
+	}
+	for _, userSettings := range saved {
+		settings[userSettings.UserID] = userSettings
+	}
+
+	return settings, nil
+}
+
+// GetChatPartnerIDs retrieves IDs of the users from userIDs who are members of a chat with the user
+func (s *store) GetChatPartnerIDs(ctx context.Context, userID int, userIDs []int) ([]int, error) {
+	var partnerIDs []int
+	if err := s.DB.WithContext(ctx).
+		Table("chat_members AS own").
+		Distinct("partner.user_id").
+		Joins("JOIN chat_members AS partner ON partner.chat_id = own.chat_id AND partner.user_id <> own.user_id").
+		Joins("JOIN chats ON chats.id = own.chat_id").
+		Where("own.user_id = ? AND partner.user_id IN ?", userID, userIDs).
+		Where("own.is_deleted = false AND partner.is_deleted = false AND chats.is_deleted = false").
+		Pluck("partner.user_id", &partnerIDs).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return partnerIDs, nil
+}
@@ -2,6 +2,7 @@ package refreshsession
 
 import (
 	"context"
+	"time"
 
 	"github.com/kotopesp/sos-kotopes/internal/core"
 	"github.com/kotopesp/sos-kotopes/pkg/postgres"
@@ -55,6 +56,12 @@ func (s *store) UpdateRefreshSession(
 		return err
 	}
 
+	// sessions are created on login and refresh, so the user is seen at least once per lifetime of the access token
+	if err := tx.Model(&core.User{}).Where("id = ?", rs.UserID).Update("last_seen_at", time.Now().UTC()).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	return tx.Commit().Error
 }
 
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/kotopesp/sos-kotopes/internal/core"
 	"github.com/kotopesp/sos-kotopes/pkg/logger"
@@ -38,19 +39,30 @@ func (s *store) CreateReport(ctx context.Context, report core.Report) (err error
 	return nil
 }
 
-// GetReportsCount - returns number of reports for current post by its ID.
-func (s *store) GetReportsCount(ctx context.Context, reportableID int, reportableType string) (int, error) {
-	var reportCount int64
+// GetReportWeights - returns sum of weights of unresolved reports for reportable entity grouped by reason.
+func (s *store) GetReportWeights(ctx context.Context, reportableID int, reportableType string) (map[core.ReportReason]float64, error) {
+	var rows []struct {
+		Reason core.ReportReason
+		Weight float64
+	}
+
 	if err := s.DB.WithContext(ctx).
 		Model(&core.Report{}).
-		Where("reportable_id = ? AND reportable_type = ?", reportableID, reportableType).
-		Count(&reportCount).Error; err != nil {
+		Select("reason, SUM(weight) AS weight").
+		Where("reportable_id = ? AND reportable_type = ? AND resolved = ?", reportableID, reportableType, false).
+		Group("reason").
+		Scan(&rows).Error; err != nil {
 		logger.Log().Error(ctx, err.Error())
 
-		return 0, err
+		return nil, err
+	}
+
+	weights := make(map[core.ReportReason]float64, len(rows))
+	for _, row := range rows {
+		weights[row.Reason] = row.Weight
 	}
 
-	return int(reportCount), nil
+	return weights, nil
 }
 
 // GetReportReasons returns list of reasons why reportable entity was reported.
@@ -78,3 +90,151 @@ func (s *store) DeleteAllReports(ctx context.Context, reportableID int, reportab
 
 	return nil
 }
+
+// GetReporterReputation - returns reputation of the reporter, zero reputation is returned if user has no resolved reports yet.
+func (s *store) GetReporterReputation(ctx context.Context, userID int) (reputation core.ReporterReputation, err error) {
+	err = s.DB.WithContext(ctx).
+		Where("user_id = ?", userID).
+		First(&reputation).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return core.ReporterReputation{UserID: userID}, nil
+		}
+		logger.Log().Error(ctx, "Failed to get reporter reputation: "+err.Error())
+
+		return core.ReporterReputation{}, err
+	}
+
+	return reputation, nil
+}
+
+// ResolveReports - marks unresolved reports for reportable entity as resolved
+// and updates reputation of their authors depending on moderator decision.
+func (s *store) ResolveReports(ctx context.Context, reportableID int, reportableType string, upheld bool) (err error) {
+	upheldIncrement, rejectedIncrement := 0, 1
+	if upheld {
+		upheldIncrement, rejectedIncrement = 1, 0
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback().Error; rollbackErr != nil {
+				logger.Log().Error(ctx, "Failed to rollback transaction: "+rollbackErr.Error())
+			}
+		}
+	}()
+
+	if err = tx.Exec(`
+		INSERT INTO reporter_reputation (user_id, upheld_reports, rejected_reports, updated_at)
+		SELECT user_id, ?, ?, ?
+		FROM reports
+		WHERE reportable_id = ? AND reportable_type = ? AND resolved = FALSE
+		ON CONFLICT (user_id) DO UPDATE SET
+			upheld_reports   = reporter_reputation.upheld_reports + EXCLUDED.upheld_reports,
+			rejected_reports = reporter_reputation.rejected_reports + EXCLUDED.rejected_reports,
+			updated_at       = EXCLUDED.updated_at`,
+		upheldIncrement, rejectedIncrement, time.Now().UTC(), reportableID, reportableType,
+	).Error; err != nil {
+		logger.Log().Error(ctx, "Failed to update reporter reputation: "+err.Error())
+		return err
+	}
+
+	if err = tx.Model(&core.Report{}).
+		Where("reportable_id = ? AND reportable_type = ? AND resolved = ?", reportableID, reportableType, false).
+		Update("resolved", true).Error; err != nil {
+		logger.Log().Error(ctx, "Failed to resolve reports: "+err.Error())
+		return err
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetReports - returns unresolved reports for reportable entity ordered by creation time.
+func (s *store) GetReports(ctx context.Context, reportableID int, reportableType string) (reports []core.Report, err error) {
+	err = s.DB.WithContext(ctx).
+		Where("reportable_id = ? AND reportable_type = ? AND resolved = ?", reportableID, reportableType, false).
+		Order("created_at ASC").
+		Find(&reports).Error
+	if err != nil {
+		logger.Log().Error(ctx, "Failed to get reports: "+err.Error())
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// SendToModeration - puts entity without its own moderation status into moderation queue.
+func (s *store) SendToModeration(ctx context.Context, reportableID int, reportableType string) error {
+	target := core.ModerationTarget{
+		ReportableID:   reportableID,
+		ReportableType: reportableType,
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	if err := s.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&target).Error; err != nil {
+		logger.Log().Error(ctx, "Failed to send to moderation: "+err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// IsOnModeration - checks whether entity is in moderation queue.
+func (s *store) IsOnModeration(ctx context.Context, reportableID int, reportableType string) (bool, error) {
+	var count int64
+
+	if err := s.DB.WithContext(ctx).
+		Model(&core.ModerationTarget{}).
+		Where("reportable_id = ? AND reportable_type = ?", reportableID, reportableType).
+		Count(&count).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetTargetsForModeration - takes amount of records limited by the constant core.AmountOfPostsForModeration
+// from moderation queue for the given reportable type.
+func (s *store) GetTargetsForModeration(ctx context.Context, reportableType string, filter core.Filter) (targets []core.ModerationTarget, err error) {
+	err = s.DB.WithContext(ctx).
+		Where("reportable_type = ?", reportableType).
+		Order("created_at " + string(filter)).
+		Limit(core.AmountOfPostsForModeration).
+		Find(&targets).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return targets, nil
+}
+
+// RemoveFromModeration - removes entity from moderation queue.
+func (s *store) RemoveFromModeration(ctx context.Context, reportableID int, reportableType string) error {
+	result := s.DB.WithContext(ctx).
+		Where("reportable_id = ? AND reportable_type = ?", reportableID, reportableType).
+		Delete(&core.ModerationTarget{})
+	if result.Error != nil {
+		logger.Log().Error(ctx, "Failed to remove from moderation: "+result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return core.ErrNotOnModeration
+	}
+
+	return nil
+}
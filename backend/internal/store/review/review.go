@@ -0,0 +1,64 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+type store struct {
+	*postgres.Postgres
+}
+
+func New(pg *postgres.Postgres) core.ReviewStore {
+	return &store{pg}
+}
+
+// GetReviewByID - returns review of the given type by its id.
+func (s *store) GetReviewByID(ctx context.Context, reviewType core.ReviewType, id int) (review core.Review, err error) {
+	err = s.DB.WithContext(ctx).
+		Table(reviewType.TableName()).
+		Where("id = ?", id).
+		First(&review).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Log().Debug(ctx, err.Error())
+			return core.Review{}, core.ErrNoSuchReview
+		}
+		logger.Log().Error(ctx, err.Error())
+
+		return core.Review{}, err
+	}
+
+	return review, nil
+}
+
+// DeleteReview - marks review of the given type as deleted.
+func (s *store) DeleteReview(ctx context.Context, reviewType core.ReviewType, id int) error {
+	now := time.Now().UTC()
+
+	result := s.DB.WithContext(ctx).
+		Table(reviewType.TableName()).
+		Where("id = ? AND is_deleted = ?", id, false).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		logger.Log().Error(ctx, result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return core.ErrNoSuchReview
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package revision
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/kotopesp/sos-kotopes/internal/core"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+	"github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+type store struct {
+	*postgres.Postgres
+}
+
+func New(pg *postgres.Postgres) core.RevisionStore {
+	return &store{pg}
+}
+
+// CreatePostRevision - saves the revision with the next number, the post is locked so concurrent updates get different numbers.
+func (s *store) CreatePostRevision(ctx context.Context, revision core.PostRevision) (core.PostRevision, error) {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		number, err := nextRevision(tx, "posts", "post_revisions", "post_id", revision.PostID)
+		if err != nil {
+			return err
+		}
+
+		revision.Revision = number
+		revision.CreatedAt = time.Now().UTC()
+
+		return tx.Create(&revision).Error
+	})
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.PostRevision{}, err
+	}
+
+	return revision, nil
+}
+
+// GetPostRevisions - retrieves revisions of the post from the first one.
+func (s *store) GetPostRevisions(ctx context.Context, postID int) ([]core.PostRevision, error) {
+	var revisions []core.PostRevision
+
+	err := s.DB.WithContext(ctx).
+		Where("post_id = ?", postID).
+		Order("revision").
+		Find(&revisions).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// CreateCommentRevision - saves the revision with the next number, the comment is locked so concurrent updates get different numbers.
+func (s *store) CreateCommentRevision(ctx context.Context, revision core.CommentRevision) (core.CommentRevision, error) {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		number, err := nextRevision(tx, "comments", "comment_revisions", "comment_id", revision.CommentID)
+		if err != nil {
+			return err
+		}
+
+		revision.Revision = number
+		revision.CreatedAt = time.Now().UTC()
+
+		return tx.Create(&revision).Error
+	})
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return core.CommentRevision{}, err
+	}
+
+	return revision, nil
+}
+
+// GetCommentRevisions - retrieves revisions of the comment from the first one.
+func (s *store) GetCommentRevisions(ctx context.Context, commentID int) ([]core.CommentRevision, error) {
+	var revisions []core.CommentRevision
+
+	err := s.DB.WithContext(ctx).
+		Where("comment_id = ?", commentID).
+		Order("revision").
+		Find(&revisions).Error
+	if err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// nextRevision locks the revised entity within the transaction and returns number of its next revision.
+// Names of tables and the column are constants of the store, they are never taken from input.
+func nextRevision(tx *gorm.DB, table, revisionTable, column string, id int) (int, error) {
+	if err := tx.Exec("SELECT id FROM "+table+" WHERE id = ? FOR UPDATE", id).Error; err != nil {
+		return 0, err
+	}
+
+	var last int
+	err := tx.Raw("SELECT COALESCE(MAX(revision), 0) FROM "+revisionTable+" WHERE "+column+" = ?", id).
+		Scan(&last).Error
+
+	return last + 1, err
+}
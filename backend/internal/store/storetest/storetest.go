@@ -0,0 +1,71 @@
+// Package storetest builds SQL of stores without a database, so queries of stores are checked in unit tests.
+package storetest
+
+import (
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+
+	pg "github.com/kotopesp/sos-kotopes/pkg/postgres"
+)
+
+// Recorder collects statements built by GORM with arguments inlined.
+type Recorder struct {
+	Statements []string
+}
+
+// Last returns the last built statement.
+func (r *Recorder) Last() string {
+	if len(r.Statements) == 0 {
+		return ""
+	}
+	return r.Statements[len(r.Statements)-1]
+}
+
+// record saves the built statement and resets it as GORM does after running it,
+// otherwise the next finisher of the same query would reuse the statement in dry run mode.
+func (r *Recorder) record(db *gorm.DB) {
+	if db.Statement.SQL.Len() == 0 {
+		return
+	}
+
+	r.Statements = append(r.Statements, db.Dialector.Explain(db.Statement.SQL.String(), db.Statement.Vars...))
+	db.Statement.SQL.Reset()
+	db.Statement.Vars = nil
+}
+
+// DryRun returns connection configured as in pkg/postgres which builds statements without running them.
+// Queries return no rows, so stores are checked by statements collected by the recorder.
+func DryRun(t *testing.T) (*pg.Postgres, *Recorder) {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.Open("host=localhost dbname=test"), &gorm.Config{
+		NamingStrategy:       schema.NamingStrategy{SingularTable: true},
+		Logger:               logger.Discard,
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := &Recorder{}
+	callbacks := db.Callback()
+	for _, register := range []func(name string, fn func(*gorm.DB)) error{
+		callbacks.Query().After("*").Register,
+		callbacks.Row().After("*").Register,
+		callbacks.Raw().After("*").Register,
+		callbacks.Create().After("*").Register,
+		callbacks.Update().After("*").Register,
+		callbacks.Delete().After("*").Register,
+	} {
+		if err := register("storetest:record", recorder.record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return &pg.Postgres{DB: db}, recorder
+}
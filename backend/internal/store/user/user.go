@@ -71,13 +71,19 @@ func (s *store) UpdateUser(ctx context.Context, id int, update core.UpdateUser)
 	if update.Description != nil {
 		updates["description"] = *update.Description
 	}
-	if update.Photo != nil {
-		updates["photo"] = *update.Photo
-	}
 	// maybe delete
 	if update.PasswordHash != nil {
 		updates["password_hash"] = *update.PasswordHash
 	}
+	if update.Phone != nil {
+		updates["phone"] = contactValue(*update.Phone)
+	}
+	if update.Telegram != nil {
+		updates["telegram"] = contactValue(*update.Telegram)
+	}
+	if update.VKLink != nil {
+		updates["vk_link"] = contactValue(*update.VKLink)
+	}
 
 	if len(updates) == 0 {
 		logger.Log().Error(ctx, core.ErrEmptyUpdateRequest.Error())
@@ -219,3 +225,11 @@ func (s *store) BanUserWithRecord(ctx context.Context, banRecord core.BannedUser
 
 	return nil
 }
+
+// contactValue returns value of the contact column, empty contact is removed
+func contactValue(contact string) interface{} {
+	if contact == "" {
+		return nil
+	}
+	return contact
+}
@@ -2,8 +2,13 @@ package userfavourite
 
 import (
 	"context"
+	"time"
+
+	"gorm.io/gorm/clause"
 
 	"github.com/kotopesp/sos-kotopes/internal/core"
+	poststore "github.com/kotopesp/sos-kotopes/internal/store/post"
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
 	"github.com/kotopesp/sos-kotopes/pkg/postgres"
 )
 
@@ -15,14 +20,88 @@ func New(pg *postgres.Postgres) core.UserFavouriteStore {
 	return &store{pg}
 }
 
-func (s *store) AddUserToFavourite(ctx context.Context, personID, userID int) (err error) {
-	panic("implement me")
+// AddUserToFavourite saves the person to favourites of the user, the person already added gives core.ErrUserAlreadyInFavourites
+func (s *store) AddUserToFavourite(ctx context.Context, personID, userID int) error {
+	favourite := core.FavouriteUser{
+		PersonID:  personID,
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	result := s.DB.WithContext(ctx).
+		Omit("Person").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&favourite)
+	if result.Error != nil {
+		logger.Log().Error(ctx, result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return core.ErrUserAlreadyInFavourites
+	}
+
+	return nil
+}
+
+// GetFavouriteUsers retrieves users favourited by the user together with the time they were favourited
+func (s *store) GetFavouriteUsers(ctx context.Context, params core.GetFavouriteUsersParams) ([]core.FavouriteUser, int, error) {
+	query := s.DB.WithContext(ctx).Model(&core.FavouriteUser{}).Where("favourite_persons.user_id = ?", params.UserID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	switch params.Sort {
+	case core.FavouriteUsersSortOldest:
+		query = query.Order("favourite_persons.created_at, favourite_persons.id")
+	case core.FavouriteUsersSortUsername:
+		query = query.Joins("JOIN users ON users.id = favourite_persons.person_id").
+			Order("LOWER(users.username), favourite_persons.id")
+	default:
+		query = query.Order("favourite_persons.created_at DESC, favourite_persons.id DESC")
+	}
+
+	if params.Limit != nil {
+		query = query.Limit(*params.Limit)
+	}
+	if params.Offset != nil {
+		query = query.Offset(*params.Offset)
+	}
+
+	var favourites []core.FavouriteUser
+	if err := query.Preload("Person").Find(&favourites).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return nil, 0, err
+	}
+
+	return favourites, int(total), nil
 }
 
-func (s *store) GetFavouriteUsers(ctx context.Context, userID int, params core.GetFavourites) (favouriteUsers []core.User, err error) {
-	panic("implement me")
+// DeleteUserFromFavourite removes the person from favourites of the user, the person missing from favourites isn't an error
+func (s *store) DeleteUserFromFavourite(ctx context.Context, personID, userID int) error {
+	if err := s.DB.WithContext(ctx).
+		Where("person_id = ? AND user_id = ?", personID, userID).
+		Delete(&core.FavouriteUser{}).Error; err != nil {
+		logger.Log().Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
 }
 
-func (s *store) DeleteUserFromFavourite(ctx context.Context, personID, userID int) (err error) {
-	panic("implement me")
+// GetFavouriteUsersPosts retrieves published posts of users favourited by the user based on the GetAllPostsParams
+func (s *store) GetFavouriteUsersPosts(ctx context.Context, userID int, params core.GetAllPostsParams) ([]core.Post, int, error) {
+	query := s.DB.WithContext(ctx).Model(&core.Post{}).
+		Where("posts.status = ?", core.Published).
+		Where("posts.author_id IN (SELECT person_id FROM favourite_persons WHERE user_id = ?)", userID)
+
+	if params.State == nil {
+		state := core.PostActive
+		params.State = &state
+	}
+
+	return poststore.ListPosts(ctx, query, params)
 }
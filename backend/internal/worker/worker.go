@@ -0,0 +1,36 @@
+// Package worker runs periodic background jobs of the app, e.g. archiving of expired posts.
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/kotopesp/sos-kotopes/pkg/logger"
+)
+
+// Job - background job, it must be safe to run the job again after it failed or was interrupted.
+type Job struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Run runs jobs one after another right away and then every interval until the context is done.
+// Failed job is logged and retried on the next run, other jobs are not affected.
+func Run(ctx context.Context, interval time.Duration, jobs ...Job) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, job := range jobs {
+			if err := job.Run(ctx); err != nil {
+				logger.Log().Error(ctx, "error with background job %s: %s", job.Name, err.Error())
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
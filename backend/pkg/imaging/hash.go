@@ -0,0 +1,63 @@
+package imaging
+
+import (
+	"image"
+	"math/bits"
+)
+
+// DHash computes difference hash of the image: brightness of neighbouring cells of 9x8 grid is compared row by row.
+// The hash survives re-encoding, resizing and small color changes, so visually similar images have close hashes.
+func DHash(img *image.RGBA) uint64 {
+	const w, h = 9, 8
+
+	var gray [h][w]uint64
+	for cy := 0; cy < h; cy++ {
+		y0, y1 := cell(cy, h, img.Rect.Dy())
+		for cx := 0; cx < w; cx++ {
+			x0, x1 := cell(cx, w, img.Rect.Dx())
+
+			// a cell of a full-size photo covers millions of pixels, so the sum doesn't fit uint32
+			var sum uint64
+			for y := y0; y < y1; y++ {
+				row := img.Pix[y*img.Stride:]
+				for x := x0; x < x1; x++ {
+					p := row[4*x : 4*x+3]
+					// luma by BT.601, pixels are premultiplied, so transparent areas are dark
+					sum += 299*uint64(p[0]) + 587*uint64(p[1]) + 114*uint64(p[2])
+				}
+			}
+			gray[cy][cx] = sum / uint64((y1-y0)*(x1-x0))
+		}
+	}
+
+	var hash uint64
+	for cy := 0; cy < h; cy++ {
+		for cx := 0; cx < w-1; cx++ {
+			hash <<= 1
+			if gray[cy][cx] < gray[cy][cx+1] {
+				hash |= 1
+			}
+		}
+	}
+
+	return hash
+}
+
+// HashDistance returns amount of different bits of two hashes, 0 means the images are the same.
+func HashDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// cell returns range of source pixels covered by cell i of n, the range is never empty even for tiny images.
+func cell(i, n, size int) (from, to int) {
+	from = i * size / n
+	to = (i + 1) * size / n
+	if to <= from {
+		to = from + 1
+	}
+	if to > size {
+		from, to = size-1, size
+	}
+
+	return from, to
+}
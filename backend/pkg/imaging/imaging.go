@@ -0,0 +1,117 @@
+// Package imaging verifies, normalizes and re-encodes uploaded images.
+// Images are always decoded to pixels and encoded again, so metadata of the original file (EXIF, GPS, comments)
+// never reaches the storage.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+// MIME types of supported formats.
+const (
+	JPEG = "image/jpeg"
+	PNG  = "image/png"
+	GIF  = "image/gif"
+	WebP = "image/webp"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("imaging: unsupported image format")
+	ErrInvalidImage      = errors.New("imaging: invalid image")
+	ErrTooManyPixels     = errors.New("imaging: image has too many pixels")
+)
+
+// decodable - formats accepted as input. WebP is produced only, decoding it requires libraries outside stdlib.
+var decodable = map[string]bool{
+	JPEG: true,
+	PNG:  true,
+	GIF:  true,
+}
+
+// Sniff detects MIME type by the content of the file, extension and type sent by the client are never trusted.
+func Sniff(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if !decodable[contentType] {
+		return "", ErrUnsupportedFormat
+	}
+
+	return contentType, nil
+}
+
+// Decode verifies and decodes the image. Dimensions are checked against maxPixels before pixels are decoded,
+// so small files declaring huge dimensions don't exhaust memory. JPEG images are rotated according to EXIF orientation.
+func Decode(data []byte, maxPixels int) (*image.RGBA, error) {
+	contentType, err := Sniff(data)
+	if err != nil {
+		return nil, err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || config.Width <= 0 || config.Height <= 0 {
+		return nil, ErrInvalidImage
+	}
+	if maxPixels > 0 && config.Width*config.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	var img image.Image
+	switch contentType {
+	case JPEG:
+		img, err = jpeg.Decode(bytes.NewReader(data))
+	case PNG:
+		img, err = png.Decode(bytes.NewReader(data))
+	case GIF:
+		img, err = gif.Decode(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	rgba := toRGBA(img)
+	if contentType == JPEG {
+		rgba = orient(rgba, exifOrientation(data))
+	}
+
+	return rgba, nil
+}
+
+// Encode writes the image in the given format, quality is used by lossy formats only.
+func Encode(img image.Image, contentType string, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+
+	var err error
+	switch contentType {
+	case JPEG:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality})
+	case PNG:
+		err = png.Encode(&buf, img)
+	case WebP:
+		err = EncodeWebP(&buf, img, quality)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) {
+		return rgba
+	}
+
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Rect, img, bounds.Min, draw.Src)
+
+	return rgba
+}
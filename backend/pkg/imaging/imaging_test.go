@@ -0,0 +1,177 @@
+package imaging_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kotopesp/sos-kotopes/pkg/imaging"
+)
+
+func testImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 255 / w), G: uint8(y * 255 / h), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
+
+// withExif inserts APP1 segment with orientation and fake GPS payload right after SOI marker.
+func withExif(data []byte, orientation uint16) []byte {
+	tiff := []byte("II*\x00\x08\x00\x00\x00\x01\x00")
+	entry := make([]byte, 12)
+	binary.LittleEndian.PutUint16(entry[0:], 0x0112)
+	binary.LittleEndian.PutUint16(entry[2:], 3)
+	binary.LittleEndian.PutUint32(entry[4:], 1)
+	binary.LittleEndian.PutUint16(entry[8:], orientation)
+	tiff = append(tiff, entry...)
+	tiff = append(tiff, 0, 0, 0, 0)
+	tiff = append(tiff, []byte("GPS 55.7558 37.6173")...)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	result := append([]byte{}, data[:2]...)
+	result = append(result, segment...)
+	return append(result, data[2:]...)
+}
+
+func TestDecode_AppliesOrientationAndDropsMetadata(t *testing.T) {
+	data := withExif(encodeJPEG(t, testImage(40, 20)), 6)
+
+	img, err := imaging.Decode(data, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 20, img.Rect.Dx())
+	assert.Equal(t, 40, img.Rect.Dy())
+	// rotated clockwise, so bottom left corner of the source becomes top left
+	topLeft := img.RGBAAt(0, 0)
+	assert.Less(t, topLeft.R, uint8(50))
+	assert.Greater(t, topLeft.G, uint8(200))
+
+	encoded, err := imaging.Encode(img, imaging.JPEG, 85)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encoded), "Exif")
+	assert.NotContains(t, string(encoded), "GPS")
+}
+
+func TestDecode_Errors(t *testing.T) {
+	var pngData bytes.Buffer
+	require.NoError(t, png.Encode(&pngData, testImage(100, 100)))
+	jpegData := encodeJPEG(t, testImage(10, 10))
+
+	tests := []struct {
+		name      string
+		data      []byte
+		maxPixels int
+		err       error
+	}{
+		{name: "not an image", data: []byte("<html><body>hello</body></html>"), err: imaging.ErrUnsupportedFormat},
+		{name: "webp input", data: []byte("RIFF\x00\x00\x00\x00WEBPVP8 "), err: imaging.ErrUnsupportedFormat},
+		{name: "truncated", data: jpegData[:len(jpegData)/2], err: imaging.ErrInvalidImage},
+		{name: "too many pixels", data: pngData.Bytes(), maxPixels: 9999, err: imaging.ErrTooManyPixels},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := imaging.Decode(tt.data, tt.maxPixels)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestFit(t *testing.T) {
+	img := testImage(400, 100)
+
+	assert.Equal(t, image.Rect(0, 0, 200, 50), imaging.Fit(img, 200).Rect)
+	assert.Equal(t, image.Rect(0, 0, 20, 80), imaging.Fit(testImage(100, 400), 80).Rect)
+	assert.Same(t, img, imaging.Fit(img, 1000))
+
+	// every pixel is average of the covered pixels, so uniform image stays uniform
+	uniform := image.NewRGBA(image.Rect(0, 0, 33, 17))
+	for i := range uniform.Pix {
+		uniform.Pix[i] = 200
+	}
+	resized := imaging.Fit(uniform, 10)
+	for _, v := range resized.Pix {
+		assert.Equal(t, uint8(200), v)
+	}
+}
+
+func TestEncodeWebP(t *testing.T) {
+	for _, size := range []image.Point{{1, 1}, {17, 33}, {320, 240}} {
+		data, err := imaging.Encode(testImage(size.X, size.Y), imaging.WebP, 80)
+		require.NoError(t, err)
+
+		require.Greater(t, len(data), 30)
+		assert.Equal(t, "RIFF", string(data[0:4]))
+		assert.Equal(t, uint32(len(data)-8), binary.LittleEndian.Uint32(data[4:]))
+		assert.Equal(t, "WEBPVP8 ", string(data[8:16]))
+		// key frame start code followed by dimensions
+		assert.Equal(t, []byte{0x9d, 0x01, 0x2a}, data[23:26])
+		assert.Equal(t, uint16(size.X), binary.LittleEndian.Uint16(data[26:]))
+		assert.Equal(t, uint16(size.Y), binary.LittleEndian.Uint16(data[28:]))
+	}
+}
+
+func TestEncodeWebP_Alpha(t *testing.T) {
+	img := testImage(8, 8)
+	img.Set(0, 0, color.RGBA{})
+
+	data, err := imaging.Encode(img, imaging.WebP, 80)
+	require.NoError(t, err)
+	assert.Equal(t, "WEBPVP8X", string(data[8:16]))
+	assert.Contains(t, string(data), "ALPH")
+}
+
+func TestDHash(t *testing.T) {
+	img := testImage(400, 300)
+	hash := imaging.DHash(img)
+
+	// re-encoded and downscaled copy is recognized
+	decoded, err := imaging.Decode(encodeJPEG(t, imaging.Fit(img, 120)), 0)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, imaging.HashDistance(hash, imaging.DHash(decoded)), 4)
+
+	// mirrored image is a different one
+	mirrored := image.NewRGBA(img.Rect)
+	for y := 0; y < 300; y++ {
+		for x := 0; x < 400; x++ {
+			mirrored.Set(x, y, img.At(399-x, y))
+		}
+	}
+	assert.Greater(t, imaging.HashDistance(hash, imaging.DHash(mirrored)), 32)
+
+	// tiny images are hashed too
+	assert.NotPanics(t, func() { imaging.DHash(testImage(1, 1)) })
+}
+
+func TestDHash_LargeImage(t *testing.T) {
+	// 12 MP photo as taken by phones, sums of its cells overflow 32 bits
+	img := testImage(4000, 3000)
+
+	distance := imaging.HashDistance(imaging.DHash(img), imaging.DHash(imaging.Fit(img, 400)))
+	assert.LessOrEqual(t, distance, 4)
+}
+
+func TestHashDistance(t *testing.T) {
+	assert.Equal(t, 0, imaging.HashDistance(0xff, 0xff))
+	assert.Equal(t, 64, imaging.HashDistance(0, ^uint64(0)))
+	assert.Equal(t, 2, imaging.HashDistance(0b1010, 0b0110))
+}
@@ -0,0 +1,73 @@
+package imaging
+
+import (
+	"image"
+)
+
+// Fit downscales the image so both its sides are not longer than size, aspect ratio is kept.
+// Smaller images are returned as is, images are never upscaled.
+func Fit(img *image.RGBA, size int) *image.RGBA {
+	w, h := img.Rect.Dx(), img.Rect.Dy()
+	if size <= 0 || (w <= size && h <= size) {
+		return img
+	}
+
+	dw, dh := size, size
+	if w > h {
+		dh = max(1, (h*size+w/2)/w)
+	} else {
+		dw = max(1, (w*size+h/2)/h)
+	}
+
+	return resize(img, dw, dh)
+}
+
+// resize scales the image down with box filter: every pixel of the result is average of the source pixels it covers.
+// Pixels are premultiplied by alpha, so transparent pixels don't bleed into the result.
+func resize(img *image.RGBA, dw, dh int) *image.RGBA {
+	w, h := img.Rect.Dx(), img.Rect.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	xs := spans(w, dw)
+	ys := spans(h, dh)
+
+	for dy := 0; dy < dh; dy++ {
+		for dx := 0; dx < dw; dx++ {
+			var sum [4]uint32
+			for sy := ys[dy]; sy < ys[dy+1]; sy++ {
+				row := img.Pix[sy*img.Stride:]
+				for sx := xs[dx]; sx < xs[dx+1]; sx++ {
+					p := row[4*sx : 4*sx+4]
+					sum[0] += uint32(p[0])
+					sum[1] += uint32(p[1])
+					sum[2] += uint32(p[2])
+					sum[3] += uint32(p[3])
+				}
+			}
+
+			count := uint32((ys[dy+1] - ys[dy]) * (xs[dx+1] - xs[dx]))
+			p := dst.Pix[dy*dst.Stride+4*dx:]
+			for i := 0; i < 4; i++ {
+				p[i] = uint8((sum[i] + count/2) / count)
+			}
+		}
+	}
+
+	return dst
+}
+
+// spans splits n source pixels into d consecutive non-empty ranges, range i is [spans[i], spans[i+1]).
+func spans(n, d int) []int {
+	s := make([]int, d+1)
+	for i := 0; i <= d; i++ {
+		s[i] = i * n / d
+	}
+	for i := 1; i <= d; i++ {
+		if s[i] <= s[i-1] {
+			s[i] = s[i-1] + 1
+		}
+	}
+	s[d] = n
+
+	return s
+}
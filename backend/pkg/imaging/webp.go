@@ -0,0 +1,824 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+)
+
+// This file implements minimal lossy WebP encoder (VP8 key frame, RFC 6386) in pure Go.
+// Every macroblock uses whole block prediction (16x16 luma and 8x8 chroma) picked by the smallest error,
+// single coefficient partition, default token probabilities and no loop filter.
+// Alpha channel, when present, is stored uncompressed in ALPH chunk.
+
+const (
+	webpMaxSize   = 16383
+	maxCoeffLevel = 2048
+)
+
+var errWebPTooLarge = errors.New("imaging: image is too large for webp")
+
+// EncodeWebP writes the image to w in lossy WebP format. Quality is in range 1-100.
+func EncodeWebP(w io.Writer, img image.Image, quality int) error {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= 0 || height <= 0 || width > webpMaxSize || height > webpMaxSize {
+		return errWebPTooLarge
+	}
+
+	nrgba := toNRGBA(img)
+	frame := newVP8Encoder(nrgba, quality).encode()
+
+	var chunks bytes.Buffer
+	if alpha := alphaPlane(nrgba); alpha != nil {
+		vp8x := make([]byte, 10)
+		vp8x[0] = 0x10 // alpha flag
+		putUint24(vp8x[4:], uint32(width-1))
+		putUint24(vp8x[7:], uint32(height-1))
+		writeChunk(&chunks, "VP8X", vp8x)
+		// First byte is header: no preprocessing, no filtering, no compression.
+		writeChunk(&chunks, "ALPH", append([]byte{0}, alpha...))
+	}
+	writeChunk(&chunks, "VP8 ", frame)
+
+	header := make([]byte, 12)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(4+chunks.Len()))
+	copy(header[8:], "WEBP")
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(chunks.Bytes())
+	return err
+}
+
+func writeChunk(buf *bytes.Buffer, fourCC string, data []byte) {
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
+	buf.WriteString(fourCC)
+	buf.Write(size[:])
+	buf.Write(data)
+	if len(data)%2 == 1 {
+		buf.WriteByte(0)
+	}
+}
+
+func putUint24(b []byte, v uint32) {
+	b[0], b[1], b[2] = byte(v), byte(v>>8), byte(v>>16)
+}
+
+// alphaPlane returns alpha values of the image, nil is returned for opaque images.
+func alphaPlane(img *image.NRGBA) []byte {
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+	alpha := make([]byte, 0, width*height)
+	opaque := true
+	for y := 0; y < height; y++ {
+		row := img.Pix[y*img.Stride : y*img.Stride+4*width]
+		for x := 0; x < width; x++ {
+			a := row[4*x+3]
+			opaque = opaque && a == 0xff
+			alpha = append(alpha, a)
+		}
+	}
+	if opaque {
+		return nil
+	}
+	return alpha
+}
+
+// Prediction modes in order of the kf_ymode and uv_mode trees.
+const (
+	predDC = iota
+	predV
+	predH
+	predTM
+)
+
+// Token planes, section 13.3.
+const (
+	planeY1WithY2 = 0
+	planeY2       = 1
+	planeUV       = 2
+)
+
+type vp8Encoder struct {
+	width, height int
+	mbw, mbh      int
+
+	// Source planes padded to whole macroblocks.
+	srcY, srcU, srcV []uint8
+	// Reconstructed planes, used as prediction of the next macroblocks the same way decoder does.
+	recY, recU, recV []uint8
+	yStride, cStride int
+
+	quant vp8Quant
+
+	// Non-zero flags of the blocks on the right edge of the previous macroblock and bottom edge of the macroblocks above.
+	leftNZ [9]uint8
+	topNZ  [][9]uint8
+
+	header *boolEncoder
+	tokens *boolEncoder
+}
+
+type vp8Quant struct {
+	qIndex int
+	y1     [2]int32
+	y2     [2]int32
+	uv     [2]int32
+}
+
+func newVP8Encoder(img *image.NRGBA, quality int) *vp8Encoder {
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+	e := &vp8Encoder{
+		width:  width,
+		height: height,
+		mbw:    (width + 15) / 16,
+		mbh:    (height + 15) / 16,
+		header: newBoolEncoder(),
+		tokens: newBoolEncoder(),
+	}
+	e.yStride = e.mbw * 16
+	e.cStride = e.mbw * 8
+	e.srcY = make([]uint8, e.yStride*e.mbh*16)
+	e.srcU = make([]uint8, e.cStride*e.mbh*8)
+	e.srcV = make([]uint8, e.cStride*e.mbh*8)
+	e.recY = make([]uint8, len(e.srcY))
+	e.recU = make([]uint8, len(e.srcU))
+	e.recV = make([]uint8, len(e.srcV))
+	e.topNZ = make([][9]uint8, e.mbw)
+	e.quant = newVP8Quant(quality)
+	e.convert(img)
+
+	return e
+}
+
+func newVP8Quant(quality int) vp8Quant {
+	if quality < 1 {
+		quality = 1
+	}
+	if quality > 100 {
+		quality = 100
+	}
+	q := (100 - quality) * 127 / 99
+
+	quant := vp8Quant{qIndex: q}
+	quant.y1 = [2]int32{int32(dequantTableDC[q]), int32(dequantTableAC[q])}
+	quant.y2 = [2]int32{int32(dequantTableDC[q]) * 2, int32(dequantTableAC[q]) * 155 / 100}
+	if quant.y2[1] < 8 {
+		quant.y2[1] = 8
+	}
+	quant.uv = [2]int32{int32(dequantTableDC[min(q, 117)]), int32(dequantTableAC[q])}
+
+	return quant
+}
+
+// convert fills source planes with BT.601 limited range YUV 4:2:0, the color space VP8 is decoded with.
+// Pixels past the image edges repeat the last row and column.
+func (e *vp8Encoder) convert(img *image.NRGBA) {
+	for y := 0; y < e.mbh*16; y++ {
+		sy := min(y, e.height-1)
+		for x := 0; x < e.yStride; x++ {
+			sx := min(x, e.width-1)
+			p := img.Pix[sy*img.Stride+4*sx:]
+			r, g, b := int32(p[0]), int32(p[1]), int32(p[2])
+			e.srcY[y*e.yStride+x] = uint8((16839*r + 33059*g + 6420*b + 16<<16 + 1<<15) >> 16)
+		}
+	}
+
+	for y := 0; y < e.mbh*8; y++ {
+		for x := 0; x < e.cStride; x++ {
+			var r, g, b int32
+			for j := 0; j < 2; j++ {
+				sy := min(2*y+j, e.height-1)
+				for i := 0; i < 2; i++ {
+					sx := min(2*x+i, e.width-1)
+					p := img.Pix[sy*img.Stride+4*sx:]
+					r, g, b = r+int32(p[0]), g+int32(p[1]), b+int32(p[2])
+				}
+			}
+			// Sums of 4 pixels, so the rounding and shift account for the extra factor.
+			e.srcU[y*e.cStride+x] = clip8((-9719*r - 19081*g + 28800*b + 128<<18 + 1<<17) >> 18)
+			e.srcV[y*e.cStride+x] = clip8((28800*r - 24116*g - 4684*b + 128<<18 + 1<<17) >> 18)
+		}
+	}
+}
+
+func (e *vp8Encoder) encode() []byte {
+	e.writeHeaders()
+
+	for mby := 0; mby < e.mbh; mby++ {
+		e.leftNZ = [9]uint8{}
+		for mbx := 0; mbx < e.mbw; mbx++ {
+			e.encodeMacroblock(mbx, mby)
+		}
+	}
+
+	first := e.header.flush()
+	tokens := e.tokens.flush()
+
+	frame := make([]byte, 10, 10+len(first)+len(tokens))
+	// Frame tag: key frame, version 0, shown, size of the first partition.
+	tag := uint32(len(first))<<5 | 1<<4
+	putUint24(frame[0:], tag)
+	frame[3], frame[4], frame[5] = 0x9d, 0x01, 0x2a
+	binary.LittleEndian.PutUint16(frame[6:], uint16(e.width))
+	binary.LittleEndian.PutUint16(frame[8:], uint16(e.height))
+	frame = append(frame, first...)
+	frame = append(frame, tokens...)
+
+	return frame
+}
+
+// writeHeaders writes frame header, section 9 and 19.2.
+func (e *vp8Encoder) writeHeaders() {
+	h := e.header
+	h.putFlag(false)   // color space
+	h.putFlag(false)   // clamping type
+	h.putFlag(false)   // segmentation
+	h.putFlag(false)   // filter type
+	h.putLiteral(0, 6) // loop filter level, 0 disables the filter
+	h.putLiteral(0, 3) // sharpness
+	h.putFlag(false)   // loop filter deltas
+	h.putLiteral(0, 2) // single coefficient partition
+	h.putLiteral(uint32(e.quant.qIndex), 7)
+	for i := 0; i < 5; i++ {
+		h.putFlag(false) // quantizer deltas
+	}
+	h.putFlag(false) // refresh entropy probs
+	for i := range tokenProbUpdateProb {
+		for j := range tokenProbUpdateProb[i] {
+			for k := range tokenProbUpdateProb[i][j] {
+				for l := range tokenProbUpdateProb[i][j][k] {
+					h.putBit(false, tokenProbUpdateProb[i][j][k][l])
+				}
+			}
+		}
+	}
+	h.putFlag(false) // no skipping of macroblocks without coefficients
+}
+
+func (e *vp8Encoder) encodeMacroblock(mbx, mby int) {
+	var coeffs [25][16]int16 // 16 luma blocks, 4 U, 4 V and Y2
+
+	// Luma.
+	yMode := e.pickMode(e.srcY, e.recY, e.yStride, mbx, mby, 16)
+	var pred [16 * 16]uint8
+	predict(pred[:], e.recY, e.yStride, mbx*16, mby*16, 16, yMode, mbx, mby)
+
+	var dc [16]int16
+	for n := 0; n < 16; n++ {
+		bx, by := mbx*16+(n%4)*4, mby*16+(n/4)*4
+		var block [16]int16
+		forwardDCT(e.srcY[by*e.yStride+bx:], e.yStride, pred[(n/4)*4*16+(n%4)*4:], 16, &block)
+		dc[n] = block[0]
+		block[0] = 0
+		quantize(&block, e.quant.y1, 1, &coeffs[n])
+	}
+	var y2 [16]int16
+	forwardWHT(&dc, &y2)
+	quantize(&y2, e.quant.y2, 0, &coeffs[24])
+
+	// Chroma.
+	uvMode := e.pickChromaMode(mbx, mby)
+	var predU, predV [8 * 8]uint8
+	predict(predU[:], e.recU, e.cStride, mbx*8, mby*8, 8, uvMode, mbx, mby)
+	predict(predV[:], e.recV, e.cStride, mbx*8, mby*8, 8, uvMode, mbx, mby)
+	for n := 0; n < 4; n++ {
+		bx, by := mbx*8+(n%2)*4, mby*8+(n/2)*4
+		off := (n/2)*4*8 + (n%2)*4
+		var block [16]int16
+		forwardDCT(e.srcU[by*e.cStride+bx:], e.cStride, predU[off:], 8, &block)
+		quantize(&block, e.quant.uv, 0, &coeffs[16+n])
+		forwardDCT(e.srcV[by*e.cStride+bx:], e.cStride, predV[off:], 8, &block)
+		quantize(&block, e.quant.uv, 0, &coeffs[20+n])
+	}
+
+	e.writeModes(yMode, uvMode)
+	e.writeCoefficients(mbx, &coeffs)
+	e.reconstruct(mbx, mby, &coeffs, pred[:], predU[:], predV[:])
+}
+
+// pickMode chooses luma prediction with the smallest squared error. Modes reading missing edges are skipped.
+func (e *vp8Encoder) pickMode(src, rec []uint8, stride, mbx, mby, size int) int {
+	best, bestErr := predDC, -1
+	var pred [16 * 16]uint8
+	for mode := predDC; mode <= predTM; mode++ {
+		if (mode == predV && mby == 0) || (mode == predH && mbx == 0) || (mode == predTM && (mbx == 0 || mby == 0)) {
+			continue
+		}
+		predict(pred[:], rec, stride, mbx*size, mby*size, size, mode, mbx, mby)
+		sum := 0
+		for y := 0; y < size; y++ {
+			for x := 0; x < size; x++ {
+				d := int(src[(mby*size+y)*stride+mbx*size+x]) - int(pred[y*size+x])
+				sum += d * d
+			}
+		}
+		if bestErr < 0 || sum < bestErr {
+			best, bestErr = mode, sum
+		}
+	}
+	return best
+}
+
+func (e *vp8Encoder) pickChromaMode(mbx, mby int) int {
+	best, bestErr := predDC, -1
+	var pred [8 * 8]uint8
+	for mode := predDC; mode <= predTM; mode++ {
+		if (mode == predV && mby == 0) || (mode == predH && mbx == 0) || (mode == predTM && (mbx == 0 || mby == 0)) {
+			continue
+		}
+		sum := 0
+		for _, planes := range [2][2][]uint8{{e.srcU, e.recU}, {e.srcV, e.recV}} {
+			predict(pred[:], planes[1], e.cStride, mbx*8, mby*8, 8, mode, mbx, mby)
+			for y := 0; y < 8; y++ {
+				for x := 0; x < 8; x++ {
+					d := int(planes[0][(mby*8+y)*e.cStride+mbx*8+x]) - int(pred[y*8+x])
+					sum += d * d
+				}
+			}
+		}
+		if bestErr < 0 || sum < bestErr {
+			best, bestErr = mode, sum
+		}
+	}
+	return best
+}
+
+// predict fills dst with prediction of size x size block at (x0, y0) built from reconstructed neighbours.
+// DC prediction of edge macroblocks uses only available neighbours, as decoder does.
+func predict(dst []uint8, rec []uint8, stride, x0, y0, size, mode, mbx, mby int) {
+	switch mode {
+	case predDC:
+		sum, count := 0, 0
+		if mby > 0 {
+			for i := 0; i < size; i++ {
+				sum += int(rec[(y0-1)*stride+x0+i])
+			}
+			count += size
+		}
+		if mbx > 0 {
+			for j := 0; j < size; j++ {
+				sum += int(rec[(y0+j)*stride+x0-1])
+			}
+			count += size
+		}
+		value := uint8(0x80)
+		if count > 0 {
+			value = uint8((sum + count/2) / count)
+		}
+		for i := 0; i < size*size; i++ {
+			dst[i] = value
+		}
+	case predV:
+		for j := 0; j < size; j++ {
+			copy(dst[j*size:(j+1)*size], rec[(y0-1)*stride+x0:])
+		}
+	case predH:
+		for j := 0; j < size; j++ {
+			left := rec[(y0+j)*stride+x0-1]
+			for i := 0; i < size; i++ {
+				dst[j*size+i] = left
+			}
+		}
+	case predTM:
+		topLeft := int32(rec[(y0-1)*stride+x0-1])
+		for j := 0; j < size; j++ {
+			left := int32(rec[(y0+j)*stride+x0-1])
+			for i := 0; i < size; i++ {
+				dst[j*size+i] = clip8(left + int32(rec[(y0-1)*stride+x0+i]) - topLeft)
+			}
+		}
+	}
+}
+
+// forwardDCT transforms difference of 4x4 source and prediction blocks, it is the inverse of decoder transform.
+func forwardDCT(src []uint8, srcStride int, pred []uint8, predStride int, out *[16]int16) {
+	var tmp [16]int32
+	for i := 0; i < 4; i++ {
+		d0 := int32(src[i*srcStride+0]) - int32(pred[i*predStride+0])
+		d1 := int32(src[i*srcStride+1]) - int32(pred[i*predStride+1])
+		d2 := int32(src[i*srcStride+2]) - int32(pred[i*predStride+2])
+		d3 := int32(src[i*srcStride+3]) - int32(pred[i*predStride+3])
+		a0, a1, a2, a3 := d0+d3, d1+d2, d1-d2, d0-d3
+		tmp[0+i*4] = (a0 + a1) * 8
+		tmp[1+i*4] = (a2*2217 + a3*5352 + 1812) >> 9
+		tmp[2+i*4] = (a0 - a1) * 8
+		tmp[3+i*4] = (a3*2217 - a2*5352 + 937) >> 9
+	}
+	for i := 0; i < 4; i++ {
+		a0 := tmp[0+i] + tmp[12+i]
+		a1 := tmp[4+i] + tmp[8+i]
+		a2 := tmp[4+i] - tmp[8+i]
+		a3 := tmp[0+i] - tmp[12+i]
+		out[0+i] = int16((a0 + a1 + 7) >> 4)
+		out[4+i] = int16((a2*2217+a3*5352+12000)>>16 + btoi(a3 != 0))
+		out[8+i] = int16((a0 - a1 + 7) >> 4)
+		out[12+i] = int16((a3*2217 - a2*5352 + 51000) >> 16)
+	}
+}
+
+// forwardWHT transforms DC coefficients of 16 luma blocks.
+func forwardWHT(in *[16]int16, out *[16]int16) {
+	var tmp [16]int32
+	for i := 0; i < 4; i++ {
+		a0 := int32(in[i*4+0]) + int32(in[i*4+2])
+		a1 := int32(in[i*4+1]) + int32(in[i*4+3])
+		a2 := int32(in[i*4+1]) - int32(in[i*4+3])
+		a3 := int32(in[i*4+0]) - int32(in[i*4+2])
+		tmp[0+i*4] = a0 + a1
+		tmp[1+i*4] = a3 + a2
+		tmp[2+i*4] = a3 - a2
+		tmp[3+i*4] = a0 - a1
+	}
+	for i := 0; i < 4; i++ {
+		a0 := tmp[0+i] + tmp[8+i]
+		a1 := tmp[4+i] + tmp[12+i]
+		a2 := tmp[4+i] - tmp[12+i]
+		a3 := tmp[0+i] - tmp[8+i]
+		out[0+i] = int16((a0 + a1) >> 1)
+		out[4+i] = int16((a3 + a2) >> 1)
+		out[8+i] = int16((a3 - a2) >> 1)
+		out[12+i] = int16((a0 - a1) >> 1)
+	}
+}
+
+// quantize divides coefficients starting from first by quantizer steps, results are levels written to the stream.
+func quantize(in *[16]int16, steps [2]int32, first int, out *[16]int16) {
+	for i := first; i < 16; i++ {
+		step := steps[btoi(i > 0)]
+		v := int32(in[i])
+		sign := int32(1)
+		if v < 0 {
+			sign, v = -1, -v
+		}
+		level := (v + step*3/8) / step
+		if level > maxCoeffLevel {
+			level = maxCoeffLevel
+		}
+		out[i] = int16(sign * level)
+	}
+}
+
+func (e *vp8Encoder) writeModes(yMode, uvMode int) {
+	h := e.header
+	h.putBit(true, 145) // 16x16 prediction
+	switch yMode {
+	case predDC:
+		h.putBit(false, 156)
+		h.putBit(false, 163)
+	case predV:
+		h.putBit(false, 156)
+		h.putBit(true, 163)
+	case predH:
+		h.putBit(true, 156)
+		h.putBit(false, 128)
+	case predTM:
+		h.putBit(true, 156)
+		h.putBit(true, 128)
+	}
+
+	h.putBit(uvMode != predDC, 142)
+	if uvMode != predDC {
+		h.putBit(uvMode != predV, 114)
+		if uvMode != predV {
+			h.putBit(uvMode != predH, 183)
+		}
+	}
+}
+
+// writeCoefficients writes tokens of the macroblock, non-zero contexts are tracked per block edge:
+// indexes 0-3 are luma, 4-5 are U, 6-7 are V and 8 is Y2.
+func (e *vp8Encoder) writeCoefficients(mbx int, coeffs *[25][16]int16) {
+	top := &e.topNZ[mbx]
+
+	nz := e.writeBlock(planeY2, int(e.leftNZ[8]+top[8]), 0, &coeffs[24])
+	e.leftNZ[8], top[8] = nz, nz
+
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			nz := e.writeBlock(planeY1WithY2, int(e.leftNZ[y]+top[x]), 1, &coeffs[y*4+x])
+			e.leftNZ[y], top[x] = nz, nz
+		}
+	}
+
+	for plane := 0; plane < 2; plane++ {
+		for y := 0; y < 2; y++ {
+			for x := 0; x < 2; x++ {
+				nz := e.writeBlock(planeUV, int(e.leftNZ[4+plane*2+y]+top[4+plane*2+x]), 0, &coeffs[16+plane*4+y*2+x])
+				e.leftNZ[4+plane*2+y], top[4+plane*2+x] = nz, nz
+			}
+		}
+	}
+}
+
+// writeBlock writes levels of one block in zigzag order, section 13. Returns 1 if the block has non-zero levels.
+func (e *vp8Encoder) writeBlock(plane, ctx, first int, levels *[16]int16) uint8 {
+	t := e.tokens
+	probs := &defaultTokenProb[plane]
+
+	last := -1
+	for n := first; n < 16; n++ {
+		if levels[zigzag[n]] != 0 {
+			last = n
+		}
+	}
+
+	p := &probs[bands[first]][ctx]
+	if last < 0 {
+		t.putBit(false, p[0]) // end of block
+		return 0
+	}
+	t.putBit(true, p[0])
+
+	for n := first; n < 16; n++ {
+		v := int32(levels[zigzag[n]])
+		if v == 0 {
+			t.putBit(false, p[1])
+			p = &probs[bands[n+1]][0]
+			continue
+		}
+		t.putBit(true, p[1])
+
+		sign := v < 0
+		if sign {
+			v = -v
+		}
+		if v == 1 {
+			t.putBit(false, p[2])
+			p2 := &probs[bands[n+1]][1]
+			t.putFlag(sign)
+			if n == 15 {
+				return 1
+			}
+			t.putBit(n != last, p2[0])
+			if n == last {
+				return 1
+			}
+			p = p2
+			continue
+		}
+
+		t.putBit(true, p[2])
+		switch {
+		case v <= 4:
+			t.putBit(false, p[3])
+			if v == 2 {
+				t.putBit(false, p[4])
+			} else {
+				t.putBit(true, p[4])
+				t.putBit(v == 4, p[5])
+			}
+		case v <= 10:
+			t.putBit(true, p[3])
+			t.putBit(false, p[6])
+			if v <= 6 {
+				t.putBit(false, p[7])
+				t.putBit(v == 6, 159)
+			} else {
+				t.putBit(true, p[7])
+				t.putBit((v-7)&2 != 0, 165)
+				t.putBit((v-7)&1 != 0, 145)
+			}
+		default:
+			t.putBit(true, p[3])
+			t.putBit(true, p[6])
+			cat := 3
+			for cat < 6 && v >= 3+(8<<(cat-2)) {
+				cat++
+			}
+			b1, b0 := (cat-3)>>1, (cat-3)&1
+			t.putBit(b1 == 1, p[8])
+			t.putBit(b0 == 1, p[9+b1])
+			extra := v - (3 + (8 << (cat - 3)))
+			tab := &cat3456[cat-3]
+			bits := 0
+			for tab[bits] != 0 {
+				bits++
+			}
+			for i := 0; i < bits; i++ {
+				t.putBit(extra&(1<<(bits-1-i)) != 0, tab[i])
+			}
+		}
+
+		p2 := &probs[bands[n+1]][2]
+		t.putFlag(sign)
+		if n == 15 {
+			return 1
+		}
+		t.putBit(n != last, p2[0])
+		if n == last {
+			return 1
+		}
+		p = p2
+	}
+
+	return 1
+}
+
+// reconstruct repeats decoder work for the macroblock, so following macroblocks are predicted from the same pixels.
+func (e *vp8Encoder) reconstruct(mbx, mby int, coeffs *[25][16]int16, predY, predU, predV []uint8) {
+	var y2 [16]int32
+	for i, level := range coeffs[24] {
+		y2[i] = int32(int16(int32(level) * e.quant.y2[btoi(i > 0)]))
+	}
+	dc := inverseWHT(&y2)
+
+	for n := 0; n < 16; n++ {
+		var block [16]int32
+		block[0] = dc[n]
+		for i := 1; i < 16; i++ {
+			block[i] = int32(coeffs[n][i]) * e.quant.y1[1]
+		}
+		bx, by := mbx*16+(n%4)*4, mby*16+(n/4)*4
+		inverseDCT(&block, predY[(n/4)*4*16+(n%4)*4:], 16, e.recY[by*e.yStride+bx:], e.yStride)
+	}
+
+	for n := 0; n < 8; n++ {
+		var block [16]int32
+		for i := 0; i < 16; i++ {
+			block[i] = int32(coeffs[16+n][i]) * e.quant.uv[btoi(i > 0)]
+		}
+		plane, pred := e.recU, predU
+		if n >= 4 {
+			plane, pred = e.recV, predV
+		}
+		m := n % 4
+		bx, by := mbx*8+(m%2)*4, mby*8+(m/2)*4
+		inverseDCT(&block, pred[(m/2)*4*8+(m%2)*4:], 8, plane[by*e.cStride+bx:], e.cStride)
+	}
+}
+
+// inverseWHT is the transform of the decoder, section 14.3.
+func inverseWHT(in *[16]int32) (out [16]int32) {
+	var m [16]int32
+	for i := 0; i < 4; i++ {
+		a0 := in[0+i] + in[12+i]
+		a1 := in[4+i] + in[8+i]
+		a2 := in[4+i] - in[8+i]
+		a3 := in[0+i] - in[12+i]
+		m[0+i] = a0 + a1
+		m[8+i] = a0 - a1
+		m[4+i] = a3 + a2
+		m[12+i] = a3 - a2
+	}
+	for i := 0; i < 4; i++ {
+		dc := m[0+i*4] + 3
+		a0 := dc + m[3+i*4]
+		a1 := m[1+i*4] + m[2+i*4]
+		a2 := m[1+i*4] - m[2+i*4]
+		a3 := dc - m[3+i*4]
+		out[i*4+0] = int32(int16((a0 + a1) >> 3))
+		out[i*4+1] = int32(int16((a3 + a2) >> 3))
+		out[i*4+2] = int32(int16((a0 - a1) >> 3))
+		out[i*4+3] = int32(int16((a3 - a2) >> 3))
+	}
+	return out
+}
+
+// inverseDCT adds inverse transformed coefficients to prediction, section 14.4.
+func inverseDCT(in *[16]int32, pred []uint8, predStride int, dst []uint8, dstStride int) {
+	const (
+		c1 = 85627 // 65536 * cos(pi/8) * sqrt(2)
+		c2 = 35468 // 65536 * sin(pi/8) * sqrt(2)
+	)
+	var coeff [16]int32
+	for i := range in {
+		coeff[i] = int32(int16(in[i]))
+	}
+	var m [4][4]int32
+	for i := 0; i < 4; i++ {
+		a := coeff[i] + coeff[8+i]
+		b := coeff[i] - coeff[8+i]
+		c := (coeff[4+i]*c2)>>16 - (coeff[12+i]*c1)>>16
+		d := (coeff[4+i]*c1)>>16 + (coeff[12+i]*c2)>>16
+		m[i][0] = a + d
+		m[i][1] = b + c
+		m[i][2] = b - c
+		m[i][3] = a - d
+	}
+	for j := 0; j < 4; j++ {
+		dc := m[0][j] + 4
+		a := dc + m[2][j]
+		b := dc - m[2][j]
+		c := (m[1][j]*c2)>>16 - (m[3][j]*c1)>>16
+		d := (m[1][j]*c1)>>16 + (m[3][j]*c2)>>16
+		row := []int32{(a + d) >> 3, (b + c) >> 3, (b - c) >> 3, (a - d) >> 3}
+		for i := 0; i < 4; i++ {
+			dst[j*dstStride+i] = clip8(int32(pred[j*predStride+i]) + row[i])
+		}
+	}
+}
+
+// boolEncoder is the boolean entropy encoder, section 7.3.
+type boolEncoder struct {
+	buf      []byte
+	rng      uint32
+	bottom   uint32
+	bitCount int
+}
+
+func newBoolEncoder() *boolEncoder {
+	return &boolEncoder{rng: 255, bitCount: 24}
+}
+
+// putBit writes bit which is false with probability prob/256.
+func (b *boolEncoder) putBit(bit bool, prob uint8) {
+	split := 1 + (((b.rng - 1) * uint32(prob)) >> 8)
+	if bit {
+		b.bottom += split
+		b.rng -= split
+	} else {
+		b.rng = split
+	}
+	for b.rng < 128 {
+		b.rng <<= 1
+		if b.bottom&(1<<31) != 0 {
+			b.carry()
+		}
+		b.bottom <<= 1
+		b.bitCount--
+		if b.bitCount == 0 {
+			b.buf = append(b.buf, byte(b.bottom>>24))
+			b.bottom &= 1<<24 - 1
+			b.bitCount = 8
+		}
+	}
+}
+
+func (b *boolEncoder) putFlag(bit bool) {
+	b.putBit(bit, 128)
+}
+
+// putLiteral writes n bits of v, most significant bit first.
+func (b *boolEncoder) putLiteral(v uint32, n int) {
+	for i := n - 1; i >= 0; i-- {
+		b.putFlag(v&(1<<i) != 0)
+	}
+}
+
+func (b *boolEncoder) carry() {
+	for i := len(b.buf) - 1; i >= 0; i-- {
+		b.buf[i]++
+		if b.buf[i] != 0 {
+			return
+		}
+	}
+}
+
+// flush pads the stream with zero bits, so decoder never reads past the end of data.
+func (b *boolEncoder) flush() []byte {
+	for i := 0; i < 32; i++ {
+		b.putFlag(false)
+	}
+	return b.buf
+}
+
+func clip8(v int32) uint8 {
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+	return uint8(v)
+}
+
+func btoi(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// toNRGBA returns the image as non-premultiplied RGBA with bounds starting at zero.
+func toNRGBA(img image.Image) *image.NRGBA {
+	if nrgba, ok := img.(*image.NRGBA); ok && nrgba.Rect.Min == (image.Point{}) {
+		return nrgba
+	}
+	bounds := img.Bounds()
+	nrgba := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	if rgba, ok := img.(*image.RGBA); ok {
+		for y := 0; y < bounds.Dy(); y++ {
+			for x := 0; x < bounds.Dx(); x++ {
+				c := color.NRGBAModel.Convert(rgba.RGBAAt(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+				i := y*nrgba.Stride + 4*x
+				nrgba.Pix[i], nrgba.Pix[i+1], nrgba.Pix[i+2], nrgba.Pix[i+3] = c.R, c.G, c.B, c.A
+			}
+		}
+		return nrgba
+	}
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			c := color.NRGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+			i := y*nrgba.Stride + 4*x
+			nrgba.Pix[i], nrgba.Pix[i+1], nrgba.Pix[i+2], nrgba.Pix[i+3] = c.R, c.G, c.B, c.A
+		}
+	}
+	return nrgba
+}
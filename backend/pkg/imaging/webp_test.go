@@ -0,0 +1,158 @@
+package imaging_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/webp"
+
+	"github.com/kotopesp/sos-kotopes/pkg/imaging"
+)
+
+// patternImage returns gradient with sharp edges of a checkerboard, so both flat and detailed blocks are encoded.
+func patternImage(w, h int) *image.RGBA {
+	img := testImage(w, h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if (x/12+y/12)%2 == 0 && x > w/2 {
+				img.Set(x, y, color.RGBA{R: 240, G: 240, B: 20, A: 255})
+			}
+		}
+	}
+	return img
+}
+
+// toRGB converts the decoded frame to RGB as browsers do. VP8 keeps colors in limited range of BT.601,
+// while image.YCbCr of the decoder converts them in full range of JFIF, so colors of At would be shifted.
+func toRGB(t *testing.T, img image.Image) *image.RGBA {
+	var frame *image.YCbCr
+	switch decoded := img.(type) {
+	case *image.YCbCr:
+		frame = decoded
+	case *image.NYCbCrA:
+		frame = &decoded.YCbCr
+	default:
+		t.Fatalf("unexpected type of decoded frame %T", img)
+	}
+
+	clamp := func(v float64) uint8 {
+		return uint8(math.Max(0, math.Min(255, math.Round(v))))
+	}
+
+	bounds := frame.Bounds()
+	rgb := image.NewRGBA(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			yy := 1.164 * (float64(frame.Y[frame.YOffset(x, y)]) - 16)
+			u := float64(frame.Cb[frame.COffset(x, y)]) - 128
+			v := float64(frame.Cr[frame.COffset(x, y)]) - 128
+			rgb.Set(x, y, color.RGBA{R: clamp(yy + 1.596*v), G: clamp(yy - 0.813*v - 0.391*u), B: clamp(yy + 2.018*u), A: 255})
+		}
+	}
+	return rgb
+}
+
+// lumaError returns mean absolute difference of luma of the decoded frame and luma of the image computed as the encoder does.
+// Luma is not subsampled, so it shows loss of compression only.
+func lumaError(img *image.RGBA, decoded image.Image) float64 {
+	frame, ok := decoded.(*image.YCbCr)
+	if !ok {
+		return math.Inf(1)
+	}
+
+	var sum float64
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := img.RGBAAt(x, y)
+			luma := (16839*int(c.R) + 33059*int(c.G) + 6420*int(c.B) + 16<<16 + 1<<15) >> 16
+			sum += math.Abs(float64(int(frame.Y[frame.YOffset(x, y)]) - luma))
+		}
+	}
+	return sum / float64(bounds.Dx()*bounds.Dy())
+}
+
+// meanError returns mean absolute difference of the channels of the images in range 0-255.
+func meanError(a, b image.Image) float64 {
+	var sum, count float64
+	bounds := a.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r1, g1, b1, _ := a.At(x, y).RGBA()
+			r2, g2, b2, _ := b.At(x, y).RGBA()
+			for _, d := range []int{int(r1>>8) - int(r2>>8), int(g1>>8) - int(g2>>8), int(b1>>8) - int(b2>>8)} {
+				if d < 0 {
+					d = -d
+				}
+				sum += float64(d)
+				count++
+			}
+		}
+	}
+	return sum / count
+}
+
+// The encoder is checked by the reference decoder, so files are readable by browsers and not only by the header.
+func TestEncodeWebP_RoundTrip(t *testing.T) {
+	for _, size := range []image.Point{{1, 1}, {17, 33}, {320, 240}} {
+		var buf bytes.Buffer
+		require.NoError(t, imaging.EncodeWebP(&buf, patternImage(size.X, size.Y), 80))
+
+		decoded, err := webp.Decode(&buf)
+		require.NoError(t, err, size)
+
+		assert.Equal(t, size, decoded.Bounds().Size())
+		// sharp edges of the pattern are kept by luma, chroma is subsampled and blurs them
+		assert.Less(t, lumaError(patternImage(size.X, size.Y), decoded), 2.0, size)
+
+		buf.Reset()
+		require.NoError(t, imaging.EncodeWebP(&buf, testImage(size.X, size.Y), 80))
+
+		decoded, err = webp.Decode(&buf)
+		require.NoError(t, err, size)
+		assert.Less(t, meanError(testImage(size.X, size.Y), toRGB(t, decoded)), 6.0, size)
+	}
+}
+
+func TestEncodeWebP_RoundTripQuality(t *testing.T) {
+	img := patternImage(160, 120)
+
+	errors := make(map[int]float64)
+	for _, quality := range []int{10, 90} {
+		var buf bytes.Buffer
+		require.NoError(t, imaging.EncodeWebP(&buf, img, quality))
+
+		decoded, err := webp.Decode(&buf)
+		require.NoError(t, err)
+		errors[quality] = lumaError(img, decoded)
+	}
+
+	assert.Less(t, errors[90], errors[10])
+}
+
+func TestEncodeWebP_RoundTripAlpha(t *testing.T) {
+	img := patternImage(40, 30)
+	for x := 0; x < 40; x++ {
+		img.Set(x, 0, color.RGBA{})
+		img.Set(x, 1, color.NRGBA{R: 200, G: 100, B: 50, A: 128})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, imaging.EncodeWebP(&buf, img, 80))
+
+	decoded, err := webp.Decode(&buf)
+	require.NoError(t, err)
+	require.Equal(t, img.Bounds().Size(), decoded.Bounds().Size())
+
+	// alpha is stored losslessly
+	for x := 0; x < 40; x++ {
+		assert.Equal(t, uint8(0), color.NRGBAModel.Convert(decoded.At(x, 0)).(color.NRGBA).A)
+		assert.Equal(t, uint8(128), color.NRGBAModel.Convert(decoded.At(x, 1)).(color.NRGBA).A)
+		assert.Equal(t, uint8(255), color.NRGBAModel.Convert(decoded.At(x, 2)).(color.NRGBA).A)
+	}
+}